@@ -600,6 +600,12 @@ func (bs *BlockStore) AddTxs(storeBatch dbm.Batch, blockDetail *types.BlockDetai
 		return err
 	}
 	//storelog.Info("add txs kv num", "n", len(kv.KV))
+	bs.setLocalKV(storeBatch, kv)
+	return nil
+}
+
+//setLocalKV 将执行器返回的localdb kv写入batch, value 为nil的时候删除
+func (bs *BlockStore) setLocalKV(storeBatch dbm.Batch, kv *types.LocalDBSet) {
 	for i := 0; i < len(kv.KV); i++ {
 		if kv.KV[i].Value == nil {
 			storeBatch.Delete(kv.KV[i].Key)
@@ -607,7 +613,6 @@ func (bs *BlockStore) AddTxs(storeBatch dbm.Batch, blockDetail *types.BlockDetai
 			storeBatch.Set(kv.KV[i].Key, kv.KV[i].Value)
 		}
 	}
-	return nil
 }
 
 //DelTxs 通过批量删除tx信息从db中
@@ -1057,3 +1062,95 @@ func (bs *BlockStore) SetUpgradeMeta(meta *types.UpgradeMeta) error {
 	storeLog.Info("SetUpgradeMeta", "meta", meta)
 	return bs.db.SetSync(version.LocalDBMeta, verByte)
 }
+
+//GetExecLocalDBMeta 获取执行器的localdb版本, 没有保存版本的执行器的版本是0
+func (bs *BlockStore) GetExecLocalDBMeta(name string) (*types.ExecLocalDBMeta, error) {
+	value, err := bs.db.Get(types.CalcExecLocalDBVersionKey(name))
+	if err != nil && err != dbm.ErrNotFoundInDb {
+		return nil, err
+	}
+	meta := &types.ExecLocalDBMeta{Name: name}
+	if len(value) == 0 {
+		return meta, nil
+	}
+	err = types.Decode(value, meta)
+	if err != nil {
+		return nil, err
+	}
+	return meta, nil
+}
+
+//SetExecLocalDBMeta 设置执行器的localdb版本, 版本为0并且没有在重建的时候不保存
+func (bs *BlockStore) SetExecLocalDBMeta(meta *types.ExecLocalDBMeta) error {
+	storeLog.Info("SetExecLocalDBMeta", "meta", meta)
+	key := types.CalcExecLocalDBVersionKey(meta.Name)
+	if meta.Version == 0 && !meta.Indexing {
+		return bs.db.DeleteSync(key)
+	}
+	return bs.db.SetSync(key, types.Encode(meta))
+}
+
+//delExecLocalKeys 删除执行器在localdb中的数据, 执行器的key的格式是 LODB-execer-xxx
+func (bs *BlockStore) delExecLocalKeys(name string) {
+	it := bs.db.Iterator(types.LocalPrefix, nil, false)
+	defer it.Close()
+	batch := bs.db.NewBatch(true)
+	count := 0
+	for it.Rewind(); it.Valid(); it.Next() {
+		if it.Error() != nil {
+			panic(it.Error())
+		}
+		key := it.Key()
+		execer := key[len(types.LocalPrefix):]
+		if len(execer) == 0 || execer[0] != '-' {
+			continue
+		}
+		execer = execer[1:]
+		end := bytes.IndexByte(execer, '-')
+		if end <= 0 || string(types.GetRealExecName(execer[:end])) != name {
+			continue
+		}
+		batch.Delete(common.CopyBytes(key))
+		count++
+		if count%10000 == 0 {
+			err := batch.Write()
+			if err != nil {
+				panic(err)
+			}
+			batch = bs.db.NewBatch(true)
+			storeLog.Info("delExecLocalKeys", "exec", name, "count", count)
+		}
+	}
+	err := batch.Write()
+	if err != nil {
+		panic(err)
+	}
+	storeLog.Info("delExecLocalKeys", "exec", name, "count", count)
+}
+
+func (bs *BlockStore) getExecLocalDBVersions() (*types.ExecLocalDBMetas, error) {
+	if bs.client == nil {
+		panic("client not bind message queue.")
+	}
+	msg := bs.client.NewMessage("execs", types.EventExecLocalDBVersions, &types.ReqNil{})
+	bs.client.Send(msg, true)
+	resp, err := bs.client.Wait(msg)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetData().(*types.ExecLocalDBMetas), nil
+}
+
+//reIndexExecLocalKV 只获取 execs 中执行器的 ExecLocal 数据
+func (bs *BlockStore) reIndexExecLocalKV(detail *types.BlockDetail, execs []string) (*types.LocalDBSet, error) {
+	if bs.client == nil {
+		panic("client not bind message queue.")
+	}
+	msg := bs.client.NewMessage("execs", types.EventReIndexExecLocal, &types.ReqReIndexExecLocal{Detail: detail, Execs: execs})
+	bs.client.Send(msg, true)
+	resp, err := bs.client.Wait(msg)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetData().(*types.LocalDBSet), nil
+}
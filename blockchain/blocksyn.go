@@ -519,6 +519,18 @@ func (chain *BlockChain) RecordFaultPeer(pid string, height int64, hash []byte,
 
 	var faultnode FaultPeerInfo
 
+	//通知p2p模块扣除节点评分, 只有区块本身校验失败时才通知, 本节点的错误不能算到对端头上
+	if pid != "self" && chain.client != nil && isInvalidBlockErr(err) && chain.markBlockReported(hash) {
+		report := &types.ReportPeer{Pid: pid, Kind: types.PeerInvalidBlock}
+		if err != nil {
+			report.Reason = err.Error()
+		}
+		msg := chain.client.NewMessage("p2p", types.EventReportPeer, report)
+		if err := chain.client.SendTimeout(msg, false, time.Second); err != nil {
+			synlog.Error("RecordFaultPeer", "ReportPeer err", err)
+		}
+	}
+
 	//通过pid获取peerinfo
 	peerinfo := chain.GetPeerInfo(pid)
 	if peerinfo == nil {
@@ -533,6 +545,36 @@ func (chain *BlockChain) RecordFaultPeer(pid string, height int64, hash []byte,
 	chain.AddFaultPeer(&faultnode)
 }
 
+//区块本身校验不通过的错误, 共识模块的CheckBlock错误通过queue返回, 只能按照错误信息比较
+var invalidBlockErrs = []error{
+	types.ErrSign,
+	types.ErrTxDup,
+	types.ErrBlockExec,
+	types.ErrCheckTxHash,
+	types.ErrCheckStateHash,
+	types.ErrBlockHeight,
+	types.ErrBlockTime,
+	types.ErrParentHash,
+}
+
+func isInvalidBlockErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	for _, e := range invalidBlockErrs {
+		if err == e || err.Error() == e.Error() {
+			return true
+		}
+	}
+	return false
+}
+
+//markBlockReported 标记区块已经通知过p2p, 已经通知过时返回false
+func (chain *BlockChain) markBlockReported(hash []byte) bool {
+	ok, _ := chain.reportedBlocks.ContainsOrAdd(string(hash), struct{}{})
+	return !ok
+}
+
 //PrintFaultPeer 打印出错的节点
 func (chain *BlockChain) PrintFaultPeer() {
 	faultpeerlock.Lock()
@@ -0,0 +1,62 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordFaultPeerReport(t *testing.T) {
+	q := queue.New("channel")
+	defer q.Close()
+	chain := New(&types.BlockChain{})
+	chain.client = q.Client()
+
+	p2p := q.Client()
+	p2p.Sub("p2p")
+	reported := make(chan *types.ReportPeer, 10)
+	go func() {
+		for msg := range p2p.Recv() {
+			if msg.Ty == types.EventReportPeer {
+				reported <- msg.GetData().(*types.ReportPeer)
+			}
+		}
+	}()
+
+	hash := []byte("badblock")
+	//本节点的错误不通知
+	chain.RecordFaultPeer("peer1", 1, hash, types.ErrTimeout)
+	chain.RecordFaultPeer("peer1", 1, hash, errors.New("ErrDataBaseDamage"))
+	//共识模块通过queue返回的错误按照错误信息匹配
+	chain.RecordFaultPeer("peer1", 1, hash, errors.New(types.ErrBlockTime.Error()))
+	//同一个区块只通知一次
+	chain.RecordFaultPeer("peer1", 1, hash, types.ErrCheckStateHash)
+	chain.RecordFaultPeer("peer2", 1, hash, types.ErrCheckStateHash)
+	chain.RecordFaultPeer("peer2", 2, []byte("badblock2"), types.ErrSign)
+
+	var pids []string
+	timeout := time.After(time.Second)
+	for len(pids) < 2 {
+		select {
+		case r := <-reported:
+			assert.Equal(t, int32(types.PeerInvalidBlock), r.Kind)
+			pids = append(pids, r.Pid)
+		case <-timeout:
+			t.Fatal("wait report timeout", pids)
+		}
+	}
+	assert.Equal(t, []string{"peer1", "peer2"}, pids)
+	select {
+	case r := <-reported:
+		t.Error("unexpected report", r)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
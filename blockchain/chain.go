@@ -39,6 +39,9 @@ var (
 
 const maxFutureBlocks = 256
 
+//已经通知 p2p 扣除评分的错误区块数
+const maxReportedBlocks = 1024
+
 //BlockChain 区块链结构体
 type BlockChain struct {
 	client queue.Client
@@ -98,6 +101,9 @@ type BlockChain struct {
 	//记录futureblocks
 	futureBlocks *lru.Cache // future blocks are broadcast later processing
 
+	//记录已经通知p2p扣除节点评分的错误区块hash, 同一个区块只通知一次
+	reportedBlocks *lru.Cache
+
 	//fork block req
 	forkInfo *ForkInfo
 	forklock sync.Mutex
@@ -107,6 +113,7 @@ type BlockChain struct {
 func New(cfg *types.BlockChain) *BlockChain {
 	initConfig(cfg)
 	futureBlocks, _ := lru.New(maxFutureBlocks)
+	reportedBlocks, _ := lru.New(maxReportedBlocks)
 
 	blockchain := &BlockChain{
 		cache:              NewBlockCache(DefCacheSize),
@@ -131,6 +138,7 @@ func New(cfg *types.BlockChain) *BlockChain {
 		faultPeerList:       make(map[string]*FaultPeerInfo),
 		bestChainPeerList:   make(map[string]*BestPeerInfo),
 		futureBlocks:        futureBlocks,
+		reportedBlocks:      reportedBlocks,
 		forkInfo:            &ForkInfo{},
 	}
 
@@ -91,6 +91,8 @@ func (chain *BlockChain) ProcRecvMsg() {
 
 		case types.EventGetSeqCBLastNum:
 			go chain.processMsg(msg, reqnum, chain.getSeqCBLastNum)
+		case types.EventGetExecLocalDBMetas:
+			go chain.processMsg(msg, reqnum, chain.getExecLocalDBMetas)
 		default:
 			go chain.processMsg(msg, reqnum, chain.unknowMsg)
 		}
@@ -134,6 +136,16 @@ func (chain *BlockChain) getSeqCBLastNum(msg queue.Message) {
 	msg.Reply(chain.client.NewMessage("rpc", types.EventGetSeqCBLastNum, lastNum))
 }
 
+func (chain *BlockChain) getExecLocalDBMetas(msg queue.Message) {
+	metas, err := chain.GetExecLocalDBMetas()
+	if err != nil {
+		chainlog.Error("getExecLocalDBMetas", "err", err.Error())
+		msg.Reply(chain.client.NewMessage("rpc", types.EventGetExecLocalDBMetas, err))
+		return
+	}
+	msg.Reply(chain.client.NewMessage("rpc", types.EventGetExecLocalDBMetas, metas))
+}
+
 func (chain *BlockChain) queryTx(msg queue.Message) {
 	txhash := (msg.Data).(*types.ReqHash)
 	TransactionDetail, err := chain.ProcQueryTxMsg(txhash.Hash)
@@ -315,22 +315,52 @@ func (b *BlockChain) connectBlock(node *blockNode, blockdetail *types.BlockDetai
 		b.index.UpdateNode(prevhash, node)
 	}
 
-	beg := types.Now()
-	// 写入磁盘 批量将block信息写入磁盘
-	newbatch := b.blockStore.NewBatch(sync)
-
 	//保存tx信息到db中
-	err = b.blockStore.AddTxs(newbatch, blockdetail)
+	localKV, err := b.blockStore.getLocalKV(blockdetail)
 	if err != nil {
 		chainlog.Error("connectBlock indexTxs:", "height", block.Height, "err", err)
 		return nil, err
 	}
+	lastSequence, err = b.writeBlock(node, blockdetail, localKV, sync)
+	if err != nil {
+		return nil, err
+	}
+
+	b.SendAddBlockEvent(blockdetail)
+
+	// 通知此block已经处理完，主要处理孤儿节点时需要设置
+	b.task.Done(blockdetail.Block.GetHeight())
+
+	//广播此block到全网络
+	if node.broadcast {
+		if blockdetail.Block.BlockTime-types.Now().Unix() > FutureBlockDelayTime {
+			//将此block添加到futureblocks中延时广播
+			b.futureBlocks.Add(string(blockdetail.Block.Hash()), blockdetail)
+			chainlog.Debug("connectBlock futureBlocks.Add", "height", block.Height, "hash", common.ToHex(blockdetail.Block.Hash()), "blocktime", blockdetail.Block.BlockTime, "curtime", types.Now().Unix())
+		} else {
+			b.SendBlockBroadcast(blockdetail)
+		}
+	}
+	//目前非平行链并开启isRecordBlockSequence功能
+	if isRecordBlockSequence && !isParaChain {
+		b.pushseq.updateSeq(lastSequence)
+	}
+	return blockdetail, nil
+}
+
+//writeBlock 将执行好的block以及localdb的kv批量写入磁盘, 并更新bestchain的tip节点
+func (b *BlockChain) writeBlock(node *blockNode, blockdetail *types.BlockDetail, localKV *types.LocalDBSet, sync bool) (int64, error) {
+	block := blockdetail.Block
+	beg := types.Now()
+	// 写入磁盘 批量将block信息写入磁盘
+	newbatch := b.blockStore.NewBatch(sync)
+	b.blockStore.setLocalKV(newbatch, localKV)
 
 	//保存block信息到db中
-	lastSequence, err = b.blockStore.SaveBlock(newbatch, blockdetail, node.sequence)
+	lastSequence, err := b.blockStore.SaveBlock(newbatch, blockdetail, node.sequence)
 	if err != nil {
 		chainlog.Error("connectBlock SaveBlock:", "height", block.Height, "err", err)
-		return nil, err
+		return 0, err
 	}
 	//cache new add block
 	b.cache.cacheBlock(blockdetail)
@@ -341,10 +371,10 @@ func (b *BlockChain) connectBlock(node *blockNode, blockdetail *types.BlockDetai
 	if block.Height == 0 {
 		blocktd = difficulty
 	} else {
-		parenttd, err := b.blockStore.GetTdByBlockHash(parentHash)
+		parenttd, err := b.blockStore.GetTdByBlockHash(block.ParentHash)
 		if err != nil {
-			chainlog.Error("connectBlock GetTdByBlockHash", "height", block.Height, "parentHash", common.ToHex(parentHash))
-			return nil, err
+			chainlog.Error("connectBlock GetTdByBlockHash", "height", block.Height, "parentHash", common.ToHex(block.ParentHash))
+			return 0, err
 		}
 		blocktd = new(big.Int).Add(difficulty, parenttd)
 	}
@@ -352,13 +382,13 @@ func (b *BlockChain) connectBlock(node *blockNode, blockdetail *types.BlockDetai
 	err = b.blockStore.SaveTdByBlockHash(newbatch, blockdetail.Block.Hash(), blocktd)
 	if err != nil {
 		chainlog.Error("connectBlock SaveTdByBlockHash:", "height", block.Height, "err", err)
-		return nil, err
+		return 0, err
 	}
 	err = newbatch.Write()
 	if err != nil {
 		chainlog.Error("connectBlock newbatch.Write", "err", err)
 		go util.ReportErrEventToFront(chainlog, b.client, "blockchain", "wallet", types.ErrDataBaseDamage)
-		return nil, err
+		return 0, err
 	}
 	chainlog.Debug("connectBlock write db", "height", block.Height, "batchsync", sync, "cost", types.Since(beg))
 
@@ -370,27 +400,7 @@ func (b *BlockChain) connectBlock(node *blockNode, blockdetail *types.BlockDetai
 	b.bestChain.SetTip(node)
 
 	b.query.updateStateHash(blockdetail.GetBlock().GetStateHash())
-
-	b.SendAddBlockEvent(blockdetail)
-
-	// 通知此block已经处理完，主要处理孤儿节点时需要设置
-	b.task.Done(blockdetail.Block.GetHeight())
-
-	//广播此block到全网络
-	if node.broadcast {
-		if blockdetail.Block.BlockTime-types.Now().Unix() > FutureBlockDelayTime {
-			//将此block添加到futureblocks中延时广播
-			b.futureBlocks.Add(string(blockdetail.Block.Hash()), blockdetail)
-			chainlog.Debug("connectBlock futureBlocks.Add", "height", block.Height, "hash", common.ToHex(blockdetail.Block.Hash()), "blocktime", blockdetail.Block.BlockTime, "curtime", types.Now().Unix())
-		} else {
-			b.SendBlockBroadcast(blockdetail)
-		}
-	}
-	//目前非平行链并开启isRecordBlockSequence功能
-	if isRecordBlockSequence && !isParaChain {
-		b.pushseq.updateSeq(lastSequence)
-	}
-	return blockdetail, nil
+	return lastSequence, nil
 }
 
 //从主链中删除blocks
@@ -26,6 +26,7 @@ func (chain *BlockChain) UpgradeChain() {
 		if err != nil {
 			panic(err)
 		}
+		chain.resetExecLocalDBMetas()
 	}
 	if chain.needReIndex(meta) {
 		//如果没有开始重建index，那么先del all keys
@@ -46,7 +47,10 @@ func (chain *BlockChain) UpgradeChain() {
 		if err != nil {
 			panic(err)
 		}
+		//全部重建之后, 所有执行器的localdb都是最新的版本
+		chain.resetExecLocalDBMetas()
 	}
+	chain.ReIndexExecs(curheight)
 }
 
 func (chain *BlockChain) reIndex(start, end int64) {
@@ -96,3 +100,138 @@ func (chain *BlockChain) reIndexOne(height int64) error {
 	newbatch.Set(version.LocalDBMeta, types.Encode(meta))
 	return newbatch.Write()
 }
+
+//resetExecLocalDBMetas 保存所有执行器当前的localdb版本
+func (chain *BlockChain) resetExecLocalDBMetas() {
+	versions, err := chain.blockStore.getExecLocalDBVersions()
+	if err != nil {
+		panic(err)
+	}
+	for _, v := range versions.Metas {
+		err = chain.blockStore.SetExecLocalDBMeta(&types.ExecLocalDBMeta{Name: v.Name, Version: v.Version})
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
+//ReIndexExecs 只重建localdb版本改变的执行器, 重建的过程中每个高度都会保存进度, 中断之后可以继续
+func (chain *BlockChain) ReIndexExecs(curheight int64) {
+	if curheight < 0 {
+		return
+	}
+	metas, err := chain.getReIndexExecMetas()
+	if err != nil {
+		panic(err)
+	}
+	if len(metas) == 0 {
+		return
+	}
+	start := curheight + 1
+	for _, meta := range metas {
+		if meta.Height < start {
+			start = meta.Height
+		}
+	}
+	chainlog.Info("begin reindex exec localdb", "start", start, "end", curheight)
+	for i := start; i <= curheight; i++ {
+		err := chain.reIndexExecsOne(i, curheight, metas)
+		if err != nil {
+			panic(err)
+		}
+	}
+	for _, meta := range metas {
+		meta.Indexing = false
+		meta.Height = 0
+		err = chain.blockStore.SetExecLocalDBMeta(meta)
+		if err != nil {
+			panic(err)
+		}
+	}
+	chainlog.Info("end reindex exec localdb", "end", curheight)
+}
+
+//GetExecLocalDBMetas 获取每个执行器localdb的版本和上一次中断的重建进度,
+//UpgradeChain 在 rpc 启动之前运行, 重建过程中的进度只能通过日志查看
+func (chain *BlockChain) GetExecLocalDBMetas() (*types.ExecLocalDBMetas, error) {
+	versions, err := chain.blockStore.getExecLocalDBVersions()
+	if err != nil {
+		return nil, err
+	}
+	metas := &types.ExecLocalDBMetas{}
+	for _, v := range versions.Metas {
+		meta, err := chain.blockStore.GetExecLocalDBMeta(v.Name)
+		if err != nil {
+			return nil, err
+		}
+		metas.Metas = append(metas.Metas, meta)
+	}
+	return metas, nil
+}
+
+//getReIndexExecMetas 找出版本改变的执行器, 第一次重建的时候先删除执行器的localdb
+func (chain *BlockChain) getReIndexExecMetas() ([]*types.ExecLocalDBMeta, error) {
+	versions, err := chain.blockStore.getExecLocalDBVersions()
+	if err != nil {
+		return nil, err
+	}
+	var metas []*types.ExecLocalDBMeta
+	for _, v := range versions.Metas {
+		meta, err := chain.blockStore.GetExecLocalDBMeta(v.Name)
+		if err != nil {
+			return nil, err
+		}
+		if meta.Version == v.Version && !meta.Indexing {
+			continue
+		}
+		//重建的过程中版本又改变了, 需要重新开始
+		if !meta.Indexing || meta.Version != v.Version {
+			chainlog.Info("reindex exec localdb", "exec", v.Name, "from", meta.Version, "to", v.Version)
+			chain.blockStore.delExecLocalKeys(v.Name)
+			meta = &types.ExecLocalDBMeta{Name: v.Name, Version: v.Version, Indexing: true}
+			err = chain.blockStore.SetExecLocalDBMeta(meta)
+			if err != nil {
+				return nil, err
+			}
+		}
+		metas = append(metas, meta)
+	}
+	return metas, nil
+}
+
+func (chain *BlockChain) reIndexExecsOne(height, end int64, metas []*types.ExecLocalDBMeta) error {
+	var execs []string
+	for _, meta := range metas {
+		if meta.Height <= height {
+			execs = append(execs, meta.Name)
+		}
+	}
+	blockdetail, err := chain.GetBlock(height)
+	if err != nil {
+		chainlog.Error("reIndexExecsOne.GetBlock", "err", err)
+		return err
+	}
+	if height%1000 == 0 {
+		chainlog.Info("reindex exec localdb -> ", "height", height, "end", end, "execs", execs)
+	}
+	kv, err := chain.blockStore.reIndexExecLocalKV(blockdetail, execs)
+	if err != nil {
+		chainlog.Error("reIndexExecsOne", "height", height, "err", err)
+		return err
+	}
+	newbatch := chain.blockStore.NewBatch(false)
+	for i := 0; i < len(kv.KV); i++ {
+		if kv.KV[i].Value == nil {
+			newbatch.Delete(kv.KV[i].Key)
+		} else {
+			newbatch.Set(kv.KV[i].Key, kv.KV[i].Value)
+		}
+	}
+	for _, meta := range metas {
+		if meta.Height <= height {
+			meta.Height = height + 1
+			newbatch.Set(types.CalcExecLocalDBVersionKey(meta.Name), types.Encode(meta))
+		}
+	}
+	return newbatch.Write()
+}
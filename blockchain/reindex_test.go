@@ -93,3 +93,57 @@ func copyBytes(keys []byte) []byte {
 	copy(data, keys)
 	return data
 }
+
+func TestReindexExecs(t *testing.T) {
+	cfg, sub := testnode.GetDefaultConfig()
+	mock33 := testnode.NewWithConfig(cfg, sub, nil)
+	defer mock33.Close()
+	chain := mock33.GetBlockChain()
+	db := chain.GetDB()
+	for i := int64(1); i <= 3; i++ {
+		txs := util.GenCoinsTxs(mock33.GetGenesisKey(), 5)
+		for j := 0; j < len(txs); j++ {
+			reply, err := mock33.GetAPI().SendTx(txs[j])
+			assert.Nil(t, err)
+			assert.Equal(t, reply.IsOk, true)
+		}
+		mock33.WaitHeight(i)
+	}
+	time.Sleep(time.Second)
+	kvs1 := getAllKeys(db)
+	coinsKeys := 0
+	for _, kv := range kvs1 {
+		if bytes.HasPrefix(kv.Key, []byte("LODB-coins-")) {
+			coinsKeys++
+		}
+	}
+	assert.True(t, coinsKeys > 0)
+
+	//coins 的localdb版本改变了, 只重建coins的localdb
+	key := types.CalcExecLocalDBVersionKey("coins")
+	db.Set(key, types.Encode(&types.ExecLocalDBMeta{Name: "coins", Version: 1}))
+	chain.ReIndexExecs(chain.GetBlockHeight())
+	assert.Equal(t, kvs1, getAllKeys(db))
+	meta, err := chain.GetStore().GetExecLocalDBMeta("coins")
+	assert.Nil(t, err)
+	assert.Equal(t, int32(0), meta.Version)
+	assert.False(t, meta.Indexing)
+
+	//重建完最后一个高度之后中断, 继续的时候不需要再执行
+	db.Set(key, types.Encode(&types.ExecLocalDBMeta{Name: "coins", Indexing: true, Height: chain.GetBlockHeight() + 1}))
+	metas, err := mock33.GetAPI().GetExecLocalDBMetas()
+	assert.Nil(t, err)
+	found := false
+	for _, m := range metas.Metas {
+		if m.Name == "coins" {
+			found = true
+			assert.True(t, m.Indexing)
+			assert.Equal(t, chain.GetBlockHeight()+1, m.Height)
+		}
+	}
+	assert.True(t, found)
+	chain.ReIndexExecs(chain.GetBlockHeight())
+	assert.Equal(t, kvs1, getAllKeys(db))
+	_, err = db.Get(key)
+	assert.Equal(t, dbm.ErrNotFoundInDb, err)
+}
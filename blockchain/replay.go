@@ -0,0 +1,303 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/golang/protobuf/proto"
+)
+
+//重放区块:
+//在一个新的数据目录中, 用当前的执行器重新执行已经同步好的区块, 和原来保存的结果比较,
+//用于在升级执行器或者开启fork之前检查执行结果是否一致
+//1. 比较每一笔交易的 receipt (Ty 和 Logs)
+//2. 比较区块的 StateHash, 不一致的时候找出和原来状态不同的 key
+//3. 比较 localdb 的 kv, 原来的 localdb 只保存最新的值, 所以只有重放到原链的最新高度时才比较,
+//   比较的是重放区间内每一个 key 最后一次写入的值, 中间高度写入后又被覆盖的值不会比较,
+//   重放到更低的高度时不比较 localdb, 调用者可以用当前链的高度和 src.Height() 判断是否比较过
+
+//不一致的类型
+const (
+	DivergeReceipt   = "receipt"
+	DivergeStateHash = "statehash"
+	DivergeLocalDB   = "localdb"
+)
+
+//ErrReplayChainNoMatch 重放的数据目录和原来的区块链不是同一条链
+var ErrReplayChainNoMatch = errors.New("ErrReplayChainNoMatch")
+
+//ReplaySource 需要验证的区块链数据
+type ReplaySource interface {
+	Height() int64
+	LoadBlock(height int64) (*types.BlockDetail, error)
+	//LocalGet 读取 localdb 中的值, 不存在时返回 nil
+	LocalGet(key []byte) ([]byte, error)
+	//StateGet 读取某个 StateHash 下 statedb 中的值
+	StateGet(req *types.StoreGet) ([][]byte, error)
+}
+
+type replaySource struct {
+	store *BlockStore
+	state func(req *types.StoreGet) ([][]byte, error)
+}
+
+//NewReplaySource 使用原来的 blockstore 和 statedb 的读取接口创建 ReplaySource
+func NewReplaySource(store *BlockStore, state func(req *types.StoreGet) ([][]byte, error)) ReplaySource {
+	return &replaySource{store: store, state: state}
+}
+
+func (src *replaySource) Height() int64 {
+	return src.store.Height()
+}
+
+func (src *replaySource) LoadBlock(height int64) (*types.BlockDetail, error) {
+	return src.store.LoadBlockByHeight(height)
+}
+
+func (src *replaySource) LocalGet(key []byte) ([]byte, error) {
+	value, err := src.store.db.Get(key)
+	if err == dbm.ErrNotFoundInDb {
+		return nil, nil
+	}
+	return value, err
+}
+
+func (src *replaySource) StateGet(req *types.StoreGet) ([][]byte, error) {
+	return src.state(req)
+}
+
+//DiffKV 不一致的 kv, Expect 是原来保存的值, Actual 是重放的结果
+type DiffKV struct {
+	Key    []byte
+	Expect []byte
+	Actual []byte
+}
+
+//Divergence 重放区块时第一个和原来的结果不一致的地方
+type Divergence struct {
+	Kind   string
+	Height int64
+	//TxIndex 不一致的交易在区块中的序号, 不能确定具体交易的时候为 -1
+	TxIndex int
+	TxHash  []byte
+	Detail  string
+	Keys    []*DiffKV
+}
+
+func (d *Divergence) String() string {
+	s := fmt.Sprintf("%s diverge at height %d", d.Kind, d.Height)
+	if d.TxIndex >= 0 {
+		s += fmt.Sprintf(" tx %d %s", d.TxIndex, common.ToHex(d.TxHash))
+	}
+	if d.Detail != "" {
+		s += ": " + d.Detail
+	}
+	for _, kv := range d.Keys {
+		s += fmt.Sprintf("\n\tkey %s expect %s actual %s", string(kv.Key), common.ToHex(kv.Expect), common.ToHex(kv.Actual))
+	}
+	return s
+}
+
+//Replay 在当前链上重新执行 src 中 start 到 end 高度的区块, end < 0 表示原链的最新高度
+//当前链必须是 src 的前缀, 从当前链的下一个高度开始执行, start 之前的区块只比较 StateHash
+//返回第一个不一致的地方, 全部一致的时候返回 nil
+func (b *BlockChain) Replay(src ReplaySource, start, end int64) (*Divergence, error) {
+	b.chainLock.Lock()
+	defer b.chainLock.Unlock()
+	srcHeight := src.Height()
+	if end < 0 || end > srcHeight {
+		end = srcHeight
+	}
+	tip := b.bestChain.Tip()
+	if tip.height >= 0 {
+		detail, err := src.LoadBlock(tip.height)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(detail.Block.Hash(), tip.hash) {
+			return nil, ErrReplayChainNoMatch
+		}
+	}
+	//key -> 重放区间内最后一次写入这个 key 的高度
+	localKeys := make(map[string]int64)
+	for height := tip.height + 1; height <= end; height++ {
+		detail, err := src.LoadBlock(height)
+		if err != nil {
+			return nil, err
+		}
+		localKV, diverge, err := b.replayBlock(src, detail, height >= start)
+		if err != nil || diverge != nil {
+			return diverge, err
+		}
+		if height >= start {
+			for _, kv := range localKV.KV {
+				localKeys[string(kv.Key)] = height
+			}
+		}
+		if height%1000 == 0 {
+			chainlog.Info("Replay", "height", height, "end", end)
+		}
+	}
+	if end < srcHeight {
+		chainlog.Info("Replay skip localdb check", "end", end, "srcHeight", srcHeight)
+		return nil, nil
+	}
+	return b.checkReplayLocal(src, localKeys)
+}
+
+//replayBlock 执行一个区块, 和原来的 StateHash 比较, check 为 true 时还要比较 receipt,
+//一致的时候写入当前链, 返回执行器生成的 localdb kv
+func (b *BlockChain) replayBlock(source ReplaySource, src *types.BlockDetail, check bool) (*types.LocalDBSet, *Divergence, error) {
+	block := proto.Clone(src.Block).(*types.Block)
+	prevStateHash := b.bestChain.Tip().statehash
+	receipts := util.ExecTx(b.client, prevStateHash, block)
+	if len(receipts.Receipts) != len(block.Txs) {
+		return nil, nil, types.ErrBlockExec
+	}
+	var maplist = make(map[string]*types.KeyValue)
+	//key -> 第一次修改这个 key 的交易序号
+	var writers = make(map[string]int)
+	var kvset []*types.KeyValue
+	var rdata []*types.ReceiptData
+	for i, receipt := range receipts.Receipts {
+		rd := &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}
+		if check {
+			if detail := diffReceipt(src.Receipts, i, rd); detail != "" {
+				return nil, newTxDivergence(DivergeReceipt, block, i, detail), nil
+			}
+		}
+		rdata = append(rdata, rd)
+		for _, kv := range receipt.KV {
+			if item, ok := maplist[string(kv.Key)]; ok {
+				item.Value = kv.Value
+			} else {
+				maplist[string(kv.Key)] = kv
+				writers[string(kv.Key)] = i
+				kvset = append(kvset, kv)
+			}
+		}
+	}
+	stateHash := util.ExecKVMemSet(b.client, prevStateHash, block.Height, kvset, false)
+	if !bytes.Equal(stateHash, src.Block.StateHash) {
+		util.ExecKVSetRollback(b.client, stateHash)
+		return nil, stateDivergence(source, src.Block, stateHash, kvset, writers), nil
+	}
+	err := util.ExecKVSetCommit(b.client, stateHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	detail := &types.BlockDetail{Block: src.Block, Receipts: rdata, KV: kvset, PrevStatusHash: prevStateHash}
+	localKV, err := b.blockStore.getLocalKV(detail)
+	if err != nil {
+		return nil, nil, err
+	}
+	node := newBlockNode(false, src.Block, "self", -1)
+	node.parent = b.bestChain.Tip()
+	b.index.AddNode(node)
+	_, err = b.writeBlock(node, detail, localKV, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	return localKV, nil, nil
+}
+
+//stateDivergence 找出重放写入的 kv 中和原来状态不同的 key, 最先写入不同值的交易作为出错的交易
+func stateDivergence(src ReplaySource, block *types.Block, stateHash []byte, kvset []*types.KeyValue, writers map[string]int) *Divergence {
+	detail := fmt.Sprintf("statehash expect %s actual %s", common.ToHex(block.StateHash), common.ToHex(stateHash))
+	d := &Divergence{Kind: DivergeStateHash, Height: block.Height, TxIndex: -1, Detail: detail}
+	keys := make([][]byte, len(kvset))
+	for i, kv := range kvset {
+		keys[i] = kv.Key
+	}
+	values, err := src.StateGet(&types.StoreGet{StateHash: block.StateHash, Keys: keys})
+	if err != nil {
+		d.Detail += ", load state err: " + err.Error()
+	}
+	for i, kv := range kvset {
+		var expect []byte
+		if i < len(values) {
+			expect = values[i]
+		}
+		if bytes.Equal(expect, kv.Value) {
+			continue
+		}
+		d.Keys = append(d.Keys, &DiffKV{Key: kv.Key, Expect: expect, Actual: kv.Value})
+		if index := writers[string(kv.Key)]; d.TxIndex < 0 || index < d.TxIndex {
+			d.TxIndex = index
+		}
+	}
+	if d.TxIndex >= 0 {
+		d.TxHash = block.Txs[d.TxIndex].Hash()
+	}
+	return d
+}
+
+//diffReceipt 比较第 i 笔交易的 receipt, 一致的时候返回空字符串
+func diffReceipt(receipts []*types.ReceiptData, i int, actual *types.ReceiptData) string {
+	if i >= len(receipts) {
+		return fmt.Sprintf("receipt count expect %d actual more", len(receipts))
+	}
+	expect := receipts[i]
+	if expect.Ty != actual.Ty {
+		return fmt.Sprintf("receipt ty expect %d actual %d", expect.Ty, actual.Ty)
+	}
+	if len(expect.Logs) != len(actual.Logs) {
+		return fmt.Sprintf("log count expect %d actual %d", len(expect.Logs), len(actual.Logs))
+	}
+	for j := range expect.Logs {
+		if expect.Logs[j].Ty != actual.Logs[j].Ty {
+			return fmt.Sprintf("log %d ty expect %d actual %d", j, expect.Logs[j].Ty, actual.Logs[j].Ty)
+		}
+		if !bytes.Equal(expect.Logs[j].Log, actual.Logs[j].Log) {
+			return fmt.Sprintf("log %d expect %s actual %s", j, common.ToHex(expect.Logs[j].Log), common.ToHex(actual.Logs[j].Log))
+		}
+	}
+	return ""
+}
+
+func newTxDivergence(kind string, block *types.Block, index int, detail string) *Divergence {
+	return &Divergence{Kind: kind, Height: block.Height, TxIndex: index, TxHash: block.Txs[index].Hash(), Detail: detail}
+}
+
+//checkReplayLocal 比较重放生成的 localdb 和原来的 localdb, 返回最低高度的不一致,
+//返回的高度是最后一次写入 key 的区块, 原来的 localdb 没有中间高度的值, 不能确定是哪一个区块开始不一致
+func (b *BlockChain) checkReplayLocal(src ReplaySource, localKeys map[string]int64) (*Divergence, error) {
+	var d *Divergence
+	for key, height := range localKeys {
+		if d != nil && height > d.Height {
+			continue
+		}
+		expect, err := src.LocalGet([]byte(key))
+		if err != nil {
+			return nil, err
+		}
+		actual, err := b.blockStore.db.Get([]byte(key))
+		if err != nil && err != dbm.ErrNotFoundInDb {
+			return nil, err
+		}
+		if bytes.Equal(expect, actual) {
+			continue
+		}
+		if d == nil || height < d.Height {
+			d = &Divergence{Kind: DivergeLocalDB, Height: height, TxIndex: -1}
+		}
+		d.Keys = append(d.Keys, &DiffKV{Key: []byte(key), Expect: expect, Actual: actual})
+	}
+	if d != nil {
+		sort.Slice(d.Keys, func(i, j int) bool {
+			return bytes.Compare(d.Keys[i].Key, d.Keys[j].Key) < 0
+		})
+		d.Detail = fmt.Sprintf("%d keys differ from the last height, height is the last block writing them, not always the block where they diverged", len(d.Keys))
+	}
+	return d, nil
+}
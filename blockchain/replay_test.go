@@ -0,0 +1,141 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/33cn/chain33/blockchain"
+	"github.com/33cn/chain33/executor"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/store"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+//tamperSource 修改原来链上的数据, 模拟执行结果不一致
+type tamperSource struct {
+	blockchain.ReplaySource
+	height  int64
+	receipt bool
+	state   bool
+	local   []byte
+}
+
+func (src *tamperSource) LoadBlock(height int64) (*types.BlockDetail, error) {
+	detail, err := src.ReplaySource.LoadBlock(height)
+	if err != nil || height != src.height {
+		return detail, err
+	}
+	detail = proto.Clone(detail).(*types.BlockDetail)
+	if src.receipt {
+		detail.Receipts[0].Logs = detail.Receipts[0].Logs[1:]
+	}
+	if src.state {
+		detail.Block.StateHash = make([]byte, 32)
+	}
+	return detail, nil
+}
+
+func (src *tamperSource) LocalGet(key []byte) ([]byte, error) {
+	if bytes.Equal(key, src.local) {
+		return []byte("tamper"), nil
+	}
+	return src.ReplaySource.LocalGet(key)
+}
+
+func newScratchChain(cfg *types.Config, sub *types.ConfigSubModule) (*blockchain.BlockChain, func()) {
+	q := queue.New("channel")
+	chain := blockchain.New(cfg.BlockChain)
+	chain.SetQueueClient(q.Client())
+	exec := executor.New(cfg.Exec, sub.Exec)
+	exec.SetQueueClient(q.Client())
+	s := store.New(cfg.Store, sub.Store)
+	s.SetQueueClient(q.Client())
+	return chain, func() {
+		s.Close()
+		exec.Close()
+		chain.Close()
+		q.Close()
+	}
+}
+
+func TestReplay(t *testing.T) {
+	cfg, sub := testnode.GetDefaultConfig()
+	mock33 := testnode.NewWithConfig(cfg, sub, nil)
+	defer mock33.Close()
+	for i := int64(1); i <= 3; i++ {
+		txs := util.GenCoinsTxs(mock33.GetGenesisKey(), 2)
+		for _, tx := range txs {
+			reply, err := mock33.GetAPI().SendTx(tx)
+			assert.Nil(t, err)
+			assert.Equal(t, reply.IsOk, true)
+		}
+		mock33.WaitHeight(i)
+	}
+	api := mock33.GetAPI()
+	src := blockchain.NewReplaySource(mock33.GetBlockChain().GetStore(), func(req *types.StoreGet) ([][]byte, error) {
+		reply, err := api.StoreGet(req)
+		if err != nil {
+			return nil, err
+		}
+		return reply.Values, nil
+	})
+	last := mock33.GetLastBlock()
+	assert.Equal(t, int64(3), last.Height)
+
+	//全部一致, 重放之后的状态和原来的相同
+	chain, closeChain := newScratchChain(cfg, sub)
+	diverge, err := chain.Replay(src, 1, 2)
+	assert.Nil(t, err)
+	assert.Nil(t, diverge)
+	assert.Equal(t, int64(2), chain.GetBlockHeight())
+	//继续上一次的重放, 到最新高度的时候比较localdb
+	diverge, err = chain.Replay(src, 1, -1)
+	assert.Nil(t, err)
+	assert.Nil(t, diverge)
+	detail, err := chain.GetBlock(3)
+	assert.Nil(t, err)
+	assert.Equal(t, last.Hash(), detail.Block.Hash())
+	closeChain()
+
+	//receipt 不一致
+	chain, closeChain = newScratchChain(cfg, sub)
+	diverge, err = chain.Replay(&tamperSource{ReplaySource: src, height: 2, receipt: true}, 1, -1)
+	assert.Nil(t, err)
+	assert.Equal(t, blockchain.DivergeReceipt, diverge.Kind)
+	assert.Equal(t, int64(2), diverge.Height)
+	assert.Equal(t, 0, diverge.TxIndex)
+	block := mock33.GetBlock(2)
+	assert.Equal(t, block.Txs[0].Hash(), diverge.TxHash)
+	assert.Equal(t, int64(1), chain.GetBlockHeight())
+	closeChain()
+
+	//StateHash 不一致, 找出不同的key
+	chain, closeChain = newScratchChain(cfg, sub)
+	diverge, err = chain.Replay(&tamperSource{ReplaySource: src, height: 2, state: true}, 3, -1)
+	assert.Nil(t, err)
+	assert.Equal(t, blockchain.DivergeStateHash, diverge.Kind)
+	assert.Equal(t, int64(2), diverge.Height)
+	assert.Equal(t, 0, diverge.TxIndex)
+	assert.NotEqual(t, 0, len(diverge.Keys))
+	closeChain()
+
+	//localdb 不一致, 找到最后写入这个key的区块
+	local := types.CalcTxKey(block.Txs[0].Hash())
+	chain, closeChain = newScratchChain(cfg, sub)
+	diverge, err = chain.Replay(&tamperSource{ReplaySource: src, local: local}, 1, -1)
+	assert.Nil(t, err)
+	assert.Equal(t, blockchain.DivergeLocalDB, diverge.Kind)
+	assert.Equal(t, int64(2), diverge.Height)
+	assert.Equal(t, 1, len(diverge.Keys))
+	assert.Equal(t, local, diverge.Keys[0].Key)
+	assert.Equal(t, []byte("tamper"), diverge.Keys[0].Expect)
+	closeChain()
+}
@@ -39,7 +39,7 @@ function init() {
     sed -i $sedfix '0,/^enable=.*/s//enable=true/' chain33.toml
     sed -i $sedfix 's/^isSeed=.*/isSeed=true/g' chain33.toml
     sed -i $sedfix 's/^innerSeedEnable=.*/innerSeedEnable=false/g' chain33.toml
-    sed -i $sedfix 's/^useGithub=.*/useGithub=false/g' chain33.toml
+    sed -i $sedfix 's/^dnsSeeds=.*/dnsSeeds=[]/g' chain33.toml
 
     # rpc
     sed -i $sedfix 's/^jrpcBindAddr=.*/jrpcBindAddr="0.0.0.0:8801"/g' chain33.toml
@@ -78,7 +78,7 @@ function base_init() {
     sed -i $sedfix '0,/^enable=.*/s//enable=true/' ${testtoml}
     sed -i $sedfix 's/^isSeed=.*/isSeed=true/g' ${testtoml}
     sed -i $sedfix 's/^innerSeedEnable=.*/innerSeedEnable=false/g' ${testtoml}
-    sed -i $sedfix 's/^useGithub=.*/useGithub=false/g' ${testtoml}
+    sed -i $sedfix 's/^dnsSeeds=.*/dnsSeeds=[]/g' ${testtoml}
 
     # rpc
     sed -i $sedfix 's/^jrpcBindAddr=.*/jrpcBindAddr="0.0.0.0:8801"/g' ${testtoml}
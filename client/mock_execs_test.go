@@ -21,6 +21,10 @@ func (m *mockExecs) SetQueueClient(q queue.Queue) {
 			switch msg.Ty {
 			case types.EventBlockChainQuery:
 				msg.Reply(client.NewMessage(topic, types.EventBlockChainQuery, &types.Reply{}))
+			case types.EventTraceTx:
+				msg.Reply(client.NewMessage(topic, types.EventTraceTx, &types.ReplyTraceTx{Height: 1}))
+			case types.EventSimulateTx:
+				msg.Reply(client.NewMessage(topic, types.EventSimulateTx, &types.ReplySimulateTx{Height: 1}))
 			default:
 				msg.ReplyErr("Do not support", types.ErrNotSupport)
 			}
@@ -23,6 +23,10 @@ func (m *mockP2P) SetQueueClient(q queue.Queue) {
 				msg.Reply(client.NewMessage(p2pKey, types.EventPeerList, &types.PeerList{}))
 			case types.EventGetNetInfo:
 				msg.Reply(client.NewMessage(p2pKey, types.EventPeerList, &types.NodeNetInfo{}))
+			case types.EventPeerScores:
+				msg.Reply(client.NewMessage(p2pKey, types.EventPeerScores, &types.PeerScores{}))
+			case types.EventBanPeer, types.EventUnbanPeer:
+				msg.Reply(client.NewMessage(p2pKey, msg.Ty, &types.Reply{IsOk: true}))
 			default:
 				msg.ReplyErr("Do not support", types.ErrNotSupport)
 			}
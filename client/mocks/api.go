@@ -34,6 +34,29 @@ func (_m *QueueProtocolAPI) AddSeqCallBack(param *types.BlockSeqCB) (*types.Repl
 	return r0, r1
 }
 
+// BanPeer provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) BanPeer(param *types.ReqBanPeer) (*types.Reply, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.Reply
+	if rf, ok := ret.Get(0).(func(*types.ReqBanPeer) *types.Reply); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Reply)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqBanPeer) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Close provides a mock function with given fields:
 func (_m *QueueProtocolAPI) Close() {
 	_m.Called()
@@ -292,6 +315,29 @@ func (_m *QueueProtocolAPI) GetBlocks(param *types.ReqBlocks) (*types.BlockDetai
 	return r0, r1
 }
 
+// GetExecLocalDBMetas provides a mock function with given fields:
+func (_m *QueueProtocolAPI) GetExecLocalDBMetas() (*types.ExecLocalDBMetas, error) {
+	ret := _m.Called()
+
+	var r0 *types.ExecLocalDBMetas
+	if rf, ok := ret.Get(0).(func() *types.ExecLocalDBMetas); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ExecLocalDBMetas)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetFatalFailure provides a mock function with given fields:
 func (_m *QueueProtocolAPI) GetFatalFailure() (*types.Int32, error) {
 	ret := _m.Called()
@@ -453,6 +499,29 @@ func (_m *QueueProtocolAPI) GetNetInfo() (*types.NodeNetInfo, error) {
 	return r0, r1
 }
 
+// GetPeerScores provides a mock function with given fields:
+func (_m *QueueProtocolAPI) GetPeerScores() (*types.PeerScores, error) {
+	ret := _m.Called()
+
+	var r0 *types.PeerScores
+	if rf, ok := ret.Get(0).(func() *types.PeerScores); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.PeerScores)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSeed provides a mock function with given fields: param
 func (_m *QueueProtocolAPI) GetSeed(param *types.GetSeedByPw) (*types.ReplySeed, error) {
 	ret := _m.Called(param)
@@ -994,6 +1063,29 @@ func (_m *QueueProtocolAPI) SignRawTx(param *types.ReqSignRawTx) (*types.ReplySi
 	return r0, r1
 }
 
+// SimulateTx provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) SimulateTx(param *types.ReqSimulateTx) (*types.ReplySimulateTx, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.ReplySimulateTx
+	if rf, ok := ret.Get(0).(func(*types.ReqSimulateTx) *types.ReplySimulateTx); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ReplySimulateTx)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqSimulateTx) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // StoreGet provides a mock function with given fields: _a0
 func (_m *QueueProtocolAPI) StoreGet(_a0 *types.StoreGet) (*types.StoreReplyValue, error) {
 	ret := _m.Called(_a0)
@@ -1063,6 +1155,98 @@ func (_m *QueueProtocolAPI) StoreList(param *types.StoreList) (*types.StoreListR
 	return r0, r1
 }
 
+// StorePrune provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) StorePrune(param *types.ReqStorePrune) (*types.StorePruneStatus, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.StorePruneStatus
+	if rf, ok := ret.Get(0).(func(*types.ReqStorePrune) *types.StorePruneStatus); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.StorePruneStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqStorePrune) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StorePruneStatus provides a mock function with given fields:
+func (_m *QueueProtocolAPI) StorePruneStatus() (*types.StorePruneStatus, error) {
+	ret := _m.Called()
+
+	var r0 *types.StorePruneStatus
+	if rf, ok := ret.Get(0).(func() *types.StorePruneStatus); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.StorePruneStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TraceTx provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) TraceTx(param *types.ReqHash) (*types.ReplyTraceTx, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.ReplyTraceTx
+	if rf, ok := ret.Get(0).(func(*types.ReqHash) *types.ReplyTraceTx); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ReplyTraceTx)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqHash) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UnbanPeer provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) UnbanPeer(param *types.ReqBanPeer) (*types.Reply, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.Reply
+	if rf, ok := ret.Get(0).(func(*types.ReqBanPeer) *types.Reply); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Reply)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqBanPeer) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Version provides a mock function with given fields:
 func (_m *QueueProtocolAPI) Version() (*types.VersionInfo, error) {
 	ret := _m.Called()
@@ -785,6 +785,55 @@ func (q *QueueProtocol) GetNetInfo() (*types.NodeNetInfo, error) {
 	return nil, err
 }
 
+// GetPeerScores get the peer scores and banned peers
+func (q *QueueProtocol) GetPeerScores() (*types.PeerScores, error) {
+	msg, err := q.query(p2pKey, types.EventPeerScores, &types.ReqNil{})
+	if err != nil {
+		log.Error("GetPeerScores", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.PeerScores); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// BanPeer ban a peer by node pubkey or address
+func (q *QueueProtocol) BanPeer(param *types.ReqBanPeer) (*types.Reply, error) {
+	if param == nil || param.Key == "" {
+		err := types.ErrInvalidParam
+		log.Error("BanPeer", "Error", err)
+		return nil, err
+	}
+	msg, err := q.query(p2pKey, types.EventBanPeer, param)
+	if err != nil {
+		log.Error("BanPeer", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.Reply); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// UnbanPeer remove a peer from the ban list
+func (q *QueueProtocol) UnbanPeer(param *types.ReqBanPeer) (*types.Reply, error) {
+	if param == nil || param.Key == "" {
+		err := types.ErrInvalidParam
+		log.Error("UnbanPeer", "Error", err)
+		return nil, err
+	}
+	msg, err := q.query(p2pKey, types.EventUnbanPeer, param)
+	if err != nil {
+		log.Error("UnbanPeer", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.Reply); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
 // SignRawTx sign transaction return the sign tx data
 func (q *QueueProtocol) SignRawTx(param *types.ReqSignRawTx) (*types.ReplySignRawTx, error) {
 	if param == nil {
@@ -866,6 +915,73 @@ func (q *QueueProtocol) StoreGetTotalCoins(param *types.IterateRangeByStateHash)
 	return nil, err
 }
 
+// StorePrune start or pause mavl pruning in store
+func (q *QueueProtocol) StorePrune(param *types.ReqStorePrune) (*types.StorePruneStatus, error) {
+	if param == nil {
+		err := types.ErrInvalidParam
+		log.Error("StorePrune", "Error", err)
+		return nil, err
+	}
+	msg, err := q.query(storeKey, types.EventStorePrune, param)
+	if err != nil {
+		log.Error("StorePrune", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.StorePruneStatus); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// StorePruneStatus get mavl pruning status from store
+func (q *QueueProtocol) StorePruneStatus() (*types.StorePruneStatus, error) {
+	msg, err := q.query(storeKey, types.EventStorePruneStatus, &types.ReqNil{})
+	if err != nil {
+		log.Error("StorePruneStatus", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.StorePruneStatus); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// SimulateTx 在最新区块的状态上模拟执行交易
+func (q *QueueProtocol) SimulateTx(param *types.ReqSimulateTx) (*types.ReplySimulateTx, error) {
+	if param == nil || param.Tx == nil {
+		err := types.ErrInvalidParam
+		log.Error("SimulateTx", "Error", err)
+		return nil, err
+	}
+	msg, err := q.query(executorKey, types.EventSimulateTx, param)
+	if err != nil {
+		log.Error("SimulateTx", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.ReplySimulateTx); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// TraceTx 重新执行交易所在的区块, 返回交易执行过程中的读写记录
+func (q *QueueProtocol) TraceTx(param *types.ReqHash) (*types.ReplyTraceTx, error) {
+	if param == nil {
+		err := types.ErrInvalidParam
+		log.Error("TraceTx", "Error", err)
+		return nil, err
+	}
+	msg, err := q.query(executorKey, types.EventTraceTx, param)
+	if err != nil {
+		log.Error("TraceTx", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.ReplyTraceTx); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
 // GetFatalFailure get fatal failure from wallet
 func (q *QueueProtocol) GetFatalFailure() (*types.Int32, error) {
 	msg, err := q.query(walletKey, types.EventFatalFailure, &types.ReqNil{})
@@ -898,6 +1014,19 @@ func (q *QueueProtocol) GetLastBlockSequence() (*types.Int64, error) {
 	return nil, types.ErrTypeAsset
 }
 
+// GetExecLocalDBMetas 获取执行器localdb的版本和重建进度
+func (q *QueueProtocol) GetExecLocalDBMetas() (*types.ExecLocalDBMetas, error) {
+	msg, err := q.query(blockchainKey, types.EventGetExecLocalDBMetas, &types.ReqNil{})
+	if err != nil {
+		log.Error("GetExecLocalDBMetas", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.ExecLocalDBMetas); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
 // GetSequenceByHash 通过hash获取对应的执行序列号
 func (q *QueueProtocol) GetSequenceByHash(param *types.ReqHash) (*types.Int64, error) {
 	if param == nil {
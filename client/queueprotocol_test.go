@@ -96,6 +96,9 @@ func TestQueueProtocol(t *testing.T) {
 	testStoreGetTotalCoins(t, api)
 	testStoreList(t, api)
 	testBlockChainQuery(t, api)
+	testSimulateTx(t, api)
+	testTraceTx(t, api)
+	testPeerScores(t, api)
 }
 
 func testBlockChainQuery(t *testing.T, api client.QueueProtocolAPI) {
@@ -119,6 +122,39 @@ func testBlockChainQuery(t *testing.T, api client.QueueProtocolAPI) {
 	}
 }
 
+func testSimulateTx(t *testing.T, api client.QueueProtocolAPI) {
+	_, err := api.SimulateTx(nil)
+	require.Equal(t, types.ErrInvalidParam, err)
+	_, err = api.SimulateTx(&types.ReqSimulateTx{})
+	require.Equal(t, types.ErrInvalidParam, err)
+	res, err := api.SimulateTx(&types.ReqSimulateTx{Tx: &types.Transaction{}})
+	require.Nil(t, err)
+	require.Equal(t, int64(1), res.Height)
+}
+
+func testTraceTx(t *testing.T, api client.QueueProtocolAPI) {
+	_, err := api.TraceTx(nil)
+	require.Equal(t, types.ErrInvalidParam, err)
+	res, err := api.TraceTx(&types.ReqHash{})
+	require.Nil(t, err)
+	require.Equal(t, int64(1), res.Height)
+}
+
+func testPeerScores(t *testing.T, api client.QueueProtocolAPI) {
+	_, err := api.GetPeerScores()
+	require.Nil(t, err)
+	_, err = api.BanPeer(nil)
+	require.Equal(t, types.ErrInvalidParam, err)
+	_, err = api.UnbanPeer(&types.ReqBanPeer{})
+	require.Equal(t, types.ErrInvalidParam, err)
+	res, err := api.BanPeer(&types.ReqBanPeer{Key: "192.168.0.1:13802", Seconds: 60})
+	require.Nil(t, err)
+	require.True(t, res.IsOk)
+	res, err = api.UnbanPeer(&types.ReqBanPeer{Key: "192.168.0.1:13802"})
+	require.Nil(t, err)
+	require.True(t, res.IsOk)
+}
+
 func testStoreGetTotalCoins(t *testing.T, api client.QueueProtocolAPI) {
 	_, err := api.StoreGetTotalCoins(&types.IterateRangeByStateHash{})
 	if err != nil {
@@ -39,6 +39,12 @@ type QueueProtocolAPI interface {
 	PeerInfo() (*types.PeerList, error)
 	// types.EventGetNetInfo
 	GetNetInfo() (*types.NodeNetInfo, error)
+	// types.EventPeerScores
+	GetPeerScores() (*types.PeerScores, error)
+	// types.EventBanPeer
+	BanPeer(param *types.ReqBanPeer) (*types.Reply, error)
+	// types.EventUnbanPeer
+	UnbanPeer(param *types.ReqBanPeer) (*types.Reply, error)
 	// --------------- p2p interfaces end
 	// +++++++++++++++ wallet interfaces begin
 	// types.EventLocalGet
@@ -113,6 +119,8 @@ type QueueProtocolAPI interface {
 	GetBlockByHashes(param *types.ReqHashes) (*types.BlockDetails, error)
 	//types.EventGetSequenceByHash:
 	GetSequenceByHash(param *types.ReqHash) (*types.Int64, error)
+	//types.EventGetExecLocalDBMetas:
+	GetExecLocalDBMetas() (*types.ExecLocalDBMetas, error)
 
 	// --------------- blockchain interfaces end
 
@@ -120,6 +128,14 @@ type QueueProtocolAPI interface {
 	StoreGet(*types.StoreGet) (*types.StoreReplyValue, error)
 	StoreGetTotalCoins(*types.IterateRangeByStateHash) (*types.ReplyGetTotalCoins, error)
 	StoreList(param *types.StoreList) (*types.StoreListReply, error)
+	// types.EventStorePrune
+	StorePrune(param *types.ReqStorePrune) (*types.StorePruneStatus, error)
+	// types.EventStorePruneStatus
+	StorePruneStatus() (*types.StorePruneStatus, error)
+	// types.EventSimulateTx
+	SimulateTx(param *types.ReqSimulateTx) (*types.ReplySimulateTx, error)
+	// types.EventTraceTx
+	TraceTx(param *types.ReqHash) (*types.ReplyTraceTx, error)
 	// --------------- store interfaces end
 
 	// +++++++++++++++ other interfaces begin
@@ -62,7 +62,7 @@ enableTxQuickIndex=false
 seeds=[]
 isSeed=false
 innerSeedEnable=true
-useGithub=true
+enableDHT=true
 innerBounds=300
 dbPath="datadir/addrbook"
 dbCache=4
@@ -220,6 +220,7 @@ ForkResetTx0= 200000
 ForkWithdraw= 200000
 ForkExecRollback= 450000
 ForkCheckBlockTime=1200000
+ForkTxCost=-1
 ForkTxHeight= -1
 ForkTxGroupPara= -1
 ForkChainParamV2= -1
@@ -236,6 +237,8 @@ Enable=0
 [fork.sub.manage]
 Enable=0
 ForkManageExec=100000
+ForkManageGovern=-1
+ForkManageMultiSig=-1
 [fork.sub.token]
 Enable=0
 ForkTokenBlackList= 0
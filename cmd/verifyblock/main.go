@@ -0,0 +1,134 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// package main 在一个新的数据目录中重新执行已经同步好的区块链, 检查执行结果是否和原来一致
+// 运行之前需要先停止使用 datadir 的节点
+// localdb 只保存最新的值, 所以只有 end 是 datadir 的最新高度时才比较 localdb, 而且只比较这一次重放的区块最后写入的值,
+// 中间高度被后面的区块覆盖的 localdb 不会检查
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+
+	"github.com/33cn/chain33/blockchain"
+	"github.com/33cn/chain33/client"
+	dbm "github.com/33cn/chain33/common/db"
+	clog "github.com/33cn/chain33/common/log"
+	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/executor"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/store"
+	"github.com/33cn/chain33/types"
+)
+
+var start = flag.Int64("start", 1, "first height to verify")
+var end = flag.Int64("end", -1, "last height to verify, -1 means the last height of datadir. localdb is only checked when replaying up to the last height of datadir, and only the last value written to each key by this run is compared")
+var datadir = flag.String("datadir", "", "data dir of chain33 to verify")
+var scratch = flag.String("scratch", "verifydata", "scratch dir to replay blocks, reuse it to continue the last replay")
+var configPath = flag.String("f", "chain33.toml", "configfile")
+
+//localNote localdb 的检查是不完整的, 结果中需要说明
+const localNote = "localdb check is partial: only the last value of each key is compared with datadir, values overwritten by later blocks are not checked, and a localdb divergence does not identify the block where it started"
+
+func absDir(datadir string) string {
+	// Check in case of paths like "/something/~/something/"
+	if len(datadir) >= 2 && datadir[:2] == "~/" {
+		usr, _ := user.Current()
+		dir := usr.HomeDir
+		datadir = filepath.Join(dir, datadir[2:])
+	}
+	return datadir
+}
+
+//newSource 读取原来的区块链数据, statedb 使用单独的 store 模块读取
+func newSource(cfg *types.Config, sub *types.ConfigSubModule, dir string) (blockchain.ReplaySource, func()) {
+	chaincfg := *cfg.BlockChain
+	storecfg := *cfg.Store
+	if dir != "" {
+		chaincfg.DbPath = filepath.Join(dir, chaincfg.DbPath)
+		storecfg.DbPath = filepath.Join(dir, storecfg.DbPath)
+	}
+	log.Info("verify data dir", "blockchain", chaincfg.DbPath, "store", storecfg.DbPath)
+	db := dbm.NewDB("blockchain", chaincfg.Driver, chaincfg.DbPath, chaincfg.DbCache)
+	q := queue.New("channel")
+	s := store.New(&storecfg, sub.Store)
+	s.SetQueueClient(q.Client())
+	api, err := client.New(q.Client(), nil)
+	if err != nil {
+		panic(err)
+	}
+	state := func(req *types.StoreGet) ([][]byte, error) {
+		reply, err := api.StoreGet(req)
+		if err != nil {
+			return nil, err
+		}
+		return reply.Values, nil
+	}
+	src := blockchain.NewReplaySource(blockchain.NewBlockStore(db, nil), state)
+	return src, func() {
+		s.Close()
+		q.Close()
+		db.Close()
+	}
+}
+
+//newScratch 在 scratch 目录中创建用于重放的 blockchain, executor 和 store
+func newScratch(cfg *types.Config, sub *types.ConfigSubModule, dir string) (*blockchain.BlockChain, func()) {
+	chaincfg := *cfg.BlockChain
+	storecfg := *cfg.Store
+	chaincfg.DbPath = filepath.Join(dir, chaincfg.DbPath)
+	storecfg.DbPath = filepath.Join(dir, storecfg.DbPath)
+	log.Info("scratch data dir", "blockchain", chaincfg.DbPath, "store", storecfg.DbPath)
+	q := queue.New("channel")
+	chain := blockchain.New(&chaincfg)
+	chain.SetQueueClient(q.Client())
+	exec := executor.New(cfg.Exec, sub.Exec)
+	exec.SetQueueClient(q.Client())
+	s := store.New(&storecfg, sub.Store)
+	s.SetQueueClient(q.Client())
+	return chain, func() {
+		s.Close()
+		exec.Close()
+		chain.Close()
+		q.Close()
+	}
+}
+
+func verify() int {
+	cfg, sub := types.InitCfg(*configPath)
+	src, closeSrc := newSource(cfg, sub, absDir(*datadir))
+	defer closeSrc()
+	chain, closeScratch := newScratch(cfg, sub, absDir(*scratch))
+	defer closeScratch()
+
+	diverge, err := chain.Replay(src, *start, *end)
+	if err != nil {
+		fmt.Println("verify block error:", err)
+		return 1
+	}
+	if diverge != nil {
+		fmt.Println(diverge)
+		if diverge.Kind == blockchain.DivergeLocalDB {
+			fmt.Println(localNote)
+		}
+		return 1
+	}
+	fmt.Println("verify block ok, height", chain.GetBlockHeight())
+	if chain.GetBlockHeight() < src.Height() {
+		fmt.Println("localdb not checked: end", chain.GetBlockHeight(), "is below the last height of datadir", src.Height())
+	} else {
+		fmt.Println(localNote)
+	}
+	return 0
+}
+
+func main() {
+	clog.SetLogLevel("info")
+	flag.Parse()
+	os.Exit(verify())
+}
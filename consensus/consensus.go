@@ -27,3 +27,16 @@ func New(cfg *types.Consensus, sub map[string][]byte) queue.Module {
 	consensus.QueryData.SetThis(cfg.Name, reflect.ValueOf(obj))
 	return obj
 }
+
+// GenesisBlock 按照共识配置生成创世区块, 不启动共识模块, 轻节点用它确定创世区块的 hash
+func GenesisBlock(cfg *types.Consensus, sub map[string][]byte) *types.Block {
+	con, err := consensus.Load(cfg.Name)
+	if err != nil {
+		panic("Unsupported consensus type:" + cfg.Name + " " + err.Error())
+	}
+	miner, ok := con(cfg, sub[cfg.Name]).(consensus.Miner)
+	if !ok {
+		panic("consensus " + cfg.Name + " is not a miner")
+	}
+	return consensus.NewGenesisBlock(miner)
+}
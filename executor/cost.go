@@ -0,0 +1,145 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+)
+
+//txMeter 统计执行器通过statedb, localdb读写数据的次数和字节数,
+//按照fork的费用表计算cost, 交易组共用一个手续费预算
+type txMeter struct {
+	schedule   *types.TxCostSchedule
+	budget     int64
+	total      int64
+	reads      int64
+	readBytes  int64
+	writes     int64
+	writeBytes int64
+}
+
+func (m *txMeter) read(key, value []byte) {
+	m.reads++
+	m.readBytes += int64(len(key) + len(value))
+}
+
+func (m *txMeter) write(key, value []byte) {
+	m.writes++
+	m.writeBytes += int64(len(key) + len(value))
+}
+
+//reset 收取手续费的时候重置预算, budget < 0 表示没有限制
+func (m *txMeter) reset(budget int64) {
+	m.budget = budget
+	m.total = 0
+}
+
+func (m *txMeter) start() {
+	m.reads, m.readBytes, m.writes, m.writeBytes = 0, 0, 0, 0
+}
+
+func (m *txMeter) cost() int64 {
+	return m.schedule.Cost(m.reads, m.readBytes, m.writes, m.writeBytes)
+}
+
+//finish 统计一笔交易的cost, 超过预算的时候返回 ErrTxCostExceed
+func (m *txMeter) finish() (*types.ReceiptLog, error) {
+	cost := m.cost()
+	m.total += cost
+	log := &types.ReceiptTxCost{
+		Reads:      m.reads,
+		ReadBytes:  m.readBytes,
+		Writes:     m.writes,
+		WriteBytes: m.writeBytes,
+		Cost:       cost,
+		Total:      m.total,
+		Budget:     m.budget,
+	}
+	receiptLog := &types.ReceiptLog{Ty: types.TyLogTxCost, Log: types.Encode(log)}
+	if m.budget >= 0 && m.total > m.budget {
+		return receiptLog, types.ErrTxCostExceed
+	}
+	return receiptLog, nil
+}
+
+//meterKV 统计statedb读写的 KV
+type meterKV struct {
+	dbm.KV
+	m *txMeter
+}
+
+func (db *meterKV) Get(key []byte) ([]byte, error) {
+	value, err := db.KV.Get(key)
+	db.m.read(key, value)
+	return value, err
+}
+
+func (db *meterKV) BatchGet(keys [][]byte) ([][]byte, error) {
+	values, err := db.KV.BatchGet(keys)
+	for i := range keys {
+		var value []byte
+		if i < len(values) {
+			value = values[i]
+		}
+		db.m.read(keys[i], value)
+	}
+	return values, err
+}
+
+func (db *meterKV) Set(key []byte, value []byte) error {
+	db.m.write(key, value)
+	return db.KV.Set(key, value)
+}
+
+//meterKVDB 统计localdb读写的 KVDB
+type meterKVDB struct {
+	dbm.KVDB
+	m *txMeter
+}
+
+func (db *meterKVDB) Get(key []byte) ([]byte, error) {
+	value, err := db.KVDB.Get(key)
+	db.m.read(key, value)
+	return value, err
+}
+
+func (db *meterKVDB) BatchGet(keys [][]byte) ([][]byte, error) {
+	values, err := db.KVDB.BatchGet(keys)
+	for i := range keys {
+		var value []byte
+		if i < len(values) {
+			value = values[i]
+		}
+		db.m.read(keys[i], value)
+	}
+	return values, err
+}
+
+func (db *meterKVDB) Set(key []byte, value []byte) error {
+	db.m.write(key, value)
+	return db.KVDB.Set(key, value)
+}
+
+func (db *meterKVDB) List(prefix, key []byte, count, direction int32) ([][]byte, error) {
+	values, err := db.KVDB.List(prefix, key, count, direction)
+	db.m.read(prefix, key)
+	for _, value := range values {
+		db.m.readBytes += int64(len(value))
+	}
+	return values, err
+}
+
+//initMeter 开启ForkTxCost之后, 执行器使用的数据库都需要计量
+func (e *executor) initMeter() {
+	schedule := types.GetTxCostSchedule(e.height)
+	if schedule == nil {
+		e.meter = nil
+		return
+	}
+	e.meter = &txMeter{schedule: schedule, budget: -1}
+	e.meterStateDB = &meterKV{KV: e.stateDB, m: e.meter}
+	e.meterLocalDB = &meterKVDB{KVDB: e.localDB, m: e.meter}
+}
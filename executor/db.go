@@ -24,6 +24,13 @@ type StateDB struct {
 	height    int64
 	local     *db.SimpleMVCC
 	opt       *StateDBOption
+	// 并行执行时, 所有快照共享区块执行前的状态
+	base *baseState
+	// 读取区块执行前状态的key和写入的key, 用于并行执行时检测冲突
+	rset map[string]bool
+	wset map[string]bool
+	//TraceTransaction 时记录读写
+	tracer *txTracer
 }
 
 // StateDBOption state db option enable mvcc
@@ -66,6 +73,7 @@ func (s *StateDB) enableMVCC() {
 
 // Begin 开启内存事务处理
 func (s *StateDB) Begin() {
+	s.tracer.add("statedb", "begin", "", nil, nil, nil)
 	s.intx = true
 	s.keys = nil
 	if types.IsFork(s.height, "ForkExecRollback") {
@@ -75,13 +83,18 @@ func (s *StateDB) Begin() {
 
 // Rollback reset tx
 func (s *StateDB) Rollback() {
+	s.tracer.add("statedb", "rollback", "", nil, nil, nil)
 	s.resetTx()
 }
 
 // Commit canche tx
 func (s *StateDB) Commit() {
+	s.tracer.add("statedb", "commit", "", nil, nil, nil)
 	for k, v := range s.txcache {
 		s.cache[k] = v
+		if s.wset != nil {
+			s.wset[k] = true
+		}
 	}
 	s.intx = false
 	s.keys = nil
@@ -100,6 +113,7 @@ func (s *StateDB) resetTx() {
 func (s *StateDB) Get(key []byte) ([]byte, error) {
 	v, err := s.get(key)
 	debugAccount("==get==", key, v)
+	s.tracer.add("statedb", "get", "", key, nil, v)
 	return v, err
 }
 
@@ -113,6 +127,21 @@ func (s *StateDB) get(key []byte) ([]byte, error) {
 	if value, ok := s.cache[skey]; ok {
 		return value, nil
 	}
+	if s.rset != nil {
+		s.rset[skey] = true
+	}
+	if s.base != nil {
+		return s.base.get(key, s.load)
+	}
+	value, err := s.load(key)
+	//get 的值可以写入cache，因为没有对系统的值做修改
+	if err == nil && s.version < 0 {
+		s.cache[skey] = value
+	}
+	return value, err
+}
+
+func (s *StateDB) load(key []byte) ([]byte, error) {
 	//mvcc 是有效的情况下，直接从mvcc中获取
 	if s.version >= 0 {
 		data, err := s.local.GetV(key, s.version)
@@ -137,11 +166,26 @@ func (s *StateDB) get(key []byte) ([]byte, error) {
 		//panic(string(key))
 		return nil, types.ErrNotFound
 	}
-	//get 的值可以写入cache，因为没有对系统的值做修改
-	s.cache[skey] = value
 	return value, nil
 }
 
+// snapshot 创建读取区块执行前状态的快照, 并记录读写的key
+func (s *StateDB) snapshot(base *baseState, localdb db.KVDB) *StateDB {
+	return &StateDB{
+		cache:     make(map[string][]byte),
+		txcache:   make(map[string][]byte),
+		client:    s.client,
+		stateHash: s.stateHash,
+		height:    s.height,
+		version:   s.version,
+		local:     db.NewSimpleMVCC(localdb),
+		opt:       s.opt,
+		base:      base,
+		rset:      make(map[string]bool),
+		wset:      make(map[string]bool),
+	}
+}
+
 func debugAccount(prefix string, key []byte, value []byte) {
 	//println(prefix, string(key), value)
 	/*
@@ -169,6 +213,10 @@ func (s *StateDB) GetSetKeys() (keys []string) {
 // Set set key value to state db
 func (s *StateDB) Set(key []byte, value []byte) error {
 	debugAccount("==set==", key, value)
+	if s.tracer.enabled() {
+		prev, _ := s.get(key)
+		s.tracer.add("statedb", "set", "", key, prev, value)
+	}
 	skey := string(key)
 	if s.intx {
 		if s.txcache == nil {
@@ -178,6 +226,9 @@ func (s *StateDB) Set(key []byte, value []byte) error {
 		setmap(s.txcache, skey, value)
 	} else {
 		setmap(s.cache, skey, value)
+		if s.wset != nil {
+			s.wset[skey] = true
+		}
 	}
 	return nil
 }
@@ -207,6 +258,7 @@ type LocalDB struct {
 	db.TransactionDB
 	cache  map[string][]byte
 	client queue.Client
+	tracer *txTracer
 }
 
 // NewLocalDB new local db
@@ -218,6 +270,7 @@ func NewLocalDB(client queue.Client) db.KVDB {
 func (l *LocalDB) Get(key []byte) ([]byte, error) {
 	value, err := l.get(key)
 	debugAccount("==lget==", key, value)
+	l.tracer.add("localdb", "get", "", key, nil, value)
 	return value, err
 }
 
@@ -251,6 +304,10 @@ func (l *LocalDB) get(key []byte) ([]byte, error) {
 // Set set key value to local db
 func (l *LocalDB) Set(key []byte, value []byte) error {
 	debugAccount("==lset==", key, value)
+	if l.tracer.enabled() {
+		prev, _ := l.get(key)
+		l.tracer.add("localdb", "set", "", key, prev, value)
+	}
 	setmap(l.cache, string(key), value)
 	return nil
 }
@@ -269,6 +326,7 @@ func (l *LocalDB) BatchGet(keys [][]byte) (values [][]byte, err error) {
 
 // List 从数据库中查询数据列表，set 中的cache 更新不会影响这个list
 func (l *LocalDB) List(prefix, key []byte, count, direction int32) ([][]byte, error) {
+	l.tracer.add("localdb", "list", "", prefix, nil, key)
 	if l.client == nil {
 		return nil, types.ErrNotFound
 	}
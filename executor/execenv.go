@@ -29,6 +29,14 @@ type executor struct {
 	txs        []*types.Transaction
 	api        client.QueueProtocolAPI
 	receipts   []*types.ReceiptData
+	tracer     *txTracer
+	// 链上管理的执行器设置, 从父区块的状态读取
+	govern *drivers.Govern
+
+	// 开启ForkTxCost之后, 执行器使用计量的数据库
+	meter        *txMeter
+	meterStateDB dbm.KV
+	meterLocalDB dbm.KVDB
 }
 
 func newExecutor(stateHash []byte, exec *Executor, height, blocktime int64, difficulty uint64,
@@ -48,6 +56,8 @@ func newExecutor(stateHash []byte, exec *Executor, height, blocktime int64, diff
 		receipts:     receipts,
 	}
 	e.coinsAccount.SetDB(e.stateDB)
+	e.govern = drivers.NewGovernWithLoader(e.stateDB.(*StateDB).load, height)
+	e.initMeter()
 	return e
 }
 
@@ -133,8 +143,13 @@ func (e *executor) checkTx(tx *types.Transaction, index int) error {
 }
 
 func (e *executor) setEnv(exec drivers.Driver) {
-	exec.SetStateDB(e.stateDB)
-	exec.SetLocalDB(e.localDB)
+	if e.meter != nil {
+		exec.SetStateDB(e.meterStateDB)
+		exec.SetLocalDB(e.meterLocalDB)
+	} else {
+		exec.SetStateDB(e.stateDB)
+		exec.SetLocalDB(e.localDB)
+	}
 	exec.SetEnv(e.height, e.blocktime, e.difficulty)
 	exec.SetAPI(e.api)
 	exec.SetTxs(e.txs)
@@ -185,9 +200,11 @@ func (e *executor) Exec(tx *types.Transaction, index int) (*types.Receipt, error
 		return nil, err
 	}
 	//第一步先检查 CheckTx
+	e.tracer.call(exec.GetDriverName() + ".CheckTx")
 	if err := exec.CheckTx(tx, index); err != nil {
 		return nil, err
 	}
+	e.tracer.call(exec.GetDriverName() + ".Exec")
 	return exec.Exec(tx, index)
 }
 
@@ -202,12 +219,13 @@ func (e *executor) execDelLocal(tx *types.Transaction, r *types.ReceiptData, ind
 }
 
 func (e *executor) loadDriver(tx *types.Transaction, index int) (c drivers.Driver) {
-	exec := drivers.LoadDriverAllow(tx, index, e.height)
+	exec := drivers.LoadDriverGovern(tx, index, e.height, e.govern)
 	e.setEnv(exec)
 	return exec
 }
 
 func (e *executor) execTxGroup(txs []*types.Transaction, index int) ([]*types.Receipt, error) {
+	e.tracer.call("execTxGroup")
 	txgroup := &types.Transactions{Txs: txs}
 	err := e.checkTxGroup(txgroup, index)
 	if err != nil {
@@ -254,6 +272,67 @@ func (e *executor) execTxGroup(txs []*types.Transaction, index int) ([]*types.Re
 	return receipts, nil
 }
 
+//execTxList 按顺序执行区块中的交易, 执行第i笔交易(交易组为第一笔交易)之前调用before,
+//n为交易组中交易的个数, before返回false时停止执行
+func (e *executor) execTxList(parallel *parallelExec, before func(i, n int) bool) []*types.Receipt {
+	var receipts []*types.Receipt
+	index := 0
+	for i := 0; i < len(e.txs); i++ {
+		tx := e.txs[i]
+		//检查groupcount
+		if tx.GroupCount < 0 || tx.GroupCount == 1 || tx.GroupCount > 20 {
+			receipts = append(receipts, types.NewErrReceipt(types.ErrTxGroupCount))
+			continue
+		}
+		if tx.GroupCount == 0 {
+			if before != nil && !before(i, 1) {
+				break
+			}
+			var receipt *types.Receipt
+			var err error
+			if parallel != nil {
+				receipt, err = parallel.commit(e, i, index)
+			} else {
+				receipt, err = e.execTx(tx, index)
+			}
+			if err != nil {
+				receipts = append(receipts, types.NewErrReceipt(err))
+				continue
+			}
+			receipts = append(receipts, receipt)
+			index++
+			continue
+		}
+		//所有tx.GroupCount > 0 的交易都是错误的交易
+		if !types.IsFork(e.height, "ForkTxGroup") {
+			receipts = append(receipts, types.NewErrReceipt(types.ErrTxGroupNotSupport))
+			continue
+		}
+		//判断GroupCount 是否会产生越界
+		if i+int(tx.GroupCount) > len(e.txs) {
+			receipts = append(receipts, types.NewErrReceipt(types.ErrTxGroupCount))
+			continue
+		}
+		if before != nil && !before(i, int(tx.GroupCount)) {
+			break
+		}
+		receiptlist, err := e.execTxGroup(e.txs[i:i+int(tx.GroupCount)], index)
+		i = i + int(tx.GroupCount) - 1
+		if len(receiptlist) > 0 && len(receiptlist) != int(tx.GroupCount) {
+			panic("len(receiptlist) must be equal tx.GroupCount")
+		}
+		if err != nil {
+			for n := 0; n < int(tx.GroupCount); n++ {
+				receipts = append(receipts, types.NewErrReceipt(err))
+			}
+			continue
+		}
+		receipts = append(receipts, receiptlist...)
+		index += int(tx.GroupCount)
+	}
+	return receipts
+}
+
 func (e *executor) loadFlag(key []byte) (int64, error) {
 	flag := &types.Int64{}
 	flagBytes, err := e.localDB.Get(key)
@@ -283,12 +362,19 @@ func (e *executor) execFee(tx *types.Transaction, index int) (*types.Receipt, er
 		}
 	}
 	var err error
+	budget := int64(-1)
 	//公链不允许手续费为0
 	if !types.IsPara() && types.GInt("MinFee") > 0 && !ex.IsFree() {
+		e.tracer.call("processFee")
 		feelog, err = e.processFee(tx)
 		if err != nil {
 			return nil, err
 		}
+		budget = tx.Fee
+	}
+	//交易组的手续费由第一笔交易支付, 整个交易组共用这个预算
+	if e.meter != nil {
+		e.meter.reset(budget)
 	}
 	return feelog, nil
 }
@@ -306,7 +392,19 @@ func copyReceipt(feelog *types.Receipt) *types.Receipt {
 func (e *executor) execTxOne(feelog *types.Receipt, tx *types.Transaction, index int) (*types.Receipt, error) {
 	//只有到pack级别的，才会增加index
 	e.stateDB.(*StateDB).StartTx()
+	if e.meter != nil {
+		e.meter.start()
+	}
 	receipt, err := e.Exec(tx, index)
+	//资源消耗的log放在执行成功的receipt的最后, 执行失败时只有错误的log
+	var costlog *types.ReceiptLog
+	if e.meter != nil {
+		var costerr error
+		costlog, costerr = e.meter.finish()
+		if err == nil {
+			err = costerr
+		}
+	}
 	if err != nil {
 		elog.Error("exec tx error = ", "err", err, "exec", string(tx.Execer), "action", tx.ActionName())
 		//add error log
@@ -332,6 +430,9 @@ func (e *executor) execTxOne(feelog *types.Receipt, tx *types.Transaction, index
 		feelog.Logs = append(feelog.Logs, receipt.Logs...)
 		feelog.Ty = receipt.Ty
 	}
+	if costlog != nil {
+		feelog.Logs = append(feelog.Logs, costlog)
+	}
 	return feelog, nil
 }
 
@@ -369,6 +470,7 @@ func (e *executor) checkKeyAllow(feelog *types.Receipt, tx *types.Transaction, i
 }
 
 func (e *executor) execTx(tx *types.Transaction, index int) (*types.Receipt, error) {
+	e.tracer.call("execTx")
 	if e.height == 0 { //genesis block 不检查手续费
 		receipt, err := e.Exec(tx, index)
 		if err != nil {
@@ -424,5 +526,9 @@ func (e *executor) execTx(tx *types.Transaction, index int) (*types.Receipt, err
 func (e *executor) isAllowExec(key []byte, tx *types.Transaction, index int) bool {
 	realExecer := e.getRealExecName(tx, index)
 	height := e.height
+	//暂停或者不在允许列表中的执行器的数据不能修改
+	if keyExecer, err := types.FindExecer(key); err == nil && !e.govern.IsExecAllowed(string(keyExecer)) {
+		return false
+	}
 	return isAllowKeyWrite(key, realExecer, tx, height)
 }
@@ -41,6 +41,8 @@ type Executor struct {
 	qclient      client.QueueProtocolAPI
 	pluginEnable map[string]bool
 	alias        map[string]string
+	//乐观并行执行区块中的交易
+	enableParallel bool
 }
 
 func execInit(sub map[string][]byte) {
@@ -71,6 +73,9 @@ func New(cfg *types.Exec, sub map[string][]byte) *Executor {
 	exec.pluginEnable["addrindex"] = !cfg.DisableAddrIndex
 	exec.pluginEnable["txindex"] = true
 	exec.pluginEnable["fee"] = true
+	exec.pluginEnable["logindex"] = cfg.EnableLogIndex
+	exec.pluginEnable["balancehistory"] = cfg.EnableBalanceHistory
+	exec.enableParallel = cfg.EnableParallel
 
 	exec.alias = make(map[string]string)
 	for _, v := range cfg.Alias {
@@ -115,6 +120,14 @@ func (exec *Executor) SetQueueClient(qcli queue.Client) {
 				go exec.procExecCheckTx(msg)
 			} else if msg.Ty == types.EventBlockChainQuery {
 				go exec.procExecQuery(msg)
+			} else if msg.Ty == types.EventSimulateTx {
+				go exec.procExecSimulateTx(msg)
+			} else if msg.Ty == types.EventTraceTx {
+				go exec.procExecTraceTx(msg)
+			} else if msg.Ty == types.EventExecLocalDBVersions {
+				go exec.procExecLocalDBVersions(msg)
+			} else if msg.Ty == types.EventReIndexExecLocal {
+				go exec.procReIndexExecLocal(msg)
 			}
 		}
 	}()
@@ -182,48 +195,14 @@ func (exec *Executor) procExecTxList(msg queue.Message) {
 	execute := newExecutor(datas.StateHash, exec, datas.Height, datas.BlockTime, datas.Difficulty, datas.Txs, nil)
 	execute.enableMVCC()
 	execute.api = exec.qclient
-	var receipts []*types.Receipt
-	index := 0
-	for i := 0; i < len(datas.Txs); i++ {
-		tx := datas.Txs[i]
-		//检查groupcount
-		if tx.GroupCount < 0 || tx.GroupCount == 1 || tx.GroupCount > 20 {
-			receipts = append(receipts, types.NewErrReceipt(types.ErrTxGroupCount))
-			continue
-		}
-		if tx.GroupCount == 0 {
-			receipt, err := execute.execTx(tx, index)
-			if err != nil {
-				receipts = append(receipts, types.NewErrReceipt(err))
-				continue
-			}
-			receipts = append(receipts, receipt)
-			index++
-			continue
-		}
-		//所有tx.GroupCount > 0 的交易都是错误的交易
-		if !types.IsFork(datas.Height, "ForkTxGroup") {
-			receipts = append(receipts, types.NewErrReceipt(types.ErrTxGroupNotSupport))
-			continue
-		}
-		//判断GroupCount 是否会产生越界
-		if i+int(tx.GroupCount) > len(datas.Txs) {
-			receipts = append(receipts, types.NewErrReceipt(types.ErrTxGroupCount))
-			continue
-		}
-		receiptlist, err := execute.execTxGroup(datas.Txs[i:i+int(tx.GroupCount)], index)
-		i = i + int(tx.GroupCount) - 1
-		if len(receiptlist) > 0 && len(receiptlist) != int(tx.GroupCount) {
-			panic("len(receiptlist) must be equal tx.GroupCount")
-		}
-		if err != nil {
-			for n := 0; n < int(tx.GroupCount); n++ {
-				receipts = append(receipts, types.NewErrReceipt(err))
-			}
-			continue
-		}
-		receipts = append(receipts, receiptlist...)
-		index += int(tx.GroupCount)
+	var parallel *parallelExec
+	if exec.enableParallel && datas.Height > 0 && types.IsFork(datas.Height, "ForkExecRollback") {
+		execute.stateDB.(*StateDB).wset = make(map[string]bool)
+		parallel = execute.execTxsParallel(datas.Txs)
+	}
+	receipts := execute.execTxList(parallel, nil)
+	if parallel != nil {
+		elog.Debug("procExecTxList parallel", "height", datas.Height, "ntx", len(datas.Txs), "reexec", parallel.reexec)
 	}
 	msg.Reply(exec.client.NewMessage("", types.EventReceipts,
 		&types.Receipts{Receipts: receipts}))
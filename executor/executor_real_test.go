@@ -11,11 +11,14 @@ import (
 	"testing"
 
 	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
 	"github.com/33cn/chain33/common/merkle"
 	_ "github.com/33cn/chain33/system"
+	pty "github.com/33cn/chain33/system/dapp/manage/types"
 	"github.com/33cn/chain33/types"
 	"github.com/33cn/chain33/util"
 	"github.com/33cn/chain33/util/testnode"
+	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -250,6 +253,300 @@ func TestExecBlock(t *testing.T) {
 	util.ExecBlock(mock33.GetClient(), nil, block, false, true)
 }
 
+//并行执行和顺序执行的receipt和状态必须完全相同
+func TestExecParallel(t *testing.T) {
+	genkey := util.TestPrivkeyList[1]
+	var blocks [][]*types.Transaction
+	//创世地址给多个地址转账, 所有的交易互相冲突
+	var addrs []string
+	var privs []crypto.PrivKey
+	var txs []*types.Transaction
+	for i := 0; i < 20; i++ {
+		addr, priv := util.Genaddress()
+		addrs = append(addrs, addr)
+		privs = append(privs, priv)
+		txs = append(txs, util.CreateCoinsTx(genkey, addr, 10*types.Coin))
+	}
+	blocks = append(blocks, txs)
+
+	//大部分交易互不冲突, 部分交易的转出地址是之前交易的转入地址, 还有余额不足的交易
+	txs = nil
+	for i := 0; i < 20; i++ {
+		to, _ := util.Genaddress()
+		txs = append(txs, util.CreateCoinsTx(privs[i], to, types.Coin))
+		if i%5 == 0 {
+			txs = append(txs, util.CreateCoinsTx(privs[i], addrs[(i+1)%20], 5*types.Coin))
+		}
+		if i%7 == 0 {
+			txs = append(txs, util.CreateCoinsTx(privs[i], to, 100*types.Coin))
+		}
+	}
+	blocks = append(blocks, txs)
+
+	//交易组和普通交易混合
+	txs = nil
+	for i := 0; i < 10; i++ {
+		txs = append(txs, util.CreateCoinsTx(privs[i], addrs[i+10], types.Coin))
+	}
+	group := []*types.Transaction{
+		util.CreateCoinsTx(privs[10], addrs[0], types.Coin),
+		util.CreateCoinsTx(privs[11], addrs[1], types.Coin),
+	}
+	txgroup, err := types.CreateTxGroup(group)
+	assert.Nil(t, err)
+	txgroup.SignN(0, types.SECP256K1, privs[10])
+	txgroup.SignN(1, types.SECP256K1, privs[11])
+	txs = append(txs, txgroup.GetTxs()...)
+	for i := 0; i < 10; i++ {
+		txs = append(txs, util.CreateCoinsTx(privs[i+10], addrs[i], types.Coin))
+	}
+	blocks = append(blocks, txs)
+
+	receipts1, hashes1 := execBlocks(t, false, blocks)
+	receipts2, hashes2 := execBlocks(t, true, blocks)
+	assert.Equal(t, len(blocks), len(receipts2))
+	for i := range receipts1 {
+		assert.Equal(t, len(blocks[i]), len(receipts1[i].Receipts))
+		assert.True(t, proto.Equal(receipts1[i], receipts2[i]))
+		assert.Equal(t, hashes1[i], hashes2[i])
+	}
+}
+
+func execBlocks(t *testing.T, parallel bool, blocks [][]*types.Transaction) (receipts []*types.Receipts, hashes [][]byte) {
+	prev := types.GInt("MinFee")
+	defer types.SetMinFee(prev)
+	cfg, sub := testnode.GetDefaultConfig()
+	cfg.Consensus.Minerstart = false
+	cfg.Exec.EnableParallel = parallel
+	cfg.Exec.MinExecFee = 100000
+	mock33 := testnode.NewWithConfig(cfg, sub, nil)
+	defer mock33.Close()
+	mock33.WaitHeight(0)
+	parent := mock33.GetBlock(0)
+	for _, txs := range blocks {
+		block := util.CreateNewBlock(parent, txs)
+		receipts = append(receipts, util.ExecTx(mock33.GetClient(), parent.StateHash, block))
+		detail, _, err := util.ExecBlock(mock33.GetClient(), parent.StateHash, block, false, true)
+		assert.Nil(t, err)
+		hashes = append(hashes, detail.Block.StateHash)
+		parent = detail.Block
+	}
+	return receipts, hashes
+}
+
+func TestSimulateTx(t *testing.T) {
+	mock33 := newMockNode()
+	defer mock33.Close()
+	prev := types.GInt("MinFee")
+	types.SetMinFee(100000)
+	defer types.SetMinFee(prev)
+	genkey := mock33.GetGenesisKey()
+	genaddr := mock33.GetGenesisAddress()
+	mock33.WaitHeight(0)
+	block := mock33.GetBlock(0)
+	addr, priv := util.Genaddress()
+
+	//没有签名的交易, 使用公钥计算from地址
+	tx := util.CreateCoinsTx(genkey, addr, types.Coin)
+	tx.Signature = nil
+	tx.Fee = 0
+	_, err := mock33.GetAPI().SimulateTx(&types.ReqSimulateTx{Tx: tx})
+	assert.Equal(t, types.ErrInvalidParam, err)
+	reply, err := mock33.GetAPI().SimulateTx(&types.ReqSimulateTx{Tx: tx, Pubkey: genkey.PubKey().Bytes()})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), reply.Height)
+	assert.Equal(t, block.StateHash, reply.StateHash)
+	assert.True(t, reply.Fee >= 100000)
+	assert.Equal(t, 1, len(reply.Receipts))
+	assert.Equal(t, int32(types.ExecOk), reply.Receipts[0].Ty)
+	assert.Equal(t, genaddr, reply.Txs[0].From())
+	assert.Equal(t, 2, len(reply.WriteKeys))
+	assert.Equal(t, len(reply.WriteKeys), len(reply.ReadKeys))
+	//状态没有修改
+	assert.Equal(t, 100000000*types.Coin, mock33.GetAccount(block.StateHash, genaddr).Balance)
+
+	//余额不足的交易只扣除手续费
+	tx = util.CreateCoinsTx(genkey, addr, 200000000*types.Coin)
+	reply, err = mock33.GetAPI().SimulateTx(&types.ReqSimulateTx{Tx: tx})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(types.ExecPack), reply.Receipts[0].Ty)
+	assert.Equal(t, 1, len(reply.WriteKeys))
+
+	//交易组中第二笔交易的转出地址没有余额, 整个交易组回滚
+	txs := []*types.Transaction{
+		util.CreateCoinsTx(genkey, addr, types.Coin),
+		util.CreateCoinsTx(priv, genaddr, 2*types.Coin),
+	}
+	txgroup, err := types.CreateTxGroup(txs)
+	assert.Nil(t, err)
+	txgroup.SignN(0, types.SECP256K1, genkey)
+	txgroup.SignN(1, types.SECP256K1, priv)
+	reply, err = mock33.GetAPI().SimulateTx(&types.ReqSimulateTx{Tx: txgroup.Tx()})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(reply.Receipts))
+	assert.Equal(t, int32(types.ExecPack), reply.Receipts[0].Ty)
+	assert.Equal(t, int32(types.ExecPack), reply.Receipts[1].Ty)
+}
+
+func TestTxCost(t *testing.T) {
+	mock33 := newMockNode()
+	defer mock33.Close()
+	prev := types.GInt("MinFee")
+	types.SetMinFee(100000)
+	defer types.SetMinFee(prev)
+	genkey := mock33.GetGenesisKey()
+	mock33.WaitHeight(0)
+	addr, _ := util.Genaddress()
+
+	tx := util.CreateCoinsTx(genkey, addr, types.Coin)
+	//没有开启ForkTxCost时不计量
+	reply, err := mock33.GetAPI().SimulateTx(&types.ReqSimulateTx{Tx: tx})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(types.ExecOk), reply.Receipts[0].Ty)
+	assert.Nil(t, getTxCost(t, reply.Receipts[0]))
+
+	types.ReplaceFork("local", "ForkTxCost", 0)
+	defer types.ReplaceFork("local", "ForkTxCost", types.MaxHeight)
+	reply, err = mock33.GetAPI().SimulateTx(&types.ReqSimulateTx{Tx: tx})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(types.ExecOk), reply.Receipts[0].Ty)
+	cost := getTxCost(t, reply.Receipts[0])
+	assert.True(t, cost.Reads > 0)
+	assert.True(t, cost.Writes > 0)
+	assert.True(t, cost.Cost > 0)
+	assert.Equal(t, cost.Cost, cost.Total)
+	assert.Equal(t, tx.Fee, cost.Budget)
+
+	//读写的费用超过了手续费, 交易执行失败, 只扣除手续费
+	types.RegisterTxCostSchedule("ForkTxCost", &types.TxCostSchedule{Write: tx.Fee})
+	defer types.RegisterTxCostSchedule("ForkTxCost", &types.TxCostSchedule{Read: 100, ReadByte: 1, Write: 1000, WriteByte: 10})
+	reply, err = mock33.GetAPI().SimulateTx(&types.ReqSimulateTx{Tx: tx})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(types.ExecPack), reply.Receipts[0].Ty)
+	//执行失败的receipt最后是错误的log, 没有资源消耗的log
+	assert.Nil(t, getTxCost(t, reply.Receipts[0]))
+	logs := reply.Receipts[0].Logs
+	assert.Equal(t, int32(types.TyLogErr), logs[len(logs)-1].Ty)
+	assert.Equal(t, types.ErrTxCostExceed.Error(), string(logs[len(logs)-1].Log))
+	assert.Equal(t, 1, len(reply.WriteKeys))
+}
+
+func getTxCost(t *testing.T, receipt *types.Receipt) *types.ReceiptTxCost {
+	for _, l := range receipt.Logs {
+		if l.Ty == types.TyLogTxCost {
+			var cost types.ReceiptTxCost
+			assert.Nil(t, types.Decode(l.Log, &cost))
+			return &cost
+		}
+	}
+	return nil
+}
+
+func TestGetLogs(t *testing.T) {
+	cfg, sub := testnode.GetDefaultConfig()
+	cfg.Exec.EnableLogIndex = true
+	mock33 := testnode.NewWithConfig(cfg, sub, nil)
+	defer mock33.Close()
+	mock33.Listen()
+	genkey := mock33.GetGenesisKey()
+	addr, _ := util.Genaddress()
+	var heights []int64
+	for i := 0; i < 3; i++ {
+		hash := mock33.SendTx(util.CreateCoinsTx(genkey, addr, types.Coin))
+		detail, err := mock33.WaitTx(hash)
+		assert.Nil(t, err)
+		heights = append(heights, detail.Height)
+	}
+	getLogs := func(req *types.ReqLogs) *types.ReplyLogs {
+		req.Execer = "coins"
+		req.Ty = types.TyLogTransfer
+		req.Addr = addr
+		msg, err := mock33.GetAPI().Query("coins", "GetLogs", req)
+		assert.Nil(t, err)
+		return msg.(*types.ReplyLogs)
+	}
+	reply := getLogs(&types.ReqLogs{EndHeight: -1, Count: 10, Direction: 1})
+	assert.Equal(t, 3, len(reply.Logs))
+	assert.Equal(t, "", reply.Cursor)
+	for i, l := range reply.Logs {
+		assert.Equal(t, heights[i], l.Height)
+	}
+
+	//翻页
+	reply = getLogs(&types.ReqLogs{EndHeight: -1, Count: 2, Direction: 1})
+	assert.Equal(t, 2, len(reply.Logs))
+	assert.NotEqual(t, "", reply.Cursor)
+	reply = getLogs(&types.ReqLogs{EndHeight: -1, Count: 2, Direction: 1, Cursor: reply.Cursor})
+	assert.Equal(t, 1, len(reply.Logs))
+	assert.Equal(t, heights[2], reply.Logs[0].Height)
+
+	//高度范围
+	reply = getLogs(&types.ReqLogs{StartHeight: heights[1], EndHeight: -1, Count: 10, Direction: 1})
+	assert.Equal(t, 2, len(reply.Logs))
+	assert.Equal(t, heights[1], reply.Logs[0].Height)
+	reply = getLogs(&types.ReqLogs{EndHeight: heights[1], Count: 10, Direction: 0})
+	assert.Equal(t, 2, len(reply.Logs))
+	assert.Equal(t, heights[1], reply.Logs[0].Height)
+	assert.Equal(t, heights[0], reply.Logs[1].Height)
+	reply = getLogs(&types.ReqLogs{StartHeight: heights[1], EndHeight: heights[1], Count: 10, Direction: 1})
+	assert.Equal(t, 1, len(reply.Logs))
+	reply = getLogs(&types.ReqLogs{StartHeight: heights[0], EndHeight: -1, Count: 10, Direction: 1})
+	assert.Equal(t, 3, len(reply.Logs))
+	reply = getLogs(&types.ReqLogs{StartHeight: heights[2], EndHeight: -1, Count: 10, Direction: 1})
+	assert.Equal(t, 1, len(reply.Logs))
+	assert.Equal(t, heights[2], reply.Logs[0].Height)
+	reply = getLogs(&types.ReqLogs{StartHeight: heights[2] + 1, EndHeight: -1, Count: 10, Direction: 1})
+	assert.Equal(t, 0, len(reply.Logs))
+
+	_, err := mock33.GetAPI().Query("coins", "GetLogs", &types.ReqLogs{Execer: "coins", Count: 0})
+	assert.Equal(t, types.ErrInvalidParam, err)
+}
+
+func TestGetBalanceHistory(t *testing.T) {
+	cfg, sub := testnode.GetDefaultConfig()
+	cfg.Exec.EnableBalanceHistory = true
+	mock33 := testnode.NewWithConfig(cfg, sub, nil)
+	defer mock33.Close()
+	mock33.Listen()
+	genkey := mock33.GetGenesisKey()
+	addr, _ := util.Genaddress()
+	var heights []int64
+	for i := 1; i <= 3; i++ {
+		hash := mock33.SendTx(util.CreateCoinsTx(genkey, addr, int64(i)*types.Coin))
+		detail, err := mock33.WaitTx(hash)
+		assert.Nil(t, err)
+		heights = append(heights, detail.Height)
+	}
+	query := func(req *types.ReqBalanceHistory) *types.ReplyBalanceHistory {
+		req.Addr = addr
+		msg, err := mock33.GetAPI().Query("coins", "GetBalanceHistory", req)
+		assert.Nil(t, err)
+		return msg.(*types.ReplyBalanceHistory)
+	}
+	reply := query(&types.ReqBalanceHistory{Count: 10})
+	assert.Equal(t, 3, len(reply.Changes))
+	assert.Equal(t, "", reply.Cursor)
+	//默认按照高度倒序
+	assert.Equal(t, heights[2], reply.Changes[0].Height)
+	assert.Equal(t, 6*types.Coin, reply.Changes[0].Current.Balance)
+	assert.Equal(t, 3*types.Coin, reply.Changes[0].Prev.Balance)
+	assert.Equal(t, types.BTY, reply.Changes[0].Symbol)
+
+	//翻页
+	reply = query(&types.ReqBalanceHistory{Execer: "coins", Count: 2, Direction: 1})
+	assert.Equal(t, 2, len(reply.Changes))
+	assert.Equal(t, heights[0], reply.Changes[0].Height)
+	assert.NotEqual(t, "", reply.Cursor)
+	reply = query(&types.ReqBalanceHistory{Execer: "coins", Count: 2, Direction: 1, Cursor: reply.Cursor})
+	assert.Equal(t, 1, len(reply.Changes))
+	assert.Equal(t, heights[2], reply.Changes[0].Height)
+
+	reply = query(&types.ReqBalanceHistory{Execer: "coins", Symbol: "none", Count: 10})
+	assert.Equal(t, 0, len(reply.Changes))
+	_, err := mock33.GetAPI().Query("coins", "GetBalanceHistory", &types.ReqBalanceHistory{Addr: addr, Symbol: types.BTY, Count: 10})
+	assert.Equal(t, types.ErrInvalidParam, err)
+}
+
 //区块执行性能更好的一个测试
 //1. 先生成 10万个账户，每个账户转1000个币
 //2. 每个区块随机取1万比交易，然后执行
@@ -271,3 +568,95 @@ func BenchmarkExecBlock(b *testing.B) {
 		util.ExecBlock(mock33.GetClient(), block0.StateHash, block, false, true)
 	}
 }
+
+func createGovernTx(t *testing.T, priv crypto.PrivKey, action string, msg types.Message) *types.Transaction {
+	tx, err := types.LoadExecutorType("manage").Create(action, msg)
+	assert.Nil(t, err)
+	tx, err = types.FormatTx("manage", tx)
+	assert.Nil(t, err)
+	tx.Sign(types.SECP256K1, priv)
+	return tx
+}
+
+func TestExecGovern(t *testing.T) {
+	mock33 := testnode.New("", nil)
+	defer mock33.Close()
+	mock33.Listen()
+	genkey := mock33.GetGenesisKey()
+	addr, _ := util.Genaddress()
+	//hotkey 是配置中的superManager
+	superkey := mock33.GetHotKey()
+	assert.Nil(t, mock33.SendHot())
+	getStatus := func() *pty.ReplyExecStatus {
+		msg, err := mock33.GetAPI().Query("manage", "GetExecStatus", &types.ReqString{Data: "coins"})
+		assert.Nil(t, err)
+		return msg.(*pty.ReplyExecStatus)
+	}
+	assert.True(t, getStatus().Allowed)
+
+	//设置的高度必须大于交易所在的区块
+	tx := createGovernTx(t, superkey, "ExecStatus", &types.ExecStatus{Name: "coins", Paused: true, Height: 1})
+	detail, err := mock33.WaitTx(mock33.SendTx(tx))
+	assert.Nil(t, err)
+	assert.Equal(t, int32(types.ExecPack), detail.Receipt.Ty)
+	//manage 执行器不能被暂停
+	tx = createGovernTx(t, superkey, "ExecStatus", &types.ExecStatus{Name: "manage", Paused: true, Height: 1000})
+	detail, err = mock33.WaitTx(mock33.SendTx(tx))
+	assert.Nil(t, err)
+	assert.Equal(t, int32(types.ExecPack), detail.Receipt.Ty)
+
+	//暂停coins执行器, 转账交易使用none执行器, 余额不变
+	height := mock33.GetLastBlock().Height
+	tx = createGovernTx(t, superkey, "ExecStatus", &types.ExecStatus{Name: "coins", Paused: true, Height: height + 2})
+	detail, err = mock33.WaitTx(mock33.SendTx(tx))
+	assert.Nil(t, err)
+	assert.Equal(t, int32(types.ExecOk), detail.Receipt.Ty)
+	assert.Equal(t, height+1, detail.Height)
+	status := getStatus()
+	assert.False(t, status.Allowed)
+	assert.True(t, status.Status.Paused)
+	assert.Equal(t, height+2, status.Status.Height)
+
+	detail, err = mock33.WaitTx(mock33.SendTx(util.CreateCoinsTx(genkey, addr, types.Coin)))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), mock33.GetAccount(mock33.GetLastBlock().StateHash, addr).Balance)
+
+	//恢复coins执行器, mempool 按照最新区块的状态检查交易
+	height = mock33.GetLastBlock().Height
+	tx = createGovernTx(t, superkey, "ExecStatus", &types.ExecStatus{Name: "coins", Paused: false, Height: height + 2})
+	_, err = mock33.WaitTx(mock33.SendTx(tx))
+	assert.Nil(t, err)
+	assert.True(t, getStatus().Allowed)
+	_, err = mock33.GetAPI().SendTx(util.CreateCoinsTx(genkey, addr, types.Coin))
+	assert.NotNil(t, err)
+
+	//白名单
+	height = mock33.GetLastBlock().Height
+	tx = createGovernTx(t, superkey, "Whitelist", &types.ExecWhitelist{Execs: []string{"coins", "ticket"}, Height: height + 2})
+	_, err = mock33.WaitTx(mock33.SendTx(tx))
+	assert.Nil(t, err)
+	_, err = mock33.WaitTx(mock33.SendTx(util.CreateCoinsTx(genkey, addr, types.Coin)))
+	assert.Nil(t, err)
+	assert.Equal(t, types.Coin, mock33.GetAccount(mock33.GetLastBlock().StateHash, addr).Balance)
+
+	height = mock33.GetLastBlock().Height
+	tx = createGovernTx(t, superkey, "Whitelist", &types.ExecWhitelist{Execs: []string{"ticket"}, Height: height + 2})
+	_, err = mock33.WaitTx(mock33.SendTx(tx))
+	assert.Nil(t, err)
+	status = getStatus()
+	assert.False(t, status.Allowed)
+	assert.False(t, status.Whitelisted)
+	msg, err := mock33.GetAPI().Query("manage", "GetExecWhitelist", &types.ReqNil{})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"ticket"}, msg.(*types.ExecWhitelist).Execs)
+	assert.Equal(t, []string{"coins", "ticket"}, msg.(*types.ExecWhitelist).PrevExecs)
+
+	//修改还没有开启的dapp fork
+	tx = createGovernTx(t, superkey, "DappFork", &types.DappForkHeight{Dapp: "manage", Fork: "ForkManageGovern", Height: 1000})
+	detail, err = mock33.WaitTx(mock33.SendTx(tx))
+	assert.Nil(t, err)
+	assert.Equal(t, int32(types.ExecPack), detail.Receipt.Ty)
+	msg, err = mock33.GetAPI().Query("manage", "GetDappFork", &types.DappForkHeight{Dapp: "manage", Fork: "ForkManageGovern"})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), msg.(*types.DappForkHeight).Height)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/33cn/chain33/queue"
 	_ "github.com/33cn/chain33/system"
 	drivers "github.com/33cn/chain33/system/dapp"
+	cty "github.com/33cn/chain33/system/dapp/coins/types"
 	"github.com/33cn/chain33/types"
 	"github.com/33cn/chain33/util"
 	"github.com/stretchr/testify/assert"
@@ -137,3 +138,112 @@ func TestKeyLocalAllow(t *testing.T) {
 	err = isAllowLocalKey([]byte("user.p.para.paracross"), []byte("LODB-paracross-xxxx"))
 	assert.Nil(t, err)
 }
+
+func TestLogIndexKV(t *testing.T) {
+	addr, _ := util.Genaddress()
+	tx := util.CreateCoinsTx(util.TestPrivkeyList[0], addr, types.Coin)
+	transfer := &types.ReceiptAccountTransfer{
+		Prev:    &types.Account{Addr: addr},
+		Current: &types.Account{Addr: addr, Balance: types.Coin},
+	}
+	receipt := &types.ReceiptData{Ty: types.ExecOk, Logs: []*types.ReceiptLog{
+		{Ty: types.TyLogErr, Log: []byte("err")},
+		{Ty: types.TyLogTransfer, Log: types.Encode(transfer)},
+	}}
+	detail := &types.BlockDetail{
+		Block:    &types.Block{Height: 10, Txs: []*types.Transaction{tx}},
+		Receipts: []*types.ReceiptData{receipt},
+	}
+	kvs := logIndexKV(detail, false)
+	//err log 只有执行器的索引, transfer log 还有地址的索引
+	assert.Equal(t, 3, len(kvs))
+	cursor := drivers.HeightIndexStr(10, 0) + ":0001"
+	assert.Equal(t, types.CalcLogAddrIndexKey(addr, "coins", types.TyLogTransfer, cursor), kvs[2].Key)
+	var info types.LogIndexInfo
+	assert.Nil(t, types.Decode(kvs[2].Value, &info))
+	assert.Equal(t, tx.Hash(), info.Hash)
+	assert.Equal(t, int32(1), info.LogIndex)
+	assert.Equal(t, cursor, info.Cursor)
+
+	dels := logIndexKV(detail, true)
+	assert.Equal(t, len(kvs), len(dels))
+	for i := range dels {
+		assert.Equal(t, kvs[i].Key, dels[i].Key)
+		assert.Nil(t, dels[i].Value)
+	}
+}
+
+func TestBalanceHistoryKV(t *testing.T) {
+	addr, _ := util.Genaddress()
+	tx := util.CreateCoinsTx(util.TestPrivkeyList[0], addr, types.Coin)
+	transfer := &types.ReceiptAccountTransfer{
+		Prev:    &types.Account{Addr: addr},
+		Current: &types.Account{Addr: addr, Balance: types.Coin},
+	}
+	receipt := &types.ReceiptData{Ty: types.ExecOk, Logs: []*types.ReceiptLog{
+		{Ty: types.TyLogErr, Log: []byte("err")},
+		{Ty: types.TyLogTransfer, Log: types.Encode(transfer)},
+	}}
+	detail := &types.BlockDetail{
+		Block:    &types.Block{Height: 10, Txs: []*types.Transaction{tx}},
+		Receipts: []*types.ReceiptData{receipt},
+	}
+	kvs := balanceHistoryKV(detail, false)
+	assert.Equal(t, 3, len(kvs))
+	heightindex := drivers.HeightIndexStr(10, 0) + ":0001"
+	assert.Equal(t, types.CalcBalanceHistoryKey(addr, "", "", heightindex), kvs[0].Key)
+	assert.Equal(t, types.CalcBalanceHistoryKey(addr, "coins", "", heightindex), kvs[1].Key)
+	assert.Equal(t, types.CalcBalanceHistoryKey(addr, "coins", types.BTY, heightindex), kvs[2].Key)
+	var change types.BalanceChange
+	assert.Nil(t, types.Decode(kvs[0].Value, &change))
+	assert.Equal(t, tx.Hash(), change.Hash)
+	assert.Equal(t, types.Coin, change.Current.Balance)
+	assert.Equal(t, heightindex, change.Cursor)
+
+	dels := balanceHistoryKV(detail, true)
+	assert.Equal(t, len(kvs), len(dels))
+	for i := range dels {
+		assert.Equal(t, kvs[i].Key, dels[i].Key)
+		assert.Nil(t, dels[i].Value)
+	}
+}
+
+func TestBalanceHistoryTokenKV(t *testing.T) {
+	//token 执行器的转账使用和coins相同的payload, 系统log是token账户的变化
+	if types.LoadExecutorType("token") == nil {
+		types.RegistorExecutor("token", cty.NewType())
+	}
+	addr, _ := util.Genaddress()
+	action := &cty.CoinsAction{
+		Ty:    cty.CoinsActionTransfer,
+		Value: &cty.CoinsAction_Transfer{Transfer: &types.AssetsTransfer{Cointoken: "TEST", Amount: 5, To: addr}},
+	}
+	tx := &types.Transaction{Execer: []byte("token"), Payload: types.Encode(action), Fee: types.Coin, To: addr}
+	from, _ := util.Genaddress()
+	fee := &types.ReceiptAccountTransfer{
+		Prev:    &types.Account{Addr: from, Balance: 2 * types.Coin},
+		Current: &types.Account{Addr: from, Balance: types.Coin},
+	}
+	transfer := &types.ReceiptAccountTransfer{
+		Prev:    &types.Account{Addr: addr},
+		Current: &types.Account{Addr: addr, Balance: 5},
+	}
+	receipt := &types.ReceiptData{Ty: types.ExecOk, Logs: []*types.ReceiptLog{
+		{Ty: types.TyLogFee, Log: types.Encode(fee)},
+		{Ty: types.TyLogTransfer, Log: types.Encode(transfer)},
+	}}
+	detail := &types.BlockDetail{
+		Block:    &types.Block{Height: 10, Txs: []*types.Transaction{tx}},
+		Receipts: []*types.ReceiptData{receipt},
+	}
+	kvs := balanceHistoryKV(detail, false)
+	assert.Equal(t, 6, len(kvs))
+	heightindex := drivers.HeightIndexStr(10, 0)
+	assert.Equal(t, types.CalcBalanceHistoryKey(from, "coins", types.BTY, heightindex+":0000"), kvs[2].Key)
+	assert.Equal(t, types.CalcBalanceHistoryKey(addr, "token", "TEST", heightindex+":0001"), kvs[5].Key)
+	var change types.BalanceChange
+	assert.Nil(t, types.Decode(kvs[5].Value, &change))
+	assert.Equal(t, "token", change.Execer)
+	assert.Equal(t, "TEST", change.Symbol)
+	assert.Equal(t, int64(5), change.Current.Balance)
+}
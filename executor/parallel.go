@@ -0,0 +1,164 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/types"
+)
+
+//乐观并行执行交易:
+//1. 非交易组的交易在区块执行前状态的快照上并行执行，记录每笔交易读取和写入的key
+//2. 按区块顺序提交执行结果，如果交易读取的key已经被之前的交易修改，或者交易的index和预计的不同，
+//   在当前状态上重新执行这笔交易
+//3. 交易组按顺序执行
+//这样得到的receipt和kv与顺序执行的结果完全相同
+
+var parallelWorkers = runtime.NumCPU()
+
+// baseState 所有快照共享的区块执行前的状态, 并发安全
+type baseState struct {
+	mtx    sync.RWMutex
+	values map[string]*baseValue
+}
+
+type baseValue struct {
+	value []byte
+	err   error
+}
+
+func newBaseState() *baseState {
+	return &baseState{values: make(map[string]*baseValue)}
+}
+
+func (b *baseState) get(key []byte, load func([]byte) ([]byte, error)) ([]byte, error) {
+	b.mtx.RLock()
+	v, ok := b.values[string(key)]
+	b.mtx.RUnlock()
+	if ok {
+		return v.value, v.err
+	}
+	value, err := load(key)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	b.mtx.Lock()
+	b.values[string(key)] = &baseValue{value: value, err: err}
+	b.mtx.Unlock()
+	return value, err
+}
+
+// parallelTx 一笔交易在快照上执行的结果
+type parallelTx struct {
+	index   int
+	receipt *types.Receipt
+	err     error
+	db      *StateDB
+}
+
+// parallelExec 一个区块中并行执行的交易, 按照交易在区块中的位置保存
+type parallelExec struct {
+	txs    []*parallelTx
+	reexec int
+}
+
+// snapshot 创建在区块执行前状态上执行交易的执行环境
+func (e *executor) snapshot(base *baseState) *executor {
+	db := e.stateDB.(*StateDB)
+	localdb := NewLocalDB(db.client)
+	snap := &executor{
+		stateDB:      db.snapshot(base, localdb),
+		localDB:      localdb,
+		coinsAccount: account.NewCoinsAccount(),
+		height:       e.height,
+		blocktime:    e.blocktime,
+		difficulty:   e.difficulty,
+		txs:          e.txs,
+		api:          e.api,
+		receipts:     e.receipts,
+		govern:       e.govern,
+	}
+	snap.coinsAccount.SetDB(snap.stateDB)
+	snap.initMeter()
+	return snap
+}
+
+// execTxsParallel 在区块执行前的状态上并行执行所有非交易组的交易,
+// 交易的index按照前面的交易都执行成功预计
+func (e *executor) execTxsParallel(txs []*types.Transaction) *parallelExec {
+	p := &parallelExec{txs: make([]*parallelTx, len(txs))}
+	jobs := make(chan int, len(txs))
+	index := 0
+	for i := 0; i < len(txs); i++ {
+		tx := txs[i]
+		if tx.GroupCount < 0 || tx.GroupCount == 1 || tx.GroupCount > 20 {
+			continue
+		}
+		if tx.GroupCount == 0 {
+			p.txs[i] = &parallelTx{index: index}
+			jobs <- i
+			index++
+			continue
+		}
+		if !types.IsFork(e.height, "ForkTxGroup") || i+int(tx.GroupCount) > len(txs) {
+			continue
+		}
+		index += int(tx.GroupCount)
+		i = i + int(tx.GroupCount) - 1
+	}
+	close(jobs)
+	workers := parallelWorkers
+	if workers > len(jobs) {
+		workers = len(jobs)
+	}
+	base := newBaseState()
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				ptx := p.txs[i]
+				snap := e.snapshot(base)
+				ptx.receipt, ptx.err = snap.execTx(txs[i], ptx.index)
+				ptx.db = snap.stateDB.(*StateDB)
+			}
+		}()
+	}
+	wg.Wait()
+	return p
+}
+
+// commit 按区块顺序提交第i笔交易, 和之前提交的交易冲突时在当前状态上重新执行
+func (p *parallelExec) commit(e *executor, i int, index int) (*types.Receipt, error) {
+	ptx := p.txs[i]
+	db := e.stateDB.(*StateDB)
+	if ptx == nil || ptx.index != index || isConflict(db, ptx.db) {
+		p.reexec++
+		return e.execTx(e.txs[i], index)
+	}
+	for k := range ptx.db.wset {
+		db.Set([]byte(k), ptx.db.cache[k])
+	}
+	return ptx.receipt, ptx.err
+}
+
+// isConflict 快照读取的key被之前的交易修改过, 或者快照删除了key时, 执行结果不能直接提交
+func isConflict(db *StateDB, snap *StateDB) bool {
+	for k := range snap.rset {
+		if db.wset[k] {
+			return true
+		}
+	}
+	for k := range snap.wset {
+		if _, ok := snap.cache[k]; !ok {
+			return true
+		}
+	}
+	return false
+}
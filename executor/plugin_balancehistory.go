@@ -0,0 +1,148 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"fmt"
+
+	drivers "github.com/33cn/chain33/system/dapp"
+	cty "github.com/33cn/chain33/system/dapp/coins/types"
+	"github.com/33cn/chain33/types"
+)
+
+func init() {
+	RegisterPlugin("balancehistory", &balanceHistoryPlugin{})
+}
+
+//balanceHistoryPlugin 根据receipt中的账户变化的log, 记录地址每一次的余额变化
+type balanceHistoryPlugin struct {
+	pluginBase
+}
+
+func (p *balanceHistoryPlugin) CheckEnable(executor *executor, enable bool) (kvs []*types.KeyValue, ok bool, err error) {
+	kvs, ok, err = p.checkFlag(executor, types.FlagBalanceHistory, enable)
+	if err == types.ErrDBFlag {
+		panic("balancehistory config is enable, it must be synchronized from 0 height ")
+	}
+	return kvs, ok, err
+}
+
+func (p *balanceHistoryPlugin) ExecLocal(executor *executor, data *types.BlockDetail) ([]*types.KeyValue, error) {
+	return balanceHistoryKV(data, false), nil
+}
+
+func (p *balanceHistoryPlugin) ExecDelLocal(executor *executor, data *types.BlockDetail) ([]*types.KeyValue, error) {
+	return balanceHistoryKV(data, true), nil
+}
+
+//balanceHistoryKV 区块中所有的余额变化, del 为true时删除
+func balanceHistoryKV(data *types.BlockDetail, del bool) []*types.KeyValue {
+	b := data.Block
+	var kvs []*types.KeyValue
+	for i := 0; i < len(b.Txs) && i < len(data.Receipts); i++ {
+		tx := b.Txs[i]
+		for j, l := range data.Receipts[i].GetLogs() {
+			change := getBalanceChange(tx, l)
+			if change == nil {
+				continue
+			}
+			change.Hash = tx.Hash()
+			change.Height = b.Height
+			change.Index = int64(i)
+			change.LogIndex = int32(j)
+			change.Cursor = fmt.Sprintf("%s:%04d", drivers.HeightIndexStr(b.Height, int64(i)), j)
+			addr := balanceAddr(change)
+			//所有资产, 执行器的资产, 某个资产的三个索引
+			keys := [][]byte{
+				types.CalcBalanceHistoryKey(addr, "", "", change.Cursor),
+				types.CalcBalanceHistoryKey(addr, change.Execer, "", change.Cursor),
+				types.CalcBalanceHistoryKey(addr, change.Execer, change.Symbol, change.Cursor),
+			}
+			var value []byte
+			if !del {
+				value = types.Encode(change)
+			}
+			for _, key := range keys {
+				kvs = append(kvs, &types.KeyValue{Key: key, Value: value})
+			}
+		}
+	}
+	return kvs
+}
+
+//getBalanceChange 从log中解析出账户的变化, 手续费是coins账户的变化,
+//其他系统的log由交易中的资产确定账户, 没有资产信息时认为是coins账户, 执行器自定义的log使用交易中的资产
+func getBalanceChange(tx *types.Transaction, l *types.ReceiptLog) *types.BalanceChange {
+	if l.Ty == types.TyLogErr || l.Ty == types.TyLogReserved {
+		return nil
+	}
+	msg, err := types.DecodeLog(tx.Execer, int64(l.Ty), l.Log)
+	if err != nil {
+		return nil
+	}
+	change := &types.BalanceChange{Ty: l.Ty}
+	switch receipt := msg.(type) {
+	case *types.ReceiptAccountTransfer:
+		change.Prev, change.Current = receipt.Prev, receipt.Current
+	case *types.ReceiptExecAccountTransfer:
+		change.Prev, change.Current = receipt.Prev, receipt.Current
+		change.ExecAddr = receipt.ExecAddr
+	default:
+		return nil
+	}
+	if balanceAddr(change) == "" {
+		return nil
+	}
+	if l.Ty == types.TyLogFee {
+		change.Execer, change.Symbol = cty.CoinsX, types.BTY
+		return change
+	}
+	_, system := types.SystemLog[int64(l.Ty)]
+	if system {
+		change.Execer, change.Symbol = cty.CoinsX, types.BTY
+	} else {
+		change.Execer = string(types.GetRealExecName(tx.Execer))
+		change.Symbol = change.Execer
+	}
+	if ety := types.LoadExecutorType(string(tx.Execer)); ety != nil {
+		if assets, err := ety.GetAssets(tx); err == nil {
+			if asset := matchAsset(assets, change); asset != nil {
+				if asset.Exec != "" {
+					change.Execer = string(types.GetRealExecName([]byte(asset.Exec)))
+				}
+				change.Symbol = asset.Symbol
+			}
+		}
+	}
+	return change
+}
+
+//matchAsset 交易只有一个资产时就是这个资产, 有多个资产时按照余额或者冻结的变化金额匹配
+func matchAsset(assets []*types.Asset, change *types.BalanceChange) *types.Asset {
+	var found []*types.Asset
+	for _, asset := range assets {
+		if asset.GetSymbol() != "" {
+			found = append(found, asset)
+		}
+	}
+	if len(found) == 1 {
+		return found[0]
+	}
+	balance := change.Current.GetBalance() - change.Prev.GetBalance()
+	frozen := change.Current.GetFrozen() - change.Prev.GetFrozen()
+	for _, asset := range found {
+		if asset.Amount == balance || asset.Amount == -balance || asset.Amount == frozen || asset.Amount == -frozen {
+			return asset
+		}
+	}
+	return nil
+}
+
+func balanceAddr(change *types.BalanceChange) string {
+	if change.Current.GetAddr() != "" {
+		return change.Current.GetAddr()
+	}
+	return change.Prev.GetAddr()
+}
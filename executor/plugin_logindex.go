@@ -0,0 +1,128 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+)
+
+func init() {
+	RegisterPlugin("logindex", &logindexPlugin{})
+}
+
+//logindexPlugin 按照执行器, log类型以及log中涉及的地址索引receipt log
+type logindexPlugin struct {
+	pluginBase
+}
+
+func (p *logindexPlugin) CheckEnable(executor *executor, enable bool) (kvs []*types.KeyValue, ok bool, err error) {
+	kvs, ok, err = p.checkFlag(executor, types.FlagLogIndex, enable)
+	if err == types.ErrDBFlag {
+		panic("logindex config is enable, it must be synchronized from 0 height ")
+	}
+	return kvs, ok, err
+}
+
+func (p *logindexPlugin) ExecLocal(executor *executor, data *types.BlockDetail) ([]*types.KeyValue, error) {
+	return logIndexKV(data, false), nil
+}
+
+func (p *logindexPlugin) ExecDelLocal(executor *executor, data *types.BlockDetail) ([]*types.KeyValue, error) {
+	return logIndexKV(data, true), nil
+}
+
+//logIndexKV 区块中所有receipt log的索引, del 为true时删除索引
+func logIndexKV(data *types.BlockDetail, del bool) []*types.KeyValue {
+	b := data.Block
+	var kvs []*types.KeyValue
+	for i := 0; i < len(b.Txs) && i < len(data.Receipts); i++ {
+		tx := b.Txs[i]
+		execer := string(tx.Execer)
+		for j, l := range data.Receipts[i].GetLogs() {
+			cursor := fmt.Sprintf("%s:%04d", drivers.HeightIndexStr(b.Height, int64(i)), j)
+			var value []byte
+			if !del {
+				value = types.Encode(&types.LogIndexInfo{
+					Hash:     tx.Hash(),
+					Height:   b.Height,
+					Index:    int64(i),
+					LogIndex: int32(j),
+					Execer:   execer,
+					Log:      l,
+					Cursor:   cursor,
+				})
+			}
+			key := types.CalcLogIndexKey(execer, l.Ty, cursor)
+			kvs = append(kvs, &types.KeyValue{Key: key, Value: value})
+			for _, addr := range logAddrs(tx.Execer, l) {
+				key := types.CalcLogAddrIndexKey(addr, execer, l.Ty, cursor)
+				kvs = append(kvs, &types.KeyValue{Key: key, Value: value})
+			}
+		}
+	}
+	return kvs
+}
+
+//logAddrs 解析log, 找出log中所有的地址字段(Addr, From, To 以及 xxxAddr)
+func logAddrs(execer []byte, l *types.ReceiptLog) []string {
+	if l.Ty == types.TyLogErr || l.Ty == types.TyLogReserved {
+		return nil
+	}
+	msg, err := types.DecodeLog(execer, int64(l.Ty), l.Log)
+	if err != nil {
+		return nil
+	}
+	set := make(map[string]bool)
+	findAddrs(reflect.ValueOf(msg), set, 0)
+	addrs := make([]string, 0, len(set))
+	for addr := range set {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+	return addrs
+}
+
+func findAddrs(v reflect.Value, set map[string]bool, depth int) {
+	//proto 结构的嵌套层数有限, 防止异常的数据结构
+	if depth > 8 {
+		return
+	}
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			findAddrs(v.Elem(), set, depth+1)
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			findAddrs(v.Index(i), set, depth+1)
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			field := t.Field(i)
+			if field.PkgPath != "" {
+				continue
+			}
+			fv := v.Field(i)
+			if fv.Kind() == reflect.String {
+				if isAddrField(field.Name) && fv.String() != "" {
+					set[fv.String()] = true
+				}
+				continue
+			}
+			findAddrs(fv, set, depth+1)
+		}
+	}
+}
+
+func isAddrField(name string) bool {
+	return name == "From" || name == "To" || strings.HasSuffix(name, "Addr")
+}
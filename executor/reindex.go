@@ -0,0 +1,79 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"sort"
+
+	"github.com/33cn/chain33/queue"
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+)
+
+func (exec *Executor) procExecLocalDBVersions(msg queue.Message) {
+	msg.Reply(exec.client.NewMessage("", types.EventExecLocalDBVersions, getLocalDBVersions()))
+}
+
+//getLocalDBVersions 执行器声明的localdb版本, 按照名字排序
+func getLocalDBVersions() *types.ExecLocalDBMetas {
+	versions := drivers.GetLocalDBVersions()
+	metas := &types.ExecLocalDBMetas{}
+	for name, version := range versions {
+		metas.Metas = append(metas.Metas, &types.ExecLocalDBMeta{Name: name, Version: version})
+	}
+	sort.Slice(metas.Metas, func(i, j int) bool {
+		return metas.Metas[i].Name < metas.Metas[j].Name
+	})
+	return metas
+}
+
+func (exec *Executor) procReIndexExecLocal(msg queue.Message) {
+	kvset, err := exec.reIndexExecLocal(msg.GetData().(*types.ReqReIndexExecLocal))
+	if err != nil {
+		msg.Reply(exec.client.NewMessage("", types.EventReIndexExecLocal, err))
+		return
+	}
+	msg.Reply(exec.client.NewMessage("", types.EventReIndexExecLocal, kvset))
+}
+
+//reIndexExecLocal 和 procExecAddBlock 相同, 但是只执行 execs 中执行器的交易, 不执行插件
+func (exec *Executor) reIndexExecLocal(req *types.ReqReIndexExecLocal) (*types.LocalDBSet, error) {
+	datas := req.GetDetail()
+	if datas == nil || datas.Block == nil {
+		return nil, types.ErrInvalidParam
+	}
+	execs := make(map[string]bool)
+	for _, name := range req.Execs {
+		execs[name] = true
+	}
+	b := datas.Block
+	execute := newExecutor(b.StateHash, exec, b.Height, b.BlockTime, uint64(b.Difficulty), b.Txs, datas.Receipts)
+	execute.enableMVCC()
+	execute.api = exec.qclient
+	for _, kv := range datas.KV {
+		execute.stateDB.Set(kv.Key, kv.Value)
+	}
+	kvset := &types.LocalDBSet{}
+	for i := 0; i < len(b.Txs) && i < len(datas.Receipts); i++ {
+		tx := b.Txs[i]
+		if !execs[string(types.GetRealExecName(tx.Execer))] {
+			continue
+		}
+		kv, err := execute.execLocal(tx, datas.Receipts[i], i)
+		if err == types.ErrActionNotSupport {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if kv != nil && kv.KV != nil {
+			if err := exec.checkPrefix(tx.Execer, kv.KV); err != nil {
+				return nil, err
+			}
+			kvset.KV = append(kvset.KV, kv.KV...)
+		}
+	}
+	return kvset, nil
+}
@@ -0,0 +1,108 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"sort"
+
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+	"github.com/golang/protobuf/proto"
+)
+
+func (exec *Executor) procExecSimulateTx(msg queue.Message) {
+	reply, err := exec.simulateTx(msg.GetData().(*types.ReqSimulateTx))
+	if err != nil {
+		msg.Reply(exec.client.NewMessage("", types.EventSimulateTx, err))
+		return
+	}
+	msg.Reply(exec.client.NewMessage("", types.EventSimulateTx, reply))
+}
+
+//simulateTx 在最新区块的状态上模拟执行交易, 执行的结果不会写入数据库
+func (exec *Executor) simulateTx(req *types.ReqSimulateTx) (*types.ReplySimulateTx, error) {
+	if req.GetTx() == nil {
+		return nil, types.ErrInvalidParam
+	}
+	header, err := exec.qclient.GetLastHeader()
+	if err != nil {
+		return nil, err
+	}
+	txs, err := simulateTxs(proto.Clone(req.Tx).(*types.Transaction), req.Pubkey)
+	if err != nil {
+		return nil, err
+	}
+	height := header.Height + 1
+	execute := newExecutor(header.StateHash, exec, height, types.Now().Unix(), uint64(header.Difficulty), txs, nil)
+	execute.enableMVCC()
+	execute.api = exec.qclient
+	db := execute.stateDB.(*StateDB)
+	db.rset = make(map[string]bool)
+	db.wset = make(map[string]bool)
+	var receipts []*types.Receipt
+	if len(txs) == 1 {
+		receipt, err := execute.execTx(txs[0], 0)
+		if err != nil {
+			return nil, err
+		}
+		receipts = append(receipts, receipt)
+	} else {
+		if !types.IsFork(height, "ForkTxGroup") {
+			return nil, types.ErrTxGroupNotSupport
+		}
+		receipts, err = execute.execTxGroup(txs, 0)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &types.ReplySimulateTx{
+		Height:    height,
+		StateHash: header.StateHash,
+		Fee:       txs[0].Fee,
+		Txs:       txs,
+		Receipts:  receipts,
+		ReadKeys:  sortKeys(db.rset),
+		WriteKeys: sortKeys(db.wset),
+	}, nil
+}
+
+//simulateTxs 展开交易组, 没有签名的交易使用请求中的公钥计算from地址
+func simulateTxs(tx *types.Transaction, pubkey []byte) ([]*types.Transaction, error) {
+	txs := []*types.Transaction{tx}
+	group, err := tx.GetTxGroup()
+	if err != nil {
+		return nil, err
+	}
+	if group != nil {
+		txs = group.GetTxs()
+	} else if tx.Fee == 0 {
+		if err := tx.SetRealFee(types.GInt("MinFee")); err != nil {
+			return nil, err
+		}
+	}
+	for _, tx := range txs {
+		if tx.Signature != nil {
+			continue
+		}
+		if len(pubkey) == 0 {
+			return nil, types.ErrInvalidParam
+		}
+		tx.Signature = &types.Signature{Ty: types.SECP256K1, Pubkey: pubkey}
+	}
+	return txs, nil
+}
+
+func sortKeys(set map[string]bool) [][]byte {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	result := make([][]byte, len(keys))
+	for i, k := range keys {
+		result[i] = []byte(k)
+	}
+	return result
+}
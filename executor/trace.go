@@ -0,0 +1,98 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+)
+
+//txTracer 记录交易执行过程中statedb, localdb的读写和执行器函数的调用,
+//只有active时才记录, nil的时候不做任何事情
+type txTracer struct {
+	active bool
+	ops    []*types.TraceOp
+}
+
+func (t *txTracer) enabled() bool {
+	return t != nil && t.active
+}
+
+func (t *txTracer) add(db, op, name string, key, prev, value []byte) {
+	if !t.enabled() {
+		return
+	}
+	t.ops = append(t.ops, &types.TraceOp{Db: db, Op: op, Name: name, Key: key, Prev: prev, Value: value})
+}
+
+func (t *txTracer) call(name string) {
+	t.add("exec", "call", name, nil, nil, nil)
+}
+
+func (e *executor) setTracer(t *txTracer) {
+	e.tracer = t
+	e.stateDB.(*StateDB).tracer = t
+	e.localDB.(*LocalDB).tracer = t
+}
+
+func (exec *Executor) procExecTraceTx(msg queue.Message) {
+	reply, err := exec.traceTx(msg.GetData().(*types.ReqHash))
+	if err != nil {
+		msg.Reply(exec.client.NewMessage("", types.EventTraceTx, err))
+		return
+	}
+	msg.Reply(exec.client.NewMessage("", types.EventTraceTx, reply))
+}
+
+//traceTx 在父区块的状态上重新执行交易所在的区块, 执行到这笔交易为止, 记录这笔交易的执行过程
+func (exec *Executor) traceTx(req *types.ReqHash) (*types.ReplyTraceTx, error) {
+	detail, err := exec.qclient.QueryTx(req)
+	if err != nil {
+		return nil, err
+	}
+	height := detail.GetHeight()
+	if height == 0 {
+		return nil, types.ErrNotSupport
+	}
+	blocks, err := exec.qclient.GetBlocks(&types.ReqBlocks{Start: height - 1, End: height})
+	if err != nil {
+		return nil, err
+	}
+	if len(blocks.GetItems()) != 2 {
+		return nil, types.ErrBlockNotFound
+	}
+	if status, err := exec.qclient.StorePruneStatus(); err == nil && status.IsPruned(height-1) {
+		return nil, types.ErrStatePruned
+	}
+	prev := blocks.Items[0].Block
+	block := blocks.Items[1].Block
+	target := int(detail.GetIndex())
+	if target >= len(block.Txs) {
+		return nil, types.ErrTxNotExist
+	}
+	execute := newExecutor(prev.StateHash, exec, height, block.BlockTime, uint64(block.Difficulty), block.Txs, nil)
+	execute.enableMVCC()
+	execute.api = exec.qclient
+	tracer := &txTracer{}
+	execute.setTracer(tracer)
+	receipts := execute.execTxList(nil, func(i, n int) bool {
+		if i > target {
+			return false
+		}
+		tracer.active = target < i+n
+		return true
+	})
+	if len(receipts) <= target {
+		return nil, types.ErrTxNotExist
+	}
+	return &types.ReplyTraceTx{
+		Height:    height,
+		Index:     int64(target),
+		StateHash: prev.StateHash,
+		Tx:        block.Txs[target],
+		Receipt:   receipts[target],
+		Ops:       tracer.ops,
+	}, nil
+}
@@ -0,0 +1,401 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package light
+
+import (
+	"bytes"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/common/difficulty"
+	"github.com/33cn/chain33/types"
+)
+
+//forkBranch 和本地区块头竞争的分叉, headers 按高度连续, 全部下载并且工作量更大时才切换
+type forkBranch struct {
+	pid     string
+	headers []*types.Header
+	//开始下载时对方节点的高度
+	target int64
+	update time.Time
+}
+
+func (l *Light) loadLastHeader() *types.Header {
+	value, err := l.db.Get(lastHeightKey)
+	if err != nil || value == nil {
+		return nil
+	}
+	var height types.Int64
+	if err := types.Decode(value, &height); err != nil {
+		llog.Error("loadLastHeader", "err", err)
+		return nil
+	}
+	header, err := l.getHeader(height.Data)
+	if err != nil {
+		llog.Error("loadLastHeader", "height", height.Data, "err", err)
+		return nil
+	}
+	return header
+}
+
+func (l *Light) lastHeader() *types.Header {
+	l.mtx.RLock()
+	defer l.mtx.RUnlock()
+	return l.last
+}
+
+//lastHeight 最新区块头的高度, 没有区块头时返回 -1
+func (l *Light) lastHeight() int64 {
+	header := l.lastHeader()
+	if header == nil {
+		return -1
+	}
+	return header.GetHeight()
+}
+
+func (l *Light) getHeader(height int64) (*types.Header, error) {
+	value, err := l.db.Get(headerKey(height))
+	if err != nil || value == nil {
+		return nil, types.ErrHeightNotExist
+	}
+	var header types.Header
+	if err := types.Decode(value, &header); err != nil {
+		return nil, err
+	}
+	return &header, nil
+}
+
+func (l *Light) getHeaders(req *types.ReqBlocks) (*types.Headers, error) {
+	if req.GetStart() < 0 || req.GetStart() > req.GetEnd() || req.GetEnd()-req.GetStart() >= maxFetchHeaders {
+		return nil, types.ErrInvalidParam
+	}
+	if req.GetEnd() > l.lastHeight() {
+		return nil, types.ErrHeightNotExist
+	}
+	headers := &types.Headers{}
+	for height := req.GetStart(); height <= req.GetEnd(); height++ {
+		header, err := l.getHeader(height)
+		if err != nil {
+			return nil, err
+		}
+		headers.Items = append(headers.Items, header)
+	}
+	return headers, nil
+}
+
+//heightByStateHash 状态 hash 对应的区块高度, 多个区块的状态相同时返回最后写入的高度
+func (l *Light) heightByStateHash(stateHash []byte) (int64, error) {
+	value, err := l.db.Get(stateHashKey(stateHash))
+	if err != nil || value == nil {
+		return 0, types.ErrHashNotExist
+	}
+	var height types.Int64
+	if err := types.Decode(value, &height); err != nil {
+		return 0, err
+	}
+	//回滚之后索引可能指向已经删除的区块头
+	header, err := l.getHeader(height.Data)
+	if err != nil || !bytes.Equal(header.GetStateHash(), stateHash) {
+		return 0, types.ErrHashNotExist
+	}
+	return height.Data, nil
+}
+
+//getTd 区块头的累计工作量
+func (l *Light) getTd(height int64) (*big.Int, error) {
+	value, err := l.db.Get(tdKey(height))
+	if err != nil || value == nil {
+		return nil, types.ErrHeightNotExist
+	}
+	return new(big.Int).SetBytes(value), nil
+}
+
+//headerWork 区块头的工作量, ForkBlockHash 之前的区块 hash 不包含难度, 按最低难度计算
+func headerWork(header *types.Header) *big.Int {
+	if !types.IsFork(header.GetHeight(), "ForkBlockHash") {
+		return difficulty.CalcWork(types.GetP(header.GetHeight()).PowLimitBits)
+	}
+	return difficulty.CalcWork(header.GetDifficulty())
+}
+
+//verifyHeader 检查区块头自身的 hash, 签名和时间, 创世区块必须和本地生成的一致, 检查点高度上的区块头必须和配置一致
+func (l *Light) verifyHeader(header *types.Header) error {
+	if !bytes.Equal(header.CalcHash(), header.GetHash()) {
+		return types.ErrBlockHashNoMatch
+	}
+	if header.GetHeight() == 0 && !bytes.Equal(header.GetHash(), l.genesis) {
+		return types.ErrBlockHashNoMatch
+	}
+	if hash, ok := l.checkpoints[header.GetHeight()]; ok && !bytes.Equal(header.GetHash(), hash) {
+		return types.ErrLightCheckpoint
+	}
+	if header.GetSignature() != nil && !types.CheckSign(header.GetHash(), "", header.GetSignature()) {
+		return types.ErrSign
+	}
+	if header.GetBlockTime()-types.Now().Unix() > futureBlockDelayTime {
+		return types.ErrFutureBlock
+	}
+	return nil
+}
+
+//pinnedHeight 本地已经同步到的最高的检查点, 这个高度和之前的区块头不能被分叉修改, 没有检查点时为创世区块
+func (l *Light) pinnedHeight() int64 {
+	var pinned int64
+	for height := range l.checkpoints {
+		if height > pinned && height <= l.last.GetHeight() {
+			pinned = height
+		}
+	}
+	return pinned
+}
+
+//checkChain headers 按高度连续并且依次连接
+func checkChain(headers []*types.Header) error {
+	for i := 1; i < len(headers); i++ {
+		if headers[i].GetHeight() != headers[i-1].GetHeight()+1 {
+			return types.ErrBlockHeightNoMatch
+		}
+		if !bytes.Equal(headers[i].GetParentHash(), headers[i-1].GetHash()) {
+			return types.ErrParentHash
+		}
+	}
+	return nil
+}
+
+//addHeaders 加入 pid 节点返回的区块头, 和本地最新区块头连接的直接加入.
+//不连接的区块头作为竞争分叉继续向 pid 请求, 直到找到分叉点并且分叉的工作量超过本地之后才切换,
+//fork 为 false 时不处理分叉, 用于广播的区块
+func (l *Light) addHeaders(pid string, headers []*types.Header, fork bool) error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	for _, header := range headers {
+		if err := l.verifyHeader(header); err != nil {
+			if l.branch != nil && l.branch.pid == pid {
+				llog.Info("addHeaders drop branch", "pid", pid, "height", header.GetHeight(), "err", err)
+				l.branch = nil
+			}
+			return err
+		}
+	}
+	if err := checkChain(headers); err != nil {
+		return err
+	}
+	if l.branch != nil && l.branch.pid == pid {
+		return l.extendBranch(headers)
+	}
+	for i, header := range headers {
+		last := l.last
+		if last == nil {
+			if header.GetHeight() != 0 {
+				return types.ErrBlockHeightNoMatch
+			}
+			if err := l.appendHeader(header); err != nil {
+				return err
+			}
+			continue
+		}
+		if header.GetHeight() > last.GetHeight()+1 {
+			return types.ErrBlockHeightNoMatch
+		}
+		if header.GetHeight() <= last.GetHeight() {
+			local, err := l.getHeader(header.GetHeight())
+			if err != nil {
+				return err
+			}
+			if bytes.Equal(local.GetHash(), header.GetHash()) {
+				continue
+			}
+		} else if bytes.Equal(header.GetParentHash(), last.GetHash()) {
+			if err := l.appendHeader(header); err != nil {
+				return err
+			}
+			continue
+		}
+		if !fork || l.branch != nil {
+			return types.ErrParentHash
+		}
+		if header.GetHeight() <= l.pinnedHeight() {
+			return types.ErrLightCheckpoint
+		}
+		target := atomic.LoadInt64(&l.peerHeight)
+		if tip := headers[len(headers)-1].GetHeight(); tip > target {
+			target = tip
+		}
+		l.branch = &forkBranch{pid: pid, headers: headers[i:], target: target, update: types.Now()}
+		llog.Info("addHeaders fork", "pid", pid, "height", header.GetHeight(), "hash", common.ToHex(header.GetHash()))
+		return l.checkBranch()
+	}
+	return nil
+}
+
+func (l *Light) appendHeader(header *types.Header) error {
+	td := headerWork(header)
+	if l.last != nil {
+		parent, err := l.getTd(l.last.GetHeight())
+		if err != nil {
+			return err
+		}
+		td.Add(td, parent)
+	}
+	batch := l.db.NewBatch(true)
+	setHeader(batch, header, td)
+	batch.Set(lastHeightKey, types.Encode(&types.Int64{Data: header.GetHeight()}))
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	l.last = header
+	llog.Debug("addHeader", "height", header.GetHeight(), "hash", common.ToHex(header.GetHash()))
+	return nil
+}
+
+func setHeader(batch dbm.Batch, header *types.Header, td *big.Int) {
+	batch.Set(headerKey(header.GetHeight()), types.Encode(header))
+	batch.Set(stateHashKey(header.GetStateHash()), types.Encode(&types.Int64{Data: header.GetHeight()}))
+	batch.Set(tdKey(header.GetHeight()), td.Bytes())
+}
+
+//extendBranch 分叉节点返回的区块头, 接在分叉的前面 (向前寻找分叉点) 或者后面 (向后下载)
+func (l *Light) extendBranch(headers []*types.Header) error {
+	branch := l.branch
+	first, tip := branch.headers[0], branch.headers[len(branch.headers)-1]
+	switch {
+	case len(headers) == 0:
+		return nil
+	case headers[len(headers)-1].GetHeight() == first.GetHeight()-1:
+		if !bytes.Equal(headers[len(headers)-1].GetHash(), first.GetParentHash()) {
+			return types.ErrParentHash
+		}
+		//去掉和本地相同的区块头, 剩下的接在分叉前面
+		for i := len(headers) - 1; i >= 0; i-- {
+			local, err := l.getHeader(headers[i].GetHeight())
+			if err == nil && bytes.Equal(local.GetHash(), headers[i].GetHash()) {
+				headers = headers[i+1:]
+				break
+			}
+		}
+		branch.headers = append(append([]*types.Header{}, headers...), branch.headers...)
+	case headers[0].GetHeight() == tip.GetHeight()+1:
+		if !bytes.Equal(headers[0].GetParentHash(), tip.GetHash()) {
+			return types.ErrParentHash
+		}
+		branch.headers = append(branch.headers, headers...)
+	default:
+		return types.ErrBlockHeightNoMatch
+	}
+	branch.update = types.Now()
+	return l.checkBranch()
+}
+
+//forkHeight 分叉的第一个区块头连接到本地的区块头时返回分叉点的高度, 否则返回 -1
+func (l *Light) forkHeight() int64 {
+	first := l.branch.headers[0]
+	if first.GetHeight() == 0 {
+		return -1
+	}
+	parent, err := l.getHeader(first.GetHeight() - 1)
+	if err != nil || !bytes.Equal(parent.GetHash(), first.GetParentHash()) {
+		return -1
+	}
+	return parent.GetHeight()
+}
+
+//checkBranch 找到分叉点之后, 分叉的工作量超过本地同一段区块头的工作量时切换到分叉,
+//分叉已经下载到对方节点的高度但是工作量不够时放弃分叉
+func (l *Light) checkBranch() error {
+	branch := l.branch
+	if l.last.GetHeight()-branch.headers[0].GetHeight() >= maxForkDepth || len(branch.headers) > 2*maxForkDepth {
+		l.branch = nil
+		llog.Info("checkBranch too deep", "pid", branch.pid)
+		return types.ErrParentHash
+	}
+	fork := l.forkHeight()
+	if fork < 0 {
+		return nil
+	}
+	if fork < l.pinnedHeight() {
+		l.branch = nil
+		llog.Info("checkBranch before checkpoint", "pid", branch.pid, "fork", fork)
+		return types.ErrLightCheckpoint
+	}
+	forkTd, err := l.getTd(fork)
+	if err != nil {
+		return err
+	}
+	lastTd, err := l.getTd(l.last.GetHeight())
+	if err != nil {
+		return err
+	}
+	td := new(big.Int).Set(forkTd)
+	for _, header := range branch.headers {
+		td.Add(td, headerWork(header))
+	}
+	tip := branch.headers[len(branch.headers)-1]
+	if td.Cmp(lastTd) <= 0 {
+		if tip.GetHeight() >= branch.target {
+			l.branch = nil
+			llog.Info("checkBranch less work", "pid", branch.pid, "height", tip.GetHeight())
+		}
+		return nil
+	}
+	batch := l.db.NewBatch(true)
+	for height := fork + 1; height <= l.last.GetHeight(); height++ {
+		batch.Delete(headerKey(height))
+		batch.Delete(tdKey(height))
+	}
+	td.Set(forkTd)
+	for _, header := range branch.headers {
+		td.Add(td, headerWork(header))
+		setHeader(batch, header, new(big.Int).Set(td))
+	}
+	batch.Set(lastHeightKey, types.Encode(&types.Int64{Data: tip.GetHeight()}))
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	llog.Info("checkBranch switch", "pid", branch.pid, "fork", fork, "from", l.last.GetHeight(), "to", tip.GetHeight(), "hash", common.ToHex(tip.GetHash()))
+	l.last = tip
+	l.branch = nil
+	return nil
+}
+
+//branchRequest 继续下载分叉需要请求的区块头, 没有分叉, 分叉的节点断开或者超时时返回 nil
+func (l *Light) branchRequest(peers []*types.Peer) *types.ReqBlocks {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	branch := l.branch
+	if branch == nil {
+		return nil
+	}
+	var peer *types.Peer
+	for _, p := range peers {
+		if p.GetName() == branch.pid && p.GetHeader() != nil {
+			peer = p
+		}
+	}
+	if peer == nil || types.Since(branch.update) > branchTimeout {
+		llog.Info("branchRequest drop", "pid", branch.pid)
+		l.branch = nil
+		return nil
+	}
+	if peer.GetHeader().GetHeight() > branch.target {
+		branch.target = peer.GetHeader().GetHeight()
+	}
+	if l.forkHeight() < 0 {
+		end := branch.headers[0].GetHeight() - 1
+		start := end - maxFetchHeaders + 1
+		if start < 0 {
+			start = 0
+		}
+		return &types.ReqBlocks{Start: start, End: end, Pid: []string{branch.pid}}
+	}
+	start := branch.headers[len(branch.headers)-1].GetHeight() + 1
+	end := start + maxFetchHeaders - 1
+	if end > branch.target {
+		end = branch.target
+	}
+	return &types.ReqBlocks{Start: start, End: end, Pid: []string{branch.pid}}
+}
@@ -0,0 +1,376 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package light 轻节点模块, 代替 blockchain, store 和 mempool 模块.
+//只从其他节点同步区块头, 验证区块头的 hash, 签名以及和父区块的连接关系, 创世区块必须和本地生成的一致.
+//出现分叉时按照累计工作量选择最优链, 竞争的分叉全部下载并且工作量更大时才切换, 不验证共识的其他数据.
+//区块头中的难度是对方节点声明的, 轻节点不能验证, 所以用配置的检查点 (lightCheckpoints) 固定可信的区块,
+//检查点高度上的区块头必须和配置一致, 已经同步到的检查点之前的区块头不能被分叉修改
+//交易和状态通过 p2p 向其他节点请求默克尔证明, 用本地区块头中的 txHash 和 stateHash 验证之后
+//再回复给 rpc 等模块, 所以 rpc 中查询交易和余额的接口在轻节点上可以直接使用
+package light
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+)
+
+var llog = log.New("module", "light")
+
+const (
+	syncInterval = 5 * time.Second
+	//一次请求的区块头数量, 不能超过 p2p GetHeaders 的限制
+	maxFetchHeaders = 1000
+	queryTimeout    = time.Minute
+	//和 blockchain 模块一样, 允许区块时间比本地时间超前 1 秒
+	futureBlockDelayTime = 1
+	//分叉点最多比本地最新区块头低 maxForkDepth
+	maxForkDepth = 10000
+	//分叉超过 branchTimeout 没有收到新的区块头时放弃
+	branchTimeout = time.Minute
+	//同时等待其他节点回复证明的查询数量
+	maxProofQueries = 64
+)
+
+var lastHeightKey = []byte("LightLastHeight")
+
+func headerKey(height int64) []byte {
+	return []byte(fmt.Sprintf("LightHeader:%012d", height))
+}
+
+func tdKey(height int64) []byte {
+	return []byte(fmt.Sprintf("LightTd:%012d", height))
+}
+
+func stateHashKey(hash []byte) []byte {
+	return append([]byte("LightStateHash:"), hash...)
+}
+
+//Light 轻节点
+type Light struct {
+	db            dbm.DB
+	client        queue.Client
+	storeClient   queue.Client
+	mempoolClient queue.Client
+	//本地生成的创世区块 hash
+	genesis []byte
+	//配置的检查点, 高度 -> 区块 hash
+	checkpoints map[int64][]byte
+	mtx     sync.RWMutex
+	last    *types.Header
+	//正在下载的竞争分叉
+	branch *forkBranch
+	//连接的节点中最高的区块高度
+	peerHeight int64
+	syncCh     chan struct{}
+	//正在等待证明的查询, 限制同时进行的查询数量
+	queries chan struct{}
+	done    chan struct{}
+	wg      sync.WaitGroup
+}
+
+//New new light client module, genesis 是按照本地共识配置生成的创世区块
+func New(cfg *types.BlockChain, genesis *types.Block) (*Light, error) {
+	//创世区块的 hash 包含执行之后的状态 hash 时, 轻节点不能在本地生成
+	if types.IsFork(0, "ForkBlockHash") {
+		return nil, types.ErrLightGenesisStateHash
+	}
+	checkpoints, err := parseCheckpoints(cfg.LightCheckpoints)
+	if err != nil {
+		return nil, err
+	}
+	l := &Light{
+		db:          dbm.NewDB("light", cfg.Driver, cfg.DbPath, cfg.DbCache),
+		genesis:     genesis.Hash(),
+		checkpoints: checkpoints,
+		peerHeight:  -1,
+		syncCh:      make(chan struct{}, 1),
+		queries:     make(chan struct{}, maxProofQueries),
+		done:        make(chan struct{}),
+	}
+	l.last = l.loadLastHeader()
+	if l.last != nil {
+		header, err := l.getHeader(0)
+		if err != nil || !bytes.Equal(header.GetHash(), l.genesis) {
+			l.db.Close()
+			return nil, types.ErrLightGenesisNotMatch
+		}
+		//新加的检查点和已经同步的区块头不一致
+		for height, hash := range l.checkpoints {
+			header, err := l.getHeader(height)
+			if err == nil && !bytes.Equal(header.GetHash(), hash) {
+				l.db.Close()
+				return nil, types.ErrLightCheckpoint
+			}
+		}
+	}
+	return l, nil
+}
+
+//parseCheckpoints 解析 height:hash 格式的检查点
+func parseCheckpoints(items []string) (map[int64][]byte, error) {
+	checkpoints := make(map[int64][]byte)
+	for _, item := range items {
+		index := strings.Index(item, ":")
+		if index < 0 {
+			llog.Error("parseCheckpoints", "invalid checkpoint", item)
+			return nil, types.ErrInvalidParam
+		}
+		height, err := strconv.ParseInt(strings.TrimSpace(item[:index]), 10, 64)
+		if err != nil || height < 0 {
+			llog.Error("parseCheckpoints", "invalid height", item)
+			return nil, types.ErrInvalidParam
+		}
+		hash, err := common.FromHex(strings.TrimSpace(item[index+1:]))
+		if err != nil || len(hash) == 0 {
+			llog.Error("parseCheckpoints", "invalid hash", item)
+			return nil, types.ErrInvalidParam
+		}
+		checkpoints[height] = hash
+	}
+	return checkpoints, nil
+}
+
+//SetQueueClient 代替 blockchain 模块处理消息, 并开始同步区块头
+func (l *Light) SetQueueClient(client queue.Client) {
+	l.client = client
+	client.Sub("blockchain")
+	l.wg.Add(2)
+	go l.recv(client, l.procChainMsg)
+	go l.syncRoutine()
+}
+
+//SetStoreClient 代替 store 模块处理消息
+func (l *Light) SetStoreClient(client queue.Client) {
+	l.storeClient = client
+	client.Sub("store")
+	l.wg.Add(1)
+	go l.recv(client, l.procStoreMsg)
+}
+
+//SetMempoolClient 代替 mempool 模块处理消息, 轻节点不处理交易
+func (l *Light) SetMempoolClient(client queue.Client) {
+	l.mempoolClient = client
+	client.Sub("mempool")
+	l.wg.Add(1)
+	go l.recv(client, l.procMempoolMsg)
+}
+
+//Close close light client module
+func (l *Light) Close() {
+	close(l.done)
+	for _, client := range []queue.Client{l.client, l.storeClient, l.mempoolClient} {
+		if client != nil {
+			client.Close()
+		}
+	}
+	l.wg.Wait()
+	l.db.Close()
+	llog.Info("light client module closed")
+}
+
+func (l *Light) recv(client queue.Client, proc func(queue.Message)) {
+	defer l.wg.Done()
+	for msg := range client.Recv() {
+		llog.Debug("light recv", "msg", types.GetEventName(int(msg.Ty)))
+		if msg.Ty != types.EventQueryTx && msg.Ty != types.EventStoreGet {
+			proc(msg)
+			continue
+		}
+		//查询交易和状态时需要等待其他节点的回复, 不能阻塞消息循环, 超过并发限制时直接返回错误
+		select {
+		case l.queries <- struct{}{}:
+			go func(msg queue.Message) {
+				defer func() { <-l.queries }()
+				proc(msg)
+			}(msg)
+		default:
+			msg.Reply(client.NewMessage("", msg.Ty, types.ErrLightTooManyQueries))
+		}
+	}
+}
+
+func (l *Light) procChainMsg(msg queue.Message) {
+	switch msg.Ty {
+	case types.EventGetLastHeader:
+		header := l.lastHeader()
+		if header == nil {
+			msg.Reply(l.client.NewMessage("", types.EventHeader, types.ErrBlockNotFound))
+			return
+		}
+		msg.Reply(l.client.NewMessage("", types.EventHeader, header))
+	case types.EventGetBlockHeight:
+		msg.Reply(l.client.NewMessage("", types.EventReplyBlockHeight, &types.ReplyBlockHeight{Height: l.lastHeight()}))
+	case types.EventGetHeaders:
+		headers, err := l.getHeaders(msg.GetData().(*types.ReqBlocks))
+		if err != nil {
+			msg.Reply(l.client.NewMessage("", types.EventHeaders, err))
+			return
+		}
+		msg.Reply(l.client.NewMessage("", types.EventHeaders, headers))
+	case types.EventGetBlockHash:
+		header, err := l.getHeader(msg.GetData().(*types.ReqInt).GetHeight())
+		if err != nil {
+			msg.Reply(l.client.NewMessage("", types.EventBlockHash, err))
+			return
+		}
+		msg.Reply(l.client.NewMessage("", types.EventBlockHash, &types.ReplyHash{Hash: header.GetHash()}))
+	case types.EventIsSync:
+		ok := l.lastHeader() != nil && l.lastHeight() >= atomic.LoadInt64(&l.peerHeight)
+		msg.Reply(l.client.NewMessage("", types.EventReplyIsSync, &types.IsCaughtUp{Iscaughtup: ok}))
+	case types.EventQueryTx:
+		detail, err := l.queryTx(msg.GetData().(*types.ReqHash).GetHash())
+		if err != nil {
+			msg.Reply(l.client.NewMessage("", types.EventTransactionDetail, err))
+			return
+		}
+		msg.Reply(l.client.NewMessage("", types.EventTransactionDetail, detail))
+	case types.EventAddBlockHeaders:
+		headers := msg.GetData().(*types.HeadersPid)
+		err := l.addHeaders(headers.GetPid(), headers.GetHeaders().GetItems(), true)
+		if err != nil {
+			llog.Debug("EventAddBlockHeaders", "pid", headers.GetPid(), "err", err)
+		}
+		msg.ReplyErr("EventAddBlockHeaders", err)
+		l.notifySync()
+	case types.EventBroadcastAddBlock:
+		blockPid := msg.GetData().(*types.BlockPid)
+		block := blockPid.GetBlock()
+		header := block.GetHeader()
+		header.Hash = block.Hash()
+		header.StateHash = block.StateHash
+		header.Difficulty = block.Difficulty
+		header.TxCount = int64(len(block.Txs))
+		header.Signature = block.Signature
+		//广播的区块只在和本地最新区块头连接时加入, 分叉通过同步区块头处理
+		err := l.addHeaders(blockPid.GetPid(), []*types.Header{header}, false)
+		msg.ReplyErr("EventBroadcastAddBlock", err)
+		if err != nil {
+			l.notifySync()
+		}
+	default:
+		msg.Reply(l.client.NewMessage("", msg.Ty, types.ErrActionNotSupport))
+	}
+}
+
+func (l *Light) procStoreMsg(msg queue.Message) {
+	switch msg.Ty {
+	case types.EventStoreGet:
+		values, err := l.getState(msg.GetData().(*types.StoreGet))
+		if err != nil {
+			msg.Reply(l.storeClient.NewMessage("", types.EventStoreGetReply, err))
+			return
+		}
+		msg.Reply(l.storeClient.NewMessage("", types.EventStoreGetReply, &types.StoreReplyValue{Values: values}))
+	default:
+		msg.Reply(l.storeClient.NewMessage("", msg.Ty, types.ErrActionNotSupport))
+	}
+}
+
+func (l *Light) procMempoolMsg(msg queue.Message) {
+	switch msg.Ty {
+	case types.EventGetMempoolSize:
+		msg.Reply(l.mempoolClient.NewMessage("", types.EventMempoolSize, &types.MempoolSize{}))
+	case types.EventTx:
+		msg.Reply(l.mempoolClient.NewMessage("", types.EventReply, &types.Reply{Msg: []byte(types.ErrActionNotSupport.Error())}))
+	default:
+		msg.Reply(l.mempoolClient.NewMessage("", msg.Ty, types.ErrActionNotSupport))
+	}
+}
+
+//query 向其他模块发送请求并等待回复
+func (l *Light) query(topic string, ty int64, data interface{}) (interface{}, error) {
+	msg := l.client.NewMessage(topic, ty, data)
+	err := l.client.SendTimeout(msg, true, queryTimeout)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := l.client.WaitTimeout(msg, queryTimeout)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetData(), nil
+}
+
+func (l *Light) notifySync() {
+	if l.lastHeight() >= atomic.LoadInt64(&l.peerHeight) {
+		return
+	}
+	select {
+	case l.syncCh <- struct{}{}:
+	default:
+	}
+}
+
+func (l *Light) syncRoutine() {
+	defer l.wg.Done()
+	ticker := time.NewTicker(syncInterval)
+	defer ticker.Stop()
+	for {
+		l.syncHeaders()
+		select {
+		case <-l.done:
+			return
+		case <-ticker.C:
+		case <-l.syncCh:
+		}
+	}
+}
+
+//syncHeaders 有分叉时继续下载分叉, 否则从高度最高的节点请求后续的区块头,
+//区块头通过 EventAddBlockHeaders 异步返回
+func (l *Light) syncHeaders() {
+	resp, err := l.query("p2p", types.EventPeerInfo, nil)
+	if err != nil {
+		llog.Debug("syncHeaders", "EventPeerInfo", err)
+		return
+	}
+	peers := resp.(*types.PeerList).GetPeers()
+	if req := l.branchRequest(peers); req != nil {
+		llog.Debug("syncHeaders branch", "start", req.GetStart(), "end", req.GetEnd(), "pid", req.GetPid())
+		_, err = l.query("p2p", types.EventFetchBlockHeaders, req)
+		if err != nil {
+			llog.Debug("syncHeaders", "EventFetchBlockHeaders", err)
+		}
+		return
+	}
+	var best *types.Peer
+	for _, peer := range peers {
+		if peer.GetSelf() || peer.GetHeader() == nil {
+			continue
+		}
+		if best == nil || peer.GetHeader().GetHeight() > best.GetHeader().GetHeight() {
+			best = peer
+		}
+	}
+	if best == nil {
+		return
+	}
+	peerHeight := best.GetHeader().GetHeight()
+	atomic.StoreInt64(&l.peerHeight, peerHeight)
+	start := l.lastHeight() + 1
+	if start > peerHeight {
+		return
+	}
+	end := start + maxFetchHeaders - 1
+	if end > peerHeight {
+		end = peerHeight
+	}
+	llog.Debug("syncHeaders", "start", start, "end", end, "pid", best.GetName())
+	_, err = l.query("p2p", types.EventFetchBlockHeaders, &types.ReqBlocks{Start: start, End: end, Pid: []string{best.GetName()}})
+	if err != nil {
+		llog.Debug("syncHeaders", "EventFetchBlockHeaders", err)
+	}
+}
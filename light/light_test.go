@@ -0,0 +1,341 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package light
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/client"
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/consensus"
+	"github.com/33cn/chain33/queue"
+	_ "github.com/33cn/chain33/system"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+//fullP2P 代替轻节点的 p2p 模块, 直接从全节点获取区块头和证明
+type fullP2P struct {
+	full   *testnode.Chain33Mock
+	client queue.Client
+	//修改全节点返回的交易证明, 用来测试验证失败
+	tamper func(detail *types.TransactionDetail)
+}
+
+func (p *fullP2P) SetQueueClient(client queue.Client) {
+	p.client = client
+	client.Sub("p2p")
+	go func() {
+		for msg := range client.Recv() {
+			go p.handle(msg)
+		}
+	}()
+}
+
+func (p *fullP2P) handle(msg queue.Message) {
+	api := p.full.GetAPI()
+	switch msg.Ty {
+	case types.EventPeerInfo:
+		header, err := api.GetLastHeader()
+		if err != nil {
+			msg.Reply(p.client.NewMessage("", types.EventPeerList, err))
+			return
+		}
+		msg.Reply(p.client.NewMessage("", types.EventPeerList, &types.PeerList{Peers: []*types.Peer{{Name: "full", Header: header}}}))
+	case types.EventFetchBlockHeaders:
+		req := msg.GetData().(*types.ReqBlocks)
+		msg.Reply(p.client.NewMessage("", types.EventReply, &types.Reply{IsOk: true}))
+		headers, err := api.GetHeaders(&types.ReqBlocks{Start: req.GetStart(), End: req.GetEnd()})
+		if err != nil {
+			return
+		}
+		p.client.Send(p.client.NewMessage("blockchain", types.EventAddBlockHeaders, &types.HeadersPid{Pid: "full", Headers: headers}), false)
+	case types.EventGetTxProof:
+		detail, err := api.QueryTx(&types.ReqHash{Hash: msg.GetData().(*types.P2PGetTxProof).GetHash()})
+		if err != nil {
+			msg.Reply(p.client.NewMessage("", types.EventGetTxProof, err))
+			return
+		}
+		if p.tamper != nil {
+			p.tamper(detail)
+		}
+		msg.Reply(p.client.NewMessage("", types.EventGetTxProof, detail))
+	case types.EventGetStateProof:
+		req := msg.GetData().(*types.P2PGetStateProof)
+		headers, err := api.GetHeaders(&types.ReqBlocks{Start: req.GetHeight(), End: req.GetHeight()})
+		if err != nil {
+			msg.Reply(p.client.NewMessage("", types.EventGetStateProof, err))
+			return
+		}
+		stateHash := headers.GetItems()[0].GetStateHash()
+		fullClient := p.full.GetClient()
+		storeMsg := fullClient.NewMessage("store", types.EventStoreGetProof, &types.StoreGet{StateHash: stateHash, Keys: req.GetKeys()})
+		fullClient.Send(storeMsg, true)
+		resp, err := fullClient.Wait(storeMsg)
+		if err != nil {
+			msg.Reply(p.client.NewMessage("", types.EventGetStateProof, err))
+			return
+		}
+		proof := resp.GetData().(*types.StoreReplyProof)
+		msg.Reply(p.client.NewMessage("", types.EventGetStateProof, &types.P2PStateProof{StateHash: stateHash, Values: proof.GetValues(), Proofs: proof.GetProofs()}))
+	default:
+		msg.Reply(p.client.NewMessage("", msg.Ty, types.ErrActionNotSupport))
+	}
+}
+
+func waitLightHeight(api client.QueueProtocolAPI, height int64) error {
+	for i := 0; i < 100; i++ {
+		header, err := api.GetLastHeader()
+		if err == nil && header.GetHeight() >= height {
+			return nil
+		}
+		time.Sleep(time.Second / 10)
+	}
+	return types.ErrTimeout
+}
+
+func TestLight(t *testing.T) {
+	full := testnode.New("", nil)
+	defer full.Close()
+	hash := full.SendTx(util.CreateCoinsTx(full.GetGenesisKey(), full.GetHotAddress(), 100*types.Coin))
+	require.Nil(t, full.WaitHeight(1))
+	last, err := full.GetAPI().GetLastHeader()
+	require.Nil(t, err)
+
+	dir, err := ioutil.TempDir("", "light")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	q := queue.New("channel")
+	defer q.Close()
+	p2p := &fullP2P{full: full}
+	p2p.SetQueueClient(q.Client())
+	genesis := consensus.GenesisBlock(full.GetCfg().Consensus, nil)
+	assert.Equal(t, full.GetBlock(0).Hash(), genesis.Hash())
+	light, err := New(&types.BlockChain{Driver: "leveldb", DbPath: dir, DbCache: 64}, genesis)
+	require.Nil(t, err)
+	light.SetQueueClient(q.Client())
+	light.SetStoreClient(q.Client())
+	light.SetMempoolClient(q.Client())
+	defer light.Close()
+	api, err := client.New(q.Client(), nil)
+	require.Nil(t, err)
+
+	//只同步区块头
+	require.Nil(t, waitLightHeight(api, last.GetHeight()))
+	header, err := api.GetLastHeader()
+	require.Nil(t, err)
+	assert.Equal(t, last.GetHash(), header.GetHash())
+	headers, err := api.GetHeaders(&types.ReqBlocks{Start: 0, End: last.GetHeight()})
+	require.Nil(t, err)
+	assert.Len(t, headers.GetItems(), int(last.GetHeight()+1))
+
+	//通过默克尔证明查询交易
+	detail, err := api.QueryTx(&types.ReqHash{Hash: hash})
+	require.Nil(t, err)
+	assert.Equal(t, hash, detail.GetTx().Hash())
+	fullDetail, err := full.GetAPI().QueryTx(&types.ReqHash{Hash: hash})
+	require.Nil(t, err)
+	assert.Equal(t, fullDetail.GetHeight(), detail.GetHeight())
+
+	p2p.tamper = func(detail *types.TransactionDetail) { detail.Index++ }
+	_, err = api.QueryTx(&types.ReqHash{Hash: hash})
+	assert.Equal(t, types.ErrTxProof, err)
+	p2p.tamper = nil
+
+	//同时等待证明的查询超过限制时直接返回错误
+	for i := 0; i < maxProofQueries; i++ {
+		light.queries <- struct{}{}
+	}
+	_, err = api.QueryTx(&types.ReqHash{Hash: hash})
+	assert.Equal(t, types.ErrLightTooManyQueries, err)
+	for i := 0; i < maxProofQueries; i++ {
+		<-light.queries
+	}
+
+	//通过状态证明查询余额, 和全节点的结果一致
+	req := &types.ReqBalance{Addresses: []string{full.GetHotAddress(), full.GetGenesisAddress(), "1BXvgjmBw1aBgmGn1hjfGyRkmN3krWpFP4"}, Execer: "coins"}
+	accs, err := account.NewCoinsAccount().GetBalance(api, req)
+	require.Nil(t, err)
+	fullAccs, err := account.NewCoinsAccount().GetBalance(full.GetAPI(), req)
+	require.Nil(t, err)
+	assert.Equal(t, fullAccs, accs)
+	assert.True(t, accs[0].GetBalance() > 0)
+	assert.Equal(t, int64(0), accs[2].GetBalance())
+
+	//轻节点不处理交易
+	_, err = api.SendTx(util.CreateCoinsTx(full.GetGenesisKey(), full.GetHotAddress(), types.Coin))
+	assert.NotNil(t, err)
+}
+
+func newForkHeader(parent *types.Header, bits uint32) *types.Header {
+	header := &types.Header{Height: parent.GetHeight() + 1, ParentHash: parent.GetHash(), BlockTime: parent.GetBlockTime(), Difficulty: bits, TxHash: []byte("fork")}
+	header.Hash = header.CalcHash()
+	return header
+}
+
+func TestLightFork(t *testing.T) {
+	full := testnode.New("", nil)
+	defer full.Close()
+	full.SendTx(util.CreateCoinsTx(full.GetGenesisKey(), full.GetHotAddress(), 100*types.Coin))
+	require.Nil(t, full.WaitHeight(1))
+	last, err := full.GetAPI().GetLastHeader()
+	require.Nil(t, err)
+	headers, err := full.GetAPI().GetHeaders(&types.ReqBlocks{Start: 0, End: last.GetHeight()})
+	require.Nil(t, err)
+	items := headers.GetItems()
+
+	dir, err := ioutil.TempDir("", "light")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	cfg := &types.BlockChain{Driver: "leveldb", DbPath: dir, DbCache: 64}
+	light, err := New(cfg, full.GetBlock(0))
+	require.Nil(t, err)
+
+	//创世区块必须和本地生成的一致
+	genesis := *items[0]
+	genesis.BlockTime++
+	genesis.Hash = genesis.CalcHash()
+	assert.Equal(t, types.ErrBlockHashNoMatch, light.addHeaders("full", []*types.Header{&genesis}, true))
+	require.Nil(t, light.addHeaders("full", items, true))
+	assert.Equal(t, last.GetHash(), light.lastHeader().GetHash())
+
+	//签名错误和时间超前的区块头
+	header := newForkHeader(last, last.GetDifficulty())
+	header.Signature = &types.Signature{Ty: types.SECP256K1, Pubkey: []byte("pubkey"), Signature: []byte("sign")}
+	assert.Equal(t, types.ErrSign, light.addHeaders("full", []*types.Header{header}, true))
+	header = newForkHeader(last, last.GetDifficulty())
+	header.BlockTime = types.Now().Unix() + 10
+	header.Hash = header.CalcHash()
+	assert.Equal(t, types.ErrFutureBlock, light.addHeaders("full", []*types.Header{header}, true))
+
+	//不连接的区块头不会使本地回滚, 向前找到分叉点之后工作量更小的分叉被放弃
+	parent := items[last.GetHeight()-1]
+	lightA := newForkHeader(parent, 0)
+	lightB := newForkHeader(lightA, 0)
+	require.Nil(t, light.addHeaders("light", []*types.Header{lightB}, true))
+	assert.NotNil(t, light.branch)
+	assert.Equal(t, last.GetHash(), light.lastHeader().GetHash())
+	//广播的区块不处理分叉
+	assert.Equal(t, types.ErrParentHash, light.addHeaders("other", []*types.Header{lightB}, false))
+	require.Nil(t, light.addHeaders("light", append(append([]*types.Header{}, items[:last.GetHeight()]...), lightA), true))
+	assert.Nil(t, light.branch)
+	assert.Equal(t, last.GetHash(), light.lastHeader().GetHash())
+
+	//工作量更大的分叉全部下载之后才切换
+	heavyA := newForkHeader(parent, last.GetDifficulty())
+	heavyB := newForkHeader(heavyA, last.GetDifficulty())
+	require.Nil(t, light.addHeaders("heavy", []*types.Header{heavyB}, true))
+	assert.Equal(t, last.GetHash(), light.lastHeader().GetHash())
+	assert.Nil(t, light.branchRequest(nil))
+	require.Nil(t, light.addHeaders("heavy", []*types.Header{heavyB}, true))
+	req := light.branchRequest([]*types.Peer{{Name: "heavy", Header: heavyB}})
+	assert.Equal(t, &types.ReqBlocks{Start: 0, End: last.GetHeight(), Pid: []string{"heavy"}}, req)
+	lastTd, err := light.getTd(last.GetHeight())
+	require.Nil(t, err)
+	require.Nil(t, light.addHeaders("heavy", append(append([]*types.Header{}, items[:last.GetHeight()]...), heavyA), true))
+	assert.Nil(t, light.branch)
+	assert.Equal(t, heavyB.GetHash(), light.lastHeader().GetHash())
+	header, err = light.getHeader(heavyA.GetHeight())
+	require.Nil(t, err)
+	assert.Equal(t, heavyA.GetHash(), header.GetHash())
+	td, err := light.getTd(heavyB.GetHeight())
+	require.Nil(t, err)
+	assert.True(t, td.Cmp(lastTd) > 0)
+	light.Close()
+
+	//数据库中的创世区块和配置生成的不一致
+	other := *full.GetBlock(0)
+	other.BlockTime++
+	_, err = New(cfg, &other)
+	assert.Equal(t, types.ErrLightGenesisNotMatch, err)
+}
+
+func TestLightCheckpoint(t *testing.T) {
+	full := testnode.New("", nil)
+	defer full.Close()
+	full.SendTx(util.CreateCoinsTx(full.GetGenesisKey(), full.GetHotAddress(), 100*types.Coin))
+	require.Nil(t, full.WaitHeight(1))
+	full.SendTx(util.CreateCoinsTx(full.GetGenesisKey(), full.GetHotAddress(), 100*types.Coin))
+	require.Nil(t, full.WaitHeight(2))
+	last, err := full.GetAPI().GetLastHeader()
+	require.Nil(t, err)
+	headers, err := full.GetAPI().GetHeaders(&types.ReqBlocks{Start: 0, End: last.GetHeight()})
+	require.Nil(t, err)
+	items := headers.GetItems()
+
+	dir, err := ioutil.TempDir("", "light")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	cfg := &types.BlockChain{Driver: "leveldb", DbPath: dir, DbCache: 64, LightCheckpoints: []string{"1"}}
+	_, err = New(cfg, full.GetBlock(0))
+	assert.Equal(t, types.ErrInvalidParam, err)
+	cfg.LightCheckpoints = []string{fmt.Sprintf("%d:%s", last.GetHeight(), common.ToHex(last.GetHash()))}
+	light, err := New(cfg, full.GetBlock(0))
+	require.Nil(t, err)
+	require.Nil(t, light.addHeaders("full", items, true))
+	assert.Equal(t, last.GetHash(), light.lastHeader().GetHash())
+
+	//声明的难度更大的分叉要修改检查点的区块头, 下载到检查点的时候被放弃
+	parent := items[last.GetHeight()-1]
+	heavyA := newForkHeader(parent, last.GetDifficulty())
+	heavyB := newForkHeader(heavyA, last.GetDifficulty())
+	require.Nil(t, light.addHeaders("heavy", []*types.Header{heavyB}, true))
+	assert.NotNil(t, light.branch)
+	err = light.addHeaders("heavy", append(append([]*types.Header{}, items[:last.GetHeight()]...), heavyA), true)
+	assert.Equal(t, types.ErrLightCheckpoint, err)
+	assert.Nil(t, light.branch)
+	assert.Equal(t, last.GetHash(), light.lastHeader().GetHash())
+	//检查点之前的分叉直接拒绝
+	header := newForkHeader(items[last.GetHeight()-2], last.GetDifficulty())
+	assert.Equal(t, types.ErrLightCheckpoint, light.addHeaders("heavy", []*types.Header{header}, true))
+	assert.Nil(t, light.branch)
+	light.Close()
+
+	//检查点和已经同步的区块头不一致
+	cfg.LightCheckpoints = []string{fmt.Sprintf("%d:%s", heavyA.GetHeight(), common.ToHex(heavyA.GetHash()))}
+	_, err = New(cfg, full.GetBlock(0))
+	assert.Equal(t, types.ErrLightCheckpoint, err)
+}
+
+func TestVerifyProof(t *testing.T) {
+	full := testnode.New("", nil)
+	defer full.Close()
+	hash := full.SendTx(util.CreateCoinsTx(full.GetGenesisKey(), full.GetHotAddress(), 100*types.Coin))
+	require.Nil(t, full.WaitHeight(1))
+	api := full.GetAPI()
+	detail, err := api.QueryTx(&types.ReqHash{Hash: hash})
+	require.Nil(t, err)
+	headers, err := api.GetHeaders(&types.ReqBlocks{Start: detail.GetHeight(), End: detail.GetHeight()})
+	require.Nil(t, err)
+	header := headers.GetItems()[0]
+	assert.Equal(t, header.GetHash(), header.CalcHash())
+
+	assert.Nil(t, verifyTxProof(hash, detail, header))
+	assert.Equal(t, types.ErrTxProof, verifyTxProof(hash[1:], detail, header))
+	detail.Index = header.GetTxCount()
+	assert.Equal(t, types.ErrTxProof, verifyTxProof(hash, detail, header))
+
+	key := account.NewCoinsAccount().AccountKey(full.GetHotAddress())
+	client := full.GetClient()
+	msg := client.NewMessage("store", types.EventStoreGetProof, &types.StoreGet{StateHash: header.GetStateHash(), Keys: [][]byte{key}})
+	client.Send(msg, true)
+	resp, err := client.Wait(msg)
+	require.Nil(t, err)
+	reply := resp.GetData().(*types.StoreReplyProof)
+	proof := &types.P2PStateProof{StateHash: header.GetStateHash(), Values: reply.GetValues(), Proofs: reply.GetProofs()}
+	assert.Nil(t, verifyStateProof(header.GetStateHash(), [][]byte{key}, proof))
+	assert.Equal(t, types.ErrStateProof, verifyStateProof(header.GetParentHash(), [][]byte{key}, proof))
+	proof.Values[0] = append([]byte{}, proof.Values[0]...)
+	proof.Values[0][0]++
+	assert.Equal(t, types.ErrStateProof, verifyStateProof(header.GetStateHash(), [][]byte{key}, proof))
+}
@@ -0,0 +1,90 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package light
+
+import (
+	"bytes"
+
+	"github.com/33cn/chain33/common/merkle"
+	mavl "github.com/33cn/chain33/system/store/mavl/db"
+	"github.com/33cn/chain33/types"
+)
+
+//queryTx 向其他节点请求交易以及默克尔证明, 用本地区块头的 txHash 验证.
+//回执不在证明的范围内, 直接使用对方节点返回的回执
+func (l *Light) queryTx(hash []byte) (*types.TransactionDetail, error) {
+	resp, err := l.query("p2p", types.EventGetTxProof, &types.P2PGetTxProof{Hash: hash})
+	if err != nil {
+		return nil, err
+	}
+	detail := resp.(*types.TransactionDetail)
+	header, err := l.getHeader(detail.GetHeight())
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyTxProof(hash, detail, header); err != nil {
+		llog.Error("queryTx", "hash", hash, "height", detail.GetHeight(), "err", err)
+		return nil, err
+	}
+	detail.Blocktime = header.GetBlockTime()
+	detail.Fromaddr = detail.GetTx().From()
+	return detail, nil
+}
+
+//verifyTxProof 验证交易的 hash 以及交易在区块中的默克尔路径
+func verifyTxProof(hash []byte, detail *types.TransactionDetail, header *types.Header) error {
+	if detail.GetTx() == nil || !bytes.Equal(detail.GetTx().Hash(), hash) {
+		return types.ErrTxProof
+	}
+	if detail.GetIndex() < 0 || detail.GetIndex() >= header.GetTxCount() {
+		return types.ErrTxProof
+	}
+	root := merkle.GetMerkleRootFromBranch(detail.GetProofs(), hash, uint32(detail.GetIndex()))
+	if !bytes.Equal(root, header.GetTxHash()) {
+		return types.ErrTxProof
+	}
+	return nil
+}
+
+//getState 向其他节点请求状态证明, 用本地区块头的 stateHash 验证
+func (l *Light) getState(req *types.StoreGet) ([][]byte, error) {
+	height, err := l.heightByStateHash(req.GetStateHash())
+	if err != nil {
+		return nil, err
+	}
+	//分叉之前区块 hash 不包含 stateHash, 无法验证状态
+	if !types.IsFork(height, "ForkBlockHash") {
+		return nil, types.ErrNotSupport
+	}
+	resp, err := l.query("p2p", types.EventGetStateProof, &types.P2PGetStateProof{Height: height, Keys: req.GetKeys()})
+	if err != nil {
+		return nil, err
+	}
+	proof := resp.(*types.P2PStateProof)
+	if err := verifyStateProof(req.GetStateHash(), req.GetKeys(), proof); err != nil {
+		llog.Error("getState", "height", height, "err", err)
+		return nil, err
+	}
+	return proof.GetValues(), nil
+}
+
+//verifyStateProof 验证每个 key 的 mavl 证明. mavl 树无法证明 key 不存在, 值为空的 key 只能相信对方节点
+func verifyStateProof(stateHash []byte, keys [][]byte, proof *types.P2PStateProof) error {
+	if !bytes.Equal(proof.GetStateHash(), stateHash) || len(proof.GetValues()) != len(keys) || len(proof.GetProofs()) != len(keys) {
+		return types.ErrStateProof
+	}
+	for i, key := range keys {
+		value := proof.GetValues()[i]
+		if len(value) == 0 {
+			continue
+		}
+		leaf := types.LeafNode{Key: key, Value: value, Size: 1}
+		p, err := mavl.ReadProof(stateHash, leaf.Hash(), proof.GetProofs()[i])
+		if err != nil || !p.Verify(key, value, stateHash) {
+			return types.ErrStateProof
+		}
+	}
+	return nil
+}
@@ -50,6 +50,7 @@ type KnownAddress struct {
 	Attempts    uint        `json:"attempts"`
 	LastAttempt time.Time   `json:"lastattempt"`
 	LastSuccess time.Time   `json:"lastsuccess"`
+	PubKey      string      `json:"pubkey,omitempty"`
 }
 
 // GetPeerStat get peer stat
@@ -118,6 +119,7 @@ func (ka *KnownAddress) Copy() *KnownAddress {
 		Attempts:    ka.Attempts,
 		LastAttempt: ka.LastAttempt,
 		LastSuccess: ka.LastSuccess,
+		PubKey:      ka.PubKey,
 	}
 	ka.kmtx.Unlock()
 	return &ret
@@ -140,6 +142,13 @@ func (ka *KnownAddress) GetAttempts() uint {
 	return ka.Attempts
 }
 
+// GetPubKey return pubkey of the address verified by tls
+func (ka *KnownAddress) GetPubKey() string {
+	ka.kmtx.Lock()
+	defer ka.kmtx.Unlock()
+	return ka.PubKey
+}
+
 // ISOurAddress determine if the address is ours
 func (a *AddrBook) ISOurAddress(addr *NetAddress) bool {
 	a.mtx.Lock()
@@ -272,6 +281,29 @@ func (a *AddrBook) loadDb() bool {
 
 }
 
+// SaveBans 保存黑名单, key 为节点公钥或者地址, value 为解除的时间
+func (a *AddrBook) SaveBans(bans map[string]int64) {
+	jsonBytes, err := json.Marshal(bans)
+	if err != nil {
+		log.Error("SaveBans", "err", err)
+		return
+	}
+	a.bookDb.Set([]byte(bansTag), jsonBytes)
+}
+
+// LoadBans 读取保存的黑名单
+func (a *AddrBook) LoadBans() map[string]int64 {
+	bans := make(map[string]int64)
+	value, err := a.bookDb.Get([]byte(bansTag))
+	if err != nil || len(value) == 0 {
+		return bans
+	}
+	if err := json.Unmarshal(value, &bans); err != nil {
+		log.Error("LoadBans", "err", err)
+	}
+	return bans
+}
+
 // Save saves the book.
 func (a *AddrBook) Save() {
 	a.saveToDb()
@@ -321,6 +353,28 @@ func (a *AddrBook) AddAddress(addr *NetAddress, ka *KnownAddress) {
 
 }
 
+// SetAddrPubKey 记录地址对应的 tls 验证过的节点公钥, 同一个公钥只保留最新的地址
+func (a *AddrBook) SetAddrPubKey(addr string, pubkey string) {
+	if pubkey == "" {
+		return
+	}
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	ka, ok := a.addrPeer[addr]
+	if !ok {
+		return
+	}
+	for peeraddr, peer := range a.addrPeer {
+		if peeraddr != addr && peer.GetPubKey() == pubkey {
+			log.Debug("SetAddrPubKey", "pubkey", pubkey, "remove old addr", peeraddr, "new addr", addr)
+			delete(a.addrPeer, peeraddr)
+		}
+	}
+	ka.kmtx.Lock()
+	ka.PubKey = pubkey
+	ka.kmtx.Unlock()
+}
+
 // RemoveAddr remove address
 func (a *AddrBook) RemoveAddr(peeraddr string) {
 	a.mtx.Lock()
@@ -0,0 +1,175 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/types"
+	"golang.org/x/net/context"
+)
+
+//许可网络:
+//只允许公钥在白名单中的节点连接, 白名单由配置文件中的 allowedNodes 和链上 manage 执行器的
+//配置项 p2p-allowed-nodes 组成, 链上的白名单定时刷新, 修改之后不需要重启节点.
+//许可网络必须开启 tls, 监听端在 grpc 拦截器中对每个请求检查握手验证过的公钥, 连接其他节点时检查握手得到的对方公钥
+
+//AllowlistConfigKey manage 执行器中保存节点白名单的配置项
+const AllowlistConfigKey = "p2p-allowed-nodes"
+
+//Allowlist 许可网络中允许连接的节点公钥
+type Allowlist struct {
+	mtx     sync.Mutex
+	enable  bool
+	onChain bool
+	cfg     map[string]bool
+	chain   map[string]bool
+}
+
+//NewAllowlist 根据配置创建白名单, 不是许可网络的时候允许所有节点连接
+func NewAllowlist(cfg *types.P2P) *Allowlist {
+	al := &Allowlist{
+		enable:  cfg.Permissioned,
+		onChain: cfg.AllowlistOnChain,
+		cfg:     make(map[string]bool),
+		chain:   make(map[string]bool),
+	}
+	for _, pubkey := range cfg.AllowedNodes {
+		al.cfg[strings.ToLower(pubkey)] = true
+	}
+	return al
+}
+
+//Enable 是否开启许可网络
+func (al *Allowlist) Enable() bool {
+	return al.enable
+}
+
+//Allowed 节点公钥是否允许连接
+func (al *Allowlist) Allowed(pubkey string) bool {
+	if !al.enable {
+		return true
+	}
+	pubkey = strings.ToLower(pubkey)
+	al.mtx.Lock()
+	defer al.mtx.Unlock()
+	return al.cfg[pubkey] || al.chain[pubkey]
+}
+
+//SetChainNodes 更新链上的白名单, 返回白名单是否发生变化
+func (al *Allowlist) SetChainNodes(nodes []string) bool {
+	chain := make(map[string]bool)
+	for _, pubkey := range nodes {
+		chain[strings.ToLower(pubkey)] = true
+	}
+	al.mtx.Lock()
+	defer al.mtx.Unlock()
+	changed := len(chain) != len(al.chain)
+	for pubkey := range chain {
+		if !al.chain[pubkey] {
+			changed = true
+		}
+	}
+	al.chain = chain
+	return changed
+}
+
+//peerAllowed 节点公钥在白名单中并且不在黑名单中
+func (nf *NodeInfo) peerAllowed(pubkey string) bool {
+	if pubkey != "" && nf.blacklist.Has(pubkey) {
+		return false
+	}
+	return nf.allowlist.Allowed(pubkey)
+}
+
+//remoteNodeKey 服务端确定对方节点的公钥, 优先使用 tls 验证过的公钥
+func remoteNodeKey(ctx context.Context, claimed string) string {
+	if pubkey := pubKeyFromContext(ctx); pubkey != "" {
+		return pubkey
+	}
+	return claimed
+}
+
+//nodeKey 连接的节点的公钥, 优先使用 tls 验证过的公钥, 还没有交换版本信息时为空
+func (p *Peer) nodeKey() string {
+	if pubkey := p.PubKey(); pubkey != "" {
+		return pubkey
+	}
+	return p.GetPeerName()
+}
+
+//loadChainAllowlist 从最新区块的状态中读取链上的节点白名单
+func (nf *NodeInfo) loadChainAllowlist() ([]string, error) {
+	client := nf.client
+	msg := client.NewMessage("blockchain", types.EventGetLastHeader, nil)
+	err := client.SendTimeout(msg, true, time.Second*10)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.WaitTimeout(msg, time.Second*30)
+	if err != nil {
+		return nil, err
+	}
+	header := resp.GetData().(*types.Header)
+	key := []byte(types.ManageKey(AllowlistConfigKey))
+	msg = client.NewMessage("store", types.EventStoreGet, &types.StoreGet{StateHash: header.StateHash, Keys: [][]byte{key}})
+	err = client.SendTimeout(msg, true, time.Second*10)
+	if err != nil {
+		return nil, err
+	}
+	resp, err = client.WaitTimeout(msg, time.Second*30)
+	if err != nil {
+		return nil, err
+	}
+	values := resp.GetData().(*types.StoreReplyValue).GetValues()
+	if len(values) == 0 || len(values[0]) == 0 {
+		return nil, nil
+	}
+	var item types.ConfigItem
+	err = types.Decode(values[0], &item)
+	if err != nil {
+		return nil, err
+	}
+	return item.GetArr().GetValue(), nil
+}
+
+//monitorAllowlist 定时刷新链上的白名单, 断开已经不在白名单中的节点
+func (n *Node) monitorAllowlist() {
+	allowlist := n.nodeInfo.allowlist
+	if !allowlist.Enable() {
+		return
+	}
+	ticker := time.NewTicker(CheckAllowlistInterval)
+	defer ticker.Stop()
+	for {
+		if n.isClose() {
+			log.Info("monitorAllowlist", "loop", "done")
+			return
+		}
+		if allowlist.onChain {
+			nodes, err := n.nodeInfo.loadChainAllowlist()
+			if err != nil {
+				log.Error("monitorAllowlist", "load allowlist err", err)
+			} else if allowlist.SetChainNodes(nodes) {
+				log.Info("monitorAllowlist", "chain allowlist", nodes)
+			}
+		}
+		for _, peer := range n.GetRegisterPeers() {
+			if pubkey := peer.nodeKey(); pubkey != "" && !allowlist.Allowed(pubkey) {
+				log.Info("monitorAllowlist", "remove peer", peer.Addr(), "pubkey", pubkey)
+				n.destroyPeer(peer)
+			}
+		}
+		for _, peer := range n.GetCacheBounds() {
+			if pubkey := peer.nodeKey(); pubkey != "" && !allowlist.Allowed(pubkey) {
+				n.RemoveCachePeer(peer.Addr())
+				peer.Close()
+			}
+		}
+		<-ticker.C
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+)
+
+func TestAllowlist(t *testing.T) {
+	//不是许可网络的时候允许所有节点
+	al := NewAllowlist(&types.P2P{AllowedNodes: []string{"aa"}})
+	assert.False(t, al.Enable())
+	assert.True(t, al.Allowed("bb"))
+
+	al = NewAllowlist(&types.P2P{Permissioned: true, AllowlistOnChain: true, AllowedNodes: []string{"AA"}})
+	assert.True(t, al.Enable())
+	assert.True(t, al.Allowed("aa"))
+	assert.True(t, al.Allowed("Aa"))
+	assert.False(t, al.Allowed("bb"))
+	assert.False(t, al.Allowed(""))
+
+	//链上的白名单
+	assert.True(t, al.SetChainNodes([]string{"BB", "cc"}))
+	assert.False(t, al.SetChainNodes([]string{"bb", "CC"}))
+	assert.True(t, al.Allowed("bb"))
+	assert.True(t, al.Allowed("cc"))
+	assert.True(t, al.SetChainNodes([]string{"bb"}))
+	assert.False(t, al.Allowed("cc"))
+	assert.True(t, al.SetChainNodes([]string{"bb", "dd"}))
+	assert.True(t, al.SetChainNodes(nil))
+	assert.False(t, al.Allowed("bb"))
+	//配置文件中的白名单不受链上白名单影响
+	assert.True(t, al.Allowed("aa"))
+}
+
+func TestPeerAllowed(t *testing.T) {
+	_, pub := newTestKey(t)
+	_, other := newTestKey(t)
+	node, q := newTestNode(t, nil)
+	defer closeTestNode(node, q)
+	nf := node.nodeInfo
+	nf.allowlist = NewAllowlist(&types.P2P{Permissioned: true, AllowedNodes: []string{strings.ToUpper(pub)}})
+	assert.True(t, nf.peerAllowed(pub))
+	assert.False(t, nf.peerAllowed(other))
+	assert.False(t, nf.peerAllowed(""))
+
+	//黑名单优先
+	nf.BanPeer(pub, 60)
+	assert.False(t, nf.peerAllowed(pub))
+	nf.UnbanPeer(pub)
+	assert.True(t, nf.peerAllowed(pub))
+
+	//没有 tls 时使用节点声明的公钥
+	assert.Equal(t, pub, remoteNodeKey(context.Background(), pub))
+}
@@ -0,0 +1,303 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/33cn/chain33/types"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/stats"
+)
+
+//流量控制:
+//所有 p2p 连接(包括连接其他节点和其他节点连接本节点)都经过 trafficConn, 按连接统计收发的字节数,
+//并用令牌桶限制总的上传下载速率和每个连接的上传下载速率. 每种消息的流量通过 grpc 的 stats.Handler 统计
+
+//每次写入的最大字节数, 避免一次写入大量数据时超过限速
+const trafficChunkSize = 16 * 1024
+
+//rateLimiter 令牌桶, 最多积累 1 秒的令牌. nil 表示不限速
+type rateLimiter struct {
+	mtx    sync.Mutex
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+//newRateLimiter kbps 单位 KB/s, 不大于 0 时返回 nil
+func newRateLimiter(kbps int64) *rateLimiter {
+	if kbps <= 0 {
+		return nil
+	}
+	rate := float64(kbps * 1024)
+	return &rateLimiter{rate: rate, tokens: rate, last: time.Now()}
+}
+
+//wait 取出 n 个令牌, 令牌不够时先透支, 等到令牌补足再返回
+func (l *rateLimiter) wait(n int) {
+	if l == nil || n <= 0 {
+		return
+	}
+	l.mtx.Lock()
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.rate {
+		l.tokens = l.rate
+	}
+	l.last = now
+	l.tokens -= float64(n)
+	var delay time.Duration
+	if l.tokens < 0 {
+		delay = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	l.mtx.Unlock()
+	if delay > 0 {
+		time.Sleep(delay)
+	}
+}
+
+//connTraffic 一个连接的流量
+type connTraffic struct {
+	addr    string
+	inbound bool
+	sent    int64
+	recv    int64
+}
+
+//Bandwidth 流量统计和限速
+type Bandwidth struct {
+	mtx              sync.Mutex
+	upload           *rateLimiter
+	download         *rateLimiter
+	peerUploadRate   int64
+	peerDownloadRate int64
+	conns            map[*connTraffic]bool
+	//已经关闭的连接的流量
+	closedSent int64
+	closedRecv int64
+	msgs       map[string]*types.MsgTraffic
+}
+
+//NewBandwidth new bandwidth
+func NewBandwidth(cfg *types.P2P) *Bandwidth {
+	return &Bandwidth{
+		upload:           newRateLimiter(cfg.UploadRate),
+		download:         newRateLimiter(cfg.DownloadRate),
+		peerUploadRate:   cfg.PeerUploadRate,
+		peerDownloadRate: cfg.PeerDownloadRate,
+		conns:            make(map[*connTraffic]bool),
+		msgs:             make(map[string]*types.MsgTraffic),
+	}
+}
+
+func (bw *Bandwidth) wrapConn(conn net.Conn, addr string, inbound bool) net.Conn {
+	traffic := &connTraffic{addr: addr, inbound: inbound}
+	bw.mtx.Lock()
+	bw.conns[traffic] = true
+	bw.mtx.Unlock()
+	return &trafficConn{
+		Conn:     conn,
+		bw:       bw,
+		traffic:  traffic,
+		upload:   newRateLimiter(bw.peerUploadRate),
+		download: newRateLimiter(bw.peerDownloadRate),
+	}
+}
+
+func (bw *Bandwidth) removeConn(traffic *connTraffic) {
+	bw.mtx.Lock()
+	defer bw.mtx.Unlock()
+	if !bw.conns[traffic] {
+		return
+	}
+	delete(bw.conns, traffic)
+	bw.closedSent += atomic.LoadInt64(&traffic.sent)
+	bw.closedRecv += atomic.LoadInt64(&traffic.recv)
+}
+
+//dialOptions 连接其他节点时使用的 grpc 选项
+func (bw *Bandwidth) dialOptions() []grpc.DialOption {
+	if bw == nil {
+		return nil
+	}
+	dialer := func(addr string, timeout time.Duration) (net.Conn, error) {
+		conn, err := net.DialTimeout("tcp", addr, timeout)
+		if err != nil {
+			return nil, err
+		}
+		return bw.wrapConn(conn, addr, false), nil
+	}
+	return []grpc.DialOption{grpc.WithDialer(dialer), grpc.WithStatsHandler(bw)}
+}
+
+//listener 统计其他节点连接本节点的流量
+func (bw *Bandwidth) listener(l net.Listener) net.Listener {
+	return &trafficListener{Listener: l, bw: bw}
+}
+
+//PeerTraffic 连接 addr 的流量
+func (bw *Bandwidth) PeerTraffic(addr string) (sent, recv int64) {
+	bw.mtx.Lock()
+	defer bw.mtx.Unlock()
+	for traffic := range bw.conns {
+		if traffic.addr == addr && !traffic.inbound {
+			sent += atomic.LoadInt64(&traffic.sent)
+			recv += atomic.LoadInt64(&traffic.recv)
+		}
+	}
+	return sent, recv
+}
+
+//fillNetInfo 把总流量, 每个连接和每种消息的流量填入 NodeNetInfo
+func (bw *Bandwidth) fillNetInfo(info *types.NodeNetInfo) {
+	bw.mtx.Lock()
+	defer bw.mtx.Unlock()
+	info.BytesSent = bw.closedSent
+	info.BytesRecv = bw.closedRecv
+	for traffic := range bw.conns {
+		peer := &types.PeerTraffic{Addr: traffic.addr, Inbound: traffic.inbound,
+			BytesSent: atomic.LoadInt64(&traffic.sent), BytesRecv: atomic.LoadInt64(&traffic.recv)}
+		info.BytesSent += peer.BytesSent
+		info.BytesRecv += peer.BytesRecv
+		info.Peers = append(info.Peers, peer)
+	}
+	sort.Slice(info.Peers, func(i, j int) bool {
+		if info.Peers[i].Addr != info.Peers[j].Addr {
+			return info.Peers[i].Addr < info.Peers[j].Addr
+		}
+		return !info.Peers[i].Inbound && info.Peers[j].Inbound
+	})
+	for _, msg := range bw.msgs {
+		copyMsg := *msg
+		info.Msgs = append(info.Msgs, &copyMsg)
+	}
+	sort.Slice(info.Msgs, func(i, j int) bool { return info.Msgs[i].Msg < info.Msgs[j].Msg })
+}
+
+func (bw *Bandwidth) addMsg(name string, sent, recv int) {
+	bw.mtx.Lock()
+	defer bw.mtx.Unlock()
+	msg, ok := bw.msgs[name]
+	if !ok {
+		msg = &types.MsgTraffic{Msg: name}
+		bw.msgs[name] = msg
+	}
+	msg.BytesSent += int64(sent)
+	msg.BytesRecv += int64(recv)
+}
+
+type rpcMethodKey struct{}
+
+//TagRPC 记录 rpc 的方法名
+func (bw *Bandwidth) TagRPC(ctx context.Context, info *stats.RPCTagInfo) context.Context {
+	method := info.FullMethodName
+	if index := strings.LastIndex(method, "/"); index >= 0 {
+		method = method[index+1:]
+	}
+	return context.WithValue(ctx, rpcMethodKey{}, method)
+}
+
+//HandleRPC 按消息类型统计流量, 广播的数据流按照数据的类型区分
+func (bw *Bandwidth) HandleRPC(ctx context.Context, s stats.RPCStats) {
+	switch s := s.(type) {
+	case *stats.InPayload:
+		bw.addMsg(msgName(ctx, s.Payload), 0, s.WireLength)
+	case *stats.OutPayload:
+		bw.addMsg(msgName(ctx, s.Payload), s.WireLength, 0)
+	}
+}
+
+//TagConn stats.Handler
+func (bw *Bandwidth) TagConn(ctx context.Context, info *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+//HandleConn stats.Handler
+func (bw *Bandwidth) HandleConn(ctx context.Context, s stats.ConnStats) {}
+
+func msgName(ctx context.Context, payload interface{}) string {
+	if data, ok := payload.(*types.BroadCastData); ok {
+		switch data.GetValue().(type) {
+		case *types.BroadCastData_Tx:
+			return "stream.tx"
+		case *types.BroadCastData_Block:
+			return "stream.block"
+		case *types.BroadCastData_CompactBlock:
+			return "stream.compactBlock"
+		case *types.BroadCastData_Ping:
+			return "stream.ping"
+		case *types.BroadCastData_Version:
+			return "stream.version"
+		}
+	}
+	method, _ := ctx.Value(rpcMethodKey{}).(string)
+	return method
+}
+
+type trafficListener struct {
+	net.Listener
+	bw *Bandwidth
+}
+
+func (l *trafficListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return l.bw.wrapConn(conn, conn.RemoteAddr().String(), true), nil
+}
+
+//trafficConn 统计连接的流量并限速
+type trafficConn struct {
+	net.Conn
+	bw        *Bandwidth
+	traffic   *connTraffic
+	upload    *rateLimiter
+	download  *rateLimiter
+	closeOnce sync.Once
+}
+
+//Read 读取之后等待下载令牌, 令牌不够时暂停读取, 由 tcp 的流量控制限制对方发送的速度
+func (c *trafficConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	if n > 0 {
+		atomic.AddInt64(&c.traffic.recv, int64(n))
+		c.bw.download.wait(n)
+		c.download.wait(n)
+	}
+	return n, err
+}
+
+func (c *trafficConn) Write(b []byte) (int, error) {
+	var written int
+	for len(b) > 0 {
+		chunk := b
+		if len(chunk) > trafficChunkSize {
+			chunk = chunk[:trafficChunkSize]
+		}
+		c.bw.upload.wait(len(chunk))
+		c.upload.wait(len(chunk))
+		n, err := c.Conn.Write(chunk)
+		written += n
+		atomic.AddInt64(&c.traffic.sent, int64(n))
+		if err != nil {
+			return written, err
+		}
+		b = b[n:]
+	}
+	return written, nil
+}
+
+func (c *trafficConn) Close() error {
+	c.closeOnce.Do(func() { c.bw.removeConn(c.traffic) })
+	return c.Conn.Close()
+}
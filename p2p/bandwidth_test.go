@@ -0,0 +1,97 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter(t *testing.T) {
+	assert.Nil(t, newRateLimiter(0))
+	var nilLimiter *rateLimiter
+	nilLimiter.wait(1024)
+
+	l := newRateLimiter(1)
+	//开始时有 1 秒的令牌
+	begin := time.Now()
+	l.wait(1024)
+	assert.True(t, time.Since(begin) < 200*time.Millisecond)
+	//令牌不够时先透支, 等待补足
+	begin = time.Now()
+	l.wait(512)
+	elapsed := time.Since(begin)
+	assert.True(t, elapsed >= 400*time.Millisecond, "elapsed %v", elapsed)
+	assert.True(t, elapsed < 1500*time.Millisecond, "elapsed %v", elapsed)
+	//令牌最多积累 1 秒
+	l.mtx.Lock()
+	l.last = l.last.Add(-10 * time.Second)
+	l.mtx.Unlock()
+	begin = time.Now()
+	l.wait(1024)
+	assert.True(t, time.Since(begin) < 200*time.Millisecond)
+	l.mtx.Lock()
+	assert.True(t, l.tokens <= 1)
+	l.mtx.Unlock()
+}
+
+func TestTrafficConn(t *testing.T) {
+	bw := NewBandwidth(&types.P2P{PeerUploadRate: 64})
+	c1, c2 := net.Pipe()
+	conn := bw.wrapConn(c1, "127.0.0.1:13802", false)
+	data := make([]byte, trafficChunkSize*2+100)
+	done := make(chan struct{})
+	go func() {
+		buf := make([]byte, len(data))
+		_, err := io.ReadFull(c2, buf)
+		assert.Nil(t, err)
+		_, err = c2.Write([]byte("pong"))
+		assert.Nil(t, err)
+		close(done)
+	}()
+	n, err := conn.Write(data)
+	require.Nil(t, err)
+	assert.Equal(t, len(data), n)
+	buf := make([]byte, 4)
+	_, err = io.ReadFull(conn, buf)
+	require.Nil(t, err)
+	<-done
+
+	sent, recv := bw.PeerTraffic("127.0.0.1:13802")
+	assert.Equal(t, int64(len(data)), sent)
+	assert.Equal(t, int64(4), recv)
+	//连接本节点的流量不计入 PeerTraffic
+	inbound := bw.wrapConn(c2, "127.0.0.1:13802", true)
+	sent, _ = bw.PeerTraffic("127.0.0.1:13802")
+	assert.Equal(t, int64(len(data)), sent)
+
+	bw.addMsg("GetData", 10, 20)
+	bw.addMsg("GetData", 1, 2)
+	info := &types.NodeNetInfo{}
+	bw.fillNetInfo(info)
+	assert.Equal(t, int64(len(data)), info.BytesSent)
+	assert.Equal(t, int64(4), info.BytesRecv)
+	require.Equal(t, 2, len(info.Peers))
+	assert.False(t, info.Peers[0].Inbound)
+	require.Equal(t, 1, len(info.Msgs))
+	assert.Equal(t, int64(11), info.Msgs[0].BytesSent)
+	assert.Equal(t, int64(22), info.Msgs[0].BytesRecv)
+
+	//关闭之后流量计入总数, 重复关闭只计算一次
+	conn.Close()
+	conn.Close()
+	inbound.Close()
+	info = &types.NodeNetInfo{}
+	bw.fillNetInfo(info)
+	assert.Equal(t, 0, len(info.Peers))
+	assert.Equal(t, int64(len(data)), info.BytesSent)
+	assert.Equal(t, int64(4), info.BytesRecv)
+}
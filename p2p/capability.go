@@ -0,0 +1,69 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/33cn/chain33/types"
+)
+
+//节点能力在 Version2 握手时交换, 保存在 Peer 上. 新的协议功能增加新的能力位,
+//只在双方都声明的时候使用, 不需要提高版本号, 也不会断开旧版本的节点
+const (
+	//CapCompression grpc 请求使用 gzip 压缩, 主动连接的一方在握手之后按协商的结果开始压缩
+	CapCompression uint64 = 1 << iota
+	//CapCompactBlock 广播区块时使用紧凑区块
+	CapCompactBlock
+	//CapLightService 提供交易和状态的默克尔证明
+	CapLightService
+	//CapTxAnnounce 预留给交易通告, 还没有实现, 不会声明
+	CapTxAnnounce
+)
+
+//supportedCaps 当前版本实现的能力
+const supportedCaps = CapCompression | CapCompactBlock | CapLightService
+
+//serviceCaps 对外提供的服务, 只需要对方声明就可以使用, 例如轻节点不提供证明, 但是可以向其他节点请求
+const serviceCaps = CapLightService
+
+var capNames = map[string]uint64{
+	"compression":  CapCompression,
+	"compactBlock": CapCompactBlock,
+	"lightService": CapLightService,
+	"txAnnounce":   CapTxAnnounce,
+}
+
+//localCaps 本节点声明的能力, 去掉配置中关闭的能力
+func localCaps(cfg *types.P2P) uint64 {
+	caps := supportedCaps
+	for _, name := range cfg.DisableCaps {
+		c, ok := capNames[name]
+		if !ok {
+			log.Error("localCaps", "unknown capability", name)
+			continue
+		}
+		caps &^= c
+	}
+	return caps
+}
+
+//negotiateCaps 协议功能取双方的交集, 服务只看对方是否声明
+func negotiateCaps(local, remote uint64) uint64 {
+	return local&remote&^serviceCaps | remote&serviceCaps
+}
+
+//capsString 能力的名字, 用于日志
+func capsString(caps uint64) string {
+	var names []string
+	for name, c := range capNames {
+		if caps&c != 0 {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return strings.Join(names, ",")
+}
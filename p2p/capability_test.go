@@ -0,0 +1,49 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalCaps(t *testing.T) {
+	assert.Equal(t, supportedCaps, localCaps(&types.P2P{}))
+	caps := localCaps(&types.P2P{DisableCaps: []string{"compactBlock", "unknown"}})
+	assert.Equal(t, CapCompression|CapLightService, caps)
+	assert.Equal(t, "compression,lightService", capsString(caps))
+	assert.Equal(t, "", capsString(0))
+}
+
+func TestNegotiateCaps(t *testing.T) {
+	all := supportedCaps
+	assert.Equal(t, all, negotiateCaps(all, all))
+	//协议功能取交集
+	assert.Equal(t, CapCompactBlock, negotiateCaps(all, CapCompactBlock))
+	assert.Equal(t, uint64(0), negotiateCaps(CapLightService, CapCompactBlock))
+	//服务只看对方是否声明, 本节点不提供服务时仍然可以使用
+	assert.Equal(t, CapLightService, negotiateCaps(CapCompactBlock, CapLightService))
+	assert.Equal(t, CapCompactBlock, negotiateCaps(all&^CapLightService, CapCompactBlock))
+	assert.Equal(t, uint64(0), negotiateCaps(all, 0))
+	//对方声明的未知能力不会被使用
+	assert.Equal(t, uint64(0), negotiateCaps(all, CapTxAnnounce))
+}
+
+func TestCompressOption(t *testing.T) {
+	var nilOpt *compressOption
+	nilOpt.Set(true)
+	assert.Nil(t, nilOpt.dialOptions())
+
+	//协商之前不压缩
+	c := new(compressOption)
+	assert.Equal(t, 2, len(c.dialOptions()))
+	assert.Equal(t, 0, len(c.callOptions(nil)))
+	c.Set(true)
+	assert.Equal(t, 1, len(c.callOptions(nil)))
+	c.Set(false)
+	assert.Equal(t, 0, len(c.callOptions(nil)))
+}
@@ -16,6 +16,8 @@ import (
 	"github.com/33cn/chain33/common/crypto"
 	"github.com/33cn/chain33/types"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // P2pComm p2p communication
@@ -25,7 +27,7 @@ var P2pComm Comm
 type Comm struct{}
 
 // AddrRouteble address router ,return enbale address
-func (Comm) AddrRouteble(addrs []string) []string {
+func (Comm) AddrRouteble(addrs []string, nodeInfo *NodeInfo) []string {
 	var enableAddrs []string
 
 	for _, addr := range addrs {
@@ -34,7 +36,7 @@ func (Comm) AddrRouteble(addrs []string) []string {
 			log.Error("AddrRouteble", "NewNetAddressString", err.Error())
 			continue
 		}
-		conn, err := netaddr.DialTimeout(VERSION)
+		conn, err := netaddr.DialTimeout(nodeInfo.newPeerCreds(), nodeInfo.bandwidth, nil)
 		if err != nil {
 			//log.Error("AddrRouteble", "DialTimeout", err.Error())
 			continue
@@ -74,10 +76,19 @@ func (c Comm) GetLocalAddr() string {
 
 func (c Comm) dialPeerWithAddress(addr *NetAddress, persistent bool, node *Node) (*Peer, error) {
 	log.Info("dialPeerWithAddress")
-	conn, err := addr.DialTimeout(node.nodeInfo.cfg.Version)
+	creds := node.nodeInfo.newPeerCreds()
+	compress := new(compressOption)
+	conn, err := addr.DialTimeout(creds, node.nodeInfo.bandwidth, compress)
 	if err != nil {
 		return nil, err
 	}
+	if creds != nil {
+		if err = c.checkPeerPubKey(creds.PubKey(), node); err != nil {
+			log.Error("dialPeerWithAddress", "addr", addr.String(), "pubkey", creds.PubKey(), "err", err)
+			conn.Close()
+			return nil, err
+		}
+	}
 
 	peer, err := c.newPeerFromConn(conn, addr, node)
 	if err != nil {
@@ -85,6 +96,8 @@ func (c Comm) dialPeerWithAddress(addr *NetAddress, persistent bool, node *Node)
 		return nil, err
 	}
 	peer.SetAddr(addr)
+	peer.SetPubKey(creds.PubKey())
+	peer.compress = compress
 	log.Debug("dialPeerWithAddress", "peer", peer.Addr(), "persistent:", persistent)
 
 	if persistent {
@@ -94,6 +107,20 @@ func (c Comm) dialPeerWithAddress(addr *NetAddress, persistent bool, node *Node)
 	return peer, nil
 }
 
+//checkPeerPubKey 检查 tls 验证过的节点公钥, 不连接自己, 不在白名单中的节点, 黑名单中的节点以及已经用其他地址连接上的节点
+func (c Comm) checkPeerPubKey(pubkey string, node *Node) error {
+	if pubkey == "" {
+		return types.ErrPeerPubKey
+	}
+	if _, selfPub := node.nodeInfo.addrBook.GetPrivPubKey(); pubkey == selfPub {
+		return types.ErrPeerPubKey
+	}
+	if !node.nodeInfo.allowlist.Allowed(pubkey) || node.nodeInfo.blacklist.Has(pubkey) || node.HasPubKey(pubkey) {
+		return types.ErrPeerPubKey
+	}
+	return nil
+}
+
 func (c Comm) newPeerFromConn(rawConn *grpc.ClientConn, remote *NetAddress, node *Node) (*Peer, error) {
 
 	// Key and NodeInfo are set after Handshake
@@ -238,6 +265,9 @@ func (c Comm) CheckSign(in *types.P2PPing) bool {
 func (c Comm) CollectPeerStat(err error, peer *Peer) {
 	if err != nil {
 		peer.peerStat.NotOk()
+		if code := status.Code(err); code == codes.DeadlineExceeded || code == codes.Unavailable {
+			go peer.node.reportPeer(peer.nodeKey(), types.PeerTimeout, err.Error())
+		}
 	} else {
 		peer.peerStat.Ok()
 	}
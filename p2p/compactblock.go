@@ -0,0 +1,271 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common/merkle"
+	"github.com/33cn/chain33/types"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+//紧凑区块:
+//广播区块时只发送区块头和交易的短哈希, 接收方从自己的 mempool 中恢复区块的交易,
+//缺少的交易通过 GetData(MSG_BLOCKTX) 向发送方获取, 交易仍然不全或者默克尔根不一致时通过 GetData(MSG_BLOCK) 下载完整的区块.
+//是否支持紧凑区块在 Version2 中协商, 不支持的节点仍然发送完整的区块
+
+//向其他节点获取缺少的交易或者完整区块时最多尝试的节点数
+const maxCompactFetchPeers = 3
+
+//从 mempool 恢复交易的超时时间, 超时之后直接下载完整的区块
+const mempoolShortTxsTimeout = 5 * time.Second
+
+//newCompactBlock 生成紧凑区块, 本节点没有收到或者转发过的交易(比如挖矿交易)认为对方也没有, 直接发送交易
+func newCompactBlock(block *types.Block, filter *Filterdata) *types.P2PCompactBlock {
+	header := *block
+	header.Txs = nil
+	cblock := &types.P2PCompactBlock{Header: &header, Hash: block.Hash(), Nonce: rand.Int63()}
+	var hash [64]byte
+	for i, tx := range block.Txs {
+		txhash := tx.Hash()
+		cblock.ShortIDs = append(cblock.ShortIDs, types.ShortTxID(cblock.Nonce, txhash))
+		hex.Encode(hash[:], txhash)
+		if i == 0 || !filter.QueryRecvData(string(hash[:])) {
+			cblock.PrefilledTxs = append(cblock.PrefilledTxs, &types.PrefilledTx{Index: int32(i), Tx: tx})
+		}
+	}
+	return cblock
+}
+
+//compactCache 缓存最近广播的区块的紧凑区块, 发送给每个节点时不需要重新计算
+type compactCache struct {
+	mtx    sync.Mutex
+	block  *types.Block
+	cblock *types.P2PCompactBlock
+}
+
+func (c *compactCache) get(block *types.Block, filter *Filterdata) *types.P2PCompactBlock {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.block != block {
+		c.block = block
+		c.cblock = newCompactBlock(block, filter)
+	}
+	return c.cblock
+}
+
+//onCompactBlock 过滤已经收到过的区块, 在后台恢复紧凑区块, pid 为发送方的节点公钥
+func (n *Node) onCompactBlock(cblock *types.P2PCompactBlock, pid string) {
+	blockhash := hex.EncodeToString(cblock.GetHash())
+	n.filter.GetLock()
+	if n.filter.QueryRecvData(blockhash) {
+		n.filter.ReleaseLock()
+		return
+	}
+	n.filter.RegRecvData(blockhash)
+	n.filter.ReleaseLock()
+	log.Info("onCompactBlock", "height", cblock.GetHeader().GetHeight(), "from peer", pid, "txs", len(cblock.GetShortIDs()),
+		"prefilled", len(cblock.GetPrefilledTxs()), "size(KB)", float32(types.Size(cblock))/1024, "block hash", blockhash)
+	go n.recvCompactBlock(cblock, pid)
+}
+
+//recvCompactBlock 恢复紧凑区块并发送给 blockchain
+func (n *Node) recvCompactBlock(cblock *types.P2PCompactBlock, pid string) {
+	//比自己低很多的区块不需要处理
+	height, err := NewNormalP2PCli().GetBlockHeight(n.nodeInfo)
+	if err == nil && height >= cblock.GetHeader().GetHeight()+128 {
+		return
+	}
+	block, err := n.buildCompactBlock(cblock, pid)
+	if err != nil {
+		log.Error("recvCompactBlock", "height", cblock.GetHeader().GetHeight(), "hash", hex.EncodeToString(cblock.GetHash()), "err", err)
+		//恢复失败, 允许再次接收这个区块
+		n.filter.RemoveRecvData(hex.EncodeToString(cblock.GetHash()))
+		return
+	}
+	client := n.nodeInfo.client
+	msg := client.NewMessage("blockchain", types.EventBroadcastAddBlock, &types.BlockPid{Pid: pid, Block: block})
+	err = client.Send(msg, false)
+	if err != nil {
+		log.Error("recvCompactBlock", "send to blockchain Error", err.Error())
+	}
+}
+
+func (n *Node) buildCompactBlock(cblock *types.P2PCompactBlock, pid string) (*types.Block, error) {
+	header := cblock.GetHeader()
+	if header == nil {
+		return nil, types.ErrCompactBlock
+	}
+	txs := make([]*types.Transaction, len(cblock.GetShortIDs()))
+	for _, prefilled := range cblock.GetPrefilledTxs() {
+		index := int(prefilled.GetIndex())
+		if index < 0 || index >= len(txs) || prefilled.GetTx() == nil {
+			return nil, types.ErrCompactBlock
+		}
+		txs[index] = prefilled.GetTx()
+	}
+	peers := n.compactPeers(pid)
+	//从 mempool 中恢复交易, 短哈希冲突的交易当作缺少的交易
+	memtxs, err := n.nodeInfo.getMempoolShortTxs(cblock.GetNonce(), cblock.GetShortIDs())
+	if err != nil {
+		log.Error("buildCompactBlock", "height", header.GetHeight(), "getMempoolShortTxs err", err, "download", "full block")
+		return n.fetchFullBlock(peers, header.GetHeight(), cblock.GetHash())
+	}
+	pool := make(map[uint64]*types.Transaction)
+	for _, tx := range memtxs {
+		id := types.ShortTxID(cblock.GetNonce(), tx.Hash())
+		if _, ok := pool[id]; ok {
+			pool[id] = nil
+			continue
+		}
+		pool[id] = tx
+	}
+	var missing []int
+	for i, id := range cblock.GetShortIDs() {
+		if txs[i] != nil {
+			continue
+		}
+		if tx := pool[id]; tx != nil {
+			txs[i] = tx
+		} else {
+			missing = append(missing, i)
+		}
+	}
+	if len(missing) > 0 {
+		log.Debug("buildCompactBlock", "height", header.GetHeight(), "txs", len(txs), "missing", len(missing))
+		n.fetchBlockTxs(peers, cblock, txs, missing)
+	}
+	block := *header
+	block.Txs = txs
+	if checkCompactBlock(&block, cblock.GetHash()) {
+		return &block, nil
+	}
+	log.Info("buildCompactBlock", "height", header.GetHeight(), "download", "full block")
+	return n.fetchFullBlock(peers, header.GetHeight(), cblock.GetHash())
+}
+
+//checkCompactBlock 恢复的交易是否完整, 并且和区块头一致
+func checkCompactBlock(block *types.Block, hash []byte) bool {
+	for _, tx := range block.Txs {
+		if tx == nil {
+			return false
+		}
+	}
+	return bytes.Equal(merkle.CalcMerkleRoot(block.Txs), block.TxHash) && bytes.Equal(block.Hash(), hash)
+}
+
+//compactPeers 获取缺少的数据时使用的节点, 发送方优先. 只有支持紧凑区块的节点能回复区块中的交易
+func (n *Node) compactPeers(pid string) []*Peer {
+	var peers, others []*Peer
+	for _, peer := range n.GetRegisterPeers() {
+		if !peer.version.HasCap(CapCompactBlock) {
+			continue
+		}
+		if peer.nodeKey() == pid {
+			peers = append(peers, peer)
+		} else {
+			others = append(others, peer)
+		}
+	}
+	peers = append(peers, others...)
+	if len(peers) > maxCompactFetchPeers {
+		peers = peers[:maxCompactFetchPeers]
+	}
+	return peers
+}
+
+//fetchBlockTxs 通过 GetData 获取区块中缺少的交易, 返回的交易按短哈希填入
+func (n *Node) fetchBlockTxs(peers []*Peer, cblock *types.P2PCompactBlock, txs []*types.Transaction, missing []int) {
+	shortIDs := cblock.GetShortIDs()
+	for _, peer := range peers {
+		var invs []*types.Inventory
+		wants := make(map[uint64]int)
+		for _, index := range missing {
+			if txs[index] != nil {
+				continue
+			}
+			invs = append(invs, &types.Inventory{Ty: msgBlockTx, Hash: cblock.GetHash(), Height: cblock.GetHeader().GetHeight(), Index: int32(index)})
+			wants[shortIDs[index]] = index
+		}
+		if len(invs) == 0 {
+			return
+		}
+		items, err := n.getData(peer, invs)
+		if err != nil {
+			log.Error("fetchBlockTxs", "peer", peer.Addr(), "err", err)
+			continue
+		}
+		for _, item := range items {
+			tx := item.GetTx()
+			if tx == nil {
+				continue
+			}
+			if index, ok := wants[types.ShortTxID(cblock.GetNonce(), tx.Hash())]; ok {
+				txs[index] = tx
+			}
+		}
+	}
+}
+
+//fetchFullBlock 通过 GetData 下载完整的区块
+func (n *Node) fetchFullBlock(peers []*Peer, height int64, hash []byte) (*types.Block, error) {
+	invs := []*types.Inventory{{Ty: msgBlock, Hash: hash, Height: height}}
+	for _, peer := range peers {
+		items, err := n.getData(peer, invs)
+		if err != nil {
+			log.Error("fetchFullBlock", "peer", peer.Addr(), "err", err)
+			continue
+		}
+		for _, item := range items {
+			if block := item.GetBlock(); block != nil && bytes.Equal(block.Hash(), hash) {
+				return block, nil
+			}
+		}
+	}
+	return nil, types.ErrCompactBlock
+}
+
+func (n *Node) getData(peer *Peer, invs []*types.Inventory) ([]*types.InvData, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultSendTimeout)
+	defer cancel()
+	resp, err := peer.mconn.gcli.GetData(ctx, &types.P2PGetData{Version: n.nodeInfo.cfg.Version, Invs: invs}, grpc.FailFast(true))
+	P2pComm.CollectPeerStat(err, peer)
+	if err != nil {
+		return nil, err
+	}
+	var items []*types.InvData
+	for {
+		invdatas, err := resp.Recv()
+		if err == io.EOF {
+			return items, nil
+		}
+		if err != nil {
+			return items, err
+		}
+		items = append(items, invdatas.GetItems()...)
+	}
+}
+
+//getMempoolShortTxs 在 mempool 中按照短哈希查询交易
+func (nf *NodeInfo) getMempoolShortTxs(nonce int64, shortIDs []uint64) ([]*types.Transaction, error) {
+	client := nf.client
+	msg := client.NewMessage("mempool", types.EventGetMempoolShortTxs, &types.ReqShortTxs{Nonce: nonce, ShortIDs: shortIDs})
+	err := client.SendTimeout(msg, true, mempoolShortTxsTimeout)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.WaitTimeout(msg, mempoolShortTxsTimeout)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetData().(*types.ReplyTxList).GetTxs(), nil
+}
@@ -0,0 +1,182 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/33cn/chain33/common/merkle"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestBlock(height int64, parent []byte, txs []*types.Transaction) *types.Block {
+	return &types.Block{Height: height, ParentHash: parent, BlockTime: height, TxHash: merkle.CalcMerkleRoot(txs), Txs: txs}
+}
+
+func TestNewCompactBlock(t *testing.T) {
+	txs := newTestTxs(4)
+	block := newTestBlock(10, nil, txs)
+	//收到过的交易认为对方也有, 挖矿交易总是直接发送
+	filter := NewFilter()
+	filter.RegRecvData(hex.EncodeToString(txs[0].Hash()))
+	filter.RegRecvData(hex.EncodeToString(txs[2].Hash()))
+	cblock := newCompactBlock(block, filter)
+	assert.Equal(t, block.Hash(), cblock.Hash)
+	assert.Nil(t, cblock.Header.Txs)
+	assert.Equal(t, 4, len(block.Txs))
+	require.Equal(t, 4, len(cblock.ShortIDs))
+	for i, tx := range txs {
+		assert.Equal(t, types.ShortTxID(cblock.Nonce, tx.Hash()), cblock.ShortIDs[i])
+	}
+	require.Equal(t, 3, len(cblock.PrefilledTxs))
+	assert.Equal(t, int32(0), cblock.PrefilledTxs[0].Index)
+	assert.Equal(t, int32(1), cblock.PrefilledTxs[1].Index)
+	assert.Equal(t, int32(3), cblock.PrefilledTxs[2].Index)
+
+	//同一个区块使用缓存
+	var cache compactCache
+	assert.True(t, cache.get(block, filter) == cache.get(block, filter))
+	assert.False(t, cache.get(block, filter) == cache.get(newTestBlock(11, nil, txs), filter))
+}
+
+func TestBuildCompactBlock(t *testing.T) {
+	txs := newTestTxs(5)
+	block := newTestBlock(10, nil, txs)
+	node, q := newTestNode(t, nil)
+	defer closeTestNode(node, q)
+	//mempool 中有除了挖矿交易以外的交易, 以及无关的交易
+	serveTestMempool(q, append(newTestTxs(3), txs[1:]...))
+
+	cblock := &types.P2PCompactBlock{Header: &types.Block{}, Hash: block.Hash(), Nonce: 7}
+	*cblock.Header = *block
+	cblock.Header.Txs = nil
+	for _, tx := range txs {
+		cblock.ShortIDs = append(cblock.ShortIDs, types.ShortTxID(cblock.Nonce, tx.Hash()))
+	}
+	cblock.PrefilledTxs = []*types.PrefilledTx{{Index: 0, Tx: txs[0]}}
+	rebuilt, err := node.buildCompactBlock(cblock, "")
+	require.Nil(t, err)
+	assert.Equal(t, block.Hash(), rebuilt.Hash())
+	assert.Equal(t, len(txs), len(rebuilt.Txs))
+	for i := range txs {
+		assert.Equal(t, txs[i].Hash(), rebuilt.Txs[i].Hash())
+	}
+
+	_, err = node.buildCompactBlock(&types.P2PCompactBlock{}, "")
+	assert.Equal(t, types.ErrCompactBlock, err)
+}
+
+func TestBuildCompactBlockCollision(t *testing.T) {
+	txs := newTestTxs(3)
+	block := newTestBlock(10, nil, txs)
+	node, q := newTestNode(t, nil)
+	defer closeTestNode(node, q)
+	//mempool 中两个交易的短哈希相同, 都不能使用, 没有节点可以获取缺少的交易时恢复失败
+	serveTestMempool(q, []*types.Transaction{txs[1], txs[2], txs[2]})
+	cblock := newCompactBlock(block, node.filter)
+	cblock.PrefilledTxs = cblock.PrefilledTxs[:1]
+	_, err := node.buildCompactBlock(cblock, "")
+	assert.Equal(t, types.ErrCompactBlock, err)
+
+	//从发送区块的节点获取冲突的交易
+	srv := newTestServer()
+	srv.blockTxs[hex.EncodeToString(block.Hash())] = txs
+	addr, stop := startTestServer(t, srv)
+	defer stop()
+	peer := newTestPeer(t, node, addr)
+	defer peer.mconn.Close()
+	peer.version.SetCaps(CapCompactBlock)
+	setTestPeer(node, peer, 10)
+	rebuilt, err := node.buildCompactBlock(cblock, "")
+	require.Nil(t, err)
+	assert.Equal(t, block.Hash(), rebuilt.Hash())
+	require.Equal(t, 1, len(srv.invs))
+	assert.Equal(t, int32(2), srv.invs[0].Index)
+}
+
+func TestBuildCompactBlockPrefilled(t *testing.T) {
+	txs := newTestTxs(3)
+	block := newTestBlock(10, nil, txs)
+	node, q := newTestNode(t, nil)
+	defer closeTestNode(node, q)
+	serveTestMempool(q, txs)
+	for _, prefilled := range []*types.PrefilledTx{{Index: 3, Tx: txs[0]}, {Index: -1, Tx: txs[0]}, {Index: 0}} {
+		cblock := newCompactBlock(block, node.filter)
+		cblock.PrefilledTxs = []*types.PrefilledTx{prefilled}
+		_, err := node.buildCompactBlock(cblock, "")
+		assert.Equal(t, types.ErrCompactBlock, err)
+	}
+}
+
+func TestBuildCompactBlockFullBlock(t *testing.T) {
+	txs := newTestTxs(3)
+	block := newTestBlock(10, nil, txs)
+	node, q := newTestNode(t, nil)
+	defer closeTestNode(node, q)
+	serveTestMempool(q, nil)
+
+	//节点返回的交易和短哈希不一致, 下载完整的区块
+	srv := newTestServer()
+	srv.blockTxs[hex.EncodeToString(block.Hash())] = newTestTxs(3)
+	addr, stop := startTestServer(t, srv)
+	defer stop()
+	peer := newTestPeer(t, node, addr)
+	defer peer.mconn.Close()
+	peer.version.SetCaps(CapCompactBlock)
+	setTestPeer(node, peer, 10)
+	cblock := newCompactBlock(block, node.filter)
+	cblock.PrefilledTxs = cblock.PrefilledTxs[:1]
+	_, err := node.buildCompactBlock(cblock, "")
+	assert.Equal(t, types.ErrCompactBlock, err)
+
+	srv.mtx.Lock()
+	srv.blocks[10] = block
+	srv.invs = nil
+	srv.mtx.Unlock()
+	rebuilt, err := node.buildCompactBlock(cblock, "")
+	require.Nil(t, err)
+	assert.Equal(t, block.Hash(), rebuilt.Hash())
+	require.Equal(t, 3, len(srv.invs))
+	assert.Equal(t, int32(msgBlock), srv.invs[2].Ty)
+
+	//不支持紧凑区块的节点不会被请求
+	peer.version.SetCaps(0)
+	_, err = node.buildCompactBlock(cblock, "")
+	assert.Equal(t, types.ErrCompactBlock, err)
+}
+
+func TestBuildCompactBlockMempoolErr(t *testing.T) {
+	txs := newTestTxs(3)
+	block := newTestBlock(10, nil, txs)
+	node, q := newTestNode(t, nil)
+	defer closeTestNode(node, q)
+	mempool := q.Client()
+	mempool.Sub("mempool")
+	go func() {
+		for msg := range mempool.Recv() {
+			msg.Reply(mempool.NewMessage("p2p", types.EventReplyTxList, types.ErrTimeout))
+		}
+	}()
+
+	//mempool 查询失败时直接下载完整的区块
+	srv := newTestServer()
+	srv.blocks[10] = block
+	addr, stop := startTestServer(t, srv)
+	defer stop()
+	peer := newTestPeer(t, node, addr)
+	defer peer.mconn.Close()
+	peer.version.SetCaps(CapCompactBlock)
+	setTestPeer(node, peer, 10)
+	cblock := newCompactBlock(block, node.filter)
+	cblock.PrefilledTxs = cblock.PrefilledTxs[:1]
+	rebuilt, err := node.buildCompactBlock(cblock, "")
+	require.Nil(t, err)
+	assert.Equal(t, block.Hash(), rebuilt.Hash())
+	require.Equal(t, 1, len(srv.invs))
+	assert.Equal(t, int32(msgBlock), srv.invs[0].Ty)
+}
@@ -10,25 +10,68 @@ import (
 
 // time limit for timeout
 var (
-	UpdateState                 = 2 * time.Second
-	PingTimeout                 = 14 * time.Second
-	DefaultSendTimeout          = 10 * time.Second
-	DialTimeout                 = 5 * time.Second
-	mapUpdateInterval           = 45 * time.Hour
-	StreamPingTimeout           = 20 * time.Second
-	MonitorPeerInfoInterval     = 10 * time.Second
-	MonitorPeerNumInterval      = 30 * time.Second
-	MonitorReBalanceInterval    = 2 * time.Minute
-	GetAddrFromAddrBookInterval = 5 * time.Second
-	GetAddrFromOnlineInterval   = 5 * time.Second
-	GetAddrFromGitHubInterval   = 5 * time.Minute
-	CheckActivePeersInterVal    = 5 * time.Second
-	CheckBlackListInterVal      = 30 * time.Second
+	UpdateState                  = 2 * time.Second
+	PingTimeout                  = 14 * time.Second
+	DefaultSendTimeout           = 10 * time.Second
+	DialTimeout                  = 5 * time.Second
+	mapUpdateInterval            = 45 * time.Hour
+	StreamPingTimeout            = 20 * time.Second
+	MonitorPeerInfoInterval      = 10 * time.Second
+	MonitorPeerNumInterval       = 30 * time.Second
+	MonitorReBalanceInterval     = 2 * time.Minute
+	GetAddrFromAddrBookInterval  = 5 * time.Second
+	GetAddrFromOnlineInterval    = 5 * time.Second
+	GetAddrFromDiscoveryInterval = time.Minute
+	CheckActivePeersInterVal     = 5 * time.Second
+	CheckBlackListInterVal       = 30 * time.Second
+	CheckAllowlistInterval       = 10 * time.Second
+	CheckPeerScoreInterval       = time.Minute
+)
+
+//轻节点请求证明
+const (
+	//一次状态证明请求最多的 key 数量
+	maxStateProofKeys = 100
+	//请求证明时最多尝试的节点数
+	maxProofPeers = 3
+)
+
+//区块下载调度
+const (
+	//一次 GetHeaders 请求的区块头数量, 不能超过 P2pserver.GetHeaders 的限制
+	maxHeadersPerReq = 2000
+	//下载区块头时最多尝试的节点数
+	maxHeaderPeers = 3
+	minWindowSize  = 4
+	maxWindowSize  = 64
+	//每个节点同时下载的窗口数
+	maxPeerWindows = 2
+	//同一个窗口最多同时分配给几个节点
+	maxWindowPeers = 2
+	//缓冲区中等待交给 blockchain 的最多区块数
+	maxBufferBlocks = 1024
+	//窗口超过预计时间的倍数之后分配给另一个节点
+	slowWindowFactor = 3
+	//吞吐量的指数加权平均中新数据的权重
+	perfWeight = 0.3
+	//没有记录的节点默认每秒下载的区块数
+	defaultPeerThroughput = 8
+	minPeerThroughput     = 0.5
+)
+
+var (
+	//按照节点的吞吐量, 一个窗口期望的下载时间
+	windowTargetTime    = 2 * time.Second
+	defaultPeerLatency  = time.Second
+	minWindowTimeout    = 5 * time.Second
+	scheduleInterval    = 500 * time.Millisecond
+	downloadIdleTimeout = time.Minute
 )
 
 const (
 	msgTx           = 1
 	msgBlock        = 2
+	msgBlockTx      = 3
 	tryMapPortTimes = 20
 )
 
@@ -45,6 +88,8 @@ const (
 	maxAttemps      = 5
 	protocol        = "tcp"
 	externalPortTag = "externalport"
+	//maxBootstrapBackoff 没有连接任何节点时, 查询 dns 种子的最大间隔周期数
+	maxBootstrapBackoff = 12
 )
 
 const (
@@ -62,6 +107,7 @@ const (
 const (
 	addrkeyTag = "addrs"
 	privKeyTag = "privkey"
+	bansTag    = "bans"
 )
 
 // P2pCacheTxSize p2pcache size of transaction
@@ -0,0 +1,243 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"sort"
+	"sync"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/types"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//kademlia 路由表:
+//节点 ID 为节点公钥的 sha256, 在 Version2 中交换, 和公钥不一致的 ID 不加入路由表.
+//按照和自己 ID 的公共前缀长度分桶, 每个桶最多 dhtBucketSize 个节点, 桶满时只替换长时间没有连接过的节点.
+//查找时通过 FindNode 向已经连接的节点获取和目标 ID 距离最近的节点, 新发现的节点连接成功后再加入路由表,
+//下一轮查找就可以从这些节点继续查找
+
+const (
+	nodeIDLen     = 32
+	dhtBucketSize = 16
+	//每轮查找请求的节点数
+	dhtAlpha = 3
+	//超过这个时间没有连接过的节点可以被替换
+	dhtStaleSeconds = 3600
+)
+
+//nodeID 节点公钥对应的节点 ID, 公钥格式错误时返回 nil
+func nodeID(pubkey string) []byte {
+	key, err := hex.DecodeString(pubkey)
+	if err != nil || len(key) == 0 {
+		return nil
+	}
+	return common.Sha256(key)
+}
+
+//commonPrefixLen 两个节点 ID 相同的前缀位数
+func commonPrefixLen(a, b []byte) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		x := a[i] ^ b[i]
+		if x == 0 {
+			continue
+		}
+		n := i * 8
+		for x&0x80 == 0 {
+			n++
+			x <<= 1
+		}
+		return n
+	}
+	return nodeIDLen * 8
+}
+
+//xorDistance 节点 ID 之间的异或距离
+func xorDistance(a, b []byte) []byte {
+	dist := make([]byte, nodeIDLen)
+	for i := 0; i < nodeIDLen && i < len(a) && i < len(b); i++ {
+		dist[i] = a[i] ^ b[i]
+	}
+	return dist
+}
+
+type dhtNode struct {
+	id       []byte
+	addr     string
+	lastSeen int64
+}
+
+type routingTable struct {
+	mtx     sync.Mutex
+	self    []byte
+	buckets [nodeIDLen * 8][]*dhtNode
+}
+
+func newRoutingTable(self []byte) *routingTable {
+	return &routingTable{self: self}
+}
+
+//update 加入或者更新节点, 最近连接过的节点放在桶的最后
+func (rt *routingTable) update(id []byte, addr string) {
+	if len(id) != nodeIDLen {
+		return
+	}
+	index := commonPrefixLen(rt.self, id)
+	if index >= len(rt.buckets) {
+		return
+	}
+	rt.mtx.Lock()
+	defer rt.mtx.Unlock()
+	now := types.Now().Unix()
+	bucket := rt.buckets[index]
+	for i, node := range bucket {
+		if bytes.Equal(node.id, id) {
+			bucket = append(bucket[:i], bucket[i+1:]...)
+			node.addr = addr
+			node.lastSeen = now
+			rt.buckets[index] = append(bucket, node)
+			return
+		}
+	}
+	node := &dhtNode{id: id, addr: addr, lastSeen: now}
+	if len(bucket) < dhtBucketSize {
+		rt.buckets[index] = append(bucket, node)
+		return
+	}
+	if now-bucket[0].lastSeen > dhtStaleSeconds {
+		rt.buckets[index] = append(bucket[1:], node)
+	}
+}
+
+//closest 和 target 距离最近的 count 个节点
+func (rt *routingTable) closest(target []byte, count int, skip func(addr string) bool) []*types.P2PNode {
+	rt.mtx.Lock()
+	var nodes []*dhtNode
+	for _, bucket := range rt.buckets {
+		for _, node := range bucket {
+			if skip == nil || !skip(node.addr) {
+				nodes = append(nodes, node)
+			}
+		}
+	}
+	rt.mtx.Unlock()
+	sort.Slice(nodes, func(i, j int) bool {
+		return bytes.Compare(xorDistance(nodes[i].id, target), xorDistance(nodes[j].id, target)) < 0
+	})
+	if len(nodes) > count {
+		nodes = nodes[:count]
+	}
+	var result []*types.P2PNode
+	for _, node := range nodes {
+		result = append(result, &types.P2PNode{NodeID: node.id, Addr: node.addr})
+	}
+	return result
+}
+
+func (rt *routingTable) size() int {
+	rt.mtx.Lock()
+	defer rt.mtx.Unlock()
+	var size int
+	for _, bucket := range rt.buckets {
+		size += len(bucket)
+	}
+	return size
+}
+
+//addDHTNode 版本信息中的节点 ID 和公钥一致时加入路由表, 不支持节点 ID 的老节点不加入
+func (nf *NodeInfo) addDHTNode(pubkey string, id []byte, addr string) {
+	if len(id) == 0 || addr == "" || nf.blacklist.Has(addr) || nf.blacklist.Has(pubkey) {
+		return
+	}
+	if !bytes.Equal(nodeID(pubkey), id) {
+		log.Debug("addDHTNode", "node id mismatch", pubkey, "addr", addr)
+		return
+	}
+	nf.dht.update(id, addr)
+}
+
+//dhtSource 通过 kademlia 路由表发现节点
+type dhtSource struct {
+	node  *Node
+	round int
+}
+
+func (d *dhtSource) Name() string {
+	return "dht"
+}
+
+func (d *dhtSource) Bootstrap() bool {
+	return false
+}
+
+//FindAddrs 轮流查找自己的 ID 和随机的 ID, 查找自己的 ID 发现距离近的节点, 查找随机的 ID 发现不同区域的节点
+func (d *dhtSource) FindAddrs() ([]string, error) {
+	nodeInfo := d.node.nodeInfo
+	target := nodeInfo.dht.self
+	d.round++
+	if d.round%2 == 0 {
+		target = make([]byte, nodeIDLen)
+		if _, err := rand.Read(target); err != nil {
+			return nil, err
+		}
+	}
+	peers := d.node.GetRegisterPeers()
+	if len(peers) == 0 {
+		return nil, nil
+	}
+	peerNodes := make(map[string]bool)
+	for _, peer := range peers {
+		peerNodes[peer.Addr()] = true
+	}
+	//先请求路由表中和目标距离最近的已连接节点, 不够时用其他已连接的节点补足
+	var queries []*Peer
+	queried := make(map[string]bool)
+	for _, node := range nodeInfo.dht.closest(target, dhtAlpha, func(addr string) bool { return !peerNodes[addr] }) {
+		queried[node.GetAddr()] = true
+	}
+	for _, peer := range peers {
+		if queried[peer.Addr()] {
+			queries = append(queries, peer)
+		}
+	}
+	for _, peer := range peers {
+		if len(queries) >= dhtAlpha {
+			break
+		}
+		if !queried[peer.Addr()] {
+			queried[peer.Addr()] = true
+			queries = append(queries, peer)
+		}
+	}
+
+	seen := make(map[string]bool)
+	var addrs []string
+	for _, peer := range queries {
+		ctx, cancel := context.WithTimeout(context.Background(), DefaultSendTimeout)
+		resp, err := peer.mconn.gcli.FindNode(ctx, &types.P2PFindNode{Version: nodeInfo.cfg.Version, Target: target}, grpc.FailFast(true))
+		cancel()
+		if err != nil {
+			//老版本的节点不支持 FindNode
+			if status.Code(err) != codes.Unimplemented {
+				log.Debug("dhtSource", "FindNode", err, "peer", peer.Addr())
+			}
+			continue
+		}
+		for _, node := range resp.GetNodes() {
+			if len(node.GetNodeID()) != nodeIDLen || bytes.Equal(node.GetNodeID(), nodeInfo.dht.self) || seen[node.GetAddr()] {
+				continue
+			}
+			seen[node.GetAddr()] = true
+			addrs = append(addrs, node.GetAddr())
+		}
+	}
+	return addrs, nil
+}
@@ -0,0 +1,107 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+//testNodeID 和 self 的公共前缀为 prefix 位的节点 ID
+func testNodeID(self []byte, prefix int, n byte) []byte {
+	id := make([]byte, nodeIDLen)
+	copy(id, self)
+	id[prefix/8] ^= 0x80 >> uint(prefix%8)
+	id[nodeIDLen-1] ^= n
+	return id
+}
+
+func TestCommonPrefixLen(t *testing.T) {
+	a := make([]byte, nodeIDLen)
+	assert.Equal(t, nodeIDLen*8, commonPrefixLen(a, a))
+	for _, prefix := range []int{0, 1, 7, 8, 100, 255} {
+		assert.Equal(t, prefix, commonPrefixLen(a, testNodeID(a, prefix, 0)))
+	}
+	b := testNodeID(a, 3, 0)
+	dist := xorDistance(a, b)
+	assert.Equal(t, byte(0x10), dist[0])
+	assert.Equal(t, nodeIDLen, len(xorDistance(a, nil)))
+}
+
+func TestRoutingTable(t *testing.T) {
+	self := nodeID("02aabbcc")
+	require.Equal(t, nodeIDLen, len(self))
+	rt := newRoutingTable(self)
+	//长度不对的 ID 和自己不加入
+	rt.update([]byte("short"), "127.0.0.1:1")
+	rt.update(self, "127.0.0.1:1")
+	assert.Equal(t, 0, rt.size())
+
+	far := testNodeID(self, 0, 0)
+	near := testNodeID(self, 200, 0)
+	mid := testNodeID(self, 100, 0)
+	rt.update(far, "127.0.0.1:1")
+	rt.update(near, "127.0.0.1:2")
+	rt.update(mid, "127.0.0.1:3")
+	//更新地址
+	rt.update(far, "127.0.0.1:4")
+	assert.Equal(t, 3, rt.size())
+
+	nodes := rt.closest(self, 2, nil)
+	require.Equal(t, 2, len(nodes))
+	assert.Equal(t, near, nodes[0].NodeID)
+	assert.Equal(t, mid, nodes[1].NodeID)
+	nodes = rt.closest(far, 10, func(addr string) bool { return addr == "127.0.0.1:3" })
+	require.Equal(t, 2, len(nodes))
+	assert.Equal(t, "127.0.0.1:4", nodes[0].Addr)
+	assert.Equal(t, near, nodes[1].NodeID)
+
+	//桶满之后只替换长时间没有连接过的节点
+	for i := 0; i < dhtBucketSize; i++ {
+		rt.update(testNodeID(self, 50, byte(i+1)), fmt.Sprintf("127.0.0.2:%d", i))
+	}
+	bucket := rt.buckets[50]
+	require.Equal(t, dhtBucketSize, len(bucket))
+	extra := testNodeID(self, 50, 0xff)
+	rt.update(extra, "127.0.0.3:1")
+	assert.Equal(t, dhtBucketSize, len(rt.buckets[50]))
+	for _, node := range rt.buckets[50] {
+		assert.False(t, bytes.Equal(extra, node.id))
+	}
+	rt.buckets[50][0].lastSeen = types.Now().Unix() - dhtStaleSeconds - 1
+	stale := rt.buckets[50][0].id
+	rt.update(extra, "127.0.0.3:1")
+	assert.Equal(t, dhtBucketSize, len(rt.buckets[50]))
+	assert.Equal(t, extra, rt.buckets[50][dhtBucketSize-1].id)
+	for _, node := range rt.buckets[50] {
+		assert.False(t, bytes.Equal(stale, node.id))
+	}
+	//再次连接的节点移到桶的最后
+	first := rt.buckets[50][0].id
+	rt.update(first, "127.0.0.2:100")
+	assert.Equal(t, first, rt.buckets[50][dhtBucketSize-1].id)
+}
+
+func TestAddDHTNode(t *testing.T) {
+	node, q := newTestNode(t, nil)
+	defer closeTestNode(node, q)
+	nf := node.nodeInfo
+	_, pub := newTestKey(t)
+	_, other := newTestKey(t)
+	//节点 ID 和公钥不一致
+	nf.addDHTNode(pub, nodeID(other), "127.0.0.1:13802")
+	nf.addDHTNode(pub, nil, "127.0.0.1:13802")
+	assert.Equal(t, 0, nf.dht.size())
+	nf.blacklist.Add("127.0.0.1:13803", 60)
+	nf.addDHTNode(pub, nodeID(pub), "127.0.0.1:13803")
+	assert.Equal(t, 0, nf.dht.size())
+	nf.addDHTNode(pub, nodeID(pub), "127.0.0.1:13802")
+	assert.Equal(t, 1, nf.dht.size())
+}
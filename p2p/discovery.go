@@ -0,0 +1,179 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/33cn/chain33/types"
+	"golang.org/x/net/context"
+)
+
+//节点发现:
+//除了种子节点和 GetAddrList 之外, 通过 discoverySource 发现新的节点, 目前有 dns 种子和 kademlia 路由表两种来源.
+//dns 种子只在没有连接任何节点时查询, 连续查询的间隔逐次加倍, 最多 maxBootstrapBackoff 个周期, 路由表在连接的节点不够时查询
+
+type discoverySource interface {
+	Name() string
+	//Bootstrap 为 true 时只在没有连接任何节点时使用
+	Bootstrap() bool
+	FindAddrs() ([]string, error)
+}
+
+//dnsSource 通过 dns 种子发现节点, 查询的域名为 <title>.<dnsSeed>, 同一个 dns 种子可以为多条链提供节点.
+//TXT 记录为空格或者逗号分隔的 ip:port 或者 pubkey@ip:port, A/AAAA 记录使用本链的默认端口
+type dnsSource struct {
+	names []string
+	port  int
+}
+
+func newDNSSource(title string, seeds []string, port int) *dnsSource {
+	title = strings.Trim(title, ".")
+	var names []string
+	for _, seed := range seeds {
+		seed = strings.Trim(strings.TrimSpace(seed), ".")
+		if seed == "" {
+			continue
+		}
+		names = append(names, fmt.Sprintf("%s.%s", title, seed))
+	}
+	return &dnsSource{names: names, port: port}
+}
+
+func (d *dnsSource) Name() string {
+	return "dns"
+}
+
+func (d *dnsSource) Bootstrap() bool {
+	return true
+}
+
+func (d *dnsSource) FindAddrs() ([]string, error) {
+	var addrs []string
+	var lastErr error
+	seen := make(map[string]bool)
+	add := func(addr string) {
+		if seen[addr] {
+			return
+		}
+		if _, err := NewNetAddressString(addr); err != nil {
+			log.Debug("dnsSource", "invalid addr", addr, "err", err)
+			return
+		}
+		seen[addr] = true
+		addrs = append(addrs, addr)
+	}
+	for _, name := range d.names {
+		ctx, cancel := context.WithTimeout(context.Background(), DialTimeout)
+		records, txtErr := net.DefaultResolver.LookupTXT(ctx, name)
+		for _, record := range records {
+			for _, entry := range strings.FieldsFunc(record, func(r rune) bool { return r == ',' || r == ' ' || r == '\t' }) {
+				//兼容 pubkey@ip:port 格式
+				if index := strings.LastIndex(entry, "@"); index >= 0 {
+					entry = entry[index+1:]
+				}
+				add(entry)
+			}
+		}
+		ips, hostErr := net.DefaultResolver.LookupHost(ctx, name)
+		cancel()
+		for _, ip := range ips {
+			add(net.JoinHostPort(ip, fmt.Sprint(d.port)))
+		}
+		if txtErr != nil && hostErr != nil {
+			lastErr = txtErr
+		}
+	}
+	if len(addrs) == 0 {
+		return nil, lastErr
+	}
+	return addrs, nil
+}
+
+//discoverySources 根据配置创建节点发现的来源
+func (n *Node) discoverySources() []discoverySource {
+	var sources []discoverySource
+	cfg := n.nodeInfo.cfg
+	if len(cfg.DNSSeeds) > 0 {
+		sources = append(sources, newDNSSource(types.GetTitle(), cfg.DNSSeeds, n.nodeInfo.port))
+	}
+	if cfg.EnableDHT {
+		sources = append(sources, &dhtSource{node: n})
+	}
+	return sources
+}
+
+func (n *Node) monitorDiscovery() {
+	sources := n.discoverySources()
+	if len(sources) == 0 {
+		return
+	}
+	ticker := time.NewTicker(GetAddrFromDiscoveryInterval)
+	defer ticker.Stop()
+	backoff := new(bootstrapBackoff)
+	for {
+		<-ticker.C
+		if n.isClose() {
+			log.Info("monitorDiscovery", "loop", "done")
+			return
+		}
+		bootstrap := backoff.next(n.Size() == 0)
+		for _, source := range sources {
+			if (source.Bootstrap() && !bootstrap) || (!source.Bootstrap() && !n.needMore()) {
+				continue
+			}
+			addrs, err := source.FindAddrs()
+			if err != nil {
+				log.Error("monitorDiscovery", "source", source.Name(), "err", err)
+				continue
+			}
+			log.Debug("monitorDiscovery", "source", source.Name(), "addrs", len(addrs))
+			n.pubDiscoveredAddrs(addrs)
+		}
+	}
+}
+
+//bootstrapBackoff 没有连接任何节点时, 查询 bootstrap 来源的间隔从 1 个周期开始逐次加倍, 最多 maxBootstrapBackoff 个周期,
+//连接上节点之后重新开始
+type bootstrapBackoff struct {
+	wait  int
+	ticks int
+}
+
+func (b *bootstrapBackoff) next(noPeer bool) bool {
+	if !noPeer {
+		b.wait = 0
+		b.ticks = 0
+		return false
+	}
+	if b.ticks < b.wait {
+		b.ticks++
+		return false
+	}
+	b.ticks = 0
+	switch {
+	case b.wait == 0:
+		b.wait = 1
+	case b.wait*2 > maxBootstrapBackoff:
+		b.wait = maxBootstrapBackoff
+	default:
+		b.wait *= 2
+	}
+	return true
+}
+
+//pubDiscoveredAddrs 把发现的节点地址交给 monitorDialPeers 连接
+func (n *Node) pubDiscoveredAddrs(addrs []string) {
+	self := n.nodeInfo.GetExternalAddr().String()
+	for _, addr := range addrs {
+		if addr == self || n.Has(addr) || n.nodeInfo.blacklist.Has(addr) {
+			continue
+		}
+		n.pubsub.FIFOPub(addr, "addr")
+	}
+}
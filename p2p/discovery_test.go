@@ -0,0 +1,38 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiscoverySources(t *testing.T) {
+	node := &Node{nodeInfo: &NodeInfo{cfg: &types.P2P{DNSSeeds: []string{"seed.example.com"}, EnableDHT: true}}}
+	var names []string
+	for _, source := range node.discoverySources() {
+		names = append(names, source.Name())
+	}
+	assert.Equal(t, []string{"dns", "dht"}, names)
+	node.nodeInfo.cfg = &types.P2P{}
+	assert.Equal(t, 0, len(node.discoverySources()))
+}
+
+func TestBootstrapBackoff(t *testing.T) {
+	backoff := new(bootstrapBackoff)
+	var queried []int
+	for tick := 0; tick < 60; tick++ {
+		if backoff.next(true) {
+			queried = append(queried, tick)
+		}
+	}
+	assert.Equal(t, []int{0, 2, 5, 10, 19, 32, 45, 58}, queried)
+	//连接上节点之后不再查询, 断开之后重新开始
+	assert.False(t, backoff.next(false))
+	assert.True(t, backoff.next(true))
+	assert.False(t, backoff.next(true))
+}
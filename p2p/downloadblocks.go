@@ -4,201 +4,611 @@
 
 package p2p
 
+//区块下载分两步: 先从一个节点下载区块头, 验证区块头的 hash 以及和父区块的连接关系,
+//第一个区块头必须连接到本地的区块, 再用另一个节点的区块头核对, 只保留双方一致的部分.
+//再把区块分成若干窗口, 根据每个节点测量到的延迟和吞吐量分配给不同的节点并行下载.
+//收到的区块先验证交易和 txHash 一致, 再和区块头比较 hash, 先放在缓冲区, 再按高度顺序交给 blockchain.
+//超过预计时间的窗口同时分配给另一个节点, 先到的数据有效
+
 import (
-	"container/list"
-	"fmt"
+	"bytes"
+	"errors"
 	"io"
+	"sort"
 	"sync"
-	"sync/atomic"
+	"time"
 
+	"github.com/33cn/chain33/common/merkle"
 	pb "github.com/33cn/chain33/types"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-// DownloadJob defines download job type
-type DownloadJob struct {
-	wg            sync.WaitGroup
-	retryList     *list.List
-	p2pcli        *Cli
-	canceljob     int32
-	mtx           sync.Mutex
-	busyPeer      map[string]*peerJob
-	downloadPeers []*Peer
+var errDownloadIdle = errors.New("download idle timeout")
+
+//PeerPerf 节点下载区块的性能, 指数加权平均
+type PeerPerf struct {
+	//从发出请求到收到第一个区块的时间
+	Latency time.Duration
+	//每秒下载的区块数
+	Throughput float64
+	Failures   int64
 }
 
-type peerJob struct {
-	pbPeer *pb.Peer
-	limit  int32
+//DownloadPerfs 所有节点下载区块的性能, 多次下载之间保留
+type DownloadPerfs struct {
+	mtx   sync.Mutex
+	perfs map[string]*PeerPerf
 }
 
-// NewDownloadJob create a downloadjob object
-func NewDownloadJob(p2pcli *Cli, peers []*Peer) *DownloadJob {
-	job := new(DownloadJob)
-	job.retryList = list.New()
-	job.p2pcli = p2pcli
-	job.busyPeer = make(map[string]*peerJob)
-	job.downloadPeers = peers
-	return job
+//NewDownloadPerfs new download perfs
+func NewDownloadPerfs() *DownloadPerfs {
+	return &DownloadPerfs{perfs: make(map[string]*PeerPerf)}
+}
+
+//Get 节点的下载性能, 没有记录时返回默认值
+func (dp *DownloadPerfs) Get(addr string) PeerPerf {
+	dp.mtx.Lock()
+	defer dp.mtx.Unlock()
+	if perf, ok := dp.perfs[addr]; ok {
+		return *perf
+	}
+	return PeerPerf{Latency: defaultPeerLatency, Throughput: defaultPeerThroughput}
 }
 
-func (d *DownloadJob) isBusyPeer(pid string) bool {
-	d.mtx.Lock()
-	defer d.mtx.Unlock()
-	if pjob, ok := d.busyPeer[pid]; ok {
-		return atomic.LoadInt32(&pjob.limit) > 10 //每个节点最多同时接受10个下载任务
+func (dp *DownloadPerfs) perf(addr string) *PeerPerf {
+	perf, ok := dp.perfs[addr]
+	if !ok {
+		perf = &PeerPerf{Latency: defaultPeerLatency, Throughput: defaultPeerThroughput}
+		dp.perfs[addr] = perf
 	}
-	return false
+	return perf
 }
 
-func (d *DownloadJob) setBusyPeer(peer *pb.Peer) {
-	d.mtx.Lock()
-	defer d.mtx.Unlock()
-	if pjob, ok := d.busyPeer[peer.GetName()]; ok {
-		atomic.AddInt32(&pjob.limit, 1)
-		d.busyPeer[peer.GetName()] = pjob
+//Update 记录一次成功的下载
+func (dp *DownloadPerfs) Update(addr string, latency time.Duration, blocks int, elapsed time.Duration) {
+	if blocks == 0 || elapsed <= 0 {
 		return
 	}
+	dp.mtx.Lock()
+	defer dp.mtx.Unlock()
+	perf := dp.perf(addr)
+	perf.Latency = time.Duration(perfWeight*float64(latency) + (1-perfWeight)*float64(perf.Latency))
+	perf.Throughput = perfWeight*float64(blocks)/elapsed.Seconds() + (1-perfWeight)*perf.Throughput
+}
 
-	d.busyPeer[peer.GetName()] = &peerJob{peer, 1}
+//Fail 下载失败或者太慢, 吞吐量减半
+func (dp *DownloadPerfs) Fail(addr string) {
+	dp.mtx.Lock()
+	defer dp.mtx.Unlock()
+	perf := dp.perf(addr)
+	perf.Failures++
+	perf.Throughput /= 2
+	if perf.Throughput < minPeerThroughput {
+		perf.Throughput = minPeerThroughput
+	}
 }
 
-func (d *DownloadJob) setFreePeer(pid string) {
-	d.mtx.Lock()
-	defer d.mtx.Unlock()
+//Delete 节点断开之后删除记录
+func (dp *DownloadPerfs) Delete(addr string) {
+	dp.mtx.Lock()
+	defer dp.mtx.Unlock()
+	delete(dp.perfs, addr)
+}
 
-	if pjob, ok := d.busyPeer[pid]; ok {
-		if atomic.AddInt32(&pjob.limit, -1) <= 0 {
-			delete(d.busyPeer, pid)
-			return
+//windowSize 按照吞吐量, 窗口大约需要 windowTargetTime 下载完成
+func (perf PeerPerf) windowSize() int64 {
+	size := int64(perf.Throughput * windowTargetTime.Seconds())
+	if size < minWindowSize {
+		return minWindowSize
+	}
+	if size > maxWindowSize {
+		return maxWindowSize
+	}
+	return size
+}
+
+//estimate 预计下载 n 个区块需要的时间
+func (perf PeerPerf) estimate(n int64) time.Duration {
+	return perf.Latency + time.Duration(float64(n)/perf.Throughput*float64(time.Second))
+}
+
+//downloadWindow 分配给节点的一段连续高度
+type downloadWindow struct {
+	start, end int64
+	//正在下载这个窗口的节点
+	peers    map[string]bool
+	assigned time.Time
+	deadline time.Time
+}
+
+type downloadResult struct {
+	window *downloadWindow
+	peer   *Peer
+	block  *pb.Block
+	//为 true 时表示这个节点对窗口的下载已经结束
+	done bool
+}
+
+type blockDownloader struct {
+	cli     *Cli
+	perfs   *DownloadPerfs
+	peers   []*Peer
+	headers []*pb.Header
+	start   int64
+	end     int64
+	ctx     context.Context
+	results chan *downloadResult
+	//下一个交给 blockchain 的高度
+	next int64
+	//下一个还没有分配的高度
+	cursor  int64
+	retry   []*downloadWindow
+	active  []*downloadWindow
+	buffer  map[int64]*pb.BlockPid
+	busy    map[string]int
+	badPeer map[string]bool
+}
+
+func newBlockDownloader(cli *Cli, peers []*Peer, headers []*pb.Header) *blockDownloader {
+	start := headers[0].GetHeight()
+	return &blockDownloader{
+		cli:     cli,
+		perfs:   cli.network.node.nodeInfo.perfs,
+		peers:   peers,
+		headers: headers,
+		start:   start,
+		end:     start + int64(len(headers)) - 1,
+		results: make(chan *downloadResult, 256),
+		next:    start,
+		cursor:  start,
+		buffer:  make(map[int64]*pb.BlockPid),
+		busy:    make(map[string]int),
+		badPeer: make(map[string]bool),
+	}
+}
+
+//fetchHeaders 从高度最高的节点开始依次尝试下载 [start, end] 的区块头, 返回第一组连接到本地区块
+//并且和另一个节点核对一致的区块头
+func (m *Cli) fetchHeaders(peers []*Peer, start, end int64) []*pb.Header {
+	var parent []byte
+	if start > 0 {
+		hash, err := m.localBlockHash(start - 1)
+		if err != nil {
+			log.Error("fetchHeaders", "height", start-1, "err", err)
+			return nil
 		}
-		d.busyPeer[pid] = pjob
+		parent = hash
 	}
+	_, infos := m.network.node.GetActivePeers()
+	sort.Slice(peers, func(i, j int) bool {
+		return infos[peers[i].Addr()].GetHeader().GetHeight() > infos[peers[j].Addr()].GetHeader().GetHeight()
+	})
+	for i, peer := range peers {
+		if i >= maxHeaderPeers {
+			break
+		}
+		headers, err := m.fetchPeerHeaders(peer, start, end, parent)
+		if err != nil {
+			log.Error("fetchHeaders", "peer", peer.Addr(), "start", start, "end", end, "err", err)
+			continue
+		}
+		if len(headers) == 0 {
+			continue
+		}
+		headers = m.confirmHeaders(peer, peers, headers, parent)
+		if len(headers) > 0 {
+			return headers
+		}
+		log.Error("fetchHeaders", "peer", peer.Addr(), "start", start, "end", end, "err", "headers not confirmed")
+	}
+	return nil
 }
 
-// GetFreePeer get free peer ,return peer
-func (d *DownloadJob) GetFreePeer(joblimit int64) *Peer {
-	_, infos := d.p2pcli.network.node.GetActivePeers()
-	for _, peer := range d.downloadPeers {
-		pbpeer, ok := infos[peer.Addr()]
-		if ok {
-			if len(peer.GetPeerName()) == 0 {
-				peer.SetPeerName(pbpeer.GetName())
-			}
+//confirmHeaders 用另一个节点核对 source 的区块头, 先比较双方都有的最高的区块头, 不一致时下载对方的区块头,
+//返回双方一致的部分. 没有其他节点可以核对时返回全部区块头
+func (m *Cli) confirmHeaders(source *Peer, peers []*Peer, headers []*pb.Header, parent []byte) []*pb.Header {
+	_, infos := m.network.node.GetActivePeers()
+	start := headers[0].GetHeight()
+	for i, peer := range peers {
+		if i >= maxHeaderPeers {
+			break
+		}
+		info, ok := infos[peer.Addr()]
+		if peer == source || !ok || info.GetHeader().GetHeight() < start {
+			continue
+		}
+		height := info.GetHeader().GetHeight()
+		if tip := start + int64(len(headers)) - 1; height > tip {
+			height = tip
+		}
+		other, err := m.fetchPeerHeaders(peer, height, height, nil)
+		if err != nil || len(other) == 0 {
+			continue
+		}
+		if bytes.Equal(other[0].GetHash(), headers[height-start].GetHash()) {
+			return headers[:height-start+1]
+		}
+		other, err = m.fetchPeerHeaders(peer, start, height, parent)
+		if err != nil {
+			continue
+		}
+		n := 0
+		for n < len(other) && bytes.Equal(other[n].GetHash(), headers[n].GetHash()) {
+			n++
+		}
+		log.Info("confirmHeaders", "source", source.Addr(), "peer", peer.Addr(), "start", start, "agreed", n)
+		return headers[:n]
+	}
+	return headers
+}
 
-			if pbpeer.GetHeader().GetHeight() >= joblimit {
-				if d.isBusyPeer(pbpeer.GetName()) {
-					continue
+//localBlockHash 本地 height 高度的区块 hash
+func (m *Cli) localBlockHash(height int64) ([]byte, error) {
+	client := m.network.node.nodeInfo.client
+	msg := client.NewMessage("blockchain", pb.EventGetBlockHash, &pb.ReqInt{Height: height})
+	err := client.SendTimeout(msg, true, time.Second*10)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.WaitTimeout(msg, time.Second*30)
+	if err != nil {
+		return nil, err
+	}
+	if err := resp.Err(); err != nil {
+		return nil, err
+	}
+	return resp.GetData().(*pb.ReplyHash).GetHash(), nil
+}
+
+//fetchPeerHeaders 下载 peer 的区块头, parent 不为空时第一个区块头必须连接到 parent,
+//不连接时对方可能在另一个分叉上, 不降低对方的评分
+func (m *Cli) fetchPeerHeaders(peer *Peer, start, end int64, parent []byte) ([]*pb.Header, error) {
+	var headers []*pb.Header
+	for height := start; height <= end; height += maxHeadersPerReq {
+		last := height + maxHeadersPerReq - 1
+		if last > end {
+			last = end
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), DefaultSendTimeout)
+		resp, err := peer.mconn.gcli.GetHeaders(ctx, &pb.P2PGetHeaders{StartHeight: height, EndHeight: last,
+			Version: m.network.node.nodeInfo.cfg.Version}, grpc.FailFast(true))
+		cancel()
+		P2pComm.CollectPeerStat(err, peer)
+		if err != nil {
+			return nil, err
+		}
+		if len(resp.GetHeaders()) == 0 {
+			break
+		}
+		for _, header := range resp.GetHeaders() {
+			if err := checkHeader(header, start+int64(len(headers)), parent); err != nil {
+				if err != pb.ErrParentHash || len(headers) > 0 {
+					peer.node.reportPeer(peer.nodeKey(), pb.PeerInvalidBlock, err.Error())
 				}
-				d.setBusyPeer(pbpeer)
-				return peer
+				return nil, err
 			}
+			headers = append(headers, header)
+			parent = header.GetHash()
+		}
+		//对方节点没有更多的区块
+		if int64(len(resp.GetHeaders())) < last-height+1 {
+			break
 		}
 	}
+	return headers, nil
+}
 
+//checkHeader 验证区块头的高度, hash 以及和父区块的连接关系, parent 为空时不检查连接关系
+func checkHeader(header *pb.Header, height int64, parent []byte) error {
+	if header.GetHeight() != height {
+		return pb.ErrBlockHeightNoMatch
+	}
+	if !bytes.Equal(header.CalcHash(), header.GetHash()) {
+		return pb.ErrBlockHashNoMatch
+	}
+	if parent != nil && !bytes.Equal(header.GetParentHash(), parent) {
+		return pb.ErrParentHash
+	}
 	return nil
 }
 
-// CancelJob cancel the downloadjob object
-func (d *DownloadJob) CancelJob() {
-	atomic.StoreInt32(&d.canceljob, 1)
+//Run 下载所有区块头对应的区块, 按高度顺序发送给 blockchain
+func (d *blockDownloader) Run() error {
+	var cancel context.CancelFunc
+	d.ctx, cancel = context.WithCancel(context.Background())
+	//结束时取消还在进行的下载
+	defer cancel()
+	ticker := time.NewTicker(scheduleInterval)
+	defer ticker.Stop()
+	idle := time.NewTimer(downloadIdleTimeout)
+	defer idle.Stop()
+	for d.next <= d.end {
+		d.schedule()
+		select {
+		case res := <-d.results:
+			if d.handle(res) {
+				if !idle.Stop() {
+					<-idle.C
+				}
+				idle.Reset(downloadIdleTimeout)
+			}
+		case <-ticker.C:
+		case <-idle.C:
+			return errDownloadIdle
+		}
+	}
+	return nil
 }
 
-func (d *DownloadJob) isCancel() bool {
-	return atomic.LoadInt32(&d.canceljob) == 1
+func (d *blockDownloader) have(height int64) bool {
+	return height < d.next || d.buffer[height] != nil
 }
 
-// DownloadBlock download the block
-func (d *DownloadJob) DownloadBlock(invs []*pb.Inventory,
-	bchan chan *pb.BlockPid) []*pb.Inventory {
-	var errinvs []*pb.Inventory
-	if d.isCancel() {
-		return errinvs
+func (d *blockDownloader) complete(w *downloadWindow) bool {
+	for height := w.start; height <= w.end; height++ {
+		if !d.have(height) {
+			return false
+		}
 	}
+	return true
+}
 
-	for _, inv := range invs { //让一个节点一次下载一个区块，下载失败区块，交给下一轮下载
-		freePeer := d.GetFreePeer(inv.GetHeight())
-		if freePeer == nil {
-			//log.Error("DownloadBlock", "freepeer is null", inv.GetHeight())
-			d.retryList.PushBack(inv)
-			continue
+//handle 处理下载的结果, 有区块交给 blockchain 时返回 true
+func (d *blockDownloader) handle(res *downloadResult) bool {
+	addr := res.peer.Addr()
+	if res.done {
+		d.busy[addr]--
+		delete(res.window.peers, addr)
+		if len(res.window.peers) == 0 && !d.complete(res.window) {
+			d.removeActive(res.window)
+			d.retry = append(d.retry, res.window)
 		}
+		return false
+	}
+	if d.badPeer[addr] {
+		return false
+	}
+	block := res.block
+	height := block.GetHeight()
+	if height < d.start || height > d.end || d.have(height) {
+		return false
+	}
+	//交易和 txHash 不一致时一定是发送区块的节点出错
+	if !bytes.Equal(block.GetTxHash(), merkle.CalcMerkleRoot(block.GetTxs())) {
+		log.Error("blockDownloader", "peer", addr, "height", height, "err", pb.ErrCheckTxHash)
+		d.badPeer[addr] = true
+		res.peer.node.reportPeer(res.peer.nodeKey(), pb.PeerInvalidBlock, pb.ErrCheckTxHash.Error())
+		return false
+	}
+	//完整的区块和区块头不一致时可能来自另一个分叉, 不能确定是哪个节点出错, 只是不再从这个节点下载
+	if !bytes.Equal(block.Hash(), d.headers[height-d.start].GetHash()) {
+		log.Error("blockDownloader", "peer", addr, "height", height, "err", pb.ErrBlockHashNoMatch)
+		d.badPeer[addr] = true
+		return false
+	}
+	res.peer.node.reportPeer(res.peer.nodeKey(), pb.PeerUsefulData, "")
+	d.buffer[height] = &pb.BlockPid{Pid: res.peer.GetPeerName(), Block: block}
+	delivered := false
+	client := d.cli.network.node.nodeInfo.client
+	for blockpid, ok := d.buffer[d.next]; ok; blockpid, ok = d.buffer[d.next] {
+		delete(d.buffer, d.next)
+		d.next++
+		newmsg := client.NewMessage("blockchain", pb.EventSyncBlock, blockpid)
+		client.SendTimeout(newmsg, false, 60*time.Second)
+		delivered = true
+	}
+	if delivered {
+		d.pruneActive()
+	}
+	return delivered
+}
 
-		d.wg.Add(1)
-		go func(peer *Peer, inv *pb.Inventory) {
-			defer d.wg.Done()
-			err := d.syncDownloadBlock(peer, inv, bchan)
-			if err != nil {
-				d.retryList.PushBack(inv) //失败的下载，放在下一轮ReDownload进行下载
-			} else {
-				d.setFreePeer(peer.GetPeerName())
-			}
-
-		}(freePeer, inv)
-
+func (d *blockDownloader) removeActive(w *downloadWindow) {
+	for i, active := range d.active {
+		if active == w {
+			d.active = append(d.active[:i], d.active[i+1:]...)
+			return
+		}
 	}
+}
 
-	return d.restOfInvs(bchan)
+//pruneActive 已经完成的窗口不再检查超时, 对应节点的下载结束之后释放
+func (d *blockDownloader) pruneActive() {
+	active := d.active[:0]
+	for _, w := range d.active {
+		if !d.complete(w) {
+			active = append(active, w)
+		}
+	}
+	d.active = active
 }
 
-func (d *DownloadJob) restOfInvs(bchan chan *pb.BlockPid) []*pb.Inventory {
-	var errinvs []*pb.Inventory
-	if d.isCancel() {
-		return errinvs
+//freePeers 可以分配新窗口的节点, 以及节点的高度
+func (d *blockDownloader) freePeers() ([]*Peer, map[string]int64) {
+	_, infos := d.cli.network.node.GetActivePeers()
+	var peers []*Peer
+	heights := make(map[string]int64)
+	for _, peer := range d.peers {
+		info, ok := infos[peer.Addr()]
+		if !ok || d.badPeer[peer.Addr()] || d.busy[peer.Addr()] >= maxPeerWindows {
+			continue
+		}
+		if len(peer.GetPeerName()) == 0 {
+			peer.SetPeerName(info.GetName())
+		}
+		peers = append(peers, peer)
+		heights[peer.Addr()] = info.GetHeader().GetHeight()
 	}
+	return peers, heights
+}
 
-	d.wg.Wait()
-	if d.retryList.Len() == 0 {
-		return errinvs
+//bestPeer 预计最早下载完成 [start, end] 的节点, 已经在下载的窗口按顺序排队计算
+func (d *blockDownloader) bestPeer(peers []*Peer, heights map[string]int64, start, end int64, exclude map[string]bool) *Peer {
+	var best *Peer
+	var bestTime time.Duration
+	for _, peer := range peers {
+		addr := peer.Addr()
+		if exclude[addr] || d.busy[addr] >= maxPeerWindows || heights[addr] < end {
+			continue
+		}
+		perf := d.perfs.Get(addr)
+		size := end - start + 1
+		if size > perf.windowSize() {
+			size = perf.windowSize()
+		}
+		t := perf.estimate(int64(d.busy[addr]+1) * size)
+		if best == nil || t < bestTime {
+			best, bestTime = peer, t
+		}
 	}
+	return best
+}
 
-	var invs []*pb.Inventory
-	for e := d.retryList.Front(); e != nil; {
-		if e.Value == nil {
+//schedule 分配需要重新下载的窗口和新的窗口, 再把超时的窗口同时分配给另一个节点
+func (d *blockDownloader) schedule() {
+	peers, heights := d.freePeers()
+	if len(peers) == 0 {
+		return
+	}
+	sort.Slice(d.retry, func(i, j int) bool { return d.retry[i].start < d.retry[j].start })
+	retry := d.retry[:0]
+	for _, w := range d.retry {
+		if d.complete(w) {
 			continue
 		}
-		log.Debug("restofInvs", "inv", e.Value.(*pb.Inventory).GetHeight())
-		invs = append(invs, e.Value.(*pb.Inventory)) //把下载遗漏的区块，重新组合进行下载
-		next := e.Next()
-		d.retryList.Remove(e)
-		e = next
+		peer := d.bestPeer(peers, heights, w.start, w.end, nil)
+		if peer == nil {
+			retry = append(retry, w)
+			continue
+		}
+		d.active = append(d.active, w)
+		d.assign(peer, w)
+	}
+	d.retry = retry
+	//缓冲区中的区块数有上限, 避免下载太多之后的区块而占用内存
+	for d.cursor <= d.end && d.cursor < d.next+maxBufferBlocks {
+		peer := d.bestPeer(peers, heights, d.cursor, d.cursor, nil)
+		if peer == nil {
+			break
+		}
+		end := d.cursor + d.perfs.Get(peer.Addr()).windowSize() - 1
+		if end > d.end {
+			end = d.end
+		}
+		if end > heights[peer.Addr()] {
+			end = heights[peer.Addr()]
+		}
+		w := &downloadWindow{start: d.cursor, end: end, peers: make(map[string]bool)}
+		d.cursor = end + 1
+		d.active = append(d.active, w)
+		d.assign(peer, w)
 	}
+	now := time.Now()
+	for _, w := range d.active {
+		if len(w.peers) >= maxWindowPeers || d.complete(w) {
+			continue
+		}
+		peer := d.bestPeer(peers, heights, w.start, w.end, w.peers)
+		if peer == nil {
+			continue
+		}
+		//阻塞交付的窗口按照另一个节点的预计时间判断是否太慢
+		if now.Before(w.deadline) && (w.start > d.next ||
+			now.Sub(w.assigned) < slowWindowFactor*d.perfs.Get(peer.Addr()).estimate(w.end-w.start+1)) {
+			continue
+		}
+		for addr := range w.peers {
+			log.Debug("blockDownloader slow window", "peer", addr, "start", w.start, "end", w.end)
+			d.perfs.Fail(addr)
+		}
+		d.assign(peer, w)
+	}
+}
 
-	return invs
+func (d *blockDownloader) assign(peer *Peer, w *downloadWindow) {
+	addr := peer.Addr()
+	var invs []*pb.Inventory
+	for height := w.start; height <= w.end; height++ {
+		if !d.have(height) {
+			invs = append(invs, &pb.Inventory{Ty: msgBlock, Height: height, Hash: d.headers[height-d.start].GetHash()})
+		}
+	}
+	timeout := slowWindowFactor * d.perfs.Get(addr).estimate(int64(len(invs)))
+	if timeout < minWindowTimeout {
+		timeout = minWindowTimeout
+	}
+	w.assigned = time.Now()
+	w.deadline = w.assigned.Add(timeout)
+	w.peers[addr] = true
+	d.busy[addr]++
+	log.Debug("blockDownloader assign", "peer", addr, "start", w.start, "end", w.end, "timeout", timeout)
+	go d.download(peer, w, invs)
 }
 
-func (d *DownloadJob) syncDownloadBlock(peer *Peer, inv *pb.Inventory, bchan chan *pb.BlockPid) error {
-	//每次下载一个高度的数据，通过bchan返回上层
-	if peer == nil {
-		return fmt.Errorf("peer is not exist")
+func (d *blockDownloader) send(res *downloadResult) bool {
+	select {
+	case d.results <- res:
+		return true
+	case <-d.ctx.Done():
+		return false
 	}
+}
 
+//download 从一个节点下载一个窗口的区块, 并记录节点的延迟和吞吐量
+func (d *blockDownloader) download(peer *Peer, w *downloadWindow, invs []*pb.Inventory) {
+	defer d.send(&downloadResult{window: w, peer: peer, done: true})
 	if !peer.GetRunning() {
-		return fmt.Errorf("peer not running")
+		return
+	}
+	begin := time.Now()
+	var latency time.Duration
+	var count int
+	err := d.downloadInvs(peer, w, invs, func() {
+		if count == 0 {
+			latency = time.Since(begin)
+		}
+		count++
+	})
+	if d.ctx.Err() != nil {
+		return
+	}
+	if err != nil || count < len(invs) {
+		log.Error("download", "peer", peer.Addr(), "start", w.start, "end", w.end, "count", count, "err", err)
+		d.perfs.Fail(peer.Addr())
+		return
+	}
+	d.perfs.Update(peer.Addr(), latency, count, time.Since(begin))
+}
+
+func (d *blockDownloader) downloadInvs(peer *Peer, w *downloadWindow, invs []*pb.Inventory, recv func()) error {
+	p2pdata := &pb.P2PGetData{Version: d.cli.network.node.nodeInfo.cfg.Version, Invs: invs}
+	resp, err := peer.mconn.gcli.GetData(d.ctx, p2pdata, grpc.FailFast(true))
+	if status.Code(err) != codes.Canceled {
+		P2pComm.CollectPeerStat(err, peer)
 	}
-	var p2pdata pb.P2PGetData
-	p2pdata.Version = d.p2pcli.network.node.nodeInfo.cfg.Version
-	p2pdata.Invs = []*pb.Inventory{inv}
-	resp, err := peer.mconn.gcli.GetData(context.Background(), &p2pdata, grpc.FailFast(true))
-	P2pComm.CollectPeerStat(err, peer)
 	if err != nil {
-		log.Error("syncDownloadBlock", "GetData err", err.Error())
 		return err
 	}
 	defer resp.CloseSend()
 	for {
 		invdatas, err := resp.Recv()
+		if err == io.EOF {
+			return nil
+		}
 		if err != nil {
-			if err == io.EOF {
-				log.Debug("download", "from", peer.Addr(), "block", inv.GetHeight())
-				return nil
-			}
-			log.Error("download", "resp,Recv err", err.Error(), "download from", peer.Addr())
 			return err
 		}
 		for _, item := range invdatas.Items {
-			bchan <- &pb.BlockPid{Pid: peer.GetPeerName(), Block: item.GetBlock()} //下载完成后插入bchan
-
+			if item.GetBlock() == nil {
+				continue
+			}
+			recv()
+			if !d.send(&downloadResult{window: w, peer: peer, block: item.GetBlock()}) {
+				return d.ctx.Err()
+			}
 		}
 	}
 }
@@ -0,0 +1,288 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+//newTestChain 生成 [1, n] 高度的区块
+func newTestChain(n int64) []*types.Block {
+	var blocks []*types.Block
+	parent := make([]byte, 32)
+	for height := int64(1); height <= n; height++ {
+		block := newTestBlock(height, parent, nil)
+		blocks = append(blocks, block)
+		parent = block.Hash()
+	}
+	return blocks
+}
+
+func testHeaders(blocks []*types.Block) []*types.Header {
+	var headers []*types.Header
+	for _, block := range blocks {
+		header := block.GetHeader()
+		header.Hash = block.Hash()
+		headers = append(headers, header)
+	}
+	return headers
+}
+
+//serveTestBlockchain 记录 p2p 发送给 blockchain 的区块, local 是本地的区块, 高度 0 的区块 hash 为全 0
+func serveTestBlockchain(q queue.Queue, local []*types.Block) chan *types.Block {
+	blocks := make(chan *types.Block, 1024)
+	client := q.Client()
+	client.Sub("blockchain")
+	go func() {
+		for msg := range client.Recv() {
+			switch msg.Ty {
+			case types.EventSyncBlock:
+				blocks <- msg.GetData().(*types.BlockPid).Block
+			case types.EventGetBlockHash:
+				height := msg.GetData().(*types.ReqInt).GetHeight()
+				if height == 0 {
+					msg.Reply(client.NewMessage("", types.EventBlockHash, &types.ReplyHash{Hash: make([]byte, 32)}))
+				} else if height <= int64(len(local)) {
+					msg.Reply(client.NewMessage("", types.EventBlockHash, &types.ReplyHash{Hash: local[height-1].Hash()}))
+				} else {
+					msg.Reply(client.NewMessage("", types.EventBlockHash, types.ErrHeightNotExist))
+				}
+			}
+		}
+	}()
+	return blocks
+}
+
+func TestCheckHeader(t *testing.T) {
+	headers := testHeaders(newTestChain(3))
+	assert.Nil(t, checkHeader(headers[0], 1, nil))
+	assert.Nil(t, checkHeader(headers[0], 1, make([]byte, 32)))
+	assert.Nil(t, checkHeader(headers[1], 2, headers[0].Hash))
+	assert.Equal(t, types.ErrBlockHeightNoMatch, checkHeader(headers[1], 1, nil))
+	assert.Equal(t, types.ErrParentHash, checkHeader(headers[2], 3, headers[0].Hash))
+	bad := *headers[1]
+	bad.BlockTime++
+	assert.Equal(t, types.ErrBlockHashNoMatch, checkHeader(&bad, 2, headers[0].Hash))
+}
+
+func TestDownloadPerfs(t *testing.T) {
+	dp := NewDownloadPerfs()
+	perf := dp.Get("a")
+	assert.Equal(t, defaultPeerLatency, perf.Latency)
+	assert.Equal(t, float64(defaultPeerThroughput), perf.Throughput)
+	assert.Equal(t, int64(defaultPeerThroughput*2), perf.windowSize())
+	assert.Equal(t, 2*time.Second, perf.estimate(8))
+
+	dp.Update("a", 0, 0, time.Second)
+	assert.Equal(t, 0, len(dp.perfs))
+	dp.Update("a", 100*time.Millisecond, 100, time.Second)
+	perf = dp.Get("a")
+	assert.Equal(t, 0.3*100+0.7*defaultPeerThroughput, perf.Throughput)
+	assert.Equal(t, time.Duration(0.3*float64(100*time.Millisecond)+0.7*float64(defaultPeerLatency)), perf.Latency)
+	assert.Equal(t, int64(maxWindowSize), perf.windowSize())
+
+	for i := 0; i < 10; i++ {
+		dp.Fail("b")
+	}
+	perf = dp.Get("b")
+	assert.Equal(t, int64(10), perf.Failures)
+	assert.Equal(t, minPeerThroughput, perf.Throughput)
+	assert.Equal(t, int64(minWindowSize), perf.windowSize())
+	dp.Delete("b")
+	assert.Equal(t, int64(0), dp.Get("b").Failures)
+}
+
+func TestFetchHeaders(t *testing.T) {
+	blocks := newTestChain(10)
+	headers := testHeaders(blocks)
+	good := newTestServer()
+	good.headers = headers
+	bad := newTestServer()
+	bad.headers = testHeaders(blocks)
+	bad.headers[5].BlockTime++
+	goodAddr, stopGood := startTestServer(t, good)
+	defer stopGood()
+	badAddr, stopBad := startTestServer(t, bad)
+	defer stopBad()
+
+	node, q := newTestNode(t, nil)
+	defer closeTestNode(node, q)
+	serveTestBlockchain(q, blocks)
+	cli := &Cli{network: &P2p{node: node}}
+	goodPeer := newTestPeer(t, node, goodAddr)
+	goodPeer.SetPeerName("good")
+	badPeer := newTestPeer(t, node, badAddr)
+	badPeer.SetPeerName("bad")
+	//先尝试高度最高的节点
+	setTestPeer(node, goodPeer, 10)
+	setTestPeer(node, badPeer, 11)
+
+	fetched := cli.fetchHeaders([]*Peer{goodPeer, badPeer}, 1, 10)
+	require.Equal(t, len(headers), len(fetched))
+	for i := range headers {
+		assert.Equal(t, headers[i].Hash, fetched[i].Hash)
+	}
+	scores := node.nodeInfo.scores.GetScores()
+	require.Equal(t, 1, len(scores))
+	assert.Equal(t, "bad", scores[0].Pid)
+	assert.Equal(t, int64(1), scores[0].InvalidBlocks)
+
+	//对方没有更多的区块
+	assert.Equal(t, 10, len(cli.fetchHeaders([]*Peer{goodPeer}, 1, 20)))
+	assert.Equal(t, 0, len(cli.fetchHeaders([]*Peer{goodPeer}, 11, 20)))
+
+	//第一个区块头不连接本地区块的节点可能在另一个分叉上, 不降低评分
+	other := newTestServer()
+	other.headers = testHeaders([]*types.Block{newTestBlock(1, []byte("other"), nil)})
+	otherAddr, stopOther := startTestServer(t, other)
+	defer stopOther()
+	otherPeer := newTestPeer(t, node, otherAddr)
+	otherPeer.SetPeerName("other")
+	setTestPeer(node, otherPeer, 1)
+	assert.Equal(t, 0, len(cli.fetchHeaders([]*Peer{otherPeer}, 1, 1)))
+	assert.Equal(t, 1, len(node.nodeInfo.scores.GetScores()))
+
+	//和另一个节点核对, 只保留一致的部分
+	split := newTestServer()
+	split.headers = testHeaders(blocks[:5])
+	parent := blocks[4].Hash()
+	for height := int64(6); height <= 12; height++ {
+		block := newTestBlock(height, parent, newTestTxs(1))
+		split.headers = append(split.headers, testHeaders([]*types.Block{block})...)
+		parent = block.Hash()
+	}
+	splitAddr, stopSplit := startTestServer(t, split)
+	defer stopSplit()
+	splitPeer := newTestPeer(t, node, splitAddr)
+	splitPeer.SetPeerName("split")
+	setTestPeer(node, splitPeer, 12)
+	fetched = cli.fetchHeaders([]*Peer{goodPeer, splitPeer}, 1, 10)
+	require.Equal(t, 5, len(fetched))
+	assert.Equal(t, headers[4].Hash, fetched[4].Hash)
+	//一致的时候只比较最高的区块头
+	assert.Equal(t, 5, len(cli.fetchHeaders([]*Peer{goodPeer, splitPeer}, 1, 5)))
+}
+
+func TestBlockDownloader(t *testing.T) {
+	blocks := newTestChain(40)
+	good := newTestServer()
+	bad := newTestServer()
+	for _, block := range blocks {
+		good.blocks[block.Height] = block
+		bad.blocks[block.Height] = block
+	}
+	//错误的节点返回交易和 txHash 不一致的区块
+	tampered := *blocks[2]
+	tampered.Txs = newTestTxs(1)
+	bad.blocks[3] = &tampered
+	goodAddr, stopGood := startTestServer(t, good)
+	defer stopGood()
+	badAddr, stopBad := startTestServer(t, bad)
+	defer stopBad()
+
+	node, q := newTestNode(t, nil)
+	defer closeTestNode(node, q)
+	recv := serveTestBlockchain(q, nil)
+	cli := &Cli{network: &P2p{node: node}}
+	goodPeer := newTestPeer(t, node, goodAddr)
+	goodPeer.SetPeerName("good")
+	badPeer := newTestPeer(t, node, badAddr)
+	badPeer.SetPeerName("bad")
+	setTestPeer(node, goodPeer, 40)
+	setTestPeer(node, badPeer, 40)
+	//错误的节点先分配窗口
+	node.nodeInfo.perfs.Update(badAddr, time.Millisecond, 1000, time.Second)
+
+	d := newBlockDownloader(cli, []*Peer{badPeer, goodPeer}, testHeaders(blocks))
+	require.Nil(t, d.Run())
+	for _, block := range blocks {
+		select {
+		case b := <-recv:
+			assert.Equal(t, block.Hash(), b.Hash())
+		case <-time.After(time.Second * 5):
+			t.Fatal("wait block timeout", block.Height)
+		}
+	}
+	assert.True(t, d.badPeer[badAddr])
+	assert.False(t, d.badPeer[goodAddr])
+	scores := node.nodeInfo.scores.GetScores()
+	require.Equal(t, 2, len(scores))
+	assert.Equal(t, "bad", scores[0].Pid)
+	assert.True(t, scores[0].InvalidBlocks > 0)
+	assert.Equal(t, "good", scores[1].Pid)
+}
+
+func TestBlockDownloaderHandle(t *testing.T) {
+	blocks := newTestChain(2)
+	node, q := newTestNode(t, nil)
+	defer closeTestNode(node, q)
+	recv := serveTestBlockchain(q, nil)
+	cli := &Cli{network: &P2p{node: node}}
+	var peers []*Peer
+	for i, name := range []string{"tampered", "fork", "good"} {
+		peer := newTestPeer(t, node, fmt.Sprintf("127.0.0.1:%d", 1001+i))
+		peer.SetPeerName(name)
+		setTestPeer(node, peer, 2)
+		peers = append(peers, peer)
+	}
+	d := newBlockDownloader(cli, peers, testHeaders(blocks))
+	w := &downloadWindow{start: 1, end: 2, peers: make(map[string]bool)}
+
+	//交易和 txHash 不一致, 发送区块的节点降低评分
+	tampered := *blocks[0]
+	tampered.Txs = newTestTxs(1)
+	assert.False(t, d.handle(&downloadResult{window: w, peer: peers[0], block: &tampered}))
+	assert.True(t, d.badPeer[peers[0].Addr()])
+	//另一个分叉的完整区块, 不再从这个节点下载, 但是不降低评分
+	fork := newTestBlock(1, make([]byte, 32), newTestTxs(1))
+	assert.False(t, d.handle(&downloadResult{window: w, peer: peers[1], block: fork}))
+	assert.True(t, d.badPeer[peers[1].Addr()])
+	scores := node.nodeInfo.scores.GetScores()
+	require.Equal(t, 1, len(scores))
+	assert.Equal(t, "tampered", scores[0].Pid)
+
+	assert.True(t, d.handle(&downloadResult{window: w, peer: peers[2], block: blocks[0]}))
+	assert.Equal(t, blocks[0].Hash(), (<-recv).Hash())
+	assert.False(t, d.badPeer[peers[2].Addr()])
+}
+
+func TestBestPeer(t *testing.T) {
+	node, q := newTestNode(t, nil)
+	defer closeTestNode(node, q)
+	cli := &Cli{network: &P2p{node: node}}
+	fast := newTestPeer(t, node, "127.0.0.1:1001")
+	slow := newTestPeer(t, node, "127.0.0.1:1002")
+	low := newTestPeer(t, node, "127.0.0.1:1003")
+	setTestPeer(node, fast, 100)
+	setTestPeer(node, slow, 100)
+	setTestPeer(node, low, 10)
+	node.nodeInfo.perfs.Update(fast.Addr(), time.Millisecond, 1000, time.Second)
+	node.nodeInfo.perfs.Fail(slow.Addr())
+
+	d := newBlockDownloader(cli, []*Peer{fast, slow, low}, testHeaders(newTestChain(100)))
+	peers, heights := d.freePeers()
+	assert.Equal(t, 3, len(peers))
+	assert.Equal(t, int64(10), heights[low.Addr()])
+	assert.Equal(t, fast, d.bestPeer(peers, heights, 1, 50, nil))
+	assert.Equal(t, slow, d.bestPeer(peers, heights, 1, 50, map[string]bool{fast.Addr(): true}))
+	//高度不够的节点不分配
+	assert.Nil(t, d.bestPeer(peers, heights, 1, 50, map[string]bool{fast.Addr(): true, slow.Addr(): true}))
+	assert.Equal(t, low, d.bestPeer([]*Peer{low}, heights, 1, 10, nil))
+	//同时下载的窗口数有上限
+	d.busy[fast.Addr()] = maxPeerWindows
+	assert.Equal(t, slow, d.bestPeer(peers, heights, 1, 50, nil))
+	peers, _ = d.freePeers()
+	assert.Equal(t, 2, len(peers))
+	d.badPeer[slow.Addr()] = true
+	peers, _ = d.freePeers()
+	assert.Equal(t, 1, len(peers))
+}
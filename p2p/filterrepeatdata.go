@@ -13,9 +13,6 @@ import (
 	lru "github.com/hashicorp/golang-lru"
 )
 
-// Filter  a Filter object
-var Filter = NewFilter()
-
 // NewFilter produce a filter object
 func NewFilter() *Filterdata {
 	filter := new(Filterdata)
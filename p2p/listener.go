@@ -10,8 +10,10 @@ import (
 	"time"
 
 	pb "github.com/33cn/chain33/types"
+	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/stats"
 )
 
 // Listener the actions
@@ -46,8 +48,8 @@ type listener struct {
 
 // NewListener produce a listener object
 func NewListener(protocol string, node *Node) Listener {
-	log.Debug("NewListener", "localPort", defaultPort)
-	l, err := net.Listen(protocol, fmt.Sprintf(":%v", defaultPort))
+	log.Debug("NewListener", "localPort", node.nodeInfo.port)
+	l, err := net.Listen(protocol, fmt.Sprintf(":%v", node.nodeInfo.port))
 	if err != nil {
 		log.Crit("Failed to listen", "Error", err.Error())
 		return nil
@@ -56,7 +58,7 @@ func NewListener(protocol string, node *Node) Listener {
 	dl := &listener{
 		nodeInfo:    node.nodeInfo,
 		node:        node,
-		netlistener: l,
+		netlistener: node.nodeInfo.bandwidth.listener(l),
 	}
 
 	pServer := NewP2pServer()
@@ -72,8 +74,44 @@ func NewListener(protocol string, node *Node) Listener {
 	maxStreams := grpc.MaxConcurrentStreams(1000)
 	keepOp := grpc.KeepaliveParams(keepparm)
 
-	dl.server = grpc.NewServer(msgRecvOp, msgSendOp, keepOp, maxStreams)
+	//协商能力按连接保存和流量统计都需要 stats.Handler, grpc 只能设置一个
+	handler := statsHandlers{&capsStatsHandler{server: pServer}, node.nodeInfo.bandwidth}
+	opts := []grpc.ServerOption{msgRecvOp, msgSendOp, keepOp, maxStreams, grpc.StatsHandler(handler)}
+	if identity := node.nodeInfo.tlsIdentity; identity != nil {
+		unary, stream := peerInterceptor(node.nodeInfo)
+		opts = append(opts, identity.serverOption(), grpc.UnaryInterceptor(unary), grpc.StreamInterceptor(stream))
+	}
+	dl.server = grpc.NewServer(opts...)
 	dl.p2pserver = pServer
 	pb.RegisterP2PgserviceServer(dl.server, pServer)
 	return dl
 }
+
+//statsHandlers 依次调用多个 stats.Handler
+type statsHandlers []stats.Handler
+
+func (hs statsHandlers) TagConn(ctx context.Context, info *stats.ConnTagInfo) context.Context {
+	for _, h := range hs {
+		ctx = h.TagConn(ctx, info)
+	}
+	return ctx
+}
+
+func (hs statsHandlers) HandleConn(ctx context.Context, cs stats.ConnStats) {
+	for _, h := range hs {
+		h.HandleConn(ctx, cs)
+	}
+}
+
+func (hs statsHandlers) TagRPC(ctx context.Context, info *stats.RPCTagInfo) context.Context {
+	for _, h := range hs {
+		ctx = h.TagRPC(ctx, info)
+	}
+	return ctx
+}
+
+func (hs statsHandlers) HandleRPC(ctx context.Context, rs stats.RPCStats) {
+	for _, h := range hs {
+		h.HandleRPC(ctx, rs)
+	}
+}
@@ -5,10 +5,6 @@
 package p2p
 
 import (
-	"bytes"
-	"io"
-	"net/http"
-	"strings"
 	"time"
 
 	"github.com/33cn/chain33/types"
@@ -31,7 +27,7 @@ func (n *Node) monitorErrPeer() {
 			log.Debug("VersoinMonitor", "NotSupport,addr", peer.Addr())
 			n.destroyPeer(peer)
 			//加入黑名单12小时
-			n.nodeInfo.blacklist.Add(peer.Addr(), int64(3600*12))
+			n.nodeInfo.blacklist.AddPeer(peer, int64(3600*12))
 			continue
 		}
 		if peer.IsMaxInbouds {
@@ -52,41 +48,6 @@ func (n *Node) monitorErrPeer() {
 	}
 }
 
-func (n *Node) getAddrFromGithub() {
-	if !n.nodeInfo.cfg.UseGithub {
-		return
-	}
-	//从github 上下载种子节点文件
-	res, err := http.Get("https://raw.githubusercontent.com/chainseed/seeds/master/bty.txt")
-	if err != nil {
-		log.Error("getAddrFromGithub", "http.Get", err.Error())
-		return
-	}
-
-	bf := new(bytes.Buffer)
-	_, err = io.Copy(bf, res.Body)
-	if err != nil {
-		log.Error("getAddrFromGithub", "io.Copy", err.Error())
-		return
-	}
-
-	fileContent := bf.String()
-	st := strings.TrimSpace(fileContent)
-	strs := strings.Split(st, "\n")
-	log.Info("getAddrFromGithub", "download file", fileContent)
-	for _, linestr := range strs {
-		pidaddr := strings.Split(linestr, "@")
-		if len(pidaddr) == 2 {
-			addr := pidaddr[1]
-			if n.Has(addr) || n.nodeInfo.blacklist.Has(addr) {
-				return
-			}
-			n.pubsub.FIFOPub(addr, "addr")
-
-		}
-	}
-}
-
 // getAddrFromOnline gets the address list from the online node
 func (n *Node) getAddrFromOnline() {
 	ticker := time.NewTicker(GetAddrFromOnlineInterval)
@@ -177,7 +138,7 @@ func (n *Node) getAddrFromOnline() {
 					continue
 				}
 
-				if !n.nodeInfo.blacklist.Has(addr) || !Filter.QueryRecvData(addr) {
+				if !n.nodeInfo.blacklist.Has(addr) || !n.filter.QueryRecvData(addr) {
 					if ticktimes < 10 {
 						//如果连接了其他节点，优先不连接种子节点
 						if _, ok := seedsMap[addr]; !ok {
@@ -200,20 +161,13 @@ func (n *Node) getAddrFromOnline() {
 func (n *Node) getAddrFromAddrBook() {
 	ticker := time.NewTicker(GetAddrFromAddrBookInterval)
 	defer ticker.Stop()
-	var tickerTimes int64
 
 	for {
 		<-ticker.C
-		tickerTimes++
 		if n.isClose() {
 			log.Debug("GetAddrFromOnLine", "loop", "done")
 			return
 		}
-		//12个循环后， 则去github下载
-		if tickerTimes > 12 && n.Size() == 0 {
-			n.getAddrFromGithub()
-			tickerTimes = 0
-		}
 
 		log.Debug("OUTBOUND NUM", "NUM", n.Size(), "start getaddr from peer,peernum", len(n.nodeInfo.addrBook.GetPeers()))
 
@@ -414,7 +368,7 @@ func (n *Node) monitorDialPeers() {
 			log.Info("monitorDialPeers", "loop", "done")
 			return
 		}
-		if Filter.QueryRecvData(addr.(string)) {
+		if n.filter.QueryRecvData(addr.(string)) {
 			//先查询有没有注册进去，避免同时重复连接相同的地址
 			continue
 		}
@@ -451,10 +405,10 @@ func (n *Node) monitorDialPeers() {
 		}
 		dialCount++
 		//把待连接的节点增加到过滤容器中
-		Filter.RegRecvData(addr.(string))
+		n.filter.RegRecvData(addr.(string))
 		log.Info("monitorDialPeer", "dialCount", dialCount)
 		go func(netAddr *NetAddress) {
-			defer Filter.RemoveRecvData(netAddr.String())
+			defer n.filter.RemoveRecvData(netAddr.String())
 			peer, err := P2pComm.dialPeer(netAddr, n)
 			if err != nil {
 				//连接失败后
@@ -495,6 +449,7 @@ func (n *Node) monitorDialPeers() {
 
 			n.addPeer(peer)
 			n.nodeInfo.addrBook.AddAddress(netAddr, nil)
+			n.nodeInfo.addrBook.SetAddrPubKey(netAddr.String(), peer.PubKey())
 
 		}(netAddr)
 
@@ -530,5 +485,5 @@ func (n *Node) monitorBlackList() {
 }
 
 func (n *Node) monitorFilter() {
-	Filter.ManageRecvFilter()
+	n.filter.ManageRecvFilter()
 }
@@ -9,9 +9,9 @@ import (
 	"fmt"
 	"net"
 	"strconv"
+	"sync/atomic"
 	"time"
 
-	pb "github.com/33cn/chain33/types"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/keepalive"
@@ -131,17 +131,9 @@ func (na *NetAddress) Copy() *NetAddress {
 	return &copytmp
 }
 
-// DialTimeout calls net.DialTimeout on the address.
-func isCompressSupport(err error) bool {
-	var errstr = `grpc: Decompressor is not installed for grpc-encoding "gzip"`
-	if grpc.Code(err) == codes.Unimplemented && grpc.ErrorDesc(err) == errstr {
-		return false
-	}
-	return true
-}
-
-// DialTimeout dial timeout
-func (na *NetAddress) DialTimeout(version int32) (*grpc.ClientConn, error) {
+// DialTimeout dial timeout, creds 为 nil 时不使用 tls, bandwidth 为 nil 时不统计流量,
+// compress 为 nil 时不使用 gzip 压缩, 否则握手协商之后由 compress 决定是否压缩
+func (na *NetAddress) DialTimeout(creds *peerCreds, bandwidth *Bandwidth, compress *compressOption) (*grpc.ClientConn, error) {
 	ch := make(chan grpc.ServiceConfig, 1)
 	ch <- P2pComm.GrpcConfig()
 
@@ -152,33 +144,61 @@ func (na *NetAddress) DialTimeout(version int32) (*grpc.ClientConn, error) {
 	keepaliveOp := grpc.WithKeepaliveParams(cliparm)
 	timeoutOp := grpc.WithTimeout(time.Second * 3)
 	log.Debug("NetAddress", "Dial", na.String())
-	conn, err := grpc.Dial(na.String(), grpc.WithInsecure(),
-		grpc.WithDefaultCallOptions(grpc.UseCompressor("gzip")), grpc.WithServiceConfig(ch), keepaliveOp, timeoutOp)
+	//等待连接建立, 返回的时候 tls 握手已经完成, creds 中有对方的公钥, 端口不通时返回错误
+	opts := append([]grpc.DialOption{creds.dialOption(), keepaliveOp, timeoutOp, grpc.WithBlock(), grpc.WithServiceConfig(ch)}, bandwidth.dialOptions()...)
+	conn, err := grpc.Dial(na.String(), append(opts, compress.dialOptions()...)...)
 	if err != nil {
 		log.Debug("grpc DialCon", "did not connect", err, "addr", na.String())
 		return nil, err
 	}
-	//判断是否对方是否支持压缩
-	cli := pb.NewP2PgserviceClient(conn)
-	_, err = cli.GetHeaders(context.Background(), &pb.P2PGetHeaders{StartHeight: 0, EndHeight: 0, Version: version}, grpc.FailFast(true))
-	if err != nil && !isCompressSupport(err) {
-		//compress not support
-		log.Error("compress not supprot , rollback to uncompress version", "addr", na.String())
-		conn.Close()
-		ch2 := make(chan grpc.ServiceConfig, 1)
-		ch2 <- P2pComm.GrpcConfig()
-		log.Debug("NetAddress", "Dial with unCompressor", na.String())
-		conn, err = grpc.Dial(na.String(), grpc.WithInsecure(), grpc.WithServiceConfig(ch2), keepaliveOp, timeoutOp)
+	return conn, nil
+}
+
+//isCompressSupport 对方节点是否支持 gzip 压缩
+func isCompressSupport(err error) bool {
+	var errstr = `grpc: Decompressor is not installed for grpc-encoding "gzip"`
+	if grpc.Code(err) == codes.Unimplemented && grpc.ErrorDesc(err) == errstr {
+		return false
 	}
+	return true
+}
 
-	if err != nil {
-		log.Debug("grpc DialCon Uncompressor", "did not connect", err)
-		if conn != nil {
-			conn.Close()
-		}
-		return nil, err
+//compressOption 连接上的请求是否使用 gzip 压缩, 双方在握手时都声明 CapCompression 之后才打开,
+//不声明任何能力的旧版本节点用压缩的请求试探是否支持
+type compressOption struct {
+	on int32
+}
+
+//Set 设置协商的结果
+func (c *compressOption) Set(on bool) {
+	if c == nil {
+		return
 	}
-	return conn, nil
+	var v int32
+	if on {
+		v = 1
+	}
+	atomic.StoreInt32(&c.on, v)
+}
+
+func (c *compressOption) callOptions(opts []grpc.CallOption) []grpc.CallOption {
+	if atomic.LoadInt32(&c.on) == 1 {
+		return append(opts, grpc.UseCompressor("gzip"))
+	}
+	return opts
+}
+
+func (c *compressOption) dialOptions() []grpc.DialOption {
+	if c == nil {
+		return nil
+	}
+	unary := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(ctx, method, req, reply, cc, c.callOptions(opts)...)
+	}
+	stream := func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(ctx, desc, cc, method, c.callOptions(opts)...)
+	}
+	return []grpc.DialOption{grpc.WithUnaryInterceptor(unary), grpc.WithStreamInterceptor(stream)}
 }
 
 // Routable returns true if the address is routable.
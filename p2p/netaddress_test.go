@@ -0,0 +1,29 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddrRouteble(t *testing.T) {
+	node, q := newTestNode(t, nil)
+	defer closeTestNode(node, q)
+	addr, stop := startTestServer(t, newTestServer())
+	defer stop()
+
+	//关闭的端口不可达
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	closed := l.Addr().String()
+	l.Close()
+
+	assert.Equal(t, []string{addr}, P2pComm.AddrRouteble([]string{addr, closed}, node.nodeInfo))
+	assert.Nil(t, P2pComm.AddrRouteble([]string{closed}, node.nodeInfo))
+}
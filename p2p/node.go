@@ -47,9 +47,7 @@ func (n *Node) Close() {
 	n.nodeInfo.addrBook.Close()
 	log.Debug("stop", "addrBook", "closed")
 	n.removeAll()
-	if Filter != nil {
-		Filter.Close()
-	}
+	n.filter.Close()
 	n.deleteNatMapPort()
 	log.Info("stop", "PeerRemoeAll", "closed")
 
@@ -69,6 +67,10 @@ type Node struct {
 	listener   Listener
 	closed     int32
 	pubsub     *pubsub.PubSub
+	//过滤已经收到的区块和交易, 以及正在连接的地址
+	filter *Filterdata
+	//最近广播的区块的紧凑区块
+	compactBlocks compactCache
 }
 
 // SetQueueClient return client for nodeinfo
@@ -78,16 +80,16 @@ func (n *Node) SetQueueClient(client queue.Client) {
 
 // NewNode produce a node object
 func NewNode(cfg *types.P2P) (*Node, error) {
+	//不使用 tls 时节点公钥没有经过验证, 许可网络不能启动
+	if cfg.Permissioned && !cfg.EnableTLS {
+		return nil, types.ErrPermissionedTLS
+	}
 
 	node := &Node{
 		outBound:   make(map[string]*Peer),
 		cacheBound: make(map[string]*Peer),
 		pubsub:     pubsub.NewPubSub(10200),
-	}
-
-	if cfg.Port != 0 && cfg.Port <= 65535 && cfg.Port > 1024 {
-		defaultPort = int(cfg.Port)
-
+		filter:     NewFilter(),
 	}
 
 	if cfg.InnerSeedEnable {
@@ -133,9 +135,9 @@ func (n *Node) doNat() {
 		}
 		time.Sleep(time.Second)
 	}
-	testExaddr := fmt.Sprintf("%v:%v", n.nodeInfo.GetExternalAddr().IP.String(), defaultPort)
+	testExaddr := fmt.Sprintf("%v:%v", n.nodeInfo.GetExternalAddr().IP.String(), n.nodeInfo.port)
 	log.Info("TestNetAddr", "testExaddr", testExaddr)
-	if len(P2pComm.AddrRouteble([]string{testExaddr})) != 0 {
+	if len(P2pComm.AddrRouteble([]string{testExaddr}, n.nodeInfo)) != 0 {
 		log.Info("node outside")
 		n.nodeInfo.SetNetSide(true)
 		if netexaddr, err := NewNetAddressString(testExaddr); err == nil {
@@ -161,8 +163,8 @@ func (n *Node) doNat() {
 
 			p2pcli := NewNormalP2PCli()
 			//测试映射后的端口能否连通或者外网+本地端口
-			if p2pcli.CheckPeerNatOk(n.nodeInfo.GetExternalAddr().String()) ||
-				p2pcli.CheckPeerNatOk(fmt.Sprintf("%v:%v", n.nodeInfo.GetExternalAddr().IP.String(), defaultPort)) {
+			if p2pcli.CheckPeerNatOk(n.nodeInfo.GetExternalAddr().String(), n.nodeInfo) ||
+				p2pcli.CheckPeerNatOk(fmt.Sprintf("%v:%v", n.nodeInfo.GetExternalAddr().IP.String(), n.nodeInfo.port), n.nodeInfo) {
 
 				n.nodeInfo.SetServiceTy(Service)
 				log.Info("doNat", "NatOk", "Support Service")
@@ -183,41 +185,64 @@ func (n *Node) doNat() {
 	}
 }
 
+//outBound 和 cacheBound 的 key 是 peer.key(), 开启 tls 时为节点公钥, 地址只是节点的属性
 func (n *Node) addPeer(pr *Peer) {
 	n.omtx.Lock()
 	defer n.omtx.Unlock()
-	if peer, ok := n.outBound[pr.Addr()]; ok {
+	key := pr.key()
+	if peer, ok := n.outBound[key]; ok {
+		if peer.Addr() != pr.Addr() {
+			//同一个节点只保留一个连接
+			log.Info("AddPeer", "duplicate pubkey", key, "addr", pr.Addr(), "exist", peer.Addr())
+			pr.Close()
+			return
+		}
 		log.Info("AddPeer", "delete peer", pr.Addr())
 		n.nodeInfo.addrBook.RemoveAddr(peer.Addr())
-		delete(n.outBound, pr.Addr())
+		delete(n.outBound, key)
 		peer.Close()
 
 	}
 	log.Debug("AddPeer", "peer", pr.Addr())
-	n.outBound[pr.Addr()] = pr
+	n.outBound[key] = pr
 	pr.Start()
 }
 
-// AddCachePeer  add cacheBound map by addr
+//lookupPeer 按照节点公钥或者地址查找节点, 返回节点在 map 中的 key
+func lookupPeer(peers map[string]*Peer, paddr string) (string, *Peer) {
+	if peer, ok := peers[paddr]; ok {
+		return paddr, peer
+	}
+	for key, peer := range peers {
+		if peer.Addr() == paddr {
+			return key, peer
+		}
+	}
+	return "", nil
+}
+
+// AddCachePeer  add cacheBound map by pubkey or addr
 func (n *Node) AddCachePeer(pr *Peer) {
 	n.cmtx.Lock()
 	defer n.cmtx.Unlock()
-	n.cacheBound[pr.Addr()] = pr
+	n.cacheBound[pr.key()] = pr
 }
 
 // RemoveCachePeer remove cacheBound by addr
 func (n *Node) RemoveCachePeer(addr string) {
 	n.cmtx.Lock()
 	defer n.cmtx.Unlock()
-	delete(n.cacheBound, addr)
+	if key, peer := lookupPeer(n.cacheBound, addr); peer != nil {
+		delete(n.cacheBound, key)
+	}
 }
 
 // HasCacheBound peer whether exists according to address
 func (n *Node) HasCacheBound(addr string) bool {
 	n.cmtx.Lock()
 	defer n.cmtx.Unlock()
-	_, ok := n.cacheBound[addr]
-	return ok
+	_, peer := lookupPeer(n.cacheBound, addr)
+	return peer != nil
 
 }
 
@@ -254,20 +279,29 @@ func (n *Node) Has(paddr string) bool {
 	n.omtx.Lock()
 	defer n.omtx.Unlock()
 
-	if _, ok := n.outBound[paddr]; ok {
+	_, peer := lookupPeer(n.outBound, paddr)
+	return peer != nil
+}
+
+// HasPubKey peer whether exists according to pubkey verified by tls
+func (n *Node) HasPubKey(pubkey string) bool {
+	n.omtx.Lock()
+	defer n.omtx.Unlock()
+	if _, ok := n.outBound[pubkey]; ok {
 		return true
 	}
-	return false
+	n.cmtx.Lock()
+	defer n.cmtx.Unlock()
+	_, ok := n.cacheBound[pubkey]
+	return ok
 }
 
-// GetRegisterPeer return one peer according to paddr
+// GetRegisterPeer return one peer according to pubkey or paddr
 func (n *Node) GetRegisterPeer(paddr string) *Peer {
 	n.omtx.Lock()
 	defer n.omtx.Unlock()
-	if peer, ok := n.outBound[paddr]; ok {
-		return peer
-	}
-	return nil
+	_, peer := lookupPeer(n.outBound, paddr)
+	return peer
 }
 
 // GetRegisterPeers return peers
@@ -303,11 +337,12 @@ func (n *Node) remove(peerAddr string) {
 
 	n.omtx.Lock()
 	defer n.omtx.Unlock()
-	peer, ok := n.outBound[peerAddr]
-	if ok {
-		delete(n.outBound, peerAddr)
+	key, peer := lookupPeer(n.outBound, peerAddr)
+	if peer != nil {
+		delete(n.outBound, key)
 		peer.Close()
 	}
+	n.nodeInfo.perfs.Delete(peerAddr)
 }
 
 func (n *Node) removeAll() {
@@ -326,6 +361,9 @@ func (n *Node) monitor() {
 	go n.monitorPeerInfo()
 	go n.monitorDialPeers()
 	go n.monitorBlackList()
+	go n.monitorAllowlist()
+	go n.monitorPeerScore()
+	go n.monitorDiscovery()
 	go n.monitorFilter()
 	go n.monitorPeers()
 	go n.nodeReBalance()
@@ -366,7 +404,7 @@ func (n *Node) detectNodeAddr() {
 		var externalPort int
 
 		if cfg.IsSeed {
-			externalPort = defaultPort
+			externalPort = n.nodeInfo.port
 		} else {
 			exportBytes, _ := n.nodeInfo.addrBook.bookDb.Get([]byte(externalPortTag))
 			if len(exportBytes) != 0 {
@@ -387,7 +425,7 @@ func (n *Node) detectNodeAddr() {
 			log.Error("DetectionNodeAddr", "error", err.Error())
 		}
 
-		if listaddr, err := NewNetAddressString(fmt.Sprintf("%v:%v", laddr, defaultPort)); err == nil {
+		if listaddr, err := NewNetAddressString(fmt.Sprintf("%v:%v", laddr, n.nodeInfo.port)); err == nil {
 			n.nodeInfo.SetListenAddr(listaddr)
 			n.nodeInfo.addrBook.AddOurAddress(listaddr)
 		}
@@ -408,13 +446,13 @@ func (n *Node) natMapPort() {
 		time.Sleep(time.Second)
 	}
 	var err error
-	if len(P2pComm.AddrRouteble([]string{n.nodeInfo.GetExternalAddr().String()})) != 0 { //判断能否连通要映射的端口
+	if len(P2pComm.AddrRouteble([]string{n.nodeInfo.GetExternalAddr().String()}, n.nodeInfo)) != 0 { //判断能否连通要映射的端口
 		log.Info("natMapPort", "addr", "routeble")
 		p2pcli := NewNormalP2PCli() //检查要映射的IP地址是否已经被映射成功
 		ok := p2pcli.CheckSelf(n.nodeInfo.GetExternalAddr().String(), n.nodeInfo)
 		if !ok {
 			log.Info("natMapPort", "port is used", n.nodeInfo.GetExternalAddr().String())
-			n.flushNodePort(uint16(defaultPort), uint16(rand.Intn(64512)+1023))
+			n.flushNodePort(uint16(n.nodeInfo.port), uint16(rand.Intn(64512)+1023))
 		}
 
 	}
@@ -422,11 +460,11 @@ func (n *Node) natMapPort() {
 	log.Info("natMapPort", "netport", n.nodeInfo.GetExternalAddr().Port)
 	for i := 0; i < tryMapPortTimes; i++ {
 		//映射事件持续约48小时
-		err = nat.Any().AddMapping("TCP", int(n.nodeInfo.GetExternalAddr().Port), defaultPort, nodename[:8], time.Hour*48)
+		err = nat.Any().AddMapping("TCP", int(n.nodeInfo.GetExternalAddr().Port), n.nodeInfo.port, nodename[:8], time.Hour*48)
 		if err != nil {
 			if i > tryMapPortTimes/2 { //如果连续失败次数超过最大限制次数的二分之一则切换为随机端口映射
 				log.Error("NatMapPort", "err", err.Error())
-				n.flushNodePort(uint16(defaultPort), uint16(rand.Intn(64512)+1023))
+				n.flushNodePort(uint16(n.nodeInfo.port), uint16(rand.Intn(64512)+1023))
 
 			}
 			log.Info("NatMapPort", "External Port", n.nodeInfo.GetExternalAddr().Port)
@@ -439,7 +477,7 @@ func (n *Node) natMapPort() {
 	if err != nil {
 		//映射失败
 		log.Warn("NatMapPort", "Nat", "Faild")
-		n.flushNodePort(uint16(defaultPort), uint16(defaultPort))
+		n.flushNodePort(uint16(n.nodeInfo.port), uint16(n.nodeInfo.port))
 		n.nodeInfo.natResultChain <- false
 		return
 	}
@@ -454,7 +492,7 @@ func (n *Node) natMapPort() {
 		<-refresh.C
 		log.Info("NatWorkRefresh")
 		for {
-			if err := nat.Any().AddMapping("TCP", int(n.nodeInfo.GetExternalAddr().Port), defaultPort, nodename[:8], time.Hour*48); err != nil {
+			if err := nat.Any().AddMapping("TCP", int(n.nodeInfo.GetExternalAddr().Port), n.nodeInfo.port, nodename[:8], time.Hour*48); err != nil {
 				log.Error("NatMapPort update", "err", err.Error())
 				time.Sleep(time.Second)
 				continue
@@ -470,7 +508,7 @@ func (n *Node) deleteNatMapPort() {
 	if n.nodeInfo.OutSide() {
 		return
 	}
-	nat.Any().DeleteMapping("TCP", int(n.nodeInfo.GetExternalAddr().Port), defaultPort)
+	nat.Any().DeleteMapping("TCP", int(n.nodeInfo.GetExternalAddr().Port), n.nodeInfo.port)
 
 }
 
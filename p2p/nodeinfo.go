@@ -26,6 +26,14 @@ type NodeInfo struct {
 	blacklist      *BlackList
 	peerInfos      *PeerInfos
 	addrBook       *AddrBook // known peers
+	tlsIdentity    *tlsIdentity
+	allowlist      *Allowlist
+	scores         *PeerScores
+	dht            *routingTable
+	bandwidth      *Bandwidth
+	perfs          *DownloadPerfs
+	caps           uint64 //本节点声明的能力
+	port           int    //本节点监听的端口
 	natDone        int32
 	outSide        int32
 	ServiceType    int32
@@ -37,16 +45,64 @@ func NewNodeInfo(cfg *types.P2P) *NodeInfo {
 	nodeInfo.monitorChan = make(chan *Peer, 1024)
 	nodeInfo.natNoticeChain = make(chan struct{}, 1)
 	nodeInfo.natResultChain = make(chan bool, 1)
-	nodeInfo.blacklist = &BlackList{badPeers: make(map[string]int64)}
+	nodeInfo.blacklist = &BlackList{badPeers: make(map[string]int64), bans: make(map[string]bool)}
 	nodeInfo.cfg = cfg
 	nodeInfo.peerInfos = new(PeerInfos)
 	nodeInfo.peerInfos.infos = make(map[string]*types.Peer)
 	nodeInfo.externalAddr = new(NetAddress)
 	nodeInfo.listenAddr = new(NetAddress)
 	nodeInfo.addrBook = NewAddrBook(cfg)
+	nodeInfo.allowlist = NewAllowlist(cfg)
+	nodeInfo.scores = NewPeerScores()
+	nodeInfo.bandwidth = NewBandwidth(cfg)
+	nodeInfo.perfs = NewDownloadPerfs()
+	nodeInfo.caps = localCaps(cfg)
+	nodeInfo.port = defaultPort
+	if cfg.Port != 0 && cfg.Port <= 65535 && cfg.Port > 1024 {
+		nodeInfo.port = int(cfg.Port)
+	}
+	_, pub := nodeInfo.addrBook.GetPrivPubKey()
+	nodeInfo.dht = newRoutingTable(nodeID(pub))
+	//恢复保存在 addrbook 中还没有过期的黑名单
+	now := types.Now().Unix()
+	for key, until := range nodeInfo.addrBook.LoadBans() {
+		if until == 0 || until > now {
+			nodeInfo.blacklist.Ban(key, until)
+		}
+	}
+	if cfg.EnableTLS {
+		privkey, _ := nodeInfo.addrBook.GetPrivPubKey()
+		identity, err := newTLSIdentity(privkey)
+		if err != nil {
+			panic(err)
+		}
+		nodeInfo.tlsIdentity = identity
+	}
 	return nodeInfo
 }
 
+//BanPeer 把节点公钥或者地址加入黑名单并保存, seconds 不大于 0 时永久加入黑名单
+func (nf *NodeInfo) BanPeer(key string, seconds int64) {
+	var until int64
+	if seconds > 0 {
+		until = types.Now().Unix() + seconds
+	}
+	nf.blacklist.Ban(key, until)
+	nf.addrBook.SaveBans(nf.blacklist.GetBans())
+}
+
+//UnbanPeer 从黑名单中删除并保存
+func (nf *NodeInfo) UnbanPeer(key string) bool {
+	ok := nf.blacklist.Unban(key)
+	nf.addrBook.SaveBans(nf.blacklist.GetBans())
+	return ok
+}
+
+//newPeerCreds 连接其他节点时使用的 tls 配置, 不开启 tls 时返回 nil
+func (nf *NodeInfo) newPeerCreds() *peerCreds {
+	return nf.tlsIdentity.newPeerCreds()
+}
+
 // PeerInfos encapsulation peer information
 type PeerInfos struct {
 	mtx   sync.Mutex
@@ -107,6 +163,8 @@ func (p *PeerInfos) GetPeerInfo(key string) *types.Peer {
 type BlackList struct {
 	mtx      sync.Mutex
 	badPeers map[string]int64
+	//运维或者节点评分加入的黑名单, 需要保存到 addrbook 中
+	bans map[string]bool
 }
 
 // FetchPeerInfo get peerinfo by node
@@ -148,6 +206,7 @@ func (nf *NodeInfo) latestPeerInfo(n *Node) map[string]*types.Peer {
 		pr.Name = peerinfo.GetName()
 		pr.MempoolSize = peerinfo.GetMempoolSize()
 		pr.Header = peerinfo.GetHeader()
+		pr.BytesSent, pr.BytesRecv = nf.bandwidth.PeerTraffic(peer.Addr())
 		peerlist[fmt.Sprintf("%v:%v", peerinfo.Addr, peerinfo.Port)] = &pr
 	}
 	return peerlist
@@ -251,11 +310,46 @@ func (bl *BlackList) Add(addr string, deadline int64) {
 
 }
 
+// AddPeer 开启 tls 时把节点公钥加入黑名单, 节点更换地址后仍然不能连接, 否则加入节点地址
+func (bl *BlackList) AddPeer(peer *Peer, deadline int64) {
+	bl.Add(peer.key(), deadline)
+}
+
 // Delete delete badpeer
 func (bl *BlackList) Delete(addr string) {
 	bl.mtx.Lock()
 	defer bl.mtx.Unlock()
 	delete(bl.badPeers, addr)
+	delete(bl.bans, addr)
+}
+
+// Ban 加入黑名单直到 until, until 为 0 表示永久加入黑名单
+func (bl *BlackList) Ban(key string, until int64) {
+	bl.mtx.Lock()
+	defer bl.mtx.Unlock()
+	bl.badPeers[key] = until
+	bl.bans[key] = true
+}
+
+// Unban 从黑名单中删除, 返回是否在黑名单中
+func (bl *BlackList) Unban(key string) bool {
+	bl.mtx.Lock()
+	defer bl.mtx.Unlock()
+	_, ok := bl.badPeers[key]
+	delete(bl.badPeers, key)
+	delete(bl.bans, key)
+	return ok
+}
+
+// GetBans 返回通过 Ban 加入的黑名单
+func (bl *BlackList) GetBans() map[string]int64 {
+	bl.mtx.Lock()
+	defer bl.mtx.Unlock()
+	bans := make(map[string]int64)
+	for key := range bl.bans {
+		bans[key] = bl.badPeers[key]
+	}
+	return bans
 }
 
 // Has the badpeer true and false
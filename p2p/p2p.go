@@ -226,6 +226,18 @@ func (network *P2p) subP2pMsg() {
 				go network.p2pCli.GetHeaders(msg, taskIndex)
 			case types.EventGetNetInfo:
 				go network.p2pCli.GetNetInfo(msg, taskIndex)
+			case types.EventReportPeer:
+				go network.p2pCli.ReportPeer(msg, taskIndex)
+			case types.EventPeerScores:
+				go network.p2pCli.GetPeerScores(msg, taskIndex)
+			case types.EventBanPeer:
+				go network.p2pCli.BanPeer(msg, taskIndex)
+			case types.EventUnbanPeer:
+				go network.p2pCli.UnbanPeer(msg, taskIndex)
+			case types.EventGetTxProof:
+				go network.p2pCli.GetTxProof(msg, taskIndex)
+			case types.EventGetStateProof:
+				go network.p2pCli.GetStateProof(msg, taskIndex)
 			default:
 				log.Warn("unknown msgtype", "msg", msg)
 				msg.Reply(network.client.NewMessage("", msg.Ty, types.Reply{Msg: []byte("unknown msgtype")}))
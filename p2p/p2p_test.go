@@ -0,0 +1,176 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"math/rand"
+	"net"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/33cn/chain33/queue"
+	_ "github.com/33cn/chain33/system/crypto/init"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+func newTestConfig(dir string) *types.P2P {
+	return &types.P2P{
+		Driver:  "leveldb",
+		DbPath:  dir,
+		DbCache: 4,
+		Version: 10020,
+		VerMin:  10020,
+		VerMax:  11000,
+	}
+}
+
+//newTestNode 创建不监听端口的节点, addrbook 保存在临时目录中
+func newTestNode(t *testing.T, cfg *types.P2P) (*Node, queue.Queue) {
+	if cfg == nil {
+		dir, err := ioutil.TempDir("", "p2ptest")
+		require.Nil(t, err)
+		cfg = newTestConfig(dir)
+	}
+	node, err := NewNode(cfg)
+	require.Nil(t, err)
+	q := queue.New("channel")
+	node.SetQueueClient(q.Client())
+	return node, q
+}
+
+func closeTestNode(node *Node, q queue.Queue) {
+	node.nodeInfo.addrBook.Close()
+	q.Close()
+	os.RemoveAll(node.nodeInfo.cfg.DbPath)
+}
+
+//testServer 只实现测试用到的 grpc 方法, 其他方法调用时 panic
+type testServer struct {
+	types.P2PgserviceServer
+	mtx     sync.Mutex
+	blocks  map[int64]*types.Block
+	headers []*types.Header
+	//按区块 hash 和序号返回交易, 用于 MSG_BLOCKTX
+	blockTxs map[string][]*types.Transaction
+	invs     []*types.Inventory
+}
+
+func newTestServer() *testServer {
+	return &testServer{blocks: make(map[int64]*types.Block), blockTxs: make(map[string][]*types.Transaction)}
+}
+
+func (s *testServer) GetData(in *types.P2PGetData, stream types.P2Pgservice_GetDataServer) error {
+	s.mtx.Lock()
+	s.invs = append(s.invs, in.GetInvs()...)
+	var items []*types.InvData
+	for _, inv := range in.GetInvs() {
+		switch inv.GetTy() {
+		case msgBlock:
+			if block, ok := s.blocks[inv.GetHeight()]; ok {
+				items = append(items, &types.InvData{Ty: msgBlock, Value: &types.InvData_Block{Block: block}})
+			}
+		case msgBlockTx:
+			txs := s.blockTxs[hex.EncodeToString(inv.GetHash())]
+			if int(inv.GetIndex()) < len(txs) {
+				items = append(items, &types.InvData{Ty: msgTx, Value: &types.InvData_Tx{Tx: txs[inv.GetIndex()]}})
+			}
+		}
+	}
+	s.mtx.Unlock()
+	return stream.Send(&types.InvDatas{Items: items})
+}
+
+func (s *testServer) GetHeaders(ctx context.Context, in *types.P2PGetHeaders) (*types.P2PHeaders, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	var headers []*types.Header
+	for _, header := range s.headers {
+		if header.GetHeight() >= in.GetStartHeight() && header.GetHeight() <= in.GetEndHeight() {
+			headers = append(headers, header)
+		}
+	}
+	return &types.P2PHeaders{Headers: headers}, nil
+}
+
+//Version2 返回 tls 握手验证过的对方节点公钥
+func (s *testServer) Version2(ctx context.Context, in *types.P2PVersion) (*types.P2PVersion, error) {
+	return &types.P2PVersion{UserAgent: pubKeyFromContext(ctx)}, nil
+}
+
+//startTestServer 在随机的本地端口上启动 grpc 服务
+func startTestServer(t *testing.T, srv types.P2PgserviceServer, opts ...grpc.ServerOption) (string, func()) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	server := grpc.NewServer(opts...)
+	types.RegisterP2PgserviceServer(server, srv)
+	go server.Serve(l)
+	return l.Addr().String(), server.Stop
+}
+
+//newTestPeer 连接 addr 的节点, 不启动心跳
+func newTestPeer(t *testing.T, node *Node, addr string, opts ...grpc.DialOption) *Peer {
+	netaddr, err := NewNetAddressString(addr)
+	require.Nil(t, err)
+	if len(opts) == 0 {
+		opts = append(opts, grpc.WithInsecure())
+	}
+	conn, err := grpc.Dial(addr, opts...)
+	require.Nil(t, err)
+	peer := NewPeer(conn, node, netaddr)
+	peer.peerAddr = netaddr
+	return peer
+}
+
+//setTestPeer 把节点加入已连接的节点和节点信息, 不启动心跳
+func setTestPeer(node *Node, peer *Peer, height int64) {
+	node.omtx.Lock()
+	node.outBound[peer.Addr()] = peer
+	node.omtx.Unlock()
+	node.nodeInfo.peerInfos.SetPeerInfo(&types.Peer{Addr: peer.peerAddr.IP.String(), Port: int32(peer.peerAddr.Port),
+		Name: peer.GetPeerName(), Header: &types.Header{Height: height}})
+}
+
+func newTestKey(t *testing.T) (string, string) {
+	priv, pub, err := P2pComm.GenPrivPubkey()
+	require.Nil(t, err)
+	return hex.EncodeToString(priv), hex.EncodeToString(pub)
+}
+
+//serveTestMempool mempool 模块返回 txs 中短哈希匹配的交易
+func serveTestMempool(q queue.Queue, txs []*types.Transaction) {
+	client := q.Client()
+	client.Sub("mempool")
+	go func() {
+		for msg := range client.Recv() {
+			if msg.Ty == types.EventGetMempoolShortTxs {
+				req := msg.GetData().(*types.ReqShortTxs)
+				var found []*types.Transaction
+				for _, tx := range txs {
+					for _, id := range req.GetShortIDs() {
+						if types.ShortTxID(req.GetNonce(), tx.Hash()) == id {
+							found = append(found, tx)
+							break
+						}
+					}
+				}
+				msg.Reply(client.NewMessage("p2p", types.EventReplyTxList, &types.ReplyTxList{Txs: found}))
+			}
+		}
+	}()
+}
+
+func newTestTxs(n int) []*types.Transaction {
+	var txs []*types.Transaction
+	for i := 0; i < n; i++ {
+		txs = append(txs, &types.Transaction{Execer: []byte("coins"), Payload: []byte("test"), Fee: 100000, Nonce: rand.Int63()})
+	}
+	return txs
+}
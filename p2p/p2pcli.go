@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"sort"
 
 	"math/rand"
 
@@ -19,6 +20,7 @@ import (
 	pb "github.com/33cn/chain33/types"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
 )
 
 // EventInterface p2p subscribe to the event hander interface
@@ -30,6 +32,12 @@ type EventInterface interface {
 	GetBlocks(msg queue.Message, taskindex int64)
 	BlockBroadcast(msg queue.Message, taskindex int64)
 	GetNetInfo(msg queue.Message, taskindex int64)
+	ReportPeer(msg queue.Message, taskindex int64)
+	GetPeerScores(msg queue.Message, taskindex int64)
+	BanPeer(msg queue.Message, taskindex int64)
+	UnbanPeer(msg queue.Message, taskindex int64)
+	GetTxProof(msg queue.Message, taskindex int64)
+	GetStateProof(msg queue.Message, taskindex int64)
 }
 
 // NormalInterface subscribe to the event hander interface
@@ -38,7 +46,7 @@ type NormalInterface interface {
 	SendVersion(peer *Peer, nodeinfo *NodeInfo) (string, error)
 	SendPing(peer *Peer, nodeinfo *NodeInfo) error
 	GetBlockHeight(nodeinfo *NodeInfo) (int64, error)
-	CheckPeerNatOk(addr string) bool
+	CheckPeerNatOk(addr string, nodeInfo *NodeInfo) bool
 	GetAddrList(peer *Peer) (map[string]int64, error)
 	GetInPeersNum(peer *Peer) (int, error)
 	CheckSelf(addr string, nodeinfo *NodeInfo) bool
@@ -245,7 +253,8 @@ func (m *Cli) SendVersion(peer *Peer, nodeinfo *NodeInfo) (string, error) {
 
 	resp, err := peer.mconn.gcli.Version2(context.Background(), &pb.P2PVersion{Version: nodeinfo.cfg.Version, Service: int64(nodeinfo.ServiceTy()), Timestamp: pb.Now().Unix(),
 		AddrRecv: peer.Addr(), AddrFrom: addrfrom, Nonce: int64(rand.Int31n(102040)),
-		UserAgent: hex.EncodeToString(in.Sign.GetPubkey()), StartHeight: blockheight}, grpc.FailFast(true))
+		UserAgent: hex.EncodeToString(in.Sign.GetPubkey()), StartHeight: blockheight,
+		NodeID: nodeinfo.dht.self, Capabilities: nodeinfo.caps}, grpc.FailFast(true))
 	log.Debug("SendVersion", "resp", resp, "addrfrom", addrfrom, "sendto", peer.Addr())
 	if err != nil {
 		log.Error("SendVersion", "Verson", err.Error(), "peer", peer.Addr())
@@ -258,7 +267,23 @@ func (m *Cli) SendVersion(peer *Peer, nodeinfo *NodeInfo) (string, error) {
 
 	P2pComm.CollectPeerStat(err, peer)
 	log.Debug("SHOW VERSION BACK", "VersionBack", resp, "peer", peer.Addr())
+	if pubkey := peer.PubKey(); !nodeinfo.peerAllowed(pubkey) || (pubkey == "" && !nodeinfo.peerAllowed(resp.GetUserAgent())) {
+		log.Error("SendVersion", "peer not allowed", resp.GetUserAgent(), "peer", peer.Addr())
+		return "", pb.ErrPeerPubKey
+	}
 	peer.version.SetVersion(resp.GetVersion())
+	caps := negotiateCaps(nodeinfo.caps, resp.GetCapabilities())
+	if resp.GetCapabilities() == 0 && nodeinfo.caps&CapCompression != 0 && probeCompress(peer, nodeinfo.cfg.Version) {
+		caps |= CapCompression
+	}
+	peer.version.SetCaps(caps)
+	peer.compress.Set(caps&CapCompression != 0)
+	log.Debug("SendVersion", "peer", peer.Addr(), "caps", capsString(caps))
+	pubkey := peer.PubKey()
+	if pubkey == "" {
+		pubkey = resp.GetUserAgent()
+	}
+	nodeinfo.addDHTNode(pubkey, resp.GetNodeID(), peer.Addr())
 
 	ip, _, err := net.SplitHostPort(resp.GetAddrRecv())
 	if err == nil {
@@ -404,145 +429,43 @@ func (m *Cli) GetBlocks(msg queue.Message, taskindex int64) {
 	req := msg.GetData().(*pb.ReqBlocks)
 	log.Info("GetBlocks", "start", req.GetStart(), "end", req.GetEnd())
 	pids := req.GetPid()
-	var MaxInvs = new(pb.P2PInv)
-	var downloadPeers []*Peer
-	peers, infos := m.network.node.GetActivePeers()
-	if len(pids) > 0 && pids[0] != "" { //指定Pid 下载数据
-		log.Info("fetch from peer in pids")
-		var pidmap = make(map[string]bool)
-		for _, pid := range pids {
+	var pidmap = make(map[string]bool)
+	for _, pid := range pids {
+		if pid != "" {
 			pidmap[pid] = true
 		}
-		for paddr, info := range infos {
-			if _, ok := pidmap[info.GetName()]; ok { //匹配成功
-
-				peer, ok := peers[paddr]
-				if ok && peer != nil {
-					downloadPeers = append(downloadPeers, peer)
-					//获取Invs
-					if len(MaxInvs.GetInvs()) != int(req.GetEnd()-req.GetStart())+1 {
-						var err error
-						MaxInvs, err = peer.mconn.gcli.GetBlocks(context.Background(), &pb.P2PGetBlocks{StartHeight: req.GetStart(), EndHeight: req.GetEnd(),
-							Version: m.network.node.nodeInfo.cfg.Version}, grpc.FailFast(true))
-						P2pComm.CollectPeerStat(err, peer)
-						if err != nil {
-							log.Error("GetBlocks", "Err", err.Error())
-							if err == pb.ErrVersion {
-								peer.version.SetSupport(false)
-								P2pComm.CollectPeerStat(err, peer)
-							}
-							continue
-						}
-					}
-
-				}
-			}
-		}
-
-	} else {
-		log.Info("fetch from all peers in pids")
-
-		for _, peer := range peers { //限制对peer 的高频次调用
-			log.Info("peer", "addr", peer.Addr(), "start", req.GetStart(), "end", req.GetEnd())
-			peerinfo, ok := infos[peer.Addr()]
-			if !ok {
-				continue
-			}
-			var pr pb.Peer
-			pr.Addr = peerinfo.GetAddr()
-			pr.Port = peerinfo.GetPort()
-			pr.Name = peerinfo.GetName()
-			pr.MempoolSize = peerinfo.GetMempoolSize()
-			pr.Header = peerinfo.GetHeader()
-
-			if peerinfo.GetHeader().GetHeight() < req.GetEnd() {
-				continue
-			}
-
-			invs, err := peer.mconn.gcli.GetBlocks(context.Background(), &pb.P2PGetBlocks{StartHeight: req.GetStart(), EndHeight: req.GetEnd(),
-				Version: m.network.node.nodeInfo.cfg.Version}, grpc.FailFast(true))
-			P2pComm.CollectPeerStat(err, peer)
-			if err != nil {
-				log.Error("GetBlocks", "Err", err.Error())
-				if err == pb.ErrVersion {
-					peer.version.SetSupport(false)
-					P2pComm.CollectPeerStat(err, peer)
-				}
-				continue
-			}
-
-			if len(invs.Invs) > len(MaxInvs.Invs) {
-				MaxInvs = invs
-				if len(MaxInvs.GetInvs()) == int(req.GetEnd()-req.GetStart())+1 {
-					break
-				}
-			}
-
+	}
+	var downloadPeers []*Peer
+	peers, infos := m.network.node.GetActivePeers()
+	for paddr, peer := range peers {
+		peerinfo, ok := infos[paddr]
+		if !ok {
+			continue
 		}
-
-		for _, peer := range peers {
-			peerinfo, ok := infos[peer.Addr()]
-			if !ok {
+		if len(pidmap) > 0 { //指定Pid 下载数据
+			if !pidmap[peerinfo.GetName()] {
 				continue
 			}
-			if peerinfo.GetHeader().GetHeight() < req.GetStart() { //高度不符合要求
-				continue
-			}
-
-			downloadPeers = append(downloadPeers, peer)
+		} else if peerinfo.GetHeader().GetHeight() < req.GetStart() { //高度不符合要求
+			continue
 		}
+		downloadPeers = append(downloadPeers, peer)
 	}
-
-	log.Debug("Invs", "Invs show", MaxInvs.GetInvs())
-	if len(MaxInvs.GetInvs()) == 0 {
-		log.Error("GetBlocks", "getInvs", 0)
+	if len(downloadPeers) == 0 {
+		log.Error("GetBlocks", "downloadPeers", 0)
 		return
 	}
 
-	//使用新的下载模式进行下载
-	var bChan = make(chan *pb.BlockPid, 256)
-	invs := MaxInvs.GetInvs()
-	job := NewDownloadJob(m, downloadPeers)
-	var jobcancel int32
-	var maxDownloadRetryCount = 100
-	go func(cancel *int32, invs []*pb.Inventory) {
-		for {
-			if atomic.LoadInt32(cancel) == 1 {
-				return
-			}
-
-			invs = job.DownloadBlock(invs, bChan)
-			if len(invs) == 0 {
-				return
-			}
-			maxDownloadRetryCount--
-			if maxDownloadRetryCount < 0 {
-				return
-			}
-		}
-	}(&jobcancel, invs)
-	i := 0
-	for {
-		timeout := time.NewTimer(time.Minute)
-		select {
-		case <-timeout.C:
-			atomic.StoreInt32(&jobcancel, 1)
-			job.CancelJob()
-			log.Error("download timeout")
-			return
-		case blockpid := <-bChan:
-			newmsg := m.network.node.nodeInfo.client.NewMessage("blockchain", pb.EventSyncBlock, blockpid)
-			m.network.node.nodeInfo.client.SendTimeout(newmsg, false, 60*time.Second)
-			i++
-			if i == len(MaxInvs.GetInvs()) {
-				return
-			}
-		}
-		if !timeout.Stop() {
-			<-timeout.C
-		}
+	//先下载并验证区块头, 再根据区块头并行下载区块
+	headers := m.fetchHeaders(downloadPeers, req.GetStart(), req.GetEnd())
+	if len(headers) == 0 {
+		log.Error("GetBlocks", "headers", 0)
+		return
+	}
+	job := newBlockDownloader(m, downloadPeers, headers)
+	if err := job.Run(); err != nil {
+		log.Error("GetBlocks", "start", req.GetStart(), "end", job.end, "next", job.next, "err", err)
 	}
-
 }
 
 // BlockBroadcast block broadcast
@@ -567,14 +490,145 @@ func (m *Cli) GetNetInfo(msg queue.Message, taskindex int64) {
 	netinfo.Service = m.network.node.nodeInfo.IsOutService()
 	netinfo.Outbounds = int32(m.network.node.Size())
 	netinfo.Inbounds = int32(len(m.network.node.listener.(interface{}).(*listener).p2pserver.getInBoundPeers()))
+	m.network.node.nodeInfo.bandwidth.fillNetInfo(&netinfo)
 	msg.Reply(m.network.client.NewMessage("rpc", pb.EventReplyNetInfo, &netinfo))
 
 }
 
+// ReportPeer 其他模块报告节点的行为, 修改节点评分
+func (m *Cli) ReportPeer(msg queue.Message, taskindex int64) {
+	defer func() {
+		<-m.network.otherFactory
+		log.Debug("ReportPeer", "task complete:", taskindex)
+	}()
+	report := msg.GetData().(*pb.ReportPeer)
+	m.network.node.reportPeer(report.GetPid(), report.GetKind(), report.GetReason())
+}
+
+// GetPeerScores 获取节点评分以及黑名单
+func (m *Cli) GetPeerScores(msg queue.Message, taskindex int64) {
+	defer func() {
+		<-m.network.otherFactory
+		log.Debug("GetPeerScores", "task complete:", taskindex)
+	}()
+	var scores pb.PeerScores
+	scores.Scores = m.network.node.nodeInfo.scores.GetScores()
+	for key, until := range m.network.node.nodeInfo.blacklist.GetBans() {
+		scores.Bans = append(scores.Bans, &pb.BannedPeer{Key: key, Until: until})
+	}
+	sort.Slice(scores.Bans, func(i, j int) bool { return scores.Bans[i].Key < scores.Bans[j].Key })
+	msg.Reply(m.network.client.NewMessage("rpc", pb.EventPeerScores, &scores))
+}
+
+// BanPeer 把节点公钥或者地址加入黑名单
+func (m *Cli) BanPeer(msg queue.Message, taskindex int64) {
+	defer func() {
+		<-m.network.otherFactory
+		log.Debug("BanPeer", "task complete:", taskindex)
+	}()
+	req := msg.GetData().(*pb.ReqBanPeer)
+	if req.GetKey() == "" {
+		msg.Reply(m.network.client.NewMessage("rpc", pb.EventBanPeer, &pb.Reply{Msg: []byte(pb.ErrInvalidParam.Error())}))
+		return
+	}
+	m.network.node.banPeer(req.GetKey(), req.GetSeconds())
+	msg.Reply(m.network.client.NewMessage("rpc", pb.EventBanPeer, &pb.Reply{IsOk: true}))
+}
+
+// UnbanPeer 从黑名单中删除节点公钥或者地址
+func (m *Cli) UnbanPeer(msg queue.Message, taskindex int64) {
+	defer func() {
+		<-m.network.otherFactory
+		log.Debug("UnbanPeer", "task complete:", taskindex)
+	}()
+	req := msg.GetData().(*pb.ReqBanPeer)
+	if !m.network.node.unbanPeer(req.GetKey()) {
+		msg.Reply(m.network.client.NewMessage("rpc", pb.EventUnbanPeer, &pb.Reply{Msg: []byte("peer not banned")}))
+		return
+	}
+	msg.Reply(m.network.client.NewMessage("rpc", pb.EventUnbanPeer, &pb.Reply{IsOk: true}))
+}
+
+// GetTxProof 轻节点请求交易的默克尔证明, 依次请求高度最高的几个节点, 证明由轻节点自己验证
+func (m *Cli) GetTxProof(msg queue.Message, taskindex int64) {
+	defer func() {
+		<-m.network.otherFactory
+		log.Debug("GetTxProof", "task complete:", taskindex)
+	}()
+	req := &pb.P2PGetTxProof{Version: m.network.node.nodeInfo.cfg.Version, Hash: msg.GetData().(*pb.P2PGetTxProof).GetHash()}
+	err := pb.ErrNoPeer
+	for _, peer := range m.proofPeers(0) {
+		ctx, cancel := context.WithTimeout(context.Background(), DefaultSendTimeout)
+		detail, e := peer.mconn.gcli.GetTxProof(ctx, req, grpc.FailFast(true))
+		cancel()
+		if e != nil {
+			log.Debug("GetTxProof", "peer", peer.Addr(), "err", e)
+			err = remoteErr(e)
+			continue
+		}
+		msg.Reply(m.network.client.NewMessage("blockchain", pb.EventGetTxProof, detail))
+		return
+	}
+	msg.Reply(m.network.client.NewMessage("blockchain", pb.EventGetTxProof, err))
+}
+
+// GetStateProof 轻节点请求指定高度的状态证明, 只请求高度不低于该高度的节点
+func (m *Cli) GetStateProof(msg queue.Message, taskindex int64) {
+	defer func() {
+		<-m.network.otherFactory
+		log.Debug("GetStateProof", "task complete:", taskindex)
+	}()
+	data := msg.GetData().(*pb.P2PGetStateProof)
+	req := &pb.P2PGetStateProof{Version: m.network.node.nodeInfo.cfg.Version, Height: data.GetHeight(), Keys: data.GetKeys()}
+	err := pb.ErrNoPeer
+	for _, peer := range m.proofPeers(req.GetHeight()) {
+		ctx, cancel := context.WithTimeout(context.Background(), DefaultSendTimeout)
+		proof, e := peer.mconn.gcli.GetStateProof(ctx, req, grpc.FailFast(true))
+		cancel()
+		if e != nil {
+			log.Debug("GetStateProof", "peer", peer.Addr(), "err", e)
+			err = remoteErr(e)
+			continue
+		}
+		msg.Reply(m.network.client.NewMessage("store", pb.EventGetStateProof, proof))
+		return
+	}
+	msg.Reply(m.network.client.NewMessage("store", pb.EventGetStateProof, err))
+}
+
+//proofPeers 高度不低于 height 的节点, 按高度从高到低排序, 最多 maxProofPeers 个
+func (m *Cli) proofPeers(height int64) []*Peer {
+	peers, infos := m.network.node.GetActivePeers()
+	var result []*Peer
+	for addr, peer := range peers {
+		if peer.version.HasCap(CapLightService) && infos[addr].GetHeader().GetHeight() >= height {
+			result = append(result, peer)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return infos[result[i].Addr()].GetHeader().GetHeight() > infos[result[j].Addr()].GetHeader().GetHeight()
+	})
+	if len(result) > maxProofPeers {
+		result = result[:maxProofPeers]
+	}
+	return result
+}
+
+//remoteErr 把对方节点返回的 grpc 错误转换成 types 中定义的错误, 方便调用者判断
+func remoteErr(err error) error {
+	msg := status.Convert(err).Message()
+	for _, e := range []error{pb.ErrTxNotExist, pb.ErrHeightNotExist, pb.ErrInvalidParam} {
+		if msg == e.Error() {
+			return e
+		}
+	}
+	return err
+}
+
 // CheckPeerNatOk check peer is ok or not
-func (m *Cli) CheckPeerNatOk(addr string) bool {
+func (m *Cli) CheckPeerNatOk(addr string, nodeInfo *NodeInfo) bool {
 	//连接自己的地址信息做测试
-	return !(len(P2pComm.AddrRouteble([]string{addr})) == 0)
+	return !(len(P2pComm.AddrRouteble([]string{addr}, nodeInfo)) == 0)
 
 }
 
@@ -585,7 +639,8 @@ func (m *Cli) CheckSelf(addr string, nodeinfo *NodeInfo) bool {
 		log.Error("AddrRouteble", "NewNetAddressString", err.Error())
 		return false
 	}
-	conn, err := netaddr.DialTimeout(VERSION)
+	creds := nodeinfo.newPeerCreds()
+	conn, err := netaddr.DialTimeout(creds, nodeinfo.bandwidth, nil)
 	if err != nil {
 		return false
 	}
@@ -597,6 +652,10 @@ func (m *Cli) CheckSelf(addr string, nodeinfo *NodeInfo) bool {
 		return false
 	}
 	_, selfName := nodeinfo.addrBook.GetPrivPubKey()
+	if creds != nil {
+		//tls 连接时使用握手验证过的公钥
+		return creds.PubKey() == selfName
+	}
 	return resp.GetName() == selfName
 
 }
@@ -653,7 +712,7 @@ func (m *Cli) getLocalPeerInfo() (*pb.P2PPeerInfo, error) {
 	localpeerinfo.MempoolSize = int32(meminfo.GetSize())
 	if m.network.node.nodeInfo.GetExternalAddr().IP == nil {
 		localpeerinfo.Addr = LocalAddr
-		localpeerinfo.Port = int32(defaultPort)
+		localpeerinfo.Port = int32(m.network.node.nodeInfo.port)
 	} else {
 		localpeerinfo.Addr = m.network.node.nodeInfo.GetExternalAddr().IP.String()
 		localpeerinfo.Port = int32(m.network.node.nodeInfo.GetExternalAddr().Port)
@@ -661,3 +720,14 @@ func (m *Cli) getLocalPeerInfo() (*pb.P2PPeerInfo, error) {
 
 	return &localpeerinfo, nil
 }
+
+//probeCompress 不声明能力的旧版本节点也可能支持压缩, 用一个压缩的请求试探
+func probeCompress(peer *Peer, version int32) bool {
+	_, err := peer.mconn.gcli.GetHeaders(context.Background(), &pb.P2PGetHeaders{StartHeight: 0, EndHeight: 0, Version: version},
+		grpc.FailFast(true), grpc.UseCompressor("gzip"))
+	if !isCompressSupport(err) {
+		log.Info("probeCompress", "compress not support", peer.Addr())
+		return false
+	}
+	return true
+}
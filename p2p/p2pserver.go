@@ -18,6 +18,7 @@ import (
 	pb "github.com/33cn/chain33/types"
 	"golang.org/x/net/context"
 	pr "google.golang.org/grpc/peer"
+	"google.golang.org/grpc/stats"
 )
 
 // P2pserver object information
@@ -27,6 +28,7 @@ type P2pserver struct {
 	node         *Node
 	streams      map[pb.P2Pgservice_ServerStreamSendServer]chan interface{}
 	inboundpeers map[string]*innerpeer
+	peerCaps     map[string]uint64 //Version2 中协商的节点能力, key 为 peerCapsKey, 连接断开时删除
 	deleteSChan  chan pb.P2Pgservice_ServerStreamSendServer
 	closed       int32
 }
@@ -59,6 +61,7 @@ func NewP2pServer() *P2pserver {
 		streams:      make(map[pb.P2Pgservice_ServerStreamSendServer]chan interface{}),
 		deleteSChan:  make(chan pb.P2Pgservice_ServerStreamSendServer, 1024),
 		inboundpeers: make(map[string]*innerpeer),
+		peerCaps:     make(map[string]uint64),
 	}
 
 }
@@ -66,10 +69,13 @@ func NewP2pServer() *P2pserver {
 // Ping p2pserver ping
 func (s *P2pserver) Ping(ctx context.Context, in *pb.P2PPing) (*pb.P2PPong, error) {
 	log.Debug("ping")
-	if !P2pComm.CheckSign(in) {
+	if !P2pComm.CheckSign(in) || !checkPingPubKey(ctx, in) {
 		log.Error("Ping", "p2p server", "check sig err")
 		return nil, pb.ErrPing
 	}
+	if !s.node.nodeInfo.peerAllowed(hex.EncodeToString(in.GetSign().GetPubkey())) {
+		return nil, pb.ErrPeerPubKey
+	}
 	var peerip string
 	var err error
 	getctx, ok := pr.FromContext(ctx)
@@ -85,6 +91,7 @@ func (s *P2pserver) Ping(ctx context.Context, in *pb.P2PPing) (*pb.P2PPong, erro
 	if err == nil {
 		if !s.node.nodeInfo.blacklist.Has(peeraddr) {
 			s.node.nodeInfo.addrBook.AddAddress(remoteNetwork, nil)
+			s.node.nodeInfo.addrBook.SetAddrPubKey(peeraddr, pubKeyFromContext(ctx))
 		}
 
 	}
@@ -141,6 +148,12 @@ func (s *P2pserver) Version2(ctx context.Context, in *pb.P2PVersion) (*pb.P2PVer
 	if !s.checkVersion(in.GetVersion()) {
 		return nil, pb.ErrVersion
 	}
+	if !s.node.nodeInfo.peerAllowed(remoteNodeKey(ctx, in.GetUserAgent())) {
+		log.Error("Version2", "peer not allowed", in.GetUserAgent(), "addr", peerip)
+		return nil, pb.ErrPeerPubKey
+	}
+	caps := s.node.nodeInfo.caps
+	s.setPeerCaps(peerCapsKey(ctx), negotiateCaps(caps, in.GetCapabilities()))
 
 	log.Debug("Version2", "before", "GetPrivPubKey")
 	_, pub := s.node.nodeInfo.addrBook.GetPrivPubKey()
@@ -154,11 +167,17 @@ func (s *P2pserver) Version2(ctx context.Context, in *pb.P2PVersion) (*pb.P2PVer
 	if err == nil {
 		if !s.node.nodeInfo.blacklist.Has(remoteNetwork.String()) {
 			s.node.nodeInfo.addrBook.AddAddress(remoteNetwork, nil)
+			s.node.nodeInfo.addrBook.SetAddrPubKey(remoteNetwork.String(), pubKeyFromContext(ctx))
+		}
+		//对方声明的外网 ip 和连接的 ip 一致时才认为可以被其他节点连接
+		if host, _, err := net.SplitHostPort(in.AddrFrom); err == nil && host == peerip {
+			s.node.nodeInfo.addDHTNode(remoteNodeKey(ctx, in.GetUserAgent()), in.GetNodeID(), remoteNetwork.String())
 		}
 	}
 
 	return &pb.P2PVersion{Version: s.node.nodeInfo.cfg.Version, Service: int64(s.node.nodeInfo.ServiceTy()), Nonce: in.Nonce,
-		AddrFrom: in.AddrRecv, AddrRecv: fmt.Sprintf("%v:%v", peerip, port), UserAgent: pub}, nil
+		AddrFrom: in.AddrRecv, AddrRecv: fmt.Sprintf("%v:%v", peerip, port), UserAgent: pub, NodeID: s.node.nodeInfo.dht.self,
+		Capabilities: caps}, nil
 
 }
 
@@ -177,9 +196,7 @@ func (s *P2pserver) SoftVersion(ctx context.Context, in *pb.P2PPing) (*pb.Reply,
 // BroadCastTx broadcast transactions of p2pserver
 func (s *P2pserver) BroadCastTx(ctx context.Context, in *pb.P2PTx) (*pb.Reply, error) {
 	log.Debug("p2pServer RECV TRANSACTION", "in", in)
-	client := s.node.nodeInfo.client
-	msg := client.NewMessage("mempool", pb.EventTx, in.Tx)
-	client.Send(msg, false)
+	s.node.sendTxToMempool(pubKeyFromContext(ctx), in.Tx)
 	return &pb.Reply{IsOk: true, Msg: []byte("ok")}, nil
 }
 
@@ -243,6 +260,8 @@ func (s *P2pserver) GetData(in *pb.P2PGetData, stream pb.P2Pgservice_GetDataServ
 	}
 	invs := in.GetInvs()
 	client := s.node.nodeInfo.client
+	//紧凑区块中缺少的交易所在的区块
+	blocks := make(map[string]*pb.Block)
 	for _, inv := range invs { //过滤掉不需要的数据
 		var invdata pb.InvData
 		var memtx = make(map[string]*pb.Transaction)
@@ -285,6 +304,19 @@ func (s *P2pserver) GetData(in *pb.P2PGetData, stream pb.P2Pgservice_GetDataServ
 				p2pInvData = append(p2pInvData, &invdata)
 			}
 
+		} else if inv.GetTy() == msgBlockTx {
+			block, ok := blocks[string(inv.GetHash())]
+			if !ok {
+				block = s.loadBlockByHash(inv.GetHash())
+				blocks[string(inv.GetHash())] = block
+			}
+			index := int(inv.GetIndex())
+			if block == nil || index < 0 || index >= len(block.GetTxs()) {
+				continue
+			}
+			invdata.Ty = msgBlockTx
+			invdata.Value = &pb.InvData_Tx{Tx: block.Txs[index]}
+			p2pInvData = append(p2pInvData, &invdata)
 		}
 	}
 
@@ -399,19 +431,36 @@ func (s *P2pserver) ServerStreamSend(in *pb.P2PPing, stream pb.P2Pgservice_Serve
 		return fmt.Errorf("beyound max inbound num")
 	}
 	log.Debug("ServerStreamSend")
+	if !checkPingPubKey(stream.Context(), in) {
+		return pb.ErrStreamPing
+	}
 	peername := hex.EncodeToString(in.GetSign().GetPubkey())
+	if !s.node.nodeInfo.peerAllowed(peername) {
+		return pb.ErrPeerPubKey
+	}
+	capsKey := peerCapsKey(stream.Context())
 	dataChain := s.addStreamHandler(stream)
 	for data := range dataChain {
 		if s.IsClose() {
 			return fmt.Errorf("node close")
 		}
+		//白名单修改或者加入黑名单之后断开连接
+		if !s.node.nodeInfo.peerAllowed(peername) {
+			s.deleteSChan <- stream
+			s.deleteInBoundPeerInfo(peername)
+			return pb.ErrPeerPubKey
+		}
 		p2pdata := new(pb.BroadCastData)
 		if block, ok := data.(*pb.P2PBlock); ok {
 			if block.GetBlock() != nil {
 				log.Debug("ServerStreamSend", "blockhash", hex.EncodeToString(block.GetBlock().GetTxHash()))
 			}
 
-			p2pdata.Value = &pb.BroadCastData_Block{Block: block}
+			if block.GetBlock() != nil && s.hasPeerCap(capsKey, CapCompactBlock) {
+				p2pdata.Value = &pb.BroadCastData_CompactBlock{CompactBlock: s.node.compactBlocks.get(block.GetBlock(), s.node.filter)}
+			} else {
+				p2pdata.Value = &pb.BroadCastData_Block{Block: block}
+			}
 		} else if tx, ok := data.(*pb.P2PTx); ok {
 			log.Debug("ServerStreamSend", "txhash", hex.EncodeToString(tx.GetTx().Hash()))
 			p2pdata.Value = &pb.BroadCastData_Tx{Tx: tx}
@@ -445,7 +494,7 @@ func (s *P2pserver) ServerStreamRead(stream pb.P2Pgservice_ServerStreamReadServe
 	log.Debug("StreamRead")
 	var hash [64]byte
 	var peeraddr, peername string
-	defer s.deleteInBoundPeerInfo(peername)
+	defer func() { s.deleteInBoundPeerInfo(peername) }()
 	var in = new(pb.BroadCastData)
 	var err error
 	for {
@@ -461,19 +510,22 @@ func (s *P2pserver) ServerStreamRead(stream pb.P2Pgservice_ServerStreamReadServe
 			log.Error("ServerStreamRead", "Recv", err)
 			return err
 		}
+		if peername != "" && !s.node.nodeInfo.peerAllowed(peername) {
+			return pb.ErrPeerPubKey
+		}
 
 		if block := in.GetBlock(); block != nil {
 			hex.Encode(hash[:], block.GetBlock().Hash())
 			blockhash := string(hash[:])
 
-			Filter.GetLock()                     //通过锁的形式，确保原子操作
-			if Filter.QueryRecvData(blockhash) { //已经注册了相同的区块hash，则不会再发送给blockchain
-				Filter.ReleaseLock() //释放锁
+			s.node.filter.GetLock()                     //通过锁的形式，确保原子操作
+			if s.node.filter.QueryRecvData(blockhash) { //已经注册了相同的区块hash，则不会再发送给blockchain
+				s.node.filter.ReleaseLock() //释放锁
 				continue
 			}
 
-			Filter.RegRecvData(blockhash) //注册已经收到的区块
-			Filter.ReleaseLock()          //释放锁
+			s.node.filter.RegRecvData(blockhash) //注册已经收到的区块
+			s.node.filter.ReleaseLock()          //释放锁
 
 			log.Info("ServerStreamRead", " Recv block==+=====+=>Height", block.GetBlock().GetHeight(),
 				"block size(KB)", float32(len(pb.Encode(block)))/1024, "block hash", blockhash)
@@ -482,30 +534,34 @@ func (s *P2pserver) ServerStreamRead(stream pb.P2Pgservice_ServerStreamReadServe
 				s.node.nodeInfo.client.Send(msg, false)
 			}
 
+		} else if cblock := in.GetCompactBlock(); cblock != nil {
+			s.node.onCompactBlock(cblock, peername)
 		} else if tx := in.GetTx(); tx != nil {
 			hex.Encode(hash[:], tx.GetTx().Hash())
 			txhash := string(hash[:])
 			log.Debug("ServerStreamRead", "txhash:", txhash)
-			Filter.GetLock()
-			if Filter.QueryRecvData(txhash) { //同上
-				Filter.ReleaseLock()
+			s.node.filter.GetLock()
+			if s.node.filter.QueryRecvData(txhash) { //同上
+				s.node.filter.ReleaseLock()
 				continue
 			}
-			Filter.RegRecvData(txhash)
-			Filter.ReleaseLock()
+			s.node.filter.RegRecvData(txhash)
+			s.node.filter.ReleaseLock()
 			if tx.GetTx() != nil {
-				msg := s.node.nodeInfo.client.NewMessage("mempool", pb.EventTx, tx.GetTx())
-				s.node.nodeInfo.client.Send(msg, false)
+				s.node.sendTxToMempool(peername, tx.GetTx())
 			}
 			//Filter.RegRecvData(txhash)
 
 		} else if ping := in.GetPing(); ping != nil { ///被远程节点初次连接后，会收到ping 数据包，收到后注册到inboundpeers.
 			//Ping package
 
-			if !P2pComm.CheckSign(ping) {
+			if !P2pComm.CheckSign(ping) || !checkPingPubKey(stream.Context(), ping) {
 				log.Error("ServerStreamRead", "check stream", "check sig err")
 				return pb.ErrStreamPing
 			}
+			if !s.node.nodeInfo.peerAllowed(hex.EncodeToString(ping.GetSign().GetPubkey())) {
+				return pb.ErrPeerPubKey
+			}
 
 			getctx, ok := pr.FromContext(stream.Context())
 			if ok && s.node.Size() > 0 {
@@ -587,6 +643,77 @@ func (s *P2pserver) CollectInPeers2(ctx context.Context, in *pb.P2PPing) (*pb.Pe
 	return &pb.PeersReply{Peers: p2pPeers}, nil
 }
 
+// FindNode 返回路由表中和 target 距离最近的节点
+func (s *P2pserver) FindNode(ctx context.Context, in *pb.P2PFindNode) (*pb.P2PNodes, error) {
+	if !s.checkVersion(in.GetVersion()) {
+		return nil, pb.ErrVersion
+	}
+	if len(in.GetTarget()) != nodeIDLen {
+		return nil, pb.ErrInvalidParam
+	}
+	nodes := s.node.nodeInfo.dht.closest(in.GetTarget(), dhtBucketSize, s.node.nodeInfo.blacklist.Has)
+	return &pb.P2PNodes{Nodes: nodes}, nil
+}
+
+// GetTxProof 轻节点获取交易以及交易在区块中的默克尔证明
+func (s *P2pserver) GetTxProof(ctx context.Context, in *pb.P2PGetTxProof) (*pb.TransactionDetail, error) {
+	if !s.checkVersion(in.GetVersion()) {
+		return nil, pb.ErrVersion
+	}
+	if s.node.nodeInfo.caps&CapLightService == 0 {
+		return nil, pb.ErrNotSupport
+	}
+	resp, err := s.queryLocal("blockchain", pb.EventQueryTx, &pb.ReqHash{Hash: in.GetHash()})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.TransactionDetail), nil
+}
+
+// GetStateProof 轻节点获取指定高度的状态证明
+func (s *P2pserver) GetStateProof(ctx context.Context, in *pb.P2PGetStateProof) (*pb.P2PStateProof, error) {
+	if !s.checkVersion(in.GetVersion()) {
+		return nil, pb.ErrVersion
+	}
+	if s.node.nodeInfo.caps&CapLightService == 0 {
+		return nil, pb.ErrNotSupport
+	}
+	if len(in.GetKeys()) == 0 || len(in.GetKeys()) > maxStateProofKeys {
+		return nil, pb.ErrInvalidParam
+	}
+	resp, err := s.queryLocal("blockchain", pb.EventGetHeaders, &pb.ReqBlocks{Start: in.GetHeight(), End: in.GetHeight()})
+	if err != nil {
+		return nil, err
+	}
+	headers := resp.(*pb.Headers).GetItems()
+	if len(headers) != 1 {
+		return nil, pb.ErrHeightNotExist
+	}
+	stateHash := headers[0].GetStateHash()
+	resp, err = s.queryLocal("store", pb.EventStoreGetProof, &pb.StoreGet{StateHash: stateHash, Keys: in.GetKeys()})
+	if err != nil {
+		return nil, err
+	}
+	proof := resp.(*pb.StoreReplyProof)
+	return &pb.P2PStateProof{StateHash: stateHash, Values: proof.GetValues(), Proofs: proof.GetProofs()}, nil
+}
+
+//queryLocal 向本节点的其他模块发送请求并等待回复
+func (s *P2pserver) queryLocal(topic string, ty int64, data interface{}) (interface{}, error) {
+	client := s.node.nodeInfo.client
+	msg := client.NewMessage(topic, ty, data)
+	err := client.SendTimeout(msg, true, time.Minute)
+	if err != nil {
+		log.Error("queryLocal", "topic", topic, "Error", err.Error())
+		return nil, err
+	}
+	resp, err := client.WaitTimeout(msg, time.Minute)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetData(), nil
+}
+
 func (s *P2pserver) checkVersion(version int32) bool {
 
 	if version < s.node.nodeInfo.cfg.VerMin || version > s.node.nodeInfo.cfg.VerMax {
@@ -620,6 +747,27 @@ func (s *P2pserver) loadMempool() (map[string]*pb.Transaction, error) {
 	return txmap, nil
 }
 
+//loadBlockByHash 从 blockchain 获取区块, 不存在时返回 nil
+func (s *P2pserver) loadBlockByHash(hash []byte) *pb.Block {
+	client := s.node.nodeInfo.client
+	msg := client.NewMessage("blockchain", pb.EventGetBlockByHashes, &pb.ReqHashes{Hashes: [][]byte{hash}})
+	err := client.SendTimeout(msg, true, time.Minute)
+	if err != nil {
+		log.Error("loadBlockByHash", "Error", err.Error())
+		return nil
+	}
+	resp, err := client.WaitTimeout(msg, time.Minute)
+	if err != nil {
+		log.Error("loadBlockByHash", "Error", err.Error())
+		return nil
+	}
+	details, ok := resp.GetData().(*pb.BlockDetails)
+	if !ok || len(details.GetItems()) == 0 || details.Items[0] == nil {
+		return nil
+	}
+	return details.Items[0].GetBlock()
+}
+
 func (s *P2pserver) manageStream() {
 	go s.deleteDisableStream()
 	go func() { //发送空的block stream ping
@@ -699,6 +847,62 @@ func (s *P2pserver) deleteInBoundPeerInfo(peername string) {
 
 }
 
+//connCapsKey grpc 连接 context 中保存连接标识的 key
+type connCapsKey struct{}
+
+//capsStatsHandler 给每个连接分配标识, Version2 协商的能力按照连接标识保存, 连接断开时删除
+type capsStatsHandler struct {
+	server *P2pserver
+	connID uint64
+}
+
+func (h *capsStatsHandler) TagConn(ctx context.Context, info *stats.ConnTagInfo) context.Context {
+	id := atomic.AddUint64(&h.connID, 1)
+	return context.WithValue(ctx, connCapsKey{}, fmt.Sprintf("conn-%d-%v", id, info.RemoteAddr))
+}
+
+func (h *capsStatsHandler) HandleConn(ctx context.Context, cs stats.ConnStats) {
+	if _, ok := cs.(*stats.ConnEnd); ok {
+		h.server.deletePeerCaps(peerCapsKey(ctx))
+	}
+}
+
+func (h *capsStatsHandler) TagRPC(ctx context.Context, info *stats.RPCTagInfo) context.Context {
+	return ctx
+}
+
+func (h *capsStatsHandler) HandleRPC(ctx context.Context, rs stats.RPCStats) {}
+
+//peerCapsKey Version2 协商的能力按连接保存, 同一个连接上的 stream 用相同的 key 查找, 不使用对方声明的 UserAgent.
+//没有设置 capsStatsHandler 时使用连接的远程地址
+func peerCapsKey(ctx context.Context) string {
+	if key, ok := ctx.Value(connCapsKey{}).(string); ok {
+		return key
+	}
+	if getctx, ok := pr.FromContext(ctx); ok && getctx.Addr != nil {
+		return getctx.Addr.String()
+	}
+	return ""
+}
+
+func (s *P2pserver) setPeerCaps(peername string, caps uint64) {
+	s.imtx.Lock()
+	defer s.imtx.Unlock()
+	s.peerCaps[peername] = caps
+}
+
+func (s *P2pserver) deletePeerCaps(peername string) {
+	s.imtx.Lock()
+	defer s.imtx.Unlock()
+	delete(s.peerCaps, peername)
+}
+
+func (s *P2pserver) hasPeerCap(peername string, c uint64) bool {
+	s.imtx.Lock()
+	defer s.imtx.Unlock()
+	return s.peerCaps[peername]&c != 0
+}
+
 func (s *P2pserver) getInBoundPeerInfo(peername string) *innerpeer {
 	s.imtx.Lock()
 	defer s.imtx.Unlock()
@@ -0,0 +1,44 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	pr "google.golang.org/grpc/peer"
+	"google.golang.org/grpc/stats"
+)
+
+func TestPeerCaps(t *testing.T) {
+	assert.Equal(t, "", peerCapsKey(context.Background()))
+	addr := &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 13802}
+	ctx := pr.NewContext(context.Background(), &pr.Peer{Addr: addr})
+	key := peerCapsKey(ctx)
+	assert.Equal(t, addr.String(), key)
+
+	s := NewP2pServer()
+	s.setPeerCaps(key, CapCompactBlock)
+	assert.True(t, s.hasPeerCap(key, CapCompactBlock))
+	assert.False(t, s.hasPeerCap(key, CapLightService))
+	//对方声明的节点名称不能修改连接协商的能力
+	assert.False(t, s.hasPeerCap("peername", CapCompactBlock))
+
+	//连接断开时删除协商的能力
+	h := &capsStatsHandler{server: s}
+	connCtx := h.TagConn(ctx, &stats.ConnTagInfo{RemoteAddr: addr})
+	connKey := peerCapsKey(connCtx)
+	assert.NotEqual(t, key, connKey)
+	s.setPeerCaps(connKey, CapCompactBlock)
+	h.HandleConn(connCtx, &stats.ConnBegin{})
+	assert.True(t, s.hasPeerCap(connKey, CapCompactBlock))
+	h.HandleConn(connCtx, &stats.ConnEnd{})
+	assert.False(t, s.hasPeerCap(connKey, CapCompactBlock))
+	assert.True(t, s.hasPeerCap(key, CapCompactBlock))
+	s.deletePeerCaps(key)
+	assert.Equal(t, 0, len(s.peerCaps))
+}
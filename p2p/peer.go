@@ -42,7 +42,9 @@ type Peer struct {
 	isclose      int32
 	version      *Version
 	name         string //远程节点的name
+	pubkey       string //tls 握手时验证过的远程节点公钥, 不使用 tls 时为空
 	mconn        *MConnection
+	compress     *compressOption //握手协商之后决定请求是否压缩, 为 nil 时不压缩
 	peerAddr     *NetAddress
 	peerStat     *Stat
 	taskChan     chan interface{} //tx block
@@ -68,6 +70,7 @@ type Version struct {
 	mtx            sync.Mutex
 	version        int32
 	versionSupport bool
+	caps           uint64 //握手时协商的能力
 }
 
 // Stat object information
@@ -111,6 +114,20 @@ func (v *Version) IsSupport() bool {
 	return v.versionSupport
 }
 
+// SetCaps set negotiated capabilities of peer
+func (v *Version) SetCaps(caps uint64) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	v.caps = caps
+}
+
+// HasCap is peer support the capability
+func (v *Version) HasCap(c uint64) bool {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	return v.caps&c != 0
+}
+
 // SetVersion set version number
 func (v *Version) SetVersion(ver int32) {
 	v.mtx.Lock()
@@ -141,6 +158,10 @@ func (p *Peer) heartBeat() {
 			go p.sendStream()
 			go p.readStream()
 			break
+		} else if err == pb.ErrPeerPubKey {
+			//不在许可网络的白名单中
+			p.node.destroyPeer(p)
+			return
 		} else {
 			time.Sleep(time.Second * 5)
 			continue
@@ -251,15 +272,19 @@ func (p *Peer) sendStream() {
 						}
 					}
 
-					p2pdata.Value = &pb.BroadCastData_Block{Block: block}
-					Filter.RegRecvData(blockhash)
+					if p.version.HasCap(CapCompactBlock) {
+						p2pdata.Value = &pb.BroadCastData_CompactBlock{CompactBlock: p.node.compactBlocks.get(block.GetBlock(), p.node.filter)}
+					} else {
+						p2pdata.Value = &pb.BroadCastData_Block{Block: block}
+					}
+					p.node.filter.RegRecvData(blockhash)
 
 				} else if tx, ok := task.(*pb.P2PTx); ok {
 					hex.Encode(hash[:], tx.GetTx().Hash())
 					txhash := string(hash[:])
 					log.Debug("sendStream", "will send tx", txhash)
 					p2pdata.Value = &pb.BroadCastData_Tx{Tx: tx}
-					Filter.RegRecvData(txhash)
+					p.node.filter.RegRecvData(txhash)
 				}
 
 				err := resp.Send(p2pdata)
@@ -267,7 +292,7 @@ func (p *Peer) sendStream() {
 				if err != nil {
 					log.Error("sendStream", "send", err)
 					if grpc.Code(err) == codes.Unimplemented { //maybe order peers delete peer to BlackList
-						p.node.nodeInfo.blacklist.Add(p.Addr(), 3600)
+						p.node.nodeInfo.blacklist.AddPeer(p, 3600)
 					}
 					time.Sleep(time.Second) //have a rest
 					resp.CloseSend()
@@ -327,7 +352,7 @@ func (p *Peer) readStream() {
 				log.Error("readStream", "recv,err:", err.Error())
 				resp.CloseSend()
 				if grpc.Code(err) == codes.Unimplemented { //maybe order peers delete peer to BlackList
-					p.node.nodeInfo.blacklist.Add(p.Addr(), 3600)
+					p.node.nodeInfo.blacklist.AddPeer(p, 3600)
 				}
 				//beyound max inbound num
 				if strings.Contains(err.Error(), "beyound max inbound num") {
@@ -344,13 +369,13 @@ func (p *Peer) readStream() {
 					//如果已经有登记过的消息记录，则不发送给本地blockchain
 					hex.Encode(hash[:], block.GetBlock().Hash())
 					blockhash := string(hash[:])
-					Filter.GetLock()
-					if Filter.QueryRecvData(blockhash) {
-						Filter.ReleaseLock()
+					p.node.filter.GetLock()
+					if p.node.filter.QueryRecvData(blockhash) {
+						p.node.filter.ReleaseLock()
 						continue
 					}
-					Filter.RegRecvData(blockhash)
-					Filter.ReleaseLock()
+					p.node.filter.RegRecvData(blockhash)
+					p.node.filter.ReleaseLock()
 					//判断比自己低的区块，则不发送给blockchain
 
 					height, err := pcli.GetBlockHeight(p.node.nodeInfo)
@@ -372,21 +397,22 @@ func (p *Peer) readStream() {
 					//Filter.RegRecvData(blockhash) //添加发送登记，下次通过stream 接收同样的消息的时候可以过滤
 				}
 
+			} else if cblock := data.GetCompactBlock(); cblock != nil {
+				p.node.onCompactBlock(cblock, p.GetPeerName())
 			} else if tx := data.GetTx(); tx != nil {
 
 				if tx.GetTx() != nil {
 					hex.Encode(hash[:], tx.Tx.Hash())
 					txhash := string(hash[:])
 					log.Debug("readStream", "tx", txhash)
-					Filter.GetLock()
-					if Filter.QueryRecvData(txhash) {
-						Filter.ReleaseLock()
+					p.node.filter.GetLock()
+					if p.node.filter.QueryRecvData(txhash) {
+						p.node.filter.ReleaseLock()
 						continue //处理方式同上
 					}
-					Filter.RegRecvData(txhash)
-					Filter.ReleaseLock()
-					msg := p.node.nodeInfo.client.NewMessage("mempool", pb.EventTx, tx.GetTx())
-					p.node.nodeInfo.client.Send(msg, false)
+					p.node.filter.RegRecvData(txhash)
+					p.node.filter.ReleaseLock()
+					p.node.sendTxToMempool(p.nodeKey(), tx.GetTx())
 					//Filter.RegRecvData(txhash) //登记
 				}
 			}
@@ -431,6 +457,28 @@ func (p *Peer) SetPeerName(name string) {
 	p.name = name
 }
 
+// SetPubKey set pubkey of peer verified by tls
+func (p *Peer) SetPubKey(pubkey string) {
+	p.mutx.Lock()
+	defer p.mutx.Unlock()
+	p.pubkey = pubkey
+}
+
+// PubKey return pubkey of peer, empty if tls is disabled
+func (p *Peer) PubKey() string {
+	p.mutx.Lock()
+	defer p.mutx.Unlock()
+	return p.pubkey
+}
+
+//key 节点的标识, 开启 tls 时为握手验证过的节点公钥, 否则为节点地址
+func (p *Peer) key() string {
+	if pubkey := p.PubKey(); pubkey != "" {
+		return pubkey
+	}
+	return p.Addr()
+}
+
 // GetPeerName get name of peer
 func (p *Peer) GetPeerName() string {
 	p.mutx.Lock()
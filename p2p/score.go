@@ -0,0 +1,208 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/types"
+)
+
+//节点评分:
+//按节点公钥记录无效区块, 错误交易, 超时以及提供有效数据的次数, 评分低于 disconnectScore 时断开连接,
+//低于 banScore 时加入黑名单, 黑名单保存在 addrbook 数据库中, 重启之后仍然有效.
+//评分每隔 CheckPeerScoreInterval 向 0 恢复 1 分
+
+//每种行为对评分的影响
+var peerScoreWeight = map[int32]int64{
+	types.PeerInvalidBlock: -50,
+	types.PeerBadTx:        -10,
+	types.PeerTimeout:      -2,
+	types.PeerUsefulData:   1,
+}
+
+const (
+	maxPeerScore    = 100
+	disconnectScore = -50
+	banScore        = -100
+	//评分过低的节点加入黑名单的时间
+	scoreBanSeconds = 24 * 3600
+)
+
+//PeerScores 所有节点的评分
+type PeerScores struct {
+	mtx    sync.Mutex
+	scores map[string]*types.PeerScore
+}
+
+//NewPeerScores new peer scores
+func NewPeerScores() *PeerScores {
+	return &PeerScores{scores: make(map[string]*types.PeerScore)}
+}
+
+//Report 记录节点的一次行为, 返回新的评分
+func (ps *PeerScores) Report(pid, addr string, kind int32) int64 {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+	score, ok := ps.scores[pid]
+	if !ok {
+		score = &types.PeerScore{Pid: pid}
+		ps.scores[pid] = score
+	}
+	if addr != "" {
+		score.Addr = addr
+	}
+	switch kind {
+	case types.PeerInvalidBlock:
+		score.InvalidBlocks++
+	case types.PeerBadTx:
+		score.BadTxs++
+	case types.PeerTimeout:
+		score.Timeouts++
+	case types.PeerUsefulData:
+		score.Useful++
+	}
+	score.Score += peerScoreWeight[kind]
+	if score.Score > maxPeerScore {
+		score.Score = maxPeerScore
+	}
+	if score.Score < banScore {
+		score.Score = banScore
+	}
+	return score.Score
+}
+
+//Reset 清除节点的评分
+func (ps *PeerScores) Reset(pid string) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+	delete(ps.scores, pid)
+}
+
+//GetScores 返回所有节点的评分, 评分低的在前面
+func (ps *PeerScores) GetScores() []*types.PeerScore {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+	var scores []*types.PeerScore
+	for _, score := range ps.scores {
+		copyScore := *score
+		scores = append(scores, &copyScore)
+	}
+	sort.Slice(scores, func(i, j int) bool {
+		if scores[i].Score != scores[j].Score {
+			return scores[i].Score < scores[j].Score
+		}
+		return scores[i].Pid < scores[j].Pid
+	})
+	return scores
+}
+
+//decay 评分向 0 恢复, 删除评分为 0 并且没有连接的节点
+func (ps *PeerScores) decay(active map[string]bool) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+	for pid, score := range ps.scores {
+		if score.Score > 0 {
+			score.Score--
+		} else if score.Score < 0 {
+			score.Score++
+		}
+		if score.Score == 0 && !active[pid] {
+			delete(ps.scores, pid)
+		}
+	}
+}
+
+//reportPeer 根据节点的行为修改评分, 评分过低时断开连接或者加入黑名单
+func (n *Node) reportPeer(pid string, kind int32, reason string) {
+	if pid == "" {
+		return
+	}
+	var peers []*Peer
+	var addr string
+	for _, peer := range n.GetRegisterPeers() {
+		if peer.nodeKey() == pid {
+			peers = append(peers, peer)
+			addr = peer.Addr()
+		}
+	}
+	score := n.nodeInfo.scores.Report(pid, addr, kind)
+	if kind != types.PeerUsefulData {
+		log.Debug("reportPeer", "pid", pid, "addr", addr, "kind", kind, "reason", reason, "score", score)
+	}
+	if score <= banScore {
+		log.Info("reportPeer", "ban peer", pid, "addr", addr, "reason", reason)
+		n.banPeer(pid, scoreBanSeconds)
+		return
+	}
+	if score <= disconnectScore {
+		for _, peer := range peers {
+			log.Info("reportPeer", "disconnect peer", pid, "addr", peer.Addr(), "score", score)
+			n.destroyPeer(peer)
+		}
+	}
+}
+
+//banPeer 把节点公钥或者地址加入黑名单, 断开公钥或者地址相同的节点
+func (n *Node) banPeer(key string, seconds int64) {
+	n.nodeInfo.BanPeer(key, seconds)
+	for _, peer := range n.GetRegisterPeers() {
+		if peer.nodeKey() == key || peer.Addr() == key {
+			n.destroyPeer(peer)
+		}
+	}
+	for _, peer := range n.GetCacheBounds() {
+		if peer.nodeKey() == key || peer.Addr() == key {
+			n.RemoveCachePeer(peer.Addr())
+			peer.Close()
+		}
+	}
+}
+
+//unbanPeer 从黑名单中删除, 同时清除节点评分
+func (n *Node) unbanPeer(key string) bool {
+	n.nodeInfo.scores.Reset(key)
+	return n.nodeInfo.UnbanPeer(key)
+}
+
+//sendTxToMempool 把其他节点发来的交易发送给 mempool, 签名错误时扣除节点评分
+func (n *Node) sendTxToMempool(pid string, tx *types.Transaction) {
+	client := n.nodeInfo.client
+	msg := client.NewMessage("mempool", types.EventTx, tx)
+	if pid == "" {
+		client.Send(msg, false)
+		return
+	}
+	client.Send(msg, true)
+	go func() {
+		resp, err := client.WaitTimeout(msg, DefaultSendTimeout)
+		if err != nil {
+			return
+		}
+		if reply, ok := resp.GetData().(*types.Reply); ok && !reply.GetIsOk() && string(reply.GetMsg()) == types.ErrSign.Error() {
+			n.reportPeer(pid, types.PeerBadTx, types.ErrSign.Error())
+		}
+	}()
+}
+
+//monitorPeerScore 定时恢复节点评分
+func (n *Node) monitorPeerScore() {
+	ticker := time.NewTicker(CheckPeerScoreInterval)
+	defer ticker.Stop()
+	for {
+		if n.isClose() {
+			log.Info("monitorPeerScore", "loop", "done")
+			return
+		}
+		<-ticker.C
+		active := make(map[string]bool)
+		for _, peer := range n.GetRegisterPeers() {
+			active[peer.nodeKey()] = true
+		}
+		n.nodeInfo.scores.decay(active)
+	}
+}
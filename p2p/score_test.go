@@ -0,0 +1,100 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeerScores(t *testing.T) {
+	ps := NewPeerScores()
+	assert.Equal(t, int64(-50), ps.Report("a", "127.0.0.1:13802", types.PeerInvalidBlock))
+	assert.Equal(t, int64(-60), ps.Report("a", "", types.PeerBadTx))
+	assert.Equal(t, int64(-62), ps.Report("a", "", types.PeerTimeout))
+	assert.Equal(t, int64(1), ps.Report("b", "", types.PeerUsefulData))
+	//评分有上下限
+	for i := 0; i < 5; i++ {
+		ps.Report("a", "", types.PeerInvalidBlock)
+	}
+	for i := 0; i < 200; i++ {
+		ps.Report("c", "", types.PeerUsefulData)
+	}
+	scores := ps.GetScores()
+	require.Equal(t, 3, len(scores))
+	assert.Equal(t, "a", scores[0].Pid)
+	assert.Equal(t, int64(banScore), scores[0].Score)
+	assert.Equal(t, "127.0.0.1:13802", scores[0].Addr)
+	assert.Equal(t, int64(6), scores[0].InvalidBlocks)
+	assert.Equal(t, int64(1), scores[0].BadTxs)
+	assert.Equal(t, int64(1), scores[0].Timeouts)
+	assert.Equal(t, "b", scores[1].Pid)
+	assert.Equal(t, int64(maxPeerScore), scores[2].Score)
+	//返回的是副本
+	scores[0].Score = 0
+	assert.Equal(t, int64(banScore), ps.GetScores()[0].Score)
+
+	//评分向 0 恢复, 没有连接并且评分为 0 的节点删除
+	ps.decay(map[string]bool{})
+	scores = ps.GetScores()
+	require.Equal(t, 2, len(scores))
+	assert.Equal(t, int64(banScore+1), scores[0].Score)
+	assert.Equal(t, int64(maxPeerScore-1), scores[1].Score)
+	ps.Report("d", "", types.PeerUsefulData)
+	ps.decay(map[string]bool{"d": true})
+	assert.Equal(t, 3, len(ps.GetScores()))
+
+	ps.Reset("a")
+	assert.Equal(t, 2, len(ps.GetScores()))
+}
+
+func TestReportPeerBan(t *testing.T) {
+	node, q := newTestNode(t, nil)
+	defer closeTestNode(node, q)
+	_, pub := newTestKey(t)
+	node.reportPeer(pub, types.PeerInvalidBlock, "test")
+	assert.False(t, node.nodeInfo.blacklist.Has(pub))
+	node.reportPeer(pub, types.PeerInvalidBlock, "test")
+	assert.True(t, node.nodeInfo.blacklist.Has(pub))
+	bans := node.nodeInfo.blacklist.GetBans()
+	assert.True(t, bans[pub] > types.Now().Unix()+scoreBanSeconds-60)
+
+	assert.True(t, node.unbanPeer(pub))
+	assert.False(t, node.nodeInfo.blacklist.Has(pub))
+	assert.Equal(t, 0, len(node.nodeInfo.scores.GetScores()))
+	assert.False(t, node.unbanPeer(pub))
+}
+
+func TestBanPersistence(t *testing.T) {
+	node, q := newTestNode(t, nil)
+	cfg := node.nodeInfo.cfg
+	node.nodeInfo.BanPeer("forever", 0)
+	node.nodeInfo.BanPeer("later", 3600)
+	//已经过期的黑名单重启之后不再恢复
+	node.nodeInfo.blacklist.Ban("expired", types.Now().Unix()-1)
+	node.nodeInfo.addrBook.SaveBans(node.nodeInfo.blacklist.GetBans())
+	//Add 加入的黑名单不保存
+	node.nodeInfo.blacklist.Add("temp", 3600)
+	node.nodeInfo.BanPeer("unban", 0)
+	assert.True(t, node.nodeInfo.UnbanPeer("unban"))
+	node.nodeInfo.addrBook.Close()
+	q.Close()
+
+	//重启之后从 addrbook 中恢复
+	node, q = newTestNode(t, cfg)
+	defer closeTestNode(node, q)
+	bl := node.nodeInfo.blacklist
+	assert.True(t, bl.Has("forever"))
+	assert.True(t, bl.Has("later"))
+	assert.False(t, bl.Has("expired"))
+	assert.False(t, bl.Has("temp"))
+	assert.False(t, bl.Has("unban"))
+	bans := bl.GetBans()
+	assert.Equal(t, int64(0), bans["forever"])
+	assert.True(t, bans["later"] > types.Now().Unix())
+}
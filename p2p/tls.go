@@ -0,0 +1,265 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	crand "crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/hex"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	pr "google.golang.org/grpc/peer"
+)
+
+//节点之间的 tls 连接:
+//tls 不支持 secp256k1, 所以用 addrbook 私钥派生出一个 P-256 的私钥, 生成自签名的证书,
+//证书的扩展字段中保存节点公钥以及节点私钥对证书公钥的签名.
+//握手时双方都要求对方提供证书, 验证扩展字段的签名后, 证书中的节点公钥就是对方的身份.
+
+//证书扩展字段的 oid
+var nodeKeyOID = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 53594, 1, 1}
+
+type nodeKeyExt struct {
+	PubKey []byte
+	Sign   []byte
+}
+
+//tlsIdentity 本节点的 tls 证书
+type tlsIdentity struct {
+	cert   tls.Certificate
+	pubkey string
+}
+
+//newTLSIdentity 由 addrbook 的私钥生成 tls 证书, 同一个私钥每次生成的证书公钥相同
+func newTLSIdentity(privkey string) (*tlsIdentity, error) {
+	cr, err := crypto.New(types.GetSignName("", types.SECP256K1))
+	if err != nil {
+		return nil, err
+	}
+	pribytes, err := hex.DecodeString(privkey)
+	if err != nil {
+		return nil, err
+	}
+	priv, err := cr.PrivKeyFromBytes(pribytes)
+	if err != nil {
+		return nil, err
+	}
+	key := deriveTLSKey(pribytes)
+	spki, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	ext, err := asn1.Marshal(nodeKeyExt{PubKey: priv.PubKey().Bytes(), Sign: priv.Sign(spki).Bytes()})
+	if err != nil {
+		return nil, err
+	}
+	pubkey := hex.EncodeToString(priv.PubKey().Bytes())
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:    big.NewInt(1),
+		Subject:         pkix.Name{CommonName: pubkey},
+		NotBefore:       now.Add(-time.Hour),
+		NotAfter:        now.Add(10 * 365 * 24 * time.Hour),
+		KeyUsage:        x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:     []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		ExtraExtensions: []pkix.Extension{{Id: nodeKeyOID, Value: ext}},
+	}
+	der, err := x509.CreateCertificate(crand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, err
+	}
+	cert := tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+	return &tlsIdentity{cert: cert, pubkey: pubkey}, nil
+}
+
+//deriveTLSKey 用私钥的 hash 作为 P-256 私钥
+func deriveTLSKey(privkey []byte) *ecdsa.PrivateKey {
+	curve := elliptic.P256()
+	hash := sha256.Sum256(append([]byte("chain33-p2p-tls"), privkey...))
+	d := new(big.Int).SetBytes(hash[:])
+	n := new(big.Int).Sub(curve.Params().N, big.NewInt(1))
+	d.Mod(d, n)
+	d.Add(d, big.NewInt(1))
+	key := &ecdsa.PrivateKey{D: d}
+	key.PublicKey.Curve = curve
+	key.PublicKey.X, key.PublicKey.Y = curve.ScalarBaseMult(d.Bytes())
+	return key
+}
+
+//verifyNodeCert 验证对方的证书, 返回证书中的节点公钥
+func verifyNodeCert(rawCerts [][]byte) (string, error) {
+	if len(rawCerts) == 0 {
+		return "", types.ErrPeerPubKey
+	}
+	cert, err := x509.ParseCertificate(rawCerts[0])
+	if err != nil {
+		return "", err
+	}
+	//自签名证书
+	if err := cert.CheckSignature(cert.SignatureAlgorithm, cert.RawTBSCertificate, cert.Signature); err != nil {
+		return "", err
+	}
+	ext, err := nodeKeyFromCert(cert)
+	if err != nil {
+		return "", err
+	}
+	cr, err := crypto.New(types.GetSignName("", types.SECP256K1))
+	if err != nil {
+		return "", err
+	}
+	pub, err := cr.PubKeyFromBytes(ext.PubKey)
+	if err != nil {
+		return "", err
+	}
+	sign, err := cr.SignatureFromBytes(ext.Sign)
+	if err != nil {
+		return "", err
+	}
+	if !pub.VerifyBytes(cert.RawSubjectPublicKeyInfo, sign) {
+		return "", types.ErrPeerPubKey
+	}
+	return hex.EncodeToString(ext.PubKey), nil
+}
+
+//nodeKeyFromCert 读取证书扩展字段, 不验证签名
+func nodeKeyFromCert(cert *x509.Certificate) (*nodeKeyExt, error) {
+	for _, e := range cert.Extensions {
+		if !e.Id.Equal(nodeKeyOID) {
+			continue
+		}
+		var ext nodeKeyExt
+		if _, err := asn1.Unmarshal(e.Value, &ext); err != nil {
+			return nil, err
+		}
+		return &ext, nil
+	}
+	return nil, types.ErrPeerPubKey
+}
+
+//serverOption 监听端的 tls 配置, 要求对方提供证书
+func (t *tlsIdentity) serverOption() grpc.ServerOption {
+	config := &tls.Config{
+		Certificates: []tls.Certificate{t.cert},
+		ClientAuth:   tls.RequireAnyClientCert,
+		MinVersion:   tls.VersionTLS12,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			_, err := verifyNodeCert(rawCerts)
+			return err
+		},
+	}
+	return grpc.Creds(credentials.NewTLS(config))
+}
+
+//peerCreds 连接一个节点时使用, 记录握手时验证过的对方节点公钥,
+//重连的时候对方的公钥必须和第一次连接时相同. nil 表示不使用 tls
+type peerCreds struct {
+	creds  credentials.TransportCredentials
+	mtx    sync.Mutex
+	pubkey string
+}
+
+//newPeerCreds 不开启 tls 的时候返回 nil
+func (t *tlsIdentity) newPeerCreds() *peerCreds {
+	if t == nil {
+		return nil
+	}
+	c := &peerCreds{}
+	config := &tls.Config{
+		Certificates: []tls.Certificate{t.cert},
+		//证书是自签名的, 由 VerifyPeerCertificate 验证节点公钥
+		InsecureSkipVerify: true,
+		MinVersion:         tls.VersionTLS12,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			pubkey, err := verifyNodeCert(rawCerts)
+			if err != nil {
+				return err
+			}
+			return c.setPubKey(pubkey)
+		},
+	}
+	c.creds = credentials.NewTLS(config)
+	return c
+}
+
+func (c *peerCreds) setPubKey(pubkey string) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.pubkey != "" && c.pubkey != pubkey {
+		return types.ErrPeerPubKey
+	}
+	c.pubkey = pubkey
+	return nil
+}
+
+//PubKey 对方的节点公钥, 不使用 tls 或者还没有握手时返回空
+func (c *peerCreds) PubKey() string {
+	if c == nil {
+		return ""
+	}
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.pubkey
+}
+
+func (c *peerCreds) dialOption() grpc.DialOption {
+	if c == nil {
+		return grpc.WithInsecure()
+	}
+	return grpc.WithTransportCredentials(c.creds)
+}
+
+//pubKeyFromContext 服务端获取经过 tls 验证的对方节点公钥, 不使用 tls 时返回空
+func pubKeyFromContext(ctx context.Context) string {
+	p, ok := pr.FromContext(ctx)
+	if !ok || p.AuthInfo == nil {
+		return ""
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.PeerCertificates) == 0 {
+		return ""
+	}
+	//握手时已经验证过签名
+	ext, err := nodeKeyFromCert(info.State.PeerCertificates[0])
+	if err != nil {
+		return ""
+	}
+	return hex.EncodeToString(ext.PubKey)
+}
+
+//checkPingPubKey 使用 tls 时, P2PPing 的签名公钥必须是握手时验证过的节点公钥
+func checkPingPubKey(ctx context.Context, ping *types.P2PPing) bool {
+	pubkey := pubKeyFromContext(ctx)
+	return pubkey == "" || pubkey == hex.EncodeToString(ping.GetSign().GetPubkey())
+}
+
+//peerInterceptor 每个请求都检查握手验证过的节点公钥, 拒绝黑名单中以及许可网络白名单以外的节点
+func peerInterceptor(nf *NodeInfo) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	unary := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !nf.peerAllowed(pubKeyFromContext(ctx)) {
+			return nil, types.ErrPeerPubKey
+		}
+		return handler(ctx, req)
+	}
+	stream := func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !nf.peerAllowed(pubKeyFromContext(ss.Context())) {
+			return types.ErrPeerPubKey
+		}
+		return handler(srv, ss)
+	}
+	return unary, stream
+}
@@ -0,0 +1,233 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"crypto/ecdsa"
+	crand "crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/hex"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+//newTestCert 生成证书, 扩展字段中的节点公钥为 claimed, signer 对 signed 签名
+func newTestCert(t *testing.T, signer crypto.PrivKey, claimed []byte, signed *ecdsa.PublicKey, key *ecdsa.PrivateKey) []byte {
+	spki, err := x509.MarshalPKIXPublicKey(signed)
+	require.Nil(t, err)
+	ext, err := asn1.Marshal(nodeKeyExt{PubKey: claimed, Sign: signer.Sign(spki).Bytes()})
+	require.Nil(t, err)
+	template := &x509.Certificate{
+		SerialNumber:    big.NewInt(1),
+		NotBefore:       time.Now().Add(-time.Hour),
+		NotAfter:        time.Now().Add(time.Hour),
+		ExtraExtensions: []pkix.Extension{{Id: nodeKeyOID, Value: ext}},
+	}
+	der, err := x509.CreateCertificate(crand.Reader, template, template, &key.PublicKey, key)
+	require.Nil(t, err)
+	return der
+}
+
+func TestTLSIdentity(t *testing.T) {
+	priv, pub := newTestKey(t)
+	identity, err := newTLSIdentity(priv)
+	require.Nil(t, err)
+	assert.Equal(t, pub, identity.pubkey)
+	pubkey, err := verifyNodeCert(identity.cert.Certificate)
+	require.Nil(t, err)
+	assert.Equal(t, pub, pubkey)
+
+	//同一个私钥生成的证书公钥相同
+	identity2, err := newTLSIdentity(priv)
+	require.Nil(t, err)
+	cert1, err := x509.ParseCertificate(identity.cert.Certificate[0])
+	require.Nil(t, err)
+	cert2, err := x509.ParseCertificate(identity2.cert.Certificate[0])
+	require.Nil(t, err)
+	assert.Equal(t, cert1.RawSubjectPublicKeyInfo, cert2.RawSubjectPublicKeyInfo)
+
+	_, err = newTLSIdentity("xyz")
+	assert.NotNil(t, err)
+}
+
+func TestVerifyNodeCertWrongKey(t *testing.T) {
+	cr, err := crypto.New(types.GetSignName("", types.SECP256K1))
+	require.Nil(t, err)
+	priv1, err := cr.GenKey()
+	require.Nil(t, err)
+	priv2, err := cr.GenKey()
+	require.Nil(t, err)
+	key := deriveTLSKey(priv1.Bytes())
+
+	der := newTestCert(t, priv1, priv1.PubKey().Bytes(), &key.PublicKey, key)
+	pubkey, err := verifyNodeCert([][]byte{der})
+	require.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString(priv1.PubKey().Bytes()), pubkey)
+
+	//声明的节点公钥和签名的私钥不一致
+	der = newTestCert(t, priv1, priv2.PubKey().Bytes(), &key.PublicKey, key)
+	_, err = verifyNodeCert([][]byte{der})
+	assert.Equal(t, types.ErrPeerPubKey, err)
+
+	//节点私钥签名的是另一个证书公钥
+	der = newTestCert(t, priv1, priv1.PubKey().Bytes(), &deriveTLSKey(priv2.Bytes()).PublicKey, key)
+	_, err = verifyNodeCert([][]byte{der})
+	assert.Equal(t, types.ErrPeerPubKey, err)
+
+	_, err = verifyNodeCert(nil)
+	assert.Equal(t, types.ErrPeerPubKey, err)
+	_, err = verifyNodeCert([][]byte{[]byte("not a cert")})
+	assert.NotNil(t, err)
+}
+
+func TestPeerCredsPubKey(t *testing.T) {
+	var creds *peerCreds
+	assert.Equal(t, "", creds.PubKey())
+	creds = &peerCreds{}
+	assert.Nil(t, creds.setPubKey("aa"))
+	assert.Nil(t, creds.setPubKey("aa"))
+	//重连的时候对方公钥改变
+	assert.Equal(t, types.ErrPeerPubKey, creds.setPubKey("bb"))
+	assert.Equal(t, "aa", creds.PubKey())
+}
+
+func TestTLSHandshake(t *testing.T) {
+	serverPriv, serverPub := newTestKey(t)
+	clientPriv, clientPub := newTestKey(t)
+	serverID, err := newTLSIdentity(serverPriv)
+	require.Nil(t, err)
+	clientID, err := newTLSIdentity(clientPriv)
+	require.Nil(t, err)
+
+	node, q := newTestNode(t, nil)
+	defer closeTestNode(node, q)
+	unary, stream := peerInterceptor(node.nodeInfo)
+	addr, stop := startTestServer(t, newTestServer(), serverID.serverOption(), grpc.UnaryInterceptor(unary), grpc.StreamInterceptor(stream))
+	defer stop()
+
+	creds := clientID.newPeerCreds()
+	peer := newTestPeer(t, node, addr, creds.dialOption())
+	defer peer.mconn.Close()
+
+	resp, err := peer.mconn.gcli.Version2(context.Background(), &types.P2PVersion{})
+	require.Nil(t, err)
+	assert.Equal(t, clientPub, resp.GetUserAgent())
+	assert.Equal(t, serverPub, creds.PubKey())
+
+	//黑名单中的节点公钥
+	node.nodeInfo.BanPeer(clientPub, 0)
+	_, err = peer.mconn.gcli.Version2(context.Background(), &types.P2PVersion{})
+	assert.NotNil(t, err)
+	node.nodeInfo.UnbanPeer(clientPub)
+	_, err = peer.mconn.gcli.Version2(context.Background(), &types.P2PVersion{})
+	assert.Nil(t, err)
+
+	//许可网络中所有的请求都要检查白名单
+	node.nodeInfo.allowlist = NewAllowlist(&types.P2P{Permissioned: true, AllowedNodes: []string{serverPub}})
+	_, err = peer.mconn.gcli.Version2(context.Background(), &types.P2PVersion{})
+	assert.NotNil(t, err)
+	stream2, err := peer.mconn.gcli.GetData(context.Background(), &types.P2PGetData{})
+	require.Nil(t, err)
+	_, err = stream2.Recv()
+	assert.NotNil(t, err)
+	node.nodeInfo.allowlist.SetChainNodes([]string{clientPub})
+	_, err = peer.mconn.gcli.Version2(context.Background(), &types.P2PVersion{})
+	assert.Nil(t, err)
+
+	//不使用 tls 的节点不能连接
+	plain := newTestPeer(t, node, addr)
+	defer plain.mconn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3)
+	defer cancel()
+	_, err = plain.mconn.gcli.Version2(ctx, &types.P2PVersion{})
+	assert.NotNil(t, err)
+}
+
+func TestDialPeerWithAddressTLS(t *testing.T) {
+	serverPriv, serverPub := newTestKey(t)
+	serverID, err := newTLSIdentity(serverPriv)
+	require.Nil(t, err)
+	addr, stop := startTestServer(t, newTestServer(), serverID.serverOption())
+	defer stop()
+
+	dir, err := ioutil.TempDir("", "p2ptest")
+	require.Nil(t, err)
+	cfg := newTestConfig(dir)
+	cfg.EnableTLS = true
+	node, q := newTestNode(t, cfg)
+	defer closeTestNode(node, q)
+	netaddr, err := NewNetAddressString(addr)
+	require.Nil(t, err)
+
+	//连接返回的时候 tls 握手已经完成, 可以检查对方的公钥
+	peer, err := P2pComm.dialPeerWithAddress(netaddr, false, node)
+	require.Nil(t, err)
+	assert.Equal(t, serverPub, peer.PubKey())
+	_, err = peer.mconn.gcli.Version2(context.Background(), &types.P2PVersion{})
+	assert.Nil(t, err)
+	peer.mconn.Close()
+
+	node.nodeInfo.BanPeer(serverPub, 0)
+	_, err = P2pComm.dialPeerWithAddress(netaddr, false, node)
+	assert.Equal(t, types.ErrPeerPubKey, err)
+}
+
+func TestNodePeerKey(t *testing.T) {
+	node, q := newTestNode(t, nil)
+	defer closeTestNode(node, q)
+	defer node.removeAll()
+	_, pub := newTestKey(t)
+	peer1 := newTestPeer(t, node, "127.0.0.1:1")
+	peer1.SetPubKey(pub)
+	node.addPeer(peer1)
+	assert.True(t, node.HasPubKey(pub))
+	assert.True(t, node.Has("127.0.0.1:1"))
+	assert.Equal(t, peer1, node.GetRegisterPeer(pub))
+	assert.Equal(t, peer1, node.GetRegisterPeer("127.0.0.1:1"))
+
+	//同一个节点公钥的其他地址不会替换已有的连接
+	peer2 := newTestPeer(t, node, "127.0.0.1:2")
+	peer2.SetPubKey(pub)
+	node.addPeer(peer2)
+	assert.Equal(t, peer1, node.GetRegisterPeer(pub))
+	assert.False(t, node.Has("127.0.0.1:2"))
+
+	//不使用 tls 的节点按照地址保存
+	peer3 := newTestPeer(t, node, "127.0.0.1:3")
+	node.addPeer(peer3)
+	assert.Equal(t, peer3, node.GetRegisterPeer("127.0.0.1:3"))
+	assert.Equal(t, 2, len(node.GetRegisterPeers()))
+	node.remove("127.0.0.1:1")
+	assert.False(t, node.HasPubKey(pub))
+
+	//开启 tls 的节点用公钥加入黑名单
+	node.nodeInfo.blacklist.AddPeer(peer1, 0)
+	assert.True(t, node.nodeInfo.blacklist.Has(pub))
+	assert.False(t, node.nodeInfo.blacklist.Has("127.0.0.1:1"))
+	node.nodeInfo.blacklist.AddPeer(peer3, 0)
+	assert.True(t, node.nodeInfo.blacklist.Has("127.0.0.1:3"))
+}
+
+func TestPermissionedRequireTLS(t *testing.T) {
+	dir, err := ioutil.TempDir("", "p2ptest")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	cfg := newTestConfig(dir)
+	cfg.Permissioned = true
+	_, err = NewNode(cfg)
+	assert.Equal(t, types.ErrPermissionedTLS, err)
+}
@@ -176,6 +176,13 @@ func (c *channelClient) GetAddrOverview(parm *types.ReqAddr) (*types.AddrOvervie
 
 // GetBalance get balance
 func (c *channelClient) GetBalance(in *types.ReqBalance) ([]*types.Account, error) {
+	if in.At != nil {
+		stateHash, err := c.getStateHash(in.At)
+		if err != nil {
+			return nil, err
+		}
+		in.StateHash = common.ToHex(stateHash)
+	}
 	return c.accountdb.GetBalance(c.QueueProtocolAPI, in)
 }
 
@@ -186,6 +193,14 @@ func (c *channelClient) GetAllExecBalance(in *types.ReqAddr) (*types.AllExecBala
 	if err != nil {
 		return nil, types.ErrInvalidAddress
 	}
+	var stateHash string
+	if in.At != nil {
+		hash, err := c.getStateHash(in.At)
+		if err != nil {
+			return nil, err
+		}
+		stateHash = common.ToHex(hash)
+	}
 	var addrs []string
 	addrs = append(addrs, addr)
 	allBalance := &types.AllExecBalance{Addr: addr}
@@ -194,6 +209,7 @@ func (c *channelClient) GetAllExecBalance(in *types.ReqAddr) (*types.AllExecBala
 		params := &types.ReqBalance{
 			Addresses: addrs,
 			Execer:    execer,
+			StateHash: stateHash,
 		}
 		res, err := c.GetBalance(params)
 		if err != nil {
@@ -212,8 +228,52 @@ func (c *channelClient) GetAllExecBalance(in *types.ReqAddr) (*types.AllExecBala
 	return allBalance, nil
 }
 
+// getStateHash 获取指定区块的状态hash, 状态已经被裁剪时返回ErrStatePruned
+func (c *channelClient) getStateHash(at *types.StateAt) ([]byte, error) {
+	var header *types.Header
+	if at.BlockHash != "" {
+		hash, err := common.FromHex(at.BlockHash)
+		if err != nil {
+			return nil, err
+		}
+		block, err := c.GetBlockOverview(&types.ReqHash{Hash: hash})
+		if err != nil {
+			return nil, err
+		}
+		header = block.GetHead()
+	} else {
+		if at.Height < 0 {
+			return nil, types.ErrInvalidParam
+		}
+		headers, err := c.GetHeaders(&types.ReqBlocks{Start: at.Height, End: at.Height})
+		if err != nil {
+			return nil, err
+		}
+		if len(headers.GetItems()) > 0 {
+			header = headers.Items[0]
+		}
+	}
+	if header == nil {
+		return nil, types.ErrBlockNotFound
+	}
+	//store不支持查询裁剪状态时, 认为状态没有被裁剪
+	status, err := c.StorePruneStatus()
+	if err == nil && status.IsPruned(header.Height) {
+		log.Error("getStateHash", "height", header.Height, "err", types.ErrStatePruned)
+		return nil, types.ErrStatePruned
+	}
+	return header.StateHash, nil
+}
+
 // GetTotalCoins get total of coins
 func (c *channelClient) GetTotalCoins(in *types.ReqGetTotalCoins) (*types.ReplyGetTotalCoins, error) {
+	if in.At != nil {
+		stateHash, err := c.getStateHash(in.At)
+		if err != nil {
+			return nil, err
+		}
+		in.StateHash = stateHash
+	}
 	//获取地址账户的余额通过account模块
 	resp, err := c.accountdb.GetTotalCoins(c.QueueProtocolAPI, in)
 	if err != nil {
@@ -249,6 +309,13 @@ func (c *channelClient) GetTimeStatus() (*types.TimeStatus, error) {
 
 // GetExecBalance get balance with exec by channelclient
 func (c *channelClient) GetExecBalance(in *types.ReqGetExecBalance) (*types.ReplyGetExecBalance, error) {
+	if in.At != nil {
+		stateHash, err := c.getStateHash(in.At)
+		if err != nil {
+			return nil, err
+		}
+		in.StateHash = stateHash
+	}
 	//通过account模块获取地址账户在合约中的余额
 	resp, err := c.accountdb.GetExecBalance(c.QueueProtocolAPI, in)
 	if err != nil {
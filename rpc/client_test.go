@@ -338,9 +338,47 @@ func testChannelClient_GetBalanceOther(t *testing.T) {
 
 }
 
+func testChannelClient_GetBalanceAt(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	db := new(account.DB)
+	client := &channelClient{
+		QueueProtocolAPI: api,
+		accountdb:        db,
+	}
+
+	headers := &types.Headers{Items: []*types.Header{{Height: 10, StateHash: []byte("statehash10")}}}
+	api.On("GetHeaders", &types.ReqBlocks{Start: 10, End: 10}).Return(headers, nil)
+	api.On("StorePruneStatus").Return(nil, types.ErrActionNotSupport).Once()
+
+	var acc = &types.Account{Addr: "1Jn2qu84Z1SUUosWjySggBS9pKWdAP3tZt", Balance: 100}
+	storevalue := &types.StoreReplyValue{Values: [][]byte{types.Encode(acc)}}
+	api.On("StoreGet", &types.StoreGet{StateHash: []byte("statehash10"), Keys: [][]byte{db.AccountKey(acc.Addr)}}).Return(storevalue, nil)
+
+	var in = &types.ReqBalance{
+		Execer:    "coins",
+		Addresses: []string{acc.Addr},
+		At:        &types.StateAt{Height: 10},
+	}
+	data, err := client.GetBalance(in)
+	assert.Nil(t, err)
+	assert.Equal(t, acc.Balance, data[0].Balance)
+
+	//状态已经被裁剪
+	status := &types.StorePruneStatus{Enable: true, CurPruneHeight: 20000}
+	api.On("StorePruneStatus").Return(status, nil)
+	in.StateHash = ""
+	_, err = client.GetBalance(in)
+	assert.Equal(t, types.ErrStatePruned, err)
+
+	api.On("GetHeaders", &types.ReqBlocks{Start: 11, End: 11}).Return(&types.Headers{}, nil)
+	_, err = client.GetAllExecBalance(&types.ReqAddr{Addr: acc.Addr, At: &types.StateAt{Height: 11}})
+	assert.Equal(t, types.ErrBlockNotFound, err)
+}
+
 func TestChannelClient_GetBalance(t *testing.T) {
 	testChannelClient_GetBalanceCoin(t)
 	testChannelClient_GetBalanceOther(t)
+	testChannelClient_GetBalanceAt(t)
 }
 
 // func TestChannelClient_GetTotalCoins(t *testing.T) {
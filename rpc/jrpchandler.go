@@ -537,6 +537,8 @@ func (c *Chain33) GetPeerInfo(in types.ReqNil, result *interface{}) error {
 			pr.Name = peer.GetName()
 			pr.Port = peer.GetPort()
 			pr.Self = peer.GetSelf()
+			pr.BytesSent = peer.GetBytesSent()
+			pr.BytesRecv = peer.GetBytesRecv()
 			pr.Header = &rpctypes.Header{
 				BlockTime:  peer.Header.GetBlockTime(),
 				Height:     peer.Header.GetHeight(),
@@ -803,7 +805,20 @@ func (c *Chain33) Query(in rpctypes.Query4Jrpc, result *interface{}) error {
 		log.Error("EventQuery1", "err", err.Error())
 		return err
 	}
-	resp, err := c.cli.Query(types.ExecName(in.Execer), in.FuncName, decodePayload)
+	var resp types.Message
+	if in.At != nil {
+		//查询指定区块的历史状态
+		var stateHash []byte
+		stateHash, err = c.cli.getStateHash(in.At)
+		if err != nil {
+			log.Error("EventQuery2", "err", err.Error())
+			return err
+		}
+		query := &types.ChainExecutor{Driver: types.ExecName(in.Execer), FuncName: in.FuncName, StateHash: stateHash, Param: types.Encode(decodePayload)}
+		resp, err = c.cli.QueryChain(query)
+	} else {
+		resp, err = c.cli.Query(types.ExecName(in.Execer), in.FuncName, decodePayload)
+	}
 	if err != nil {
 		log.Error("EventQuery2", "err", err.Error())
 		return err
@@ -903,13 +918,59 @@ func (c *Chain33) GetNetInfo(in *types.ReqNil, result *interface{}) error {
 	if err != nil {
 		return err
 	}
-	*result = &rpctypes.NodeNetinfo{
+	netinfo := &rpctypes.NodeNetinfo{
 		Externaladdr: resp.GetExternaladdr(),
 		Localaddr:    resp.GetLocaladdr(),
 		Service:      resp.GetService(),
 		Outbounds:    resp.GetOutbounds(),
 		Inbounds:     resp.GetInbounds(),
+		BytesSent:    resp.GetBytesSent(),
+		BytesRecv:    resp.GetBytesRecv(),
+	}
+	for _, peer := range resp.GetPeers() {
+		netinfo.Peers = append(netinfo.Peers, &rpctypes.PeerTraffic{Addr: peer.GetAddr(), Inbound: peer.GetInbound(),
+			BytesSent: peer.GetBytesSent(), BytesRecv: peer.GetBytesRecv()})
+	}
+	for _, msg := range resp.GetMsgs() {
+		netinfo.Msgs = append(netinfo.Msgs, &rpctypes.MsgTraffic{Msg: msg.GetMsg(), BytesSent: msg.GetBytesSent(), BytesRecv: msg.GetBytesRecv()})
+	}
+	*result = netinfo
+	return nil
+}
+
+// GetPeerScores 获取节点评分以及黑名单
+func (c *Chain33) GetPeerScores(in *types.ReqNil, result *interface{}) error {
+	resp, err := c.cli.GetPeerScores()
+	if err != nil {
+		return err
+	}
+	*result = resp
+	return nil
+}
+
+// BanPeer 把节点公钥或者地址加入黑名单, seconds 不大于 0 时永久加入黑名单
+func (c *Chain33) BanPeer(in *types.ReqBanPeer, result *interface{}) error {
+	reply, err := c.cli.BanPeer(in)
+	if err != nil {
+		return err
 	}
+	var resp rpctypes.Reply
+	resp.IsOk = reply.GetIsOk()
+	resp.Msg = string(reply.GetMsg())
+	*result = &resp
+	return nil
+}
+
+// UnbanPeer 从黑名单中删除节点公钥或者地址
+func (c *Chain33) UnbanPeer(in *types.ReqBanPeer, result *interface{}) error {
+	reply, err := c.cli.UnbanPeer(in)
+	if err != nil {
+		return err
+	}
+	var resp rpctypes.Reply
+	resp.IsOk = reply.GetIsOk()
+	resp.Msg = string(reply.GetMsg())
+	*result = &resp
 	return nil
 }
 
@@ -1150,6 +1211,150 @@ func (c *Chain33) GetSeqCallBackLastNum(in *types.ReqString, result *interface{}
 	return nil
 }
 
+// SetStorePrune 启动或者暂停mavl裁剪
+func (c *Chain33) SetStorePrune(in *types.ReqStorePrune, result *interface{}) error {
+	resp, err := c.cli.StorePrune(in)
+	if err != nil {
+		return err
+	}
+	*result = resp
+	return nil
+}
+
+// GetStorePruneStatus 获取mavl裁剪的进度
+func (c *Chain33) GetStorePruneStatus(in *types.ReqNil, result *interface{}) error {
+	resp, err := c.cli.StorePruneStatus()
+	if err != nil {
+		return err
+	}
+	*result = resp
+	return nil
+}
+
+// GetExecLocalDBMetas 获取执行器localdb的版本和重建进度
+func (c *Chain33) GetExecLocalDBMetas(in *types.ReqNil, result *interface{}) error {
+	resp, err := c.cli.GetExecLocalDBMetas()
+	if err != nil {
+		return err
+	}
+	*result = resp
+	return nil
+}
+
+// SimulateTx 在最新区块的状态上模拟执行交易, 返回回执、手续费和读写的状态
+func (c *Chain33) SimulateTx(in *rpctypes.SimulateTxParam, result *interface{}) error {
+	data, err := common.FromHex(in.Data)
+	if err != nil {
+		return err
+	}
+	var tx types.Transaction
+	err = types.Decode(data, &tx)
+	if err != nil {
+		return err
+	}
+	req := &types.ReqSimulateTx{Tx: &tx}
+	if in.Pubkey != "" {
+		req.Pubkey, err = common.FromHex(in.Pubkey)
+		if err != nil {
+			return err
+		}
+	}
+	reply, err := c.cli.SimulateTx(req)
+	if err != nil {
+		return err
+	}
+	res, err := convertSimulateTx(reply)
+	if err != nil {
+		return err
+	}
+	*result = res
+	return nil
+}
+
+// TraceTransaction 重新执行交易所在的区块, 返回交易执行过程中statedb, localdb的读写和执行器函数的调用
+func (c *Chain33) TraceTransaction(in rpctypes.QueryParm, result *interface{}) error {
+	hash, err := common.FromHex(in.Hash)
+	if err != nil {
+		return err
+	}
+	reply, err := c.cli.TraceTx(&types.ReqHash{Hash: hash})
+	if err != nil {
+		return err
+	}
+	tx, err := rpctypes.DecodeTx(reply.Tx)
+	if err != nil {
+		return err
+	}
+	var recp rpctypes.ReceiptData
+	recp.Ty = reply.Receipt.GetTy()
+	for _, lg := range reply.Receipt.GetLogs() {
+		recp.Logs = append(recp.Logs, &rpctypes.ReceiptLog{Ty: lg.Ty, Log: common.ToHex(lg.GetLog())})
+	}
+	rd, err := rpctypes.DecodeLog(reply.Tx.Execer, &recp)
+	if err != nil {
+		return err
+	}
+	res := &rpctypes.TraceTxResult{
+		Height:    reply.Height,
+		Index:     reply.Index,
+		StateHash: common.ToHex(reply.StateHash),
+		Tx:        tx,
+		Receipt:   rd,
+	}
+	for _, op := range reply.Ops {
+		item := &rpctypes.TraceOp{DB: op.Db, Op: op.Op, Name: op.Name, Key: string(op.Key)}
+		if op.Prev != nil {
+			item.Prev = common.ToHex(op.Prev)
+		}
+		if op.Value != nil {
+			item.Value = common.ToHex(op.Value)
+		}
+		res.Ops = append(res.Ops, item)
+	}
+	*result = res
+	return nil
+}
+
+func convertSimulateTx(reply *types.ReplySimulateTx) (*rpctypes.SimulateTxResult, error) {
+	if len(reply.Txs) != len(reply.Receipts) {
+		return nil, types.ErrDecode
+	}
+	res := &rpctypes.SimulateTxResult{
+		Height:    reply.Height,
+		StateHash: common.ToHex(reply.StateHash),
+		Fee:       reply.Fee,
+	}
+	for i, tx := range reply.Txs {
+		receipt := reply.Receipts[i]
+		var recp rpctypes.ReceiptData
+		recp.Ty = receipt.GetTy()
+		for _, lg := range receipt.GetLogs() {
+			recp.Logs = append(recp.Logs, &rpctypes.ReceiptLog{Ty: lg.Ty, Log: common.ToHex(lg.GetLog())})
+		}
+		rd, err := rpctypes.DecodeLog(tx.Execer, &recp)
+		if err != nil {
+			return nil, err
+		}
+		stx := &rpctypes.SimulatedTx{
+			Execer:     string(tx.Execer),
+			ActionName: tx.ActionName(),
+			From:       tx.From(),
+			Receipt:    rd,
+		}
+		for _, kv := range receipt.GetKV() {
+			stx.KV = append(stx.KV, &rpctypes.KeyValue{Key: string(kv.Key), Value: common.ToHex(kv.Value)})
+		}
+		res.Txs = append(res.Txs, stx)
+	}
+	for _, key := range reply.ReadKeys {
+		res.ReadKeys = append(res.ReadKeys, string(key))
+	}
+	for _, key := range reply.WriteKeys {
+		res.WriteKeys = append(res.WriteKeys, string(key))
+	}
+	return res, nil
+}
+
 func convertBlockDetails(details []*types.BlockDetail, retDetails *rpctypes.BlockDetails, isDetail bool) error {
 	for _, item := range details {
 		var bdtl rpctypes.BlockDetail
@@ -17,6 +17,7 @@ import (
 	cty "github.com/33cn/chain33/system/dapp/coins/types"
 	mty "github.com/33cn/chain33/system/dapp/manage/types"
 	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -913,7 +914,9 @@ func TestChain33_GetPeerInfoOk(t *testing.T) {
 
 	var peerlist types.PeerList
 	var pr = &types.Peer{
-		Addr: "abcdsd",
+		Addr:      "abcdsd",
+		BytesSent: 100,
+		BytesRecv: 200,
 	}
 	peerlist.Peers = append(peerlist.Peers, pr)
 
@@ -922,6 +925,32 @@ func TestChain33_GetPeerInfoOk(t *testing.T) {
 	var in types.ReqNil
 	_ = testChain33.GetPeerInfo(in, &testResult)
 	assert.Equal(t, testResult.(*rpctypes.PeerList).Peers[0].Addr, peerlist.Peers[0].Addr)
+	assert.Equal(t, int64(100), testResult.(*rpctypes.PeerList).Peers[0].BytesSent)
+	assert.Equal(t, int64(200), testResult.(*rpctypes.PeerList).Peers[0].BytesRecv)
+}
+
+func TestChain33_GetNetInfo(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	testChain33 := newTestChain33(api)
+
+	netinfo := &types.NodeNetInfo{
+		Externaladdr: "192.168.0.1:13802",
+		Outbounds:    1,
+		BytesSent:    300,
+		BytesRecv:    400,
+		Peers:        []*types.PeerTraffic{{Addr: "192.168.0.2:13802", BytesSent: 300, BytesRecv: 400}},
+		Msgs:         []*types.MsgTraffic{{Msg: "stream.block", BytesSent: 300}},
+	}
+	api.On("GetNetInfo").Return(netinfo, nil)
+	var testResult interface{}
+	err := testChain33.GetNetInfo(&types.ReqNil{}, &testResult)
+	assert.Nil(t, err)
+	result := testResult.(*rpctypes.NodeNetinfo)
+	assert.Equal(t, "192.168.0.1:13802", result.Externaladdr)
+	assert.Equal(t, int64(300), result.BytesSent)
+	assert.Equal(t, int64(400), result.BytesRecv)
+	assert.Equal(t, "192.168.0.2:13802", result.Peers[0].Addr)
+	assert.Equal(t, "stream.block", result.Msgs[0].Msg)
 }
 
 func TestChain33_GetHeaders(t *testing.T) {
@@ -1260,3 +1289,133 @@ func TestChain33_GetExecBalance(t *testing.T) {
 	err = client.GetExecBalance(in, &testResult2)
 	assert.NotNil(t, err)
 }
+
+func TestChain33_SetStorePrune(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	client := newTestChain33(api)
+	var result interface{}
+	api.On("StorePrune", mock.Anything).Return(nil, types.ErrPruneNotEnable)
+	err := client.SetStorePrune(&types.ReqStorePrune{Pause: true}, &result)
+	assert.Equal(t, types.ErrPruneNotEnable, err)
+
+	api = new(mocks.QueueProtocolAPI)
+	client = newTestChain33(api)
+	var result2 interface{}
+	api.On("StorePrune", mock.Anything).Return(&types.StorePruneStatus{Enable: true, Paused: true}, nil)
+	err = client.SetStorePrune(&types.ReqStorePrune{Pause: true}, &result2)
+	assert.Nil(t, err)
+	assert.True(t, result2.(*types.StorePruneStatus).Paused)
+}
+
+func TestChain33_GetStorePruneStatus(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	client := newTestChain33(api)
+	var result interface{}
+	status := &types.StorePruneStatus{Enable: true, LastPruneHeight: 20000, ReclaimedBytes: 1024}
+	api.On("StorePruneStatus").Return(status, nil)
+	err := client.GetStorePruneStatus(&types.ReqNil{}, &result)
+	assert.Nil(t, err)
+	assert.Equal(t, status, result)
+}
+
+func TestChain33_GetExecLocalDBMetas(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	client := newTestChain33(api)
+	var result interface{}
+	metas := &types.ExecLocalDBMetas{Metas: []*types.ExecLocalDBMeta{{Name: "coins", Version: 1, Indexing: true, Height: 100}}}
+	api.On("GetExecLocalDBMetas").Return(metas, nil)
+	err := client.GetExecLocalDBMetas(&types.ReqNil{}, &result)
+	assert.Nil(t, err)
+	assert.Equal(t, metas, result)
+}
+
+func TestChain33_PeerScores(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	client := newTestChain33(api)
+	var result interface{}
+	scores := &types.PeerScores{
+		Scores: []*types.PeerScore{{Pid: "02aa", Addr: "192.168.0.1:13802", Score: -20, BadTxs: 2}},
+		Bans:   []*types.BannedPeer{{Key: "02bb", Until: 1000}},
+	}
+	api.On("GetPeerScores").Return(scores, nil)
+	err := client.GetPeerScores(&types.ReqNil{}, &result)
+	assert.Nil(t, err)
+	assert.Equal(t, scores, result)
+
+	api.On("BanPeer", mock.Anything).Return(nil, types.ErrInvalidParam).Once()
+	err = client.BanPeer(&types.ReqBanPeer{}, &result)
+	assert.Equal(t, types.ErrInvalidParam, err)
+	api.On("BanPeer", mock.Anything).Return(&types.Reply{IsOk: true}, nil)
+	err = client.BanPeer(&types.ReqBanPeer{Key: "02bb", Seconds: 60}, &result)
+	assert.Nil(t, err)
+	assert.True(t, result.(*rpctypes.Reply).IsOk)
+
+	api.On("UnbanPeer", mock.Anything).Return(&types.Reply{Msg: []byte("peer not banned")}, nil)
+	err = client.UnbanPeer(&types.ReqBanPeer{Key: "02cc"}, &result)
+	assert.Nil(t, err)
+	assert.False(t, result.(*rpctypes.Reply).IsOk)
+	assert.Equal(t, "peer not banned", result.(*rpctypes.Reply).Msg)
+}
+
+func TestChain33_SimulateTx(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	client := newTestChain33(api)
+	var result interface{}
+	err := client.SimulateTx(&rpctypes.SimulateTxParam{Data: "0xzz"}, &result)
+	assert.NotNil(t, err)
+
+	tx := util.CreateCoinsTx(util.TestPrivkeyList[0], "1MY4pMgjpS2vWiaSDZasRhN47pcwEire32", types.Coin)
+	reply := &types.ReplySimulateTx{
+		Height:   10,
+		Fee:      tx.Fee,
+		Txs:      []*types.Transaction{tx},
+		Receipts: []*types.Receipt{{Ty: types.ExecOk, KV: []*types.KeyValue{{Key: []byte("mavl-coins-bty-addr"), Value: []byte{1}}}}},
+		ReadKeys: [][]byte{[]byte("mavl-coins-bty-addr")},
+	}
+	api.On("SimulateTx", mock.Anything).Return(reply, nil)
+	err = client.SimulateTx(&rpctypes.SimulateTxParam{Data: common.ToHex(types.Encode(tx))}, &result)
+	assert.Nil(t, err)
+	res := result.(*rpctypes.SimulateTxResult)
+	assert.Equal(t, int64(10), res.Height)
+	assert.Equal(t, tx.From(), res.Txs[0].From)
+	assert.Equal(t, "ExecOk", res.Txs[0].Receipt.TyName)
+	assert.Equal(t, "0x01", res.Txs[0].KV[0].Value)
+	assert.Equal(t, []string{"mavl-coins-bty-addr"}, res.ReadKeys)
+
+	reply.Receipts = nil
+	err = client.SimulateTx(&rpctypes.SimulateTxParam{Data: common.ToHex(types.Encode(tx))}, &result)
+	assert.Equal(t, types.ErrDecode, err)
+}
+
+func TestChain33_TraceTransaction(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	client := newTestChain33(api)
+	var result interface{}
+	api.On("TraceTx", mock.Anything).Return(nil, types.ErrTxNotExist).Once()
+	err := client.TraceTransaction(rpctypes.QueryParm{Hash: "0x01"}, &result)
+	assert.Equal(t, types.ErrTxNotExist, err)
+
+	tx := util.CreateCoinsTx(util.TestPrivkeyList[0], "1MY4pMgjpS2vWiaSDZasRhN47pcwEire32", types.Coin)
+	reply := &types.ReplyTraceTx{
+		Height:  10,
+		Index:   1,
+		Tx:      tx,
+		Receipt: &types.Receipt{Ty: types.ExecOk},
+		Ops: []*types.TraceOp{
+			{Db: "exec", Op: "call", Name: "coins.Exec"},
+			{Db: "statedb", Op: "set", Key: []byte("mavl-coins-bty-addr"), Prev: []byte{1}, Value: []byte{2}},
+		},
+	}
+	api.On("TraceTx", mock.Anything).Return(reply, nil)
+	err = client.TraceTransaction(rpctypes.QueryParm{Hash: common.ToHex(tx.Hash())}, &result)
+	assert.Nil(t, err)
+	res := result.(*rpctypes.TraceTxResult)
+	assert.Equal(t, int64(10), res.Height)
+	assert.Equal(t, "ExecOk", res.Receipt.TyName)
+	assert.Equal(t, 2, len(res.Ops))
+	assert.Equal(t, "coins.Exec", res.Ops[0].Name)
+	assert.Equal(t, "", res.Ops[0].Value)
+	assert.Equal(t, "mavl-coins-bty-addr", res.Ops[1].Key)
+	assert.Equal(t, "0x01", res.Ops[1].Prev)
+	assert.Equal(t, "0x02", res.Ops[1].Value)
+}
@@ -97,3 +97,47 @@ func TestSendToExec(t *testing.T) {
 	balance := mocker.GetExecAccount(block.StateHash, "user.f3d", mocker.GetGenesisAddress()).Balance
 	assert.Equal(t, int64(10), balance)
 }
+
+func TestTraceTransaction(t *testing.T) {
+	mocker := testnode.New("--free--", nil)
+	defer mocker.Close()
+	mocker.Listen()
+	jrpcClient := getRPCClient(t, mocker)
+	gen := mocker.GetGenesisKey()
+	addr1, key1 := util.Genaddress()
+	addr2, _ := util.Genaddress()
+	tx1 := util.CreateCoinsTx(gen, addr1, 1*types.Coin)
+	mocker.SendTx(tx1)
+	mocker.Wait()
+	tx2 := util.CreateCoinsTx(key1, addr2, 6*int64(1e7))
+	mocker.SendTx(tx2)
+	detail, err := mocker.WaitTx(tx2.Hash())
+	assert.Nil(t, err)
+
+	var res rpctypes.TraceTxResult
+	req := rpctypes.QueryParm{Hash: common.ToHex(tx2.Hash())}
+	err = jrpcClient.Call("Chain33.TraceTransaction", req, &res)
+	assert.Nil(t, err)
+	assert.Equal(t, detail.Height, res.Height)
+	assert.Equal(t, detail.Index, res.Index)
+	assert.Equal(t, "ExecOk", res.Receipt.TyName)
+	assert.Equal(t, len(detail.Receipt.Logs), len(res.Receipt.Logs))
+	assert.Equal(t, "execTx", res.Ops[0].Name)
+	var calls []string
+	var sets []string
+	for _, op := range res.Ops {
+		if op.Op == "call" {
+			calls = append(calls, op.Name)
+		}
+		if op.DB == "statedb" && op.Op == "set" {
+			sets = append(sets, op.Key)
+		}
+	}
+	assert.Contains(t, calls, "coins.CheckTx")
+	assert.Contains(t, calls, "coins.Exec")
+	assert.Contains(t, sets, "mavl-coins-bty-"+addr1)
+	assert.Contains(t, sets, "mavl-coins-bty-"+addr2)
+
+	err = jrpcClient.Call("Chain33.TraceTransaction", rpctypes.QueryParm{Hash: "0x0102"}, &res)
+	assert.NotNil(t, err)
+}
@@ -33,6 +33,57 @@ type RawParm struct {
 	Data  string `json:"data"`
 }
 
+// SimulateTxParam 模拟执行交易的参数, 交易没有签名时需要提供公钥
+type SimulateTxParam struct {
+	Data   string `json:"data"`
+	Pubkey string `json:"pubkey"`
+}
+
+// SimulateTxResult 模拟执行交易的结果
+type SimulateTxResult struct {
+	Height    int64          `json:"height"`
+	StateHash string         `json:"stateHash"`
+	Fee       int64          `json:"fee"`
+	Txs       []*SimulatedTx `json:"txs"`
+	ReadKeys  []string       `json:"readKeys"`
+	WriteKeys []string       `json:"writeKeys"`
+}
+
+// SimulatedTx 一笔交易模拟执行的回执和修改的状态
+type SimulatedTx struct {
+	Execer     string             `json:"execer"`
+	ActionName string             `json:"actionName"`
+	From       string             `json:"from"`
+	Receipt    *ReceiptDataResult `json:"receipt"`
+	KV         []*KeyValue        `json:"kv"`
+}
+
+// KeyValue 状态数据, value为hex编码
+type KeyValue struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// TraceTxResult 交易重新执行的过程
+type TraceTxResult struct {
+	Height    int64              `json:"height"`
+	Index     int64              `json:"index"`
+	StateHash string             `json:"stateHash"`
+	Tx        *Transaction       `json:"tx"`
+	Receipt   *ReceiptDataResult `json:"receipt"`
+	Ops       []*TraceOp         `json:"ops"`
+}
+
+// TraceOp 一次数据库读写或者执行器函数调用, value为hex编码
+type TraceOp struct {
+	DB    string `json:"db"`
+	Op    string `json:"op"`
+	Name  string `json:"name,omitempty"`
+	Key   string `json:"key,omitempty"`
+	Prev  string `json:"prev,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
 // QueryParm Query parameter
 type QueryParm struct {
 	Hash string `json:"hash"`
@@ -196,6 +247,8 @@ type Peer struct {
 	MempoolSize int32   `json:"mempoolSize"`
 	Self        bool    `json:"self"`
 	Header      *Header `json:"header"`
+	BytesSent   int64   `json:"bytesSent"`
+	BytesRecv   int64   `json:"bytesRecv"`
 }
 
 // WalletAccounts Wallet Module
@@ -280,6 +333,8 @@ type Query4Jrpc struct {
 	Execer   string          `json:"execer"`
 	FuncName string          `json:"funcName"`
 	Payload  json.RawMessage `json:"payload"`
+	// 查询指定区块的历史状态, 为空时查询最新状态
+	At *types.StateAt `json:"at,omitempty"`
 }
 
 // ChainExecutor chain executor
@@ -300,11 +355,30 @@ type WalletStatus struct {
 
 // NodeNetinfo node net info
 type NodeNetinfo struct {
-	Externaladdr string `json:"externalAddr"`
-	Localaddr    string `json:"localAddr"`
-	Service      bool   `json:"service"`
-	Outbounds    int32  `json:"outbounds"`
-	Inbounds     int32  `json:"inbounds"`
+	Externaladdr string         `json:"externalAddr"`
+	Localaddr    string         `json:"localAddr"`
+	Service      bool           `json:"service"`
+	Outbounds    int32          `json:"outbounds"`
+	Inbounds     int32          `json:"inbounds"`
+	BytesSent    int64          `json:"bytesSent"`
+	BytesRecv    int64          `json:"bytesRecv"`
+	Peers        []*PeerTraffic `json:"peers,omitempty"`
+	Msgs         []*MsgTraffic  `json:"msgs,omitempty"`
+}
+
+// PeerTraffic traffic of a connection
+type PeerTraffic struct {
+	Addr      string `json:"addr"`
+	Inbound   bool   `json:"inbound"`
+	BytesSent int64  `json:"bytesSent"`
+	BytesRecv int64  `json:"bytesRecv"`
+}
+
+// MsgTraffic traffic of a message type
+type MsgTraffic struct {
+	Msg       string `json:"msg"`
+	BytesSent int64  `json:"bytesSent"`
+	BytesRecv int64  `json:"bytesRecv"`
 }
 
 // ReplyPrivacyPkPair   reply privekey pubkey pair
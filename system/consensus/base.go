@@ -126,23 +126,25 @@ func (bc *BaseClient) InitBlock() {
 	}
 	if block == nil {
 		// 创世区块
-		newblock := &types.Block{}
-		newblock.Height = 0
-		newblock.BlockTime = bc.child.GetGenesisBlockTime()
-		// TODO: 下面这些值在创世区块中赋值nil，是否合理？
-		newblock.ParentHash = zeroHash[:]
-		tx := bc.child.CreateGenesisTx()
-		newblock.Txs = tx
-		newblock.TxHash = merkle.CalcMerkleRoot(newblock.Txs)
-		if newblock.Height == 0 {
-			newblock.Difficulty = types.GetP(0).PowLimitBits
-		}
-		bc.WriteBlock(zeroHash[:], newblock)
+		bc.WriteBlock(zeroHash[:], NewGenesisBlock(bc.child))
 	} else {
 		bc.SetCurrentBlock(block)
 	}
 }
 
+//NewGenesisBlock 用共识模块的创世交易和创世时间生成创世区块, 不执行交易
+func NewGenesisBlock(miner Miner) *types.Block {
+	newblock := &types.Block{}
+	newblock.Height = 0
+	newblock.BlockTime = miner.GetGenesisBlockTime()
+	// TODO: 下面这些值在创世区块中赋值nil，是否合理？
+	newblock.ParentHash = zeroHash[:]
+	newblock.Txs = miner.CreateGenesisTx()
+	newblock.TxHash = merkle.CalcMerkleRoot(newblock.Txs)
+	newblock.Difficulty = types.GetP(0).PowLimitBits
+	return newblock
+}
+
 //Close 关闭
 func (bc *BaseClient) Close() {
 	atomic.StoreInt32(&bc.minerStart, 0)
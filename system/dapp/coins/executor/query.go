@@ -28,6 +28,16 @@ func (c *Coins) Query_GetAddrTxsCount(in *types.ReqKey) (types.Message, error) {
 	return c.GetAddrTxsCount(in)
 }
 
+// Query_GetLogs query receipt logs by execer, log type and address
+func (c *Coins) Query_GetLogs(in *types.ReqLogs) (types.Message, error) {
+	return c.GetLogs(in)
+}
+
+// Query_GetBalanceHistory query balance changes of the address
+func (c *Coins) Query_GetBalanceHistory(in *types.ReqBalanceHistory) (types.Message, error) {
+	return c.GetBalanceHistory(in)
+}
+
 // GetAddrReciver get address reciver by address
 func (c *Coins) GetAddrReciver(addr *types.ReqAddr) (types.Message, error) {
 	reciver := types.Int64{}
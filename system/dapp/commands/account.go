@@ -5,10 +5,12 @@
 package commands
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"strconv"
 
+	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/address"
 	"github.com/33cn/chain33/rpc/jsonclient"
 	rpctypes "github.com/33cn/chain33/rpc/types"
@@ -29,6 +31,7 @@ func AccountCmd() *cobra.Command {
 		DumpKeyCmd(),
 		GetAccountListCmd(),
 		GetBalanceCmd(),
+		GetBalanceHistoryCmd(),
 		ImportKeyCmd(),
 		NewAccountCmd(),
 		SetLabelCmd(),
@@ -141,8 +144,12 @@ func balance(cmd *cobra.Command, args []string) {
 		fmt.Fprintln(os.Stderr, types.ErrInvalidAddress)
 		return
 	}
+	var at *types.StateAt
+	if height >= 0 {
+		at = &types.StateAt{Height: int64(height)}
+	}
 	if execer == "" {
-		req := types.ReqAddr{Addr: addr}
+		req := types.ReqAddr{Addr: addr, At: at}
 		var res rpctypes.AllExecBalance
 		ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.GetAllExecBalance", req, &res)
 		ctx.SetResultCb(parseGetAllBalanceRes)
@@ -153,30 +160,13 @@ func balance(cmd *cobra.Command, args []string) {
 		fmt.Fprintln(os.Stderr, types.ErrExecNameNotAllow)
 		return
 	}
-	stateHash := ""
-	if height >= 0 {
-		params := types.ReqBlocks{
-			Start:    int64(height),
-			End:      int64(height),
-			IsDetail: false,
-		}
-		var res rpctypes.Headers
-		ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.GetHeaders", params, &res)
-		_, err := ctx.RunResult()
-		if err != nil {
-			fmt.Fprintln(os.Stderr, err)
-			return
-		}
-		h := res.Items[0]
-		stateHash = h.StateHash
-	}
 
 	var addrs []string
 	addrs = append(addrs, addr)
 	params := types.ReqBalance{
 		Addresses: addrs,
 		Execer:    execer,
-		StateHash: stateHash,
+		At:        at,
 	}
 	var res []*rpctypes.Account
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.GetBalance", params, &res)
@@ -214,6 +204,81 @@ func parseGetAllBalanceRes(arg interface{}) (interface{}, error) {
 	return result, nil
 }
 
+// GetBalanceHistoryCmd get balance changes of an address
+func GetBalanceHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "Get balance changes of a account address",
+		Run:   balanceHistory,
+	}
+	addBalanceHistoryFlags(cmd)
+	return cmd
+}
+
+func addBalanceHistoryFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("addr", "a", "", "account addr")
+	cmd.MarkFlagRequired("addr")
+	cmd.Flags().StringP("exec", "e", "", "asset executor, empty for all assets")
+	cmd.Flags().StringP("symbol", "s", "", "asset symbol, empty for all symbols of the executor")
+	cmd.Flags().Int32P("count", "c", 10, "max number of changes")
+	cmd.Flags().Int32P("direction", "d", 0, "query direction, 0: desc, 1: asc")
+	cmd.Flags().StringP("cursor", "", "", "cursor returned by last query")
+}
+
+func balanceHistory(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	addr, _ := cmd.Flags().GetString("addr")
+	execer, _ := cmd.Flags().GetString("exec")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	count, _ := cmd.Flags().GetInt32("count")
+	direction, _ := cmd.Flags().GetInt32("direction")
+	cursor, _ := cmd.Flags().GetString("cursor")
+	err := address.CheckAddress(addr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, types.ErrInvalidAddress)
+		return
+	}
+	req := &types.ReqBalanceHistory{
+		Addr:      addr,
+		Execer:    execer,
+		Symbol:    symbol,
+		Count:     count,
+		Direction: direction,
+		Cursor:    cursor,
+	}
+	var params rpctypes.Query4Jrpc
+	params.Execer = "coins"
+	params.FuncName = "GetBalanceHistory"
+	params.Payload = types.MustPBToJSON(req)
+	var res json.RawMessage
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.SetResultCb(parseBalanceHistoryRes)
+	ctx.Run()
+}
+
+func parseBalanceHistoryRes(arg interface{}) (interface{}, error) {
+	var res types.ReplyBalanceHistory
+	err := types.JSONToPB(*arg.(*json.RawMessage), &res)
+	if err != nil {
+		return nil, err
+	}
+	result := &commandtypes.BalanceHistoryResult{Cursor: res.Cursor}
+	for _, change := range res.Changes {
+		result.Changes = append(result.Changes, &commandtypes.BalanceChangeResult{
+			Hash:     common.ToHex(change.Hash),
+			Height:   change.Height,
+			Index:    change.Index,
+			Execer:   change.Execer,
+			Symbol:   change.Symbol,
+			ExecAddr: change.ExecAddr,
+			Log:      types.GetLogName([]byte(change.Execer), int64(change.Ty)),
+			Prev:     strconv.FormatFloat(float64(change.Prev.GetBalance())/float64(types.Coin), 'f', 4, 64),
+			Current:  strconv.FormatFloat(float64(change.Current.GetBalance())/float64(types.Coin), 'f', 4, 64),
+		})
+	}
+	return result, nil
+}
+
 // ImportKeyCmd  import private key
 func ImportKeyCmd() *cobra.Command {
 	cmd := &cobra.Command{
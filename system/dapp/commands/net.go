@@ -9,6 +9,7 @@ import (
 
 	"github.com/33cn/chain33/rpc/jsonclient"
 	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/types"
 )
 
 // NetCmd net command
@@ -26,6 +27,9 @@ func NetCmd() *cobra.Command {
 		GetNetInfoCmd(),
 		GetFatalFailureCmd(),
 		GetTimeStausCmd(),
+		GetPeerScoresCmd(),
+		BanPeerCmd(),
+		UnbanPeerCmd(),
 	)
 
 	return cmd
@@ -132,3 +136,77 @@ func timestatus(cmd *cobra.Command, args []string) {
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.GetTimeStatus", nil, &res)
 	ctx.Run()
 }
+
+// GetPeerScoresCmd get peer scores and banned peers
+func GetPeerScoresCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scores",
+		Short: "Get peer scores and banned peers",
+		Run:   peerScores,
+	}
+	return cmd
+}
+
+func peerScores(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	var res types.PeerScores
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.GetPeerScores", nil, &res)
+	ctx.Run()
+}
+
+// BanPeerCmd ban peer by node pubkey or address
+func BanPeerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ban",
+		Short: "Ban peer by node pubkey or address",
+		Run:   banPeer,
+	}
+	addBanPeerFlags(cmd)
+	return cmd
+}
+
+func addBanPeerFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("key", "k", "", "node pubkey or address(ip:port) of peer")
+	cmd.MarkFlagRequired("key")
+	cmd.Flags().Int64P("seconds", "s", 86400, "ban seconds, 0 means forever")
+}
+
+func banPeer(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	key, _ := cmd.Flags().GetString("key")
+	seconds, _ := cmd.Flags().GetInt64("seconds")
+	params := types.ReqBanPeer{
+		Key:     key,
+		Seconds: seconds,
+	}
+	var res rpctypes.Reply
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.BanPeer", params, &res)
+	ctx.Run()
+}
+
+// UnbanPeerCmd remove peer from ban list
+func UnbanPeerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unban",
+		Short: "Remove peer from ban list",
+		Run:   unbanPeer,
+	}
+	addUnbanPeerFlags(cmd)
+	return cmd
+}
+
+func addUnbanPeerFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("key", "k", "", "node pubkey or address(ip:port) of peer")
+	cmd.MarkFlagRequired("key")
+}
+
+func unbanPeer(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	key, _ := cmd.Flags().GetString("key")
+	params := types.ReqBanPeer{
+		Key: key,
+	}
+	var res rpctypes.Reply
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.UnbanPeer", params, &res)
+	ctx.Run()
+}
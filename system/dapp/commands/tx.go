@@ -35,6 +35,8 @@ func TxCmd() *cobra.Command {
 		GetRawTxCmd(),
 		DecodeTxCmd(),
 		GetAddrOverviewCmd(),
+		SimulateTxCmd(),
+		TraceTxCmd(),
 	)
 
 	return cmd
@@ -215,6 +217,63 @@ func getTxHexByHash(cmd *cobra.Command, args []string) {
 	ctx.RunWithoutMarshal()
 }
 
+// SimulateTxCmd simulate transaction on the latest state
+func SimulateTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate",
+		Short: "Simulate a transaction on the latest state, show receipt and state changes",
+		Run:   simulateTx,
+	}
+	addSimulateTxFlags(cmd)
+	return cmd
+}
+
+func addSimulateTxFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("data", "d", "", "transaction hex, signed or unsigned")
+	cmd.MarkFlagRequired("data")
+	cmd.Flags().StringP("pubkey", "p", "", "public key of sender, required for unsigned transaction")
+}
+
+func simulateTx(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	data, _ := cmd.Flags().GetString("data")
+	pubkey, _ := cmd.Flags().GetString("pubkey")
+	params := rpctypes.SimulateTxParam{
+		Data:   data,
+		Pubkey: pubkey,
+	}
+	var res rpctypes.SimulateTxResult
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.SimulateTx", params, &res)
+	ctx.Run()
+}
+
+// TraceTxCmd re-execute transaction and show state reads and writes
+func TraceTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trace",
+		Short: "Re-execute transaction, show state reads, writes and executor calls",
+		Run:   traceTx,
+	}
+	addTraceTxFlags(cmd)
+	return cmd
+}
+
+func addTraceTxFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("hash", "s", "", "transaction hash")
+	cmd.MarkFlagRequired("hash")
+}
+
+func traceTx(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	hash, _ := cmd.Flags().GetString("hash")
+	params := rpctypes.QueryParm{
+		Hash: hash,
+	}
+	var res rpctypes.TraceTxResult
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.TraceTransaction", params, &res)
+	ctx.Run()
+}
+
 // DecodeTxCmd decode raw hex to transaction
 func DecodeTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -318,3 +318,22 @@ type ExecBalance struct {
 	Frozen   string `json:"frozen"`
 	Active   string `json:"active"`
 }
+
+// BalanceChangeResult defines a balance change of balance history command
+type BalanceChangeResult struct {
+	Hash     string `json:"hash"`
+	Height   int64  `json:"height"`
+	Index    int64  `json:"index"`
+	Execer   string `json:"execer"`
+	Symbol   string `json:"symbol"`
+	ExecAddr string `json:"execAddr,omitempty"`
+	Log      string `json:"log"`
+	Prev     string `json:"prev"`
+	Current  string `json:"current"`
+}
+
+// BalanceHistoryResult defines result of balance history command
+type BalanceHistoryResult struct {
+	Changes []*BalanceChangeResult `json:"changes"`
+	Cursor  string                 `json:"cursor,omitempty"`
+}
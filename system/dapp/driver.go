@@ -65,6 +65,8 @@ type Driver interface {
 	GetFuncMap() map[string]reflect.Method
 	GetExecutorType() types.ExecutorType
 	CheckReceiptExecOk() bool
+	//ExecLocal 保存的数据格式的版本, 版本改变之后会重建这个执行器的localdb
+	GetLocalDBVersion() int32
 }
 
 // DriverBase defines driverbase type
@@ -86,6 +88,11 @@ type DriverBase struct {
 	ety          types.ExecutorType
 }
 
+// GetLocalDBVersion 默认的localdb版本是0, 修改了ExecLocal数据格式的执行器需要增加版本
+func (d *DriverBase) GetLocalDBVersion() int32 {
+	return 0
+}
+
 // GetPayloadValue define get payload func
 func (d *DriverBase) GetPayloadValue() types.Message {
 	if d.ety == nil {
@@ -0,0 +1,143 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dapp
+
+import (
+	"sync"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+)
+
+//链上管理执行器:
+//1. manage 合约可以设置执行器从某个高度开始暂停或者恢复
+//2. manage 合约可以设置允许调用的执行器列表
+//3. manage 合约可以设置某个dapp fork 的高度
+//这些设置保存在statedb中, 只有开启 manage.ForkManageGovern 之后才生效
+//设置的高度必须大于交易所在区块的高度, 所以一个区块中生效的设置只由父区块的状态决定
+
+//IsGovernEnable 链上管理执行器是否开启
+func IsGovernEnable(height int64) bool {
+	return types.HasFork("manage.ForkManageGovern") && types.IsDappFork(height, "manage", "ForkManageGovern")
+}
+
+//Govern 读取某个高度上链上管理的设置, 读取的结果会被缓存
+type Govern struct {
+	mu     sync.Mutex
+	load   func(key []byte) ([]byte, error)
+	height int64
+	cache  map[string][]byte
+}
+
+//NewGovern 从 db 中读取高度为 height 的区块生效的设置
+func NewGovern(db dbm.KV, height int64) *Govern {
+	return NewGovernWithLoader(db.Get, height)
+}
+
+//NewGovernWithLoader 使用 load 读取父区块状态中的设置
+func NewGovernWithLoader(load func(key []byte) ([]byte, error), height int64) *Govern {
+	return &Govern{load: load, height: height, cache: make(map[string][]byte)}
+}
+
+func (g *Govern) get(key string, msg types.Message) bool {
+	g.mu.Lock()
+	value, ok := g.cache[key]
+	if !ok {
+		value, _ = g.load([]byte(key))
+		g.cache[key] = value
+	}
+	g.mu.Unlock()
+	if len(value) == 0 {
+		return false
+	}
+	return types.Decode(value, msg) == nil
+}
+
+//IsExecPaused 执行器是否被暂停
+func (g *Govern) IsExecPaused(name string) bool {
+	var status types.ExecStatus
+	if !g.get(types.ManageExecStatusKey(name), &status) {
+		return false
+	}
+	return IsPausedAt(&status, g.height)
+}
+
+//IsExecWhitelisted 执行器是否在允许调用的列表中, 列表为空时不限制
+func (g *Govern) IsExecWhitelisted(name string) bool {
+	var list types.ExecWhitelist
+	if !g.get(types.ManageExecWhitelistKey(), &list) {
+		return true
+	}
+	execs := WhitelistAt(&list, g.height)
+	if len(execs) == 0 {
+		return true
+	}
+	for _, exec := range execs {
+		if exec == name {
+			return true
+		}
+	}
+	return false
+}
+
+//IsExecAllowed 执行器是否可以被调用, manage 和 none 执行器总是可以调用
+func (g *Govern) IsExecAllowed(name string) bool {
+	if g == nil || !IsGovernEnable(g.height) {
+		return true
+	}
+	name = string(types.GetRealExecName([]byte(name)))
+	if name == "manage" || name == "none" {
+		return true
+	}
+	return !g.IsExecPaused(name) && g.IsExecWhitelisted(name)
+}
+
+//IsDappFork 优先使用链上设置的dapp fork高度
+func (g *Govern) IsDappFork(dapp, fork string) bool {
+	if IsGovernEnable(g.height) {
+		var f types.DappForkHeight
+		if g.get(types.ManageDappForkKey(dapp, fork), &f) {
+			return g.height >= f.Height
+		}
+	}
+	return types.IsDappFork(g.height, dapp, fork)
+}
+
+//IsPausedAt 执行器状态在高度 height 是否暂停
+func IsPausedAt(status *types.ExecStatus, height int64) bool {
+	if height >= status.Height {
+		return status.Paused
+	}
+	return status.PrevPaused
+}
+
+//WhitelistAt 高度 height 上生效的执行器列表
+func WhitelistAt(list *types.ExecWhitelist, height int64) []string {
+	if height >= list.Height {
+		return list.Execs
+	}
+	return list.PrevExecs
+}
+
+//LoadDriverGovern 和 LoadDriverAllow 相同, 另外根据链上管理的设置判断执行器是否可以调用,
+//不能调用的执行器使用 none 执行器
+func LoadDriverGovern(tx *types.Transaction, index int, height int64, g *Govern) (driver Driver) {
+	if !g.IsExecAllowed(string(tx.Execer)) {
+		exec, err := LoadDriver("none", height)
+		if err != nil {
+			panic(err)
+		}
+		return exec
+	}
+	return LoadDriverAllow(tx, index, height)
+}
+
+//IsDappFork 优先使用链上设置的dapp fork高度
+func (d *DriverBase) IsDappFork(fork string) bool {
+	if d.statedb == nil {
+		return types.IsDappFork(d.GetHeight(), d.child.GetDriverName(), fork)
+	}
+	return NewGovern(d.statedb, d.GetHeight()).IsDappFork(d.child.GetDriverName(), fork)
+}
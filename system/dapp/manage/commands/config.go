@@ -9,6 +9,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/33cn/chain33/util"
 
@@ -30,6 +31,14 @@ func ConfigCmd() *cobra.Command {
 	cmd.AddCommand(
 		ConfigTxCmd(),
 		QueryConfigCmd(),
+		ExecStatusTxCmd(),
+		WhitelistTxCmd(),
+		DappForkTxCmd(),
+		QueryExecStatusCmd(),
+		ProposeTxCmd(),
+		ApproveTxCmd(),
+		RevokeTxCmd(),
+		QueryProposalsCmd(),
 	)
 
 	return cmd
@@ -112,3 +121,232 @@ func queryConfig(cmd *cobra.Command, args []string) {
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
 	ctx.Run()
 }
+
+func createManageTx(paraName string, action *pty.ManageAction) {
+	tx := &types.Transaction{Payload: types.Encode(action)}
+	tx, err := types.FormatTx(util.GetParaExecName(paraName, "manage"), tx)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println(hex.EncodeToString(types.Encode(tx)))
+}
+
+// ExecStatusTxCmd pause or resume a executor
+func ExecStatusTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec_status_tx",
+		Short: "pause or resume a executor from height",
+		Run:   execStatusTx,
+	}
+	cmd.Flags().StringP("name", "n", "", "executor name")
+	cmd.MarkFlagRequired("name")
+	cmd.Flags().BoolP("pause", "p", false, "pause the executor, resume if false")
+	cmd.Flags().Int64P("height", "t", 0, "the height from which the status takes effect")
+	cmd.MarkFlagRequired("height")
+	addGovernProposeFlag(cmd)
+	return cmd
+}
+
+//addGovernProposeFlag 开启多签之后, 链上管理的修改需要通过提案
+func addGovernProposeFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool("propose", false, "create a proposal, applied when approved by enough managers")
+}
+
+func execStatusTx(cmd *cobra.Command, args []string) {
+	paraName, _ := cmd.Flags().GetString("paraName")
+	name, _ := cmd.Flags().GetString("name")
+	pause, _ := cmd.Flags().GetBool("pause")
+	height, _ := cmd.Flags().GetInt64("height")
+	propose, _ := cmd.Flags().GetBool("propose")
+	status := &types.ExecStatus{Name: name, Paused: pause, Height: height}
+	action := &pty.ManageAction{Ty: pty.ManageActionExecStatus, Value: &pty.ManageAction_ExecStatus{ExecStatus: status}}
+	if propose {
+		action = &pty.ManageAction{Ty: pty.ManageActionProposeExecStatus, Value: &pty.ManageAction_ProposeExecStatus{ProposeExecStatus: status}}
+	}
+	createManageTx(paraName, action)
+}
+
+// WhitelistTxCmd set the executors which are allowed to call
+func WhitelistTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "whitelist_tx",
+		Short: "set the executors allowed to call from height, empty means no limit",
+		Run:   whitelistTx,
+	}
+	cmd.Flags().StringP("execs", "e", "", "executor names, separated by comma")
+	cmd.Flags().Int64P("height", "t", 0, "the height from which the whitelist takes effect")
+	cmd.MarkFlagRequired("height")
+	addGovernProposeFlag(cmd)
+	return cmd
+}
+
+func whitelistTx(cmd *cobra.Command, args []string) {
+	paraName, _ := cmd.Flags().GetString("paraName")
+	execs, _ := cmd.Flags().GetString("execs")
+	height, _ := cmd.Flags().GetInt64("height")
+	var names []string
+	for _, name := range strings.Split(execs, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	propose, _ := cmd.Flags().GetBool("propose")
+	list := &types.ExecWhitelist{Execs: names, Height: height}
+	action := &pty.ManageAction{Ty: pty.ManageActionExecWhitelist, Value: &pty.ManageAction_Whitelist{Whitelist: list}}
+	if propose {
+		action = &pty.ManageAction{Ty: pty.ManageActionProposeWhitelist, Value: &pty.ManageAction_ProposeWhitelist{ProposeWhitelist: list}}
+	}
+	createManageTx(paraName, action)
+}
+
+// DappForkTxCmd schedule a dapp fork height
+func DappForkTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dapp_fork_tx",
+		Short: "schedule the height of a dapp fork which is not activated",
+		Run:   dappForkTx,
+	}
+	cmd.Flags().StringP("dapp", "d", "", "dapp name")
+	cmd.MarkFlagRequired("dapp")
+	cmd.Flags().StringP("fork", "f", "", "fork name")
+	cmd.MarkFlagRequired("fork")
+	cmd.Flags().Int64P("height", "t", 0, "fork height")
+	cmd.MarkFlagRequired("height")
+	addGovernProposeFlag(cmd)
+	return cmd
+}
+
+func dappForkTx(cmd *cobra.Command, args []string) {
+	paraName, _ := cmd.Flags().GetString("paraName")
+	dapp, _ := cmd.Flags().GetString("dapp")
+	fork, _ := cmd.Flags().GetString("fork")
+	height, _ := cmd.Flags().GetInt64("height")
+	propose, _ := cmd.Flags().GetBool("propose")
+	forkHeight := &types.DappForkHeight{Dapp: dapp, Fork: fork, Height: height}
+	action := &pty.ManageAction{Ty: pty.ManageActionDappFork, Value: &pty.ManageAction_DappFork{DappFork: forkHeight}}
+	if propose {
+		action = &pty.ManageAction{Ty: pty.ManageActionProposeDappFork, Value: &pty.ManageAction_ProposeDappFork{ProposeDappFork: forkHeight}}
+	}
+	createManageTx(paraName, action)
+}
+
+// QueryExecStatusCmd query the status of a executor
+func QueryExecStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query_exec_status",
+		Short: "Query executor status",
+		Run:   queryExecStatus,
+	}
+	cmd.Flags().StringP("name", "n", "", "executor name")
+	cmd.MarkFlagRequired("name")
+	return cmd
+}
+
+func queryExecStatus(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	name, _ := cmd.Flags().GetString("name")
+	var params rpctypes.Query4Jrpc
+	params.Execer = util.GetParaExecName(paraName, "manage")
+	params.FuncName = "GetExecStatus"
+	params.Payload = types.MustPBToJSON(&types.ReqString{Data: name})
+
+	var res pty.ReplyExecStatus
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
+
+// ProposeTxCmd create a proposal to modify config
+func ProposeTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose_tx",
+		Short: "propose to set system config, applied when approved by enough managers",
+		Run:   proposeTx,
+	}
+	addConfigTxFlags(cmd)
+	return cmd
+}
+
+func proposeTx(cmd *cobra.Command, args []string) {
+	paraName, _ := cmd.Flags().GetString("paraName")
+	key, _ := cmd.Flags().GetString("key")
+	op, _ := cmd.Flags().GetString("operation")
+	opAddr, _ := cmd.Flags().GetString("value")
+	createManageTx(paraName, &pty.ManageAction{
+		Ty:    pty.ManageActionPropose,
+		Value: &pty.ManageAction_Propose{Propose: &types.ModifyConfig{Key: key, Op: op, Value: opAddr}},
+	})
+}
+
+func addProposalIDFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("id", "i", "", "proposal id")
+	cmd.MarkFlagRequired("id")
+}
+
+// ApproveTxCmd approve a proposal
+func ApproveTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve_tx",
+		Short: "approve a config proposal",
+		Run:   approveTx,
+	}
+	addProposalIDFlags(cmd)
+	return cmd
+}
+
+func approveTx(cmd *cobra.Command, args []string) {
+	paraName, _ := cmd.Flags().GetString("paraName")
+	id, _ := cmd.Flags().GetString("id")
+	createManageTx(paraName, &pty.ManageAction{
+		Ty:    pty.ManageActionApprove,
+		Value: &pty.ManageAction_Approve{Approve: &pty.ProposalID{Id: id}},
+	})
+}
+
+// RevokeTxCmd revoke the approval of a proposal
+func RevokeTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke_tx",
+		Short: "revoke the approval of a config proposal",
+		Run:   revokeTx,
+	}
+	addProposalIDFlags(cmd)
+	return cmd
+}
+
+func revokeTx(cmd *cobra.Command, args []string) {
+	paraName, _ := cmd.Flags().GetString("paraName")
+	id, _ := cmd.Flags().GetString("id")
+	createManageTx(paraName, &pty.ManageAction{
+		Ty:    pty.ManageActionRevoke,
+		Value: &pty.ManageAction_Revoke{Revoke: &pty.ProposalID{Id: id}},
+	})
+}
+
+// QueryProposalsCmd list the open config proposals
+func QueryProposalsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query_proposals",
+		Short: "Query open config proposals",
+		Run:   queryProposals,
+	}
+	cmd.Flags().StringP("cursor", "", "", "list from the proposal after cursor")
+	cmd.Flags().Int32P("count", "c", 10, "proposal count")
+	return cmd
+}
+
+func queryProposals(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	cursor, _ := cmd.Flags().GetString("cursor")
+	count, _ := cmd.Flags().GetInt32("count")
+	var params rpctypes.Query4Jrpc
+	params.Execer = util.GetParaExecName(paraName, "manage")
+	params.FuncName = "ListProposals"
+	params.Payload = types.MustPBToJSON(&pty.ReqConfigProposals{Cursor: cursor, Count: count})
+
+	var res pty.ReplyConfigProposals
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
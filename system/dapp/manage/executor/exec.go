@@ -26,12 +26,126 @@ func (c *Manage) checkTxToAddress(tx *types.Transaction, index int) error {
 func (c *Manage) Exec_Modify(manageAction *types.ModifyConfig, tx *types.Transaction, index int) (*types.Receipt, error) {
 	clog.Info("manage.Exec", "start index", index)
 	// 兼容在区块上没有To地址检查的交易数据
-	if types.IsDappFork(c.GetHeight(), mty.ManageX, "ForkManageExec") {
+	if c.IsDappFork("ForkManageExec") {
 		if err := c.checkTxToAddress(tx, index); err != nil {
 			return nil, err
 		}
 	}
+	//开启多签之后, 需要通过提案修改配置
+	if c.isMultiSigEnable() && MultiSigThreshold() > 1 {
+		return nil, mty.ErrNeedProposal
+	}
 	action := NewAction(c, tx)
 	return action.modifyConfig(manageAction)
 
 }
+
+//checkGovern 开启多签并且门限大于1之后, 链上管理的修改需要通过提案
+func (c *Manage) checkGovern(tx *types.Transaction, index int) error {
+	if !dapp.IsGovernEnable(c.GetHeight()) {
+		return types.ErrActionNotSupport
+	}
+	if c.isMultiSigEnable() && MultiSigThreshold() > 1 {
+		return mty.ErrNeedProposal
+	}
+	return c.checkTxToAddress(tx, index)
+}
+
+// Exec_ExecStatus pause or resume a executor
+func (c *Manage) Exec_ExecStatus(status *types.ExecStatus, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if err := c.checkGovern(tx, index); err != nil {
+		return nil, err
+	}
+	action := NewAction(c, tx)
+	return action.execStatus(status)
+}
+
+// Exec_Whitelist set the executors which are allowed to call
+func (c *Manage) Exec_Whitelist(list *types.ExecWhitelist, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if err := c.checkGovern(tx, index); err != nil {
+		return nil, err
+	}
+	action := NewAction(c, tx)
+	return action.execWhitelist(list)
+}
+
+// Exec_DappFork schedule a dapp fork height
+func (c *Manage) Exec_DappFork(fork *types.DappForkHeight, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if err := c.checkGovern(tx, index); err != nil {
+		return nil, err
+	}
+	action := NewAction(c, tx)
+	return action.dappFork(fork)
+}
+
+func (c *Manage) isMultiSigEnable() bool {
+	return c.IsDappFork("ForkManageMultiSig")
+}
+
+func (c *Manage) checkMultiSig(tx *types.Transaction, index int) error {
+	if !c.isMultiSigEnable() {
+		return types.ErrActionNotSupport
+	}
+	return c.checkTxToAddress(tx, index)
+}
+
+// Exec_Propose create a proposal to modify config
+func (c *Manage) Exec_Propose(modify *types.ModifyConfig, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if err := c.checkMultiSig(tx, index); err != nil {
+		return nil, err
+	}
+	action := NewAction(c, tx)
+	return action.propose(modify)
+}
+
+func (c *Manage) checkGovernProposal(tx *types.Transaction, index int) error {
+	if !dapp.IsGovernEnable(c.GetHeight()) {
+		return types.ErrActionNotSupport
+	}
+	return c.checkMultiSig(tx, index)
+}
+
+// Exec_ProposeExecStatus create a proposal to pause or resume a executor
+func (c *Manage) Exec_ProposeExecStatus(status *types.ExecStatus, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if err := c.checkGovernProposal(tx, index); err != nil {
+		return nil, err
+	}
+	action := NewAction(c, tx)
+	return action.proposeGovern(&mty.ConfigProposal{ExecStatus: status})
+}
+
+// Exec_ProposeWhitelist create a proposal to set the executors which are allowed to call
+func (c *Manage) Exec_ProposeWhitelist(list *types.ExecWhitelist, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if err := c.checkGovernProposal(tx, index); err != nil {
+		return nil, err
+	}
+	action := NewAction(c, tx)
+	return action.proposeGovern(&mty.ConfigProposal{Whitelist: list})
+}
+
+// Exec_ProposeDappFork create a proposal to schedule a dapp fork height
+func (c *Manage) Exec_ProposeDappFork(fork *types.DappForkHeight, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if err := c.checkGovernProposal(tx, index); err != nil {
+		return nil, err
+	}
+	action := NewAction(c, tx)
+	return action.proposeGovern(&mty.ConfigProposal{DappFork: fork})
+}
+
+// Exec_Approve approve a proposal
+func (c *Manage) Exec_Approve(id *mty.ProposalID, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if err := c.checkMultiSig(tx, index); err != nil {
+		return nil, err
+	}
+	action := NewAction(c, tx)
+	return action.approve(id.Id)
+}
+
+// Exec_Revoke revoke the approval of a proposal
+func (c *Manage) Exec_Revoke(id *mty.ProposalID, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if err := c.checkMultiSig(tx, index); err != nil {
+		return nil, err
+	}
+	action := NewAction(c, tx)
+	return action.revoke(id.Id)
+}
@@ -34,3 +34,33 @@ func (c *Manage) ExecDelLocal_Modify(transfer *types.ModifyConfig, tx *types.Tra
 	}
 	return set, nil
 }
+
+// ExecDelLocal_Propose defines execdellocal propose func
+func (c *Manage) ExecDelLocal_Propose(modify *types.ModifyConfig, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return proposalLocal(receipt, true), nil
+}
+
+// ExecDelLocal_Approve defines execdellocal approve func
+func (c *Manage) ExecDelLocal_Approve(id *pty.ProposalID, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return proposalLocal(receipt, true), nil
+}
+
+// ExecDelLocal_Revoke defines execdellocal revoke func
+func (c *Manage) ExecDelLocal_Revoke(id *pty.ProposalID, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return proposalLocal(receipt, true), nil
+}
+
+// ExecDelLocal_ProposeExecStatus defines execdellocal propose exec status func
+func (c *Manage) ExecDelLocal_ProposeExecStatus(status *types.ExecStatus, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return proposalLocal(receipt, true), nil
+}
+
+// ExecDelLocal_ProposeWhitelist defines execdellocal propose whitelist func
+func (c *Manage) ExecDelLocal_ProposeWhitelist(list *types.ExecWhitelist, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return proposalLocal(receipt, true), nil
+}
+
+// ExecDelLocal_ProposeDappFork defines execdellocal propose dapp fork func
+func (c *Manage) ExecDelLocal_ProposeDappFork(fork *types.DappForkHeight, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return proposalLocal(receipt, true), nil
+}
@@ -27,3 +27,62 @@ func (c *Manage) ExecLocal_Modify(transfer *types.ModifyConfig, tx *types.Transa
 	}
 	return set, nil
 }
+
+func proposalLocalKey(id string) []byte {
+	return localKey(types.ManageGovernPrefix + "proposal-" + id)
+}
+
+//proposalLocal 本地只保存还没有关闭的提案
+func proposalLocal(receipt *types.ReceiptData, del bool) *types.LocalDBSet {
+	set := &types.LocalDBSet{}
+	for _, item := range receipt.Logs {
+		if item.Ty != pty.TyLogConfigProposal {
+			continue
+		}
+		var log pty.ReceiptConfigProposal
+		err := types.Decode(item.Log, &log)
+		if err != nil {
+			panic(err) //数据错误了，已经被修改了
+		}
+		p := log.Current
+		if del {
+			p = log.Prev
+		}
+		kv := &types.KeyValue{Key: proposalLocalKey(log.Current.Id)}
+		if p != nil && p.Status == pty.ProposalOpen {
+			kv.Value = types.Encode(p)
+		}
+		set.KV = append(set.KV, kv)
+	}
+	return set
+}
+
+// ExecLocal_Propose defines execlocal propose func
+func (c *Manage) ExecLocal_Propose(modify *types.ModifyConfig, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return proposalLocal(receipt, false), nil
+}
+
+// ExecLocal_Approve defines execlocal approve func
+func (c *Manage) ExecLocal_Approve(id *pty.ProposalID, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return proposalLocal(receipt, false), nil
+}
+
+// ExecLocal_Revoke defines execlocal revoke func
+func (c *Manage) ExecLocal_Revoke(id *pty.ProposalID, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return proposalLocal(receipt, false), nil
+}
+
+// ExecLocal_ProposeExecStatus defines execlocal propose exec status func
+func (c *Manage) ExecLocal_ProposeExecStatus(status *types.ExecStatus, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return proposalLocal(receipt, false), nil
+}
+
+// ExecLocal_ProposeWhitelist defines execlocal propose whitelist func
+func (c *Manage) ExecLocal_ProposeWhitelist(list *types.ExecWhitelist, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return proposalLocal(receipt, false), nil
+}
+
+// ExecLocal_ProposeDappFork defines execlocal propose dapp fork func
+func (c *Manage) ExecLocal_ProposeDappFork(fork *types.DappForkHeight, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return proposalLocal(receipt, false), nil
+}
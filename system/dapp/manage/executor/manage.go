@@ -64,6 +64,15 @@ func IsSuperManager(addr string) bool {
 	return false
 }
 
+// MultiSigThreshold 开启 ForkManageMultiSig 之后修改配置需要的批准数, 没有配置时为1,
+// 这时仍然可以直接修改配置
+func MultiSigThreshold() int {
+	if n := conf.GInt("multiSigThreshold"); n > 0 {
+		return int(n)
+	}
+	return 1
+}
+
 // CheckReceiptExecOk return true to check if receipt ty is ok
 func (c *Manage) CheckReceiptExecOk() bool {
 	return true
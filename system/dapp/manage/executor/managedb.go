@@ -5,7 +5,12 @@
 package executor
 
 import (
+	"strings"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
 	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/system/dapp"
 	pty "github.com/33cn/chain33/system/dapp/manage/types"
 	"github.com/33cn/chain33/types"
 )
@@ -15,11 +20,12 @@ type Action struct {
 	db       dbm.KV
 	fromaddr string
 	height   int64
+	txhash   string
 }
 
 // NewAction new a action object
 func NewAction(m *Manage, tx *types.Transaction) *Action {
-	return &Action{db: m.GetStateDB(), fromaddr: tx.From(), height: m.GetHeight()}
+	return &Action{db: m.GetStateDB(), fromaddr: tx.From(), height: m.GetHeight(), txhash: common.ToHex(tx.Hash())}
 
 }
 
@@ -35,13 +41,28 @@ func (m *Action) modifyConfig(modify *types.ModifyConfig) (*types.Receipt, error
 	if !IsSuperManager(m.fromaddr) {
 		return nil, pty.ErrNoPrivilege
 	}
+	if err := m.checkModify(modify); err != nil {
+		return nil, err
+	}
+	return m.applyModify(modify)
+}
+
+func (m *Action) checkModify(modify *types.ModifyConfig) error {
 	if len(modify.Key) == 0 {
-		return nil, pty.ErrBadConfigKey
+		return pty.ErrBadConfigKey
+	}
+	//链上管理执行器的设置只能通过对应的action修改
+	if (dapp.IsGovernEnable(m.height) || m.isMultiSigEnable()) && strings.HasPrefix(modify.Key, types.ManageGovernPrefix) {
+		return pty.ErrBadConfigKey
 	}
 	if modify.Op != "add" && modify.Op != "delete" {
-		return nil, pty.ErrBadConfigOp
+		return pty.ErrBadConfigOp
 	}
+	return nil
+}
 
+//applyModify 修改配置, 调用之前需要检查权限
+func (m *Action) applyModify(modify *types.ModifyConfig) (*types.Receipt, error) {
 	var item types.ConfigItem
 	value, err := m.db.Get([]byte(types.ManageKey(modify.Key)))
 	if err != nil {
@@ -113,3 +134,349 @@ func (m *Action) modifyConfig(modify *types.ModifyConfig) (*types.Receipt, error
 	receipt := &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}
 	return receipt, nil
 }
+
+//checkExecName 执行器的名字中不能有 "-" 和 "#", manage 和 none 执行器不能被管理
+func checkExecName(name string) error {
+	if name == "" || len(name) > address.MaxExecNameLength || strings.ContainsAny(name, "-#") {
+		return pty.ErrBadExecName
+	}
+	if name == pty.ManageX || name == "none" {
+		return pty.ErrBadExecName
+	}
+	return nil
+}
+
+//checkGovernExec 只能暂停已经注册的执行器
+func checkGovernExec(name string) error {
+	if err := checkExecName(name); err != nil {
+		return err
+	}
+	if types.LoadExecutorType(name) == nil {
+		return pty.ErrBadExecName
+	}
+	return nil
+}
+
+func (m *Action) getGovern(key string, msg types.Message) (bool, error) {
+	value, err := m.db.Get([]byte(key))
+	if err != nil || len(value) == 0 {
+		return false, nil
+	}
+	if err := types.Decode(value, msg); err != nil {
+		clog.Error("getGovern", "decode db key", key)
+		return false, err
+	}
+	return true, nil
+}
+
+func (m *Action) saveGovern(key string, value types.Message, ty int32, log types.Message) *types.Receipt {
+	valueSave := types.Encode(value)
+	m.db.Set([]byte(key), valueSave)
+	kv := []*types.KeyValue{{Key: []byte(key), Value: valueSave}}
+	logs := []*types.ReceiptLog{{Ty: ty, Log: types.Encode(log)}}
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}
+}
+
+//checkExecStatus 设置的高度必须大于当前区块, 当前区块中的交易不受影响
+func (m *Action) checkExecStatus(status *types.ExecStatus) error {
+	if err := checkGovernExec(status.Name); err != nil {
+		return err
+	}
+	if status.Height <= m.height {
+		return pty.ErrBadGovernHeight
+	}
+	return nil
+}
+
+//checkWhitelist 允许列表中可以有还没有部署的执行器
+func (m *Action) checkWhitelist(list *types.ExecWhitelist) error {
+	for _, name := range list.Execs {
+		if err := checkExecName(name); err != nil {
+			return err
+		}
+	}
+	if list.Height <= m.height {
+		return pty.ErrBadGovernHeight
+	}
+	return nil
+}
+
+func (m *Action) checkDappFork(fork *types.DappForkHeight) error {
+	if fork.Dapp == "" || fork.Fork == "" || !types.HasFork(fork.Dapp+"."+fork.Fork) {
+		return pty.ErrBadDappFork
+	}
+	if fork.Height <= m.height {
+		return pty.ErrBadGovernHeight
+	}
+	return nil
+}
+
+//execStatus 设置执行器从 status.Height 开始暂停或者恢复
+func (m *Action) execStatus(status *types.ExecStatus) (*types.Receipt, error) {
+	if !IsSuperManager(m.fromaddr) {
+		return nil, pty.ErrNoPrivilege
+	}
+	return m.applyExecStatus(status)
+}
+
+//applyExecStatus 修改执行器的状态, 调用之前需要检查权限
+func (m *Action) applyExecStatus(status *types.ExecStatus) (*types.Receipt, error) {
+	if err := m.checkExecStatus(status); err != nil {
+		return nil, err
+	}
+	key := types.ManageExecStatusKey(status.Name)
+	var prev types.ExecStatus
+	found, err := m.getGovern(key, &prev)
+	if err != nil {
+		return nil, err
+	}
+	current := &types.ExecStatus{Name: status.Name, Paused: status.Paused, Height: status.Height}
+	if found {
+		current.PrevPaused = dapp.IsPausedAt(&prev, m.height)
+	} else {
+		prev.Name = status.Name
+	}
+	clog.Info("execStatus", "name", status.Name, "paused", status.Paused, "height", status.Height)
+	log := &pty.ReceiptExecStatus{Prev: &prev, Current: current}
+	return m.saveGovern(key, current, pty.TyLogExecStatus, log), nil
+}
+
+//execWhitelist 设置从 list.Height 开始允许调用的执行器列表, 列表为空表示不限制
+func (m *Action) execWhitelist(list *types.ExecWhitelist) (*types.Receipt, error) {
+	if !IsSuperManager(m.fromaddr) {
+		return nil, pty.ErrNoPrivilege
+	}
+	return m.applyWhitelist(list)
+}
+
+//applyWhitelist 修改允许调用的执行器列表, 调用之前需要检查权限
+func (m *Action) applyWhitelist(list *types.ExecWhitelist) (*types.Receipt, error) {
+	if err := m.checkWhitelist(list); err != nil {
+		return nil, err
+	}
+	key := types.ManageExecWhitelistKey()
+	var prev types.ExecWhitelist
+	found, err := m.getGovern(key, &prev)
+	if err != nil {
+		return nil, err
+	}
+	current := &types.ExecWhitelist{Execs: list.Execs, Height: list.Height}
+	if found {
+		current.PrevExecs = dapp.WhitelistAt(&prev, m.height)
+	}
+	clog.Info("execWhitelist", "execs", list.Execs, "height", list.Height)
+	log := &pty.ReceiptExecWhitelist{Prev: &prev, Current: current}
+	return m.saveGovern(key, current, pty.TyLogExecWhitelist, log), nil
+}
+
+//dappFork 修改还没有开启的dapp fork的高度
+func (m *Action) dappFork(fork *types.DappForkHeight) (*types.Receipt, error) {
+	if !IsSuperManager(m.fromaddr) {
+		return nil, pty.ErrNoPrivilege
+	}
+	return m.applyDappFork(fork)
+}
+
+//applyDappFork 修改dapp fork的高度, 调用之前需要检查权限
+func (m *Action) applyDappFork(fork *types.DappForkHeight) (*types.Receipt, error) {
+	if err := m.checkDappFork(fork); err != nil {
+		return nil, err
+	}
+	key := types.ManageDappForkKey(fork.Dapp, fork.Fork)
+	prev := types.DappForkHeight{Dapp: fork.Dapp, Fork: fork.Fork}
+	found, err := m.getGovern(key, &prev)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		prev.Height = types.GetDappFork(fork.Dapp, fork.Fork)
+	}
+	//已经开启的fork不能修改
+	if m.height >= prev.Height {
+		return nil, pty.ErrBadDappFork
+	}
+	current := &types.DappForkHeight{Dapp: fork.Dapp, Fork: fork.Fork, Height: fork.Height}
+	clog.Info("dappFork", "dapp", fork.Dapp, "fork", fork.Fork, "height", fork.Height)
+	log := &pty.ReceiptDappFork{Prev: &prev, Current: current}
+	return m.saveGovern(key, current, pty.TyLogDappFork, log), nil
+}
+
+//isMultiSigEnable 多签的fork高度可以通过链上管理修改
+func (m *Action) isMultiSigEnable() bool {
+	return dapp.NewGovern(m.db, m.height).IsDappFork(pty.ManageX, "ForkManageMultiSig")
+}
+
+//approvedCount 统计提案中仍然是超级管理员的批准数
+func approvedCount(p *pty.ConfigProposal) int {
+	n := 0
+	for _, addr := range p.Approvals {
+		if IsSuperManager(addr) {
+			n++
+		}
+	}
+	return n
+}
+
+func (m *Action) getProposal(id string) (*pty.ConfigProposal, error) {
+	value, err := m.db.Get(pty.ProposalKey(id))
+	if err != nil || len(value) == 0 {
+		return nil, pty.ErrProposalNotFound
+	}
+	var p pty.ConfigProposal
+	if err := types.Decode(value, &p); err != nil {
+		clog.Error("getProposal", "decode db key", id)
+		return nil, err
+	}
+	return &p, nil
+}
+
+//applyProposal 执行提案中的修改
+func (m *Action) applyProposal(p *pty.ConfigProposal) (*types.Receipt, error) {
+	switch {
+	case p.ExecStatus != nil:
+		return m.applyExecStatus(p.ExecStatus)
+	case p.Whitelist != nil:
+		return m.applyWhitelist(p.Whitelist)
+	case p.DappFork != nil:
+		return m.applyDappFork(p.DappFork)
+	}
+	return m.applyModify(p.Modify)
+}
+
+//saveProposal 保存提案, 批准数达到门限的时候执行提案中的修改,
+//执行失败的提案(比如已经过了设置的生效高度)被关闭, 不能再次批准
+func (m *Action) saveProposal(prev, current *pty.ConfigProposal) (*types.Receipt, error) {
+	receipt := &types.Receipt{Ty: types.ExecOk}
+	if current.Status == pty.ProposalOpen && approvedCount(current) >= MultiSigThreshold() {
+		applied, err := m.applyProposal(current)
+		if err != nil {
+			clog.Error("saveProposal", "apply proposal", current.Id, "err", err)
+			current.Status = pty.ProposalFailed
+		} else {
+			current.Status = pty.ProposalApplied
+			receipt.KV = append(receipt.KV, applied.KV...)
+			receipt.Logs = append(receipt.Logs, applied.Logs...)
+			clog.Info("saveProposal", "apply proposal", current.Id, "approvals", current.Approvals)
+		}
+	}
+	key := pty.ProposalKey(current.Id)
+	value := types.Encode(current)
+	m.db.Set(key, value)
+	receipt.KV = append(receipt.KV, &types.KeyValue{Key: key, Value: value})
+	log := &pty.ReceiptConfigProposal{Prev: prev, Current: current}
+	receipt.Logs = append(receipt.Logs, &types.ReceiptLog{Ty: pty.TyLogConfigProposal, Log: types.Encode(log)})
+	return receipt, nil
+}
+
+//propose 创建修改配置的提案, 创建者默认批准提案
+func (m *Action) propose(modify *types.ModifyConfig) (*types.Receipt, error) {
+	if !IsSuperManager(m.fromaddr) {
+		return nil, pty.ErrNoPrivilege
+	}
+	if err := m.checkModify(modify); err != nil {
+		return nil, err
+	}
+	return m.saveProposal(nil, m.newProposal(&pty.ConfigProposal{Modify: modify}))
+}
+
+//proposeGovern 创建链上管理的提案, 和直接修改一样先检查参数, 批准的时候再次检查
+func (m *Action) proposeGovern(p *pty.ConfigProposal) (*types.Receipt, error) {
+	if !IsSuperManager(m.fromaddr) {
+		return nil, pty.ErrNoPrivilege
+	}
+	var err error
+	switch {
+	case p.ExecStatus != nil:
+		err = m.checkExecStatus(p.ExecStatus)
+	case p.Whitelist != nil:
+		err = m.checkWhitelist(p.Whitelist)
+	case p.DappFork != nil:
+		err = m.checkDappFork(p.DappFork)
+	default:
+		err = types.ErrInvalidParam
+	}
+	if err != nil {
+		return nil, err
+	}
+	return m.saveProposal(nil, m.newProposal(p))
+}
+
+//newProposal 创建者默认批准提案
+func (m *Action) newProposal(p *pty.ConfigProposal) *pty.ConfigProposal {
+	expire := conf.GInt("proposalExpire")
+	if expire <= 0 {
+		expire = pty.DefaultProposalExpire
+	}
+	p.Id = m.txhash
+	p.Proposer = m.fromaddr
+	p.Approvals = []string{m.fromaddr}
+	p.Height = m.height
+	p.ExpireHeight = m.height + expire
+	p.Status = pty.ProposalOpen
+	return p
+}
+
+func (m *Action) approve(id string) (*types.Receipt, error) {
+	if !IsSuperManager(m.fromaddr) {
+		return nil, pty.ErrNoPrivilege
+	}
+	p, err := m.getProposal(id)
+	if err != nil {
+		return nil, err
+	}
+	if p.Status != pty.ProposalOpen {
+		return nil, pty.ErrProposalClosed
+	}
+	if m.height > p.ExpireHeight {
+		return m.expireProposal(p)
+	}
+	for _, addr := range p.Approvals {
+		if addr == m.fromaddr {
+			return nil, pty.ErrProposalApproved
+		}
+	}
+	prev := *p
+	current := *p
+	current.Approvals = append(append([]string{}, p.Approvals...), m.fromaddr)
+	return m.saveProposal(&prev, &current)
+}
+
+//revoke 撤回批准, 没有任何批准的提案被关闭
+func (m *Action) revoke(id string) (*types.Receipt, error) {
+	if !IsSuperManager(m.fromaddr) {
+		return nil, pty.ErrNoPrivilege
+	}
+	p, err := m.getProposal(id)
+	if err != nil {
+		return nil, err
+	}
+	if p.Status != pty.ProposalOpen {
+		return nil, pty.ErrProposalClosed
+	}
+	if m.height > p.ExpireHeight {
+		return m.expireProposal(p)
+	}
+	prev := *p
+	current := *p
+	current.Approvals = nil
+	for _, addr := range p.Approvals {
+		if addr != m.fromaddr {
+			current.Approvals = append(current.Approvals, addr)
+		}
+	}
+	if len(current.Approvals) == len(p.Approvals) {
+		return nil, pty.ErrProposalNotApproved
+	}
+	if len(current.Approvals) == 0 {
+		current.Status = pty.ProposalRevoked
+	}
+	return m.saveProposal(&prev, &current)
+}
+
+//expireProposal 过期的提案在下一次批准或者撤回的时候被关闭
+func (m *Action) expireProposal(p *pty.ConfigProposal) (*types.Receipt, error) {
+	prev := *p
+	current := *p
+	current.Status = pty.ProposalExpired
+	return m.saveProposal(&prev, &current)
+}
@@ -0,0 +1,185 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	pty "github.com/33cn/chain33/system/dapp/manage/types"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var proposalCfg = `
+Title="local"
+[exec.sub.manage]
+superManager=["1Bsg9j6gW83sShoee1fZAt9TkUjcrCgA9S", "12qyocayNF7Lv6C9qW4avxs2E7U41fKSfv", "1Q8hGLfoGe63efeWa8fJ4Pnukhkngt6poK"]
+multiSigThreshold=2
+proposalExpire=10
+`
+
+func getProposalLog(t *testing.T, receipt *types.Receipt) *pty.ReceiptConfigProposal {
+	l := receipt.Logs[len(receipt.Logs)-1]
+	assert.Equal(t, int32(pty.TyLogConfigProposal), l.Ty)
+	var log pty.ReceiptConfigProposal
+	assert.Nil(t, types.Decode(l.Log, &log))
+	return &log
+}
+
+func TestConfigProposal(t *testing.T) {
+	types.InitCfgString(proposalCfg)
+	assert.Equal(t, 2, MultiSigThreshold())
+	db, err := dbm.NewGoMemDB("manage", "", 0)
+	assert.Nil(t, err)
+	managers := conf.GStrList("superManager")
+	newAction := func(from string, height int64, hash string) *Action {
+		return &Action{db: db, fromaddr: from, height: height, txhash: hash}
+	}
+	modify := &types.ModifyConfig{Key: "token-blacklist", Op: "add", Value: "BTY"}
+
+	receipt, err := newAction(managers[0], 1, "0x01").propose(modify)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(receipt.Logs))
+	log := getProposalLog(t, receipt)
+	assert.Nil(t, log.Prev)
+	assert.Equal(t, int32(pty.ProposalOpen), log.Current.Status)
+	assert.Equal(t, int64(11), log.Current.ExpireHeight)
+	local := proposalLocal(&types.ReceiptData{Logs: receipt.Logs}, false)
+	assert.Equal(t, types.Encode(log.Current), local.KV[0].Value)
+
+	_, err = newAction("1BjLtd6Eqeo19URRVQzvBFbx1X2TSoPabp", 2, "0x02").approve("0x01")
+	assert.Equal(t, pty.ErrNoPrivilege, err)
+	_, err = newAction(managers[0], 2, "0x02").approve("0x01")
+	assert.Equal(t, pty.ErrProposalApproved, err)
+	_, err = newAction(managers[1], 2, "0x02").revoke("0x01")
+	assert.Equal(t, pty.ErrProposalNotApproved, err)
+	_, err = newAction(managers[1], 2, "0x02").approve("0x03")
+	assert.Equal(t, pty.ErrProposalNotFound, err)
+
+	//批准数达到门限, 修改配置
+	receipt, err = newAction(managers[1], 2, "0x02").approve("0x01")
+	assert.Nil(t, err)
+	assert.Equal(t, int32(pty.TyLogModifyConfig), receipt.Logs[0].Ty)
+	log = getProposalLog(t, receipt)
+	assert.Equal(t, int32(pty.ProposalOpen), log.Prev.Status)
+	assert.Equal(t, int32(pty.ProposalApplied), log.Current.Status)
+	assert.Equal(t, []string{managers[0], managers[1]}, log.Current.Approvals)
+	value, err := db.Get([]byte(types.ManageKey(modify.Key)))
+	assert.Nil(t, err)
+	var item types.ConfigItem
+	assert.Nil(t, types.Decode(value, &item))
+	assert.Equal(t, []string{"BTY"}, item.GetArr().Value)
+	local = proposalLocal(&types.ReceiptData{Logs: receipt.Logs}, false)
+	assert.Nil(t, local.KV[0].Value)
+	local = proposalLocal(&types.ReceiptData{Logs: receipt.Logs}, true)
+	assert.Equal(t, types.Encode(log.Prev), local.KV[0].Value)
+
+	_, err = newAction(managers[2], 3, "0x03").approve("0x01")
+	assert.Equal(t, pty.ErrProposalClosed, err)
+
+	//过期的提案在批准或者撤回的时候被关闭, 不执行提案中的修改
+	_, err = newAction(managers[0], 3, "0x03").propose(&types.ModifyConfig{Key: "token-blacklist", Op: "add", Value: "YCC"})
+	assert.Nil(t, err)
+	receipt, err = newAction(managers[1], 14, "0x04").approve("0x03")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(receipt.Logs))
+	log = getProposalLog(t, receipt)
+	assert.Equal(t, int32(pty.ProposalExpired), log.Current.Status)
+	assert.Equal(t, []string{managers[0]}, log.Current.Approvals)
+	local = proposalLocal(&types.ReceiptData{Logs: receipt.Logs}, false)
+	assert.Nil(t, local.KV[0].Value)
+	_, err = newAction(managers[0], 14, "0x04").revoke("0x03")
+	assert.Equal(t, pty.ErrProposalClosed, err)
+	_, err = newAction(managers[0], 14, "0x04").propose(modify)
+	assert.Nil(t, err)
+	receipt, err = newAction(managers[0], 25, "0x05").revoke("0x04")
+	assert.Nil(t, err)
+	assert.Equal(t, int32(pty.ProposalExpired), getProposalLog(t, receipt).Current.Status)
+
+	//撤回所有的批准之后提案关闭
+	_, err = newAction(managers[0], 25, "0x05").propose(modify)
+	assert.Nil(t, err)
+	receipt, err = newAction(managers[0], 26, "0x06").revoke("0x05")
+	assert.Nil(t, err)
+	log = getProposalLog(t, receipt)
+	assert.Equal(t, int32(pty.ProposalRevoked), log.Current.Status)
+	assert.Equal(t, 0, len(log.Current.Approvals))
+
+	//开启多签之后不能通过修改配置的方式修改提案
+	_, err = newAction(managers[0], 27, "0x07").propose(&types.ModifyConfig{Key: types.ManageGovernPrefix + "proposal-0x03", Op: "add", Value: "1"})
+	assert.Equal(t, pty.ErrBadConfigKey, err)
+}
+
+func TestGovernProposal(t *testing.T) {
+	types.InitCfgString(proposalCfg)
+	db, err := dbm.NewGoMemDB("manage", "", 0)
+	assert.Nil(t, err)
+	managers := conf.GStrList("superManager")
+	newAction := func(from string, height int64, hash string) *Action {
+		return &Action{db: db, fromaddr: from, height: height, txhash: hash}
+	}
+
+	_, err = newAction("1BjLtd6Eqeo19URRVQzvBFbx1X2TSoPabp", 1, "0x01").proposeGovern(&pty.ConfigProposal{ExecStatus: &types.ExecStatus{Name: "coins", Paused: true, Height: 5}})
+	assert.Equal(t, pty.ErrNoPrivilege, err)
+	_, err = newAction(managers[0], 1, "0x01").proposeGovern(&pty.ConfigProposal{ExecStatus: &types.ExecStatus{Name: "coins", Paused: true, Height: 1}})
+	assert.Equal(t, pty.ErrBadGovernHeight, err)
+	_, err = newAction(managers[0], 1, "0x01").proposeGovern(&pty.ConfigProposal{})
+	assert.Equal(t, types.ErrInvalidParam, err)
+
+	//提案创建的时候不修改执行器的状态, 批准数达到门限之后才修改
+	receipt, err := newAction(managers[0], 1, "0x01").proposeGovern(&pty.ConfigProposal{ExecStatus: &types.ExecStatus{Name: "coins", Paused: true, Height: 5}})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(receipt.Logs))
+	_, err = db.Get([]byte(types.ManageExecStatusKey("coins")))
+	assert.NotNil(t, err)
+	receipt, err = newAction(managers[1], 2, "0x02").approve("0x01")
+	assert.Nil(t, err)
+	assert.Equal(t, int32(pty.TyLogExecStatus), receipt.Logs[0].Ty)
+	assert.Equal(t, int32(pty.ProposalApplied), getProposalLog(t, receipt).Current.Status)
+	value, err := db.Get([]byte(types.ManageExecStatusKey("coins")))
+	assert.Nil(t, err)
+	var status types.ExecStatus
+	assert.Nil(t, types.Decode(value, &status))
+	assert.True(t, status.Paused)
+
+	//批准的时候再次检查, 已经过了生效高度的提案执行失败并且被关闭
+	_, err = newAction(managers[0], 3, "0x03").proposeGovern(&pty.ConfigProposal{Whitelist: &types.ExecWhitelist{Execs: []string{"coins"}, Height: 4}})
+	assert.Nil(t, err)
+	receipt, err = newAction(managers[1], 4, "0x04").approve("0x03")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(receipt.Logs))
+	assert.Equal(t, int32(pty.ProposalFailed), getProposalLog(t, receipt).Current.Status)
+	_, err = db.Get([]byte(types.ManageExecWhitelistKey()))
+	assert.NotNil(t, err)
+	_, err = newAction(managers[2], 4, "0x04").approve("0x03")
+	assert.Equal(t, pty.ErrProposalClosed, err)
+}
+
+func TestGovernDappFork(t *testing.T) {
+	types.InitCfgString(proposalCfg)
+	db, err := dbm.NewGoMemDB("manage", "", 0)
+	assert.Nil(t, err)
+	//链上设置的多签fork高度覆盖配置中的高度
+	fork := &types.DappForkHeight{Dapp: pty.ManageX, Fork: "ForkManageMultiSig", Height: 10}
+	assert.Nil(t, db.Set([]byte(types.ManageDappForkKey(fork.Dapp, fork.Fork)), types.Encode(fork)))
+	manage := newManage().(*Manage)
+	manage.SetStateDB(db)
+	tx := &types.Transaction{Execer: []byte(pty.ManageX), To: address.ExecAddress(pty.ManageX)}
+	modify := &types.ModifyConfig{Key: "token-blacklist", Op: "add", Value: "BTY"}
+
+	manage.SetEnv(9, 0, 0)
+	_, err = manage.Exec_Modify(modify, tx, 0)
+	assert.Equal(t, pty.ErrNoPrivilege, err)
+	_, err = manage.Exec_Propose(modify, tx, 0)
+	assert.Equal(t, types.ErrActionNotSupport, err)
+
+	manage.SetEnv(10, 0, 0)
+	_, err = manage.Exec_Modify(modify, tx, 0)
+	assert.Equal(t, pty.ErrNeedProposal, err)
+	_, err = manage.Exec_Propose(modify, tx, 0)
+	assert.Equal(t, pty.ErrNoPrivilege, err)
+}
@@ -7,6 +7,8 @@ package executor
 import (
 	"fmt"
 
+	"github.com/33cn/chain33/system/dapp"
+	pty "github.com/33cn/chain33/system/dapp/manage/types"
 	"github.com/33cn/chain33/types"
 )
 
@@ -44,3 +46,113 @@ func (c *Manage) Query_GetConfigItem(in *types.ReqString) (types.Message, error)
 
 	return &reply, nil
 }
+
+//nextHeight 查询的设置对下一个区块中的交易生效
+func (c *Manage) nextHeight() (int64, error) {
+	header, err := c.GetAPI().GetLastHeader()
+	if err != nil {
+		return 0, err
+	}
+	return header.Height + 1, nil
+}
+
+// Query_GetExecStatus get the status of a executor
+func (c *Manage) Query_GetExecStatus(in *types.ReqString) (types.Message, error) {
+	if in.Data == "" {
+		return nil, types.ErrInvalidParam
+	}
+	height, err := c.nextHeight()
+	if err != nil {
+		return nil, err
+	}
+	reply := &pty.ReplyExecStatus{Name: in.Data, Status: &types.ExecStatus{Name: in.Data}}
+	value, err := c.GetStateDB().Get([]byte(types.ManageExecStatusKey(in.Data)))
+	if err == nil && len(value) > 0 {
+		if err := types.Decode(value, reply.Status); err != nil {
+			return nil, err
+		}
+	}
+	govern := dapp.NewGovern(c.GetStateDB(), height)
+	reply.Allowed = govern.IsExecAllowed(in.Data)
+	reply.Whitelisted = govern.IsExecWhitelisted(in.Data)
+	return reply, nil
+}
+
+// Query_GetExecWhitelist get the executors which are allowed to call
+func (c *Manage) Query_GetExecWhitelist(in *types.ReqNil) (types.Message, error) {
+	var list types.ExecWhitelist
+	value, err := c.GetStateDB().Get([]byte(types.ManageExecWhitelistKey()))
+	if err == nil && len(value) > 0 {
+		if err := types.Decode(value, &list); err != nil {
+			return nil, err
+		}
+	}
+	return &list, nil
+}
+
+// Query_GetDappFork get the height of a dapp fork, the height set by manage is preferred
+func (c *Manage) Query_GetDappFork(in *types.DappForkHeight) (types.Message, error) {
+	if !types.HasFork(in.Dapp + "." + in.Fork) {
+		return nil, pty.ErrBadDappFork
+	}
+	reply := &types.DappForkHeight{Dapp: in.Dapp, Fork: in.Fork, Height: types.GetDappFork(in.Dapp, in.Fork)}
+	value, err := c.GetStateDB().Get([]byte(types.ManageDappForkKey(in.Dapp, in.Fork)))
+	if err == nil && len(value) > 0 {
+		if err := types.Decode(value, reply); err != nil {
+			return nil, err
+		}
+	}
+	return reply, nil
+}
+
+// MaxProposalsPerQuery 一次查询返回的最多提案数
+const MaxProposalsPerQuery = 100
+
+// Query_GetProposal get a config proposal
+func (c *Manage) Query_GetProposal(in *pty.ProposalID) (types.Message, error) {
+	value, err := c.GetStateDB().Get(pty.ProposalKey(in.Id))
+	if err != nil || len(value) == 0 {
+		return nil, pty.ErrProposalNotFound
+	}
+	var p pty.ConfigProposal
+	if err := types.Decode(value, &p); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// Query_ListProposals list the open config proposals which are not expired
+func (c *Manage) Query_ListProposals(in *pty.ReqConfigProposals) (types.Message, error) {
+	if in.Count <= 0 || in.Count > MaxProposalsPerQuery {
+		return nil, types.ErrInvalidParam
+	}
+	height, err := c.nextHeight()
+	if err != nil {
+		return nil, err
+	}
+	prefix := proposalLocalKey("")
+	var key []byte
+	if in.Cursor != "" {
+		key = proposalLocalKey(in.Cursor)
+	}
+	values, err := c.GetLocalDB().List(prefix, key, in.Count, 1)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	reply := &pty.ReplyConfigProposals{}
+	for _, value := range values {
+		var p pty.ConfigProposal
+		if err := types.Decode(value, &p); err != nil {
+			return nil, err
+		}
+		if p.ExpireHeight >= height {
+			reply.Proposals = append(reply.Proposals, &p)
+		}
+		reply.Cursor = p.Id
+	}
+	//没有更多的提案
+	if len(values) < int(in.Count) {
+		reply.Cursor = ""
+	}
+	return reply, nil
+}
@@ -7,14 +7,41 @@ package types
 // ManageActionModifyConfig manager action
 const (
 	ManageActionModifyConfig = iota
+	ManageActionExecStatus
+	ManageActionExecWhitelist
+	ManageActionDappFork
+	ManageActionPropose
+	ManageActionApprove
+	ManageActionRevoke
+	ManageActionProposeExecStatus
+	ManageActionProposeWhitelist
+	ManageActionProposeDappFork
 )
 
 // TyLogModifyConfig log
 const (
-	TyLogModifyConfig = 410
+	TyLogModifyConfig   = 410
+	TyLogExecStatus     = 411
+	TyLogExecWhitelist  = 412
+	TyLogDappFork       = 413
+	TyLogConfigProposal = 414
 )
 
 // ConfigItemArrayConfig config Item
 const (
 	ConfigItemArrayConfig = iota
 )
+
+// 修改配置提案的状态
+const (
+	ProposalOpen = iota + 1
+	ProposalApplied
+	ProposalRevoked
+	//ProposalFailed 批准数达到门限, 但是执行提案中的修改失败
+	ProposalFailed
+	//ProposalExpired 过期之后被批准或者撤回的提案
+	ProposalExpired
+)
+
+// DefaultProposalExpire 没有配置 proposalExpire 时, 提案的有效区块数
+const DefaultProposalExpire = 100000
@@ -15,4 +15,20 @@ var (
 	ErrBadConfigOp = errors.New("ErrBadConfigOp")
 	// ErrBadConfigValue defines a err string errbadconfigvalue
 	ErrBadConfigValue = errors.New("ErrBadConfigValue")
+	// ErrBadExecName defines a err string errbadexecname
+	ErrBadExecName = errors.New("ErrBadExecName")
+	// ErrBadGovernHeight defines a err string errbadgovernheight
+	ErrBadGovernHeight = errors.New("ErrBadGovernHeight")
+	// ErrBadDappFork defines a err string errbaddappfork
+	ErrBadDappFork = errors.New("ErrBadDappFork")
+	// ErrNeedProposal defines a err string errneedproposal
+	ErrNeedProposal = errors.New("ErrNeedProposal")
+	// ErrProposalNotFound defines a err string errproposalnotfound
+	ErrProposalNotFound = errors.New("ErrProposalNotFound")
+	// ErrProposalClosed defines a err string errproposalclosed
+	ErrProposalClosed = errors.New("ErrProposalClosed")
+	// ErrProposalApproved defines a err string errproposalapproved
+	ErrProposalApproved = errors.New("ErrProposalApproved")
+	// ErrProposalNotApproved defines a err string errproposalnotapproved
+	ErrProposalNotApproved = errors.New("ErrProposalNotApproved")
 )
@@ -25,6 +25,15 @@ const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 type ManageAction struct {
 	// Types that are valid to be assigned to Value:
 	//	*ManageAction_Modify
+	//	*ManageAction_ExecStatus
+	//	*ManageAction_Whitelist
+	//	*ManageAction_DappFork
+	//	*ManageAction_Propose
+	//	*ManageAction_Approve
+	//	*ManageAction_Revoke
+	//	*ManageAction_ProposeExecStatus
+	//	*ManageAction_ProposeWhitelist
+	//	*ManageAction_ProposeDappFork
 	Value                isManageAction_Value `protobuf_oneof:"value"`
 	Ty                   int32                `protobuf:"varint,2,opt,name=Ty,proto3" json:"Ty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
@@ -65,8 +74,62 @@ type ManageAction_Modify struct {
 	Modify *types.ModifyConfig `protobuf:"bytes,1,opt,name=modify,proto3,oneof"`
 }
 
+type ManageAction_ExecStatus struct {
+	ExecStatus *types.ExecStatus `protobuf:"bytes,3,opt,name=execStatus,proto3,oneof"`
+}
+
+type ManageAction_Whitelist struct {
+	Whitelist *types.ExecWhitelist `protobuf:"bytes,4,opt,name=whitelist,proto3,oneof"`
+}
+
+type ManageAction_DappFork struct {
+	DappFork *types.DappForkHeight `protobuf:"bytes,5,opt,name=dappFork,proto3,oneof"`
+}
+
+type ManageAction_Propose struct {
+	Propose *types.ModifyConfig `protobuf:"bytes,6,opt,name=propose,proto3,oneof"`
+}
+
+type ManageAction_Approve struct {
+	Approve *ProposalID `protobuf:"bytes,7,opt,name=approve,proto3,oneof"`
+}
+
+type ManageAction_Revoke struct {
+	Revoke *ProposalID `protobuf:"bytes,8,opt,name=revoke,proto3,oneof"`
+}
+
+type ManageAction_ProposeExecStatus struct {
+	ProposeExecStatus *types.ExecStatus `protobuf:"bytes,9,opt,name=proposeExecStatus,proto3,oneof"`
+}
+
+type ManageAction_ProposeWhitelist struct {
+	ProposeWhitelist *types.ExecWhitelist `protobuf:"bytes,10,opt,name=proposeWhitelist,proto3,oneof"`
+}
+
+type ManageAction_ProposeDappFork struct {
+	ProposeDappFork *types.DappForkHeight `protobuf:"bytes,11,opt,name=proposeDappFork,proto3,oneof"`
+}
+
 func (*ManageAction_Modify) isManageAction_Value() {}
 
+func (*ManageAction_ExecStatus) isManageAction_Value() {}
+
+func (*ManageAction_Whitelist) isManageAction_Value() {}
+
+func (*ManageAction_DappFork) isManageAction_Value() {}
+
+func (*ManageAction_Propose) isManageAction_Value() {}
+
+func (*ManageAction_Approve) isManageAction_Value() {}
+
+func (*ManageAction_Revoke) isManageAction_Value() {}
+
+func (*ManageAction_ProposeExecStatus) isManageAction_Value() {}
+
+func (*ManageAction_ProposeWhitelist) isManageAction_Value() {}
+
+func (*ManageAction_ProposeDappFork) isManageAction_Value() {}
+
 func (m *ManageAction) GetValue() isManageAction_Value {
 	if m != nil {
 		return m.Value
@@ -81,6 +144,69 @@ func (m *ManageAction) GetModify() *types.ModifyConfig {
 	return nil
 }
 
+func (m *ManageAction) GetExecStatus() *types.ExecStatus {
+	if x, ok := m.GetValue().(*ManageAction_ExecStatus); ok {
+		return x.ExecStatus
+	}
+	return nil
+}
+
+func (m *ManageAction) GetWhitelist() *types.ExecWhitelist {
+	if x, ok := m.GetValue().(*ManageAction_Whitelist); ok {
+		return x.Whitelist
+	}
+	return nil
+}
+
+func (m *ManageAction) GetDappFork() *types.DappForkHeight {
+	if x, ok := m.GetValue().(*ManageAction_DappFork); ok {
+		return x.DappFork
+	}
+	return nil
+}
+
+func (m *ManageAction) GetPropose() *types.ModifyConfig {
+	if x, ok := m.GetValue().(*ManageAction_Propose); ok {
+		return x.Propose
+	}
+	return nil
+}
+
+func (m *ManageAction) GetApprove() *ProposalID {
+	if x, ok := m.GetValue().(*ManageAction_Approve); ok {
+		return x.Approve
+	}
+	return nil
+}
+
+func (m *ManageAction) GetRevoke() *ProposalID {
+	if x, ok := m.GetValue().(*ManageAction_Revoke); ok {
+		return x.Revoke
+	}
+	return nil
+}
+
+func (m *ManageAction) GetProposeExecStatus() *types.ExecStatus {
+	if x, ok := m.GetValue().(*ManageAction_ProposeExecStatus); ok {
+		return x.ProposeExecStatus
+	}
+	return nil
+}
+
+func (m *ManageAction) GetProposeWhitelist() *types.ExecWhitelist {
+	if x, ok := m.GetValue().(*ManageAction_ProposeWhitelist); ok {
+		return x.ProposeWhitelist
+	}
+	return nil
+}
+
+func (m *ManageAction) GetProposeDappFork() *types.DappForkHeight {
+	if x, ok := m.GetValue().(*ManageAction_ProposeDappFork); ok {
+		return x.ProposeDappFork
+	}
+	return nil
+}
+
 func (m *ManageAction) GetTy() int32 {
 	if m != nil {
 		return m.Ty
@@ -92,6 +218,15 @@ func (m *ManageAction) GetTy() int32 {
 func (*ManageAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _ManageAction_OneofMarshaler, _ManageAction_OneofUnmarshaler, _ManageAction_OneofSizer, []interface{}{
 		(*ManageAction_Modify)(nil),
+		(*ManageAction_ExecStatus)(nil),
+		(*ManageAction_Whitelist)(nil),
+		(*ManageAction_DappFork)(nil),
+		(*ManageAction_Propose)(nil),
+		(*ManageAction_Approve)(nil),
+		(*ManageAction_Revoke)(nil),
+		(*ManageAction_ProposeExecStatus)(nil),
+		(*ManageAction_ProposeWhitelist)(nil),
+		(*ManageAction_ProposeDappFork)(nil),
 	}
 }
 
@@ -104,6 +239,51 @@ func _ManageAction_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.Modify); err != nil {
 			return err
 		}
+	case *ManageAction_ExecStatus:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ExecStatus); err != nil {
+			return err
+		}
+	case *ManageAction_Whitelist:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Whitelist); err != nil {
+			return err
+		}
+	case *ManageAction_DappFork:
+		b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.DappFork); err != nil {
+			return err
+		}
+	case *ManageAction_Propose:
+		b.EncodeVarint(6<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Propose); err != nil {
+			return err
+		}
+	case *ManageAction_Approve:
+		b.EncodeVarint(7<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Approve); err != nil {
+			return err
+		}
+	case *ManageAction_Revoke:
+		b.EncodeVarint(8<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Revoke); err != nil {
+			return err
+		}
+	case *ManageAction_ProposeExecStatus:
+		b.EncodeVarint(9<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ProposeExecStatus); err != nil {
+			return err
+		}
+	case *ManageAction_ProposeWhitelist:
+		b.EncodeVarint(10<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ProposeWhitelist); err != nil {
+			return err
+		}
+	case *ManageAction_ProposeDappFork:
+		b.EncodeVarint(11<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ProposeDappFork); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("ManageAction.Value has unexpected type %T", x)
@@ -122,6 +302,78 @@ func _ManageAction_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.B
 		err := b.DecodeMessage(msg)
 		m.Value = &ManageAction_Modify{msg}
 		return true, err
+	case 3: // value.execStatus
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(types.ExecStatus)
+		err := b.DecodeMessage(msg)
+		m.Value = &ManageAction_ExecStatus{msg}
+		return true, err
+	case 4: // value.whitelist
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(types.ExecWhitelist)
+		err := b.DecodeMessage(msg)
+		m.Value = &ManageAction_Whitelist{msg}
+		return true, err
+	case 5: // value.dappFork
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(types.DappForkHeight)
+		err := b.DecodeMessage(msg)
+		m.Value = &ManageAction_DappFork{msg}
+		return true, err
+	case 6: // value.propose
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(types.ModifyConfig)
+		err := b.DecodeMessage(msg)
+		m.Value = &ManageAction_Propose{msg}
+		return true, err
+	case 7: // value.approve
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ProposalID)
+		err := b.DecodeMessage(msg)
+		m.Value = &ManageAction_Approve{msg}
+		return true, err
+	case 8: // value.revoke
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ProposalID)
+		err := b.DecodeMessage(msg)
+		m.Value = &ManageAction_Revoke{msg}
+		return true, err
+	case 9: // value.proposeExecStatus
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(types.ExecStatus)
+		err := b.DecodeMessage(msg)
+		m.Value = &ManageAction_ProposeExecStatus{msg}
+		return true, err
+	case 10: // value.proposeWhitelist
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(types.ExecWhitelist)
+		err := b.DecodeMessage(msg)
+		m.Value = &ManageAction_ProposeWhitelist{msg}
+		return true, err
+	case 11: // value.proposeDappFork
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(types.DappForkHeight)
+		err := b.DecodeMessage(msg)
+		m.Value = &ManageAction_ProposeDappFork{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -136,6 +388,51 @@ func _ManageAction_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *ManageAction_ExecStatus:
+		s := proto.Size(x.ExecStatus)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ManageAction_Whitelist:
+		s := proto.Size(x.Whitelist)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ManageAction_DappFork:
+		s := proto.Size(x.DappFork)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ManageAction_Propose:
+		s := proto.Size(x.Propose)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ManageAction_Approve:
+		s := proto.Size(x.Approve)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ManageAction_Revoke:
+		s := proto.Size(x.Revoke)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ManageAction_ProposeExecStatus:
+		s := proto.Size(x.ProposeExecStatus)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ManageAction_ProposeWhitelist:
+		s := proto.Size(x.ProposeWhitelist)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ManageAction_ProposeDappFork:
+		s := proto.Size(x.ProposeDappFork)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -143,21 +440,561 @@ func _ManageAction_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
+type ReceiptExecStatus struct {
+	Prev                 *types.ExecStatus `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *types.ExecStatus `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ReceiptExecStatus) Reset()         { *m = ReceiptExecStatus{} }
+func (m *ReceiptExecStatus) String() string { return proto.CompactTextString(m) }
+func (*ReceiptExecStatus) ProtoMessage()    {}
+func (*ReceiptExecStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519fa8ed5ffbbc8f, []int{1}
+}
+
+func (m *ReceiptExecStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptExecStatus.Unmarshal(m, b)
+}
+func (m *ReceiptExecStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptExecStatus.Marshal(b, m, deterministic)
+}
+func (m *ReceiptExecStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptExecStatus.Merge(m, src)
+}
+func (m *ReceiptExecStatus) XXX_Size() int {
+	return xxx_messageInfo_ReceiptExecStatus.Size(m)
+}
+func (m *ReceiptExecStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptExecStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptExecStatus proto.InternalMessageInfo
+
+func (m *ReceiptExecStatus) GetPrev() *types.ExecStatus {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptExecStatus) GetCurrent() *types.ExecStatus {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ReceiptExecWhitelist struct {
+	Prev                 *types.ExecWhitelist `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *types.ExecWhitelist `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ReceiptExecWhitelist) Reset()         { *m = ReceiptExecWhitelist{} }
+func (m *ReceiptExecWhitelist) String() string { return proto.CompactTextString(m) }
+func (*ReceiptExecWhitelist) ProtoMessage()    {}
+func (*ReceiptExecWhitelist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519fa8ed5ffbbc8f, []int{2}
+}
+
+func (m *ReceiptExecWhitelist) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptExecWhitelist.Unmarshal(m, b)
+}
+func (m *ReceiptExecWhitelist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptExecWhitelist.Marshal(b, m, deterministic)
+}
+func (m *ReceiptExecWhitelist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptExecWhitelist.Merge(m, src)
+}
+func (m *ReceiptExecWhitelist) XXX_Size() int {
+	return xxx_messageInfo_ReceiptExecWhitelist.Size(m)
+}
+func (m *ReceiptExecWhitelist) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptExecWhitelist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptExecWhitelist proto.InternalMessageInfo
+
+func (m *ReceiptExecWhitelist) GetPrev() *types.ExecWhitelist {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptExecWhitelist) GetCurrent() *types.ExecWhitelist {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ReceiptDappFork struct {
+	Prev                 *types.DappForkHeight `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *types.DappForkHeight `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ReceiptDappFork) Reset()         { *m = ReceiptDappFork{} }
+func (m *ReceiptDappFork) String() string { return proto.CompactTextString(m) }
+func (*ReceiptDappFork) ProtoMessage()    {}
+func (*ReceiptDappFork) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519fa8ed5ffbbc8f, []int{3}
+}
+
+func (m *ReceiptDappFork) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptDappFork.Unmarshal(m, b)
+}
+func (m *ReceiptDappFork) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptDappFork.Marshal(b, m, deterministic)
+}
+func (m *ReceiptDappFork) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptDappFork.Merge(m, src)
+}
+func (m *ReceiptDappFork) XXX_Size() int {
+	return xxx_messageInfo_ReceiptDappFork.Size(m)
+}
+func (m *ReceiptDappFork) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptDappFork.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptDappFork proto.InternalMessageInfo
+
+func (m *ReceiptDappFork) GetPrev() *types.DappForkHeight {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptDappFork) GetCurrent() *types.DappForkHeight {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ReplyExecStatus struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// 最新区块高度上执行器是否可以调用
+	Allowed              bool              `protobuf:"varint,2,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Status               *types.ExecStatus `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Whitelisted          bool              `protobuf:"varint,4,opt,name=whitelisted,proto3" json:"whitelisted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ReplyExecStatus) Reset()         { *m = ReplyExecStatus{} }
+func (m *ReplyExecStatus) String() string { return proto.CompactTextString(m) }
+func (*ReplyExecStatus) ProtoMessage()    {}
+func (*ReplyExecStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519fa8ed5ffbbc8f, []int{4}
+}
+
+func (m *ReplyExecStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyExecStatus.Unmarshal(m, b)
+}
+func (m *ReplyExecStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyExecStatus.Marshal(b, m, deterministic)
+}
+func (m *ReplyExecStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyExecStatus.Merge(m, src)
+}
+func (m *ReplyExecStatus) XXX_Size() int {
+	return xxx_messageInfo_ReplyExecStatus.Size(m)
+}
+func (m *ReplyExecStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyExecStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyExecStatus proto.InternalMessageInfo
+
+func (m *ReplyExecStatus) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ReplyExecStatus) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
+	}
+	return false
+}
+
+func (m *ReplyExecStatus) GetStatus() *types.ExecStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ReplyExecStatus) GetWhitelisted() bool {
+	if m != nil {
+		return m.Whitelisted
+	}
+	return false
+}
+
+type ProposalID struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProposalID) Reset()         { *m = ProposalID{} }
+func (m *ProposalID) String() string { return proto.CompactTextString(m) }
+func (*ProposalID) ProtoMessage()    {}
+func (*ProposalID) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519fa8ed5ffbbc8f, []int{5}
+}
+
+func (m *ProposalID) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProposalID.Unmarshal(m, b)
+}
+func (m *ProposalID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProposalID.Marshal(b, m, deterministic)
+}
+func (m *ProposalID) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalID.Merge(m, src)
+}
+func (m *ProposalID) XXX_Size() int {
+	return xxx_messageInfo_ProposalID.Size(m)
+}
+func (m *ProposalID) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalID.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalID proto.InternalMessageInfo
+
+func (m *ProposalID) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// 修改配置或者链上管理的提案, 超级管理员的批准数达到门限之后自动执行,
+// modify, execStatus, whitelist, dappFork 只有一个不为空
+type ConfigProposal struct {
+	// 创建提案的交易哈希
+	Id                   string                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Modify               *types.ModifyConfig   `protobuf:"bytes,2,opt,name=modify,proto3" json:"modify,omitempty"`
+	Proposer             string                `protobuf:"bytes,3,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Approvals            []string              `protobuf:"bytes,4,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	Height               int64                 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	ExpireHeight         int64                 `protobuf:"varint,6,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
+	Status               int32                 `protobuf:"varint,7,opt,name=status,proto3" json:"status,omitempty"`
+	ExecStatus           *types.ExecStatus     `protobuf:"bytes,8,opt,name=execStatus,proto3" json:"execStatus,omitempty"`
+	Whitelist            *types.ExecWhitelist  `protobuf:"bytes,9,opt,name=whitelist,proto3" json:"whitelist,omitempty"`
+	DappFork             *types.DappForkHeight `protobuf:"bytes,10,opt,name=dappFork,proto3" json:"dappFork,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ConfigProposal) Reset()         { *m = ConfigProposal{} }
+func (m *ConfigProposal) String() string { return proto.CompactTextString(m) }
+func (*ConfigProposal) ProtoMessage()    {}
+func (*ConfigProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519fa8ed5ffbbc8f, []int{6}
+}
+
+func (m *ConfigProposal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfigProposal.Unmarshal(m, b)
+}
+func (m *ConfigProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfigProposal.Marshal(b, m, deterministic)
+}
+func (m *ConfigProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigProposal.Merge(m, src)
+}
+func (m *ConfigProposal) XXX_Size() int {
+	return xxx_messageInfo_ConfigProposal.Size(m)
+}
+func (m *ConfigProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigProposal proto.InternalMessageInfo
+
+func (m *ConfigProposal) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ConfigProposal) GetModify() *types.ModifyConfig {
+	if m != nil {
+		return m.Modify
+	}
+	return nil
+}
+
+func (m *ConfigProposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *ConfigProposal) GetApprovals() []string {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
+}
+
+func (m *ConfigProposal) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ConfigProposal) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+func (m *ConfigProposal) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ConfigProposal) GetExecStatus() *types.ExecStatus {
+	if m != nil {
+		return m.ExecStatus
+	}
+	return nil
+}
+
+func (m *ConfigProposal) GetWhitelist() *types.ExecWhitelist {
+	if m != nil {
+		return m.Whitelist
+	}
+	return nil
+}
+
+func (m *ConfigProposal) GetDappFork() *types.DappForkHeight {
+	if m != nil {
+		return m.DappFork
+	}
+	return nil
+}
+
+type ReceiptConfigProposal struct {
+	Prev                 *ConfigProposal `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *ConfigProposal `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ReceiptConfigProposal) Reset()         { *m = ReceiptConfigProposal{} }
+func (m *ReceiptConfigProposal) String() string { return proto.CompactTextString(m) }
+func (*ReceiptConfigProposal) ProtoMessage()    {}
+func (*ReceiptConfigProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519fa8ed5ffbbc8f, []int{7}
+}
+
+func (m *ReceiptConfigProposal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptConfigProposal.Unmarshal(m, b)
+}
+func (m *ReceiptConfigProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptConfigProposal.Marshal(b, m, deterministic)
+}
+func (m *ReceiptConfigProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptConfigProposal.Merge(m, src)
+}
+func (m *ReceiptConfigProposal) XXX_Size() int {
+	return xxx_messageInfo_ReceiptConfigProposal.Size(m)
+}
+func (m *ReceiptConfigProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptConfigProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptConfigProposal proto.InternalMessageInfo
+
+func (m *ReceiptConfigProposal) GetPrev() *ConfigProposal {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptConfigProposal) GetCurrent() *ConfigProposal {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ReqConfigProposals struct {
+	// 从 cursor 的下一个提案开始
+	Cursor               string   `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Count                int32    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqConfigProposals) Reset()         { *m = ReqConfigProposals{} }
+func (m *ReqConfigProposals) String() string { return proto.CompactTextString(m) }
+func (*ReqConfigProposals) ProtoMessage()    {}
+func (*ReqConfigProposals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519fa8ed5ffbbc8f, []int{8}
+}
+
+func (m *ReqConfigProposals) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqConfigProposals.Unmarshal(m, b)
+}
+func (m *ReqConfigProposals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqConfigProposals.Marshal(b, m, deterministic)
+}
+func (m *ReqConfigProposals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqConfigProposals.Merge(m, src)
+}
+func (m *ReqConfigProposals) XXX_Size() int {
+	return xxx_messageInfo_ReqConfigProposals.Size(m)
+}
+func (m *ReqConfigProposals) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqConfigProposals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqConfigProposals proto.InternalMessageInfo
+
+func (m *ReqConfigProposals) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *ReqConfigProposals) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type ReplyConfigProposals struct {
+	Proposals            []*ConfigProposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	Cursor               string            `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ReplyConfigProposals) Reset()         { *m = ReplyConfigProposals{} }
+func (m *ReplyConfigProposals) String() string { return proto.CompactTextString(m) }
+func (*ReplyConfigProposals) ProtoMessage()    {}
+func (*ReplyConfigProposals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519fa8ed5ffbbc8f, []int{9}
+}
+
+func (m *ReplyConfigProposals) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyConfigProposals.Unmarshal(m, b)
+}
+func (m *ReplyConfigProposals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyConfigProposals.Marshal(b, m, deterministic)
+}
+func (m *ReplyConfigProposals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyConfigProposals.Merge(m, src)
+}
+func (m *ReplyConfigProposals) XXX_Size() int {
+	return xxx_messageInfo_ReplyConfigProposals.Size(m)
+}
+func (m *ReplyConfigProposals) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyConfigProposals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyConfigProposals proto.InternalMessageInfo
+
+func (m *ReplyConfigProposals) GetProposals() []*ConfigProposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+func (m *ReplyConfigProposals) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ManageAction)(nil), "types.ManageAction")
+	proto.RegisterType((*ReceiptExecStatus)(nil), "types.ReceiptExecStatus")
+	proto.RegisterType((*ReceiptExecWhitelist)(nil), "types.ReceiptExecWhitelist")
+	proto.RegisterType((*ReceiptDappFork)(nil), "types.ReceiptDappFork")
+	proto.RegisterType((*ReplyExecStatus)(nil), "types.ReplyExecStatus")
+	proto.RegisterType((*ProposalID)(nil), "types.ProposalID")
+	proto.RegisterType((*ConfigProposal)(nil), "types.ConfigProposal")
+	proto.RegisterType((*ReceiptConfigProposal)(nil), "types.ReceiptConfigProposal")
+	proto.RegisterType((*ReqConfigProposals)(nil), "types.ReqConfigProposals")
+	proto.RegisterType((*ReplyConfigProposals)(nil), "types.ReplyConfigProposals")
 }
 
 func init() { proto.RegisterFile("manage.proto", fileDescriptor_519fa8ed5ffbbc8f) }
 
 var fileDescriptor_519fa8ed5ffbbc8f = []byte{
-	// 136 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0xc9, 0x4d, 0xcc, 0x4b,
-	0x4c, 0x4f, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x2d, 0xa9, 0x2c, 0x48, 0x2d, 0x96,
-	0xe2, 0x4b, 0xad, 0x48, 0x4d, 0x2e, 0x2d, 0xc9, 0x2f, 0x82, 0x08, 0x2b, 0x85, 0x71, 0xf1, 0xf8,
-	0x82, 0x95, 0x39, 0x26, 0x97, 0x64, 0xe6, 0xe7, 0x09, 0xe9, 0x72, 0xb1, 0xe5, 0xe6, 0xa7, 0x64,
-	0xa6, 0x55, 0x4a, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0x09, 0xeb, 0x81, 0xf5, 0xe9, 0xf9, 0x82,
-	0x05, 0x9d, 0xf3, 0xf3, 0xd2, 0x32, 0xd3, 0x3d, 0x18, 0x82, 0xa0, 0x8a, 0x84, 0xf8, 0xb8, 0x98,
-	0x42, 0x2a, 0x25, 0x98, 0x14, 0x18, 0x35, 0x58, 0x83, 0x98, 0x42, 0x2a, 0x9d, 0xd8, 0xb9, 0x58,
-	0xcb, 0x12, 0x73, 0x4a, 0x53, 0x93, 0xd8, 0xc0, 0xc6, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0xf1, 0x53, 0xa6, 0x25, 0x85, 0x00, 0x00, 0x00,
+	// 639 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x95, 0xdd, 0x4e, 0xdb, 0x4c,
+	0x10, 0x86, 0x89, 0xf3, 0x63, 0x7b, 0x40, 0xf0, 0x31, 0x1f, 0x54, 0x2b, 0xc4, 0x41, 0x64, 0xa9,
+	0x52, 0x10, 0x22, 0x08, 0xd2, 0x1b, 0x80, 0xd2, 0x2a, 0x3d, 0x40, 0xaa, 0xb6, 0x48, 0x3d, 0x76,
+	0xed, 0x25, 0x58, 0x38, 0xd9, 0xed, 0xda, 0x0e, 0xf8, 0x1e, 0x7a, 0x6b, 0xbd, 0x9c, 0x9e, 0x57,
+	0xde, 0xac, 0x7f, 0x89, 0xdd, 0xb3, 0xec, 0xec, 0x33, 0xfb, 0xee, 0xbe, 0x9e, 0x99, 0xc0, 0xde,
+	0xd2, 0x5d, 0xb9, 0x0b, 0x36, 0x15, 0x92, 0xc7, 0x1c, 0x87, 0x71, 0x2a, 0x58, 0x74, 0xb2, 0xcf,
+	0x5e, 0x99, 0x97, 0xc4, 0x5c, 0x6e, 0xc2, 0xce, 0xef, 0x01, 0xec, 0xdd, 0x2b, 0xee, 0xc6, 0x8b,
+	0x03, 0xbe, 0xc2, 0x0b, 0x18, 0x2d, 0xb9, 0x1f, 0x3c, 0xa6, 0xa4, 0x37, 0xee, 0x4d, 0x76, 0xaf,
+	0xff, 0x9f, 0xaa, 0xc4, 0xe9, 0xbd, 0x0a, 0x7e, 0xe4, 0xab, 0xc7, 0x60, 0x31, 0xdf, 0xa1, 0x1a,
+	0xc2, 0x19, 0x40, 0x76, 0xe2, 0xb7, 0xd8, 0x8d, 0x93, 0x88, 0xf4, 0x55, 0xca, 0xa1, 0x4e, 0xf9,
+	0x54, 0x6c, 0xcc, 0x77, 0x68, 0x05, 0xc3, 0x0f, 0x60, 0xbf, 0x3c, 0x05, 0x31, 0x0b, 0x83, 0x28,
+	0x26, 0x03, 0x95, 0x73, 0x54, 0xc9, 0xf9, 0x9e, 0xef, 0xcd, 0x77, 0x68, 0x09, 0xe2, 0x0c, 0x2c,
+	0xdf, 0x15, 0xe2, 0x33, 0x97, 0xcf, 0x64, 0xa8, 0x92, 0x8e, 0x75, 0xd2, 0x9d, 0x0e, 0xcf, 0x59,
+	0xb0, 0x78, 0xca, 0xb2, 0x0a, 0x10, 0x2f, 0xc1, 0x14, 0x92, 0x0b, 0x1e, 0x31, 0x32, 0xea, 0x7a,
+	0x4f, 0x4e, 0xe1, 0x05, 0x98, 0xae, 0x10, 0x92, 0xaf, 0x19, 0x31, 0x6b, 0xaf, 0xf9, 0xaa, 0x00,
+	0x37, 0xfc, 0x72, 0x97, 0xe1, 0x9a, 0xc1, 0x73, 0x18, 0x49, 0xb6, 0xe6, 0xcf, 0x8c, 0x58, 0xed,
+	0xb4, 0x46, 0xf0, 0x06, 0x0e, 0xb5, 0x4c, 0x69, 0x0d, 0xb1, 0xdb, 0x3d, 0x7b, 0x4b, 0xe3, 0x2d,
+	0xfc, 0xa7, 0x83, 0x85, 0x4b, 0x04, 0x3a, 0x1d, 0x7c, 0xc3, 0xe3, 0x0d, 0x1c, 0xe8, 0x58, 0x6e,
+	0x1c, 0xd9, 0xed, 0xf6, 0xb3, 0xc9, 0xe3, 0x3e, 0x18, 0x0f, 0x29, 0x31, 0xc6, 0xbd, 0xc9, 0x90,
+	0x1a, 0x0f, 0xe9, 0xad, 0x09, 0xc3, 0xb5, 0x1b, 0x26, 0xcc, 0x59, 0xc0, 0x21, 0x65, 0x1e, 0x0b,
+	0x44, 0x5c, 0xb9, 0xf4, 0x7b, 0x18, 0x08, 0xc9, 0xd6, 0xa4, 0xd7, 0xf2, 0x54, 0xaa, 0xb6, 0xf1,
+	0x1c, 0x4c, 0x2f, 0x91, 0x92, 0xad, 0x62, 0x62, 0xb4, 0x91, 0x39, 0xe1, 0x08, 0x38, 0xaa, 0x08,
+	0x95, 0x8f, 0x9b, 0xd4, 0xb4, 0xb6, 0x9a, 0xa2, 0xe5, 0xa6, 0x4d, 0xb9, 0xed, 0x70, 0xa1, 0xb8,
+	0x84, 0x03, 0xad, 0x58, 0xd8, 0x70, 0x56, 0x13, 0xdb, 0x6e, 0x9f, 0x56, 0xbb, 0x6c, 0xaa, 0xb5,
+	0xd0, 0x85, 0xdc, 0xaf, 0x5e, 0xa6, 0x27, 0xc2, 0xb4, 0x62, 0x24, 0xc2, 0x60, 0xe5, 0x2e, 0x99,
+	0xd2, 0xb3, 0xa9, 0xfa, 0x8d, 0x04, 0x4c, 0x37, 0x0c, 0xf9, 0x0b, 0xf3, 0xd5, 0xc1, 0x16, 0xcd,
+	0x97, 0x78, 0x06, 0xa3, 0xa8, 0xbb, 0x2f, 0xa9, 0x06, 0x70, 0x0c, 0xbb, 0x45, 0xa3, 0x31, 0x5f,
+	0xf5, 0xa4, 0x45, 0xab, 0x21, 0xe7, 0x14, 0xa0, 0xac, 0xe9, 0xec, 0xfb, 0x07, 0xbe, 0xbe, 0x86,
+	0x11, 0xf8, 0xce, 0x1f, 0x03, 0xf6, 0x37, 0xbd, 0x94, 0x43, 0x4d, 0x24, 0xeb, 0x14, 0x3d, 0x58,
+	0x8c, 0xd6, 0x46, 0x2c, 0xc6, 0xca, 0x09, 0x58, 0xba, 0xe4, 0xa4, 0xba, 0xbc, 0x4d, 0x8b, 0x35,
+	0x9e, 0x82, 0xbd, 0xe9, 0x3e, 0x37, 0x8c, 0xc8, 0x60, 0xdc, 0x9f, 0xd8, 0xb4, 0x0c, 0xe0, 0x3b,
+	0x18, 0x3d, 0x29, 0x27, 0xd5, 0x8c, 0xe8, 0x53, 0xbd, 0x42, 0x07, 0xf6, 0xd8, 0xab, 0x08, 0x24,
+	0xdb, 0xf8, 0xac, 0xa6, 0x41, 0x9f, 0xd6, 0x62, 0x59, 0xae, 0x36, 0xcc, 0x54, 0x95, 0xad, 0x57,
+	0x78, 0x55, 0x1b, 0x72, 0x56, 0x9b, 0x99, 0x15, 0x08, 0xaf, 0xab, 0x23, 0xce, 0xee, 0x28, 0xaf,
+	0x12, 0xc3, 0xab, 0xca, 0x80, 0x83, 0xae, 0x1a, 0x29, 0x30, 0x27, 0x82, 0x63, 0x5d, 0x93, 0x0d,
+	0xf7, 0xb7, 0x57, 0x66, 0x1d, 0xfa, 0x57, 0x65, 0x36, 0xe8, 0xa2, 0x32, 0x6f, 0x01, 0x29, 0xfb,
+	0x59, 0xdf, 0x55, 0xc6, 0x7b, 0x89, 0x8c, 0xb8, 0xd4, 0xdf, 0x5c, 0xaf, 0xf0, 0x08, 0x86, 0x1e,
+	0x4f, 0xf4, 0xe1, 0x43, 0xba, 0x59, 0x38, 0x5e, 0xd6, 0xbe, 0x22, 0x4c, 0x9b, 0xa7, 0xcc, 0xc0,
+	0x16, 0xf9, 0x82, 0xf4, 0xc6, 0xfd, 0xf6, 0xeb, 0xd8, 0x62, 0x8b, 0xb4, 0x51, 0x95, 0xfe, 0x31,
+	0x52, 0xff, 0x71, 0xb3, 0xbf, 0x03, 0x00, 0x49, 0xef, 0x25, 0xca, 0x0a, 0x07, 0x00, 0x00,
 }
@@ -16,11 +16,25 @@ var (
 	// ManageX defines a global string
 	ManageX    = "manage"
 	actionName = map[string]int32{
-		"Modify": ManageActionModifyConfig,
+		"Modify":     ManageActionModifyConfig,
+		"ExecStatus": ManageActionExecStatus,
+		"Whitelist":  ManageActionExecWhitelist,
+		"DappFork":   ManageActionDappFork,
+		"Propose":    ManageActionPropose,
+		"Approve":    ManageActionApprove,
+		"Revoke":     ManageActionRevoke,
+
+		"ProposeExecStatus": ManageActionProposeExecStatus,
+		"ProposeWhitelist":  ManageActionProposeWhitelist,
+		"ProposeDappFork":   ManageActionProposeDappFork,
 	}
 	logmap = map[int64]*types.LogInfo{
 		// 这里reflect.TypeOf类型必须是proto.Message类型，且是交易的回持结构
-		TyLogModifyConfig: {reflect.TypeOf(types.ReceiptConfig{}), "LogModifyConfig"},
+		TyLogModifyConfig:   {reflect.TypeOf(types.ReceiptConfig{}), "LogModifyConfig"},
+		TyLogExecStatus:     {reflect.TypeOf(ReceiptExecStatus{}), "LogExecStatus"},
+		TyLogExecWhitelist:  {reflect.TypeOf(ReceiptExecWhitelist{}), "LogExecWhitelist"},
+		TyLogDappFork:       {reflect.TypeOf(ReceiptDappFork{}), "LogDappFork"},
+		TyLogConfigProposal: {reflect.TypeOf(ReceiptConfigProposal{}), "LogConfigProposal"},
 	}
 )
 
@@ -30,6 +44,15 @@ func init() {
 
 	types.RegisterDappFork(ManageX, "Enable", 120000)
 	types.RegisterDappFork(ManageX, "ForkManageExec", 400000)
+	//链上管理执行器的暂停, 白名单以及dapp fork高度
+	types.RegisterDappFork(ManageX, "ForkManageGovern", types.MaxHeight)
+	//修改配置需要多个超级管理员批准
+	types.RegisterDappFork(ManageX, "ForkManageMultiSig", types.MaxHeight)
+}
+
+// ProposalKey 修改配置提案的statedb key
+func ProposalKey(id string) []byte {
+	return []byte(types.ManageKey(types.ManageGovernPrefix + "proposal-" + id))
 }
 
 // ManageType defines managetype
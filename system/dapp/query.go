@@ -8,6 +8,7 @@ import (
 	"errors"
 	"reflect"
 
+	dbm "github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/types"
 	"github.com/golang/protobuf/proto"
 )
@@ -95,6 +96,120 @@ func (d *DriverBase) GetAddrTxsCount(reqkey *types.ReqKey) (types.Message, error
 	return &counts, nil
 }
 
+// MaxLogsPerQuery GetLogs 每次最多返回的log数量
+const MaxLogsPerQuery = 1000
+
+// GetLogs query receipt logs by execer, log type and address in the height range,
+// logs are indexed by the logindex executor plugin
+func (d *DriverBase) GetLogs(req *types.ReqLogs) (types.Message, error) {
+	if req.GetExecer() == "" || req.GetCount() <= 0 || req.GetCount() > MaxLogsPerQuery {
+		return nil, types.ErrInvalidParam
+	}
+	if req.GetEndHeight() >= 0 && req.GetStartHeight() > req.GetEndHeight() {
+		return nil, types.ErrInvalidParam
+	}
+	var prefix []byte
+	if req.GetAddr() != "" {
+		prefix = types.CalcLogAddrIndexKey(req.GetAddr(), req.GetExecer(), req.GetTy(), "")
+	} else {
+		prefix = types.CalcLogIndexKey(req.GetExecer(), req.GetTy(), "")
+	}
+	db := d.GetLocalDB()
+	var key []byte
+	if req.GetCursor() != "" {
+		key = append(append([]byte{}, prefix...), []byte(req.GetCursor())...)
+	} else if req.GetDirection() == dbm.ListASC && req.GetStartHeight() > 0 {
+		//seek到开始高度之前的最后一个log, 从它之后开始列出
+		seek := append(append([]byte{}, prefix...), []byte(HeightIndexStr(req.GetStartHeight(), 0))...)
+		values, err := db.List(prefix, seek, 1, dbm.ListSeek)
+		if err != nil && err != types.ErrNotFound {
+			return nil, err
+		}
+		if len(values) == 2 {
+			key = values[0]
+		}
+	}
+	//从cursor或者一端开始按页列出, 跳过高度范围之前的log, 遇到高度范围之后的log结束
+	var reply types.ReplyLogs
+	end := false
+	for !end && len(reply.Logs) < int(req.GetCount()) {
+		values, err := db.List(prefix, key, req.GetCount(), req.GetDirection())
+		if err != nil && err != types.ErrNotFound {
+			return nil, err
+		}
+		for _, value := range values {
+			var info types.LogIndexInfo
+			err := types.Decode(value, &info)
+			if err != nil {
+				return nil, err
+			}
+			key = append(append([]byte{}, prefix...), []byte(info.Cursor)...)
+			if !inLogRange(&info, req) {
+				if req.GetDirection() == dbm.ListASC {
+					end = info.Height >= req.GetStartHeight()
+				} else {
+					end = info.Height < req.GetStartHeight()
+				}
+				if end {
+					break
+				}
+				continue
+			}
+			reply.Logs = append(reply.Logs, &info)
+			if len(reply.Logs) == int(req.GetCount()) {
+				break
+			}
+		}
+		if len(values) < int(req.GetCount()) {
+			end = true
+		}
+	}
+	//返回了count个log, 后面可能还有, 用最后一个log的cursor翻页
+	if len(reply.Logs) == int(req.GetCount()) {
+		reply.Cursor = reply.Logs[len(reply.Logs)-1].Cursor
+	}
+	return &reply, nil
+}
+
+func inLogRange(info *types.LogIndexInfo, req *types.ReqLogs) bool {
+	if info.Height < req.GetStartHeight() {
+		return false
+	}
+	return req.GetEndHeight() < 0 || info.Height <= req.GetEndHeight()
+}
+
+// GetBalanceHistory query balance changes of the address, indexed by the balancehistory executor plugin
+func (d *DriverBase) GetBalanceHistory(req *types.ReqBalanceHistory) (types.Message, error) {
+	if req.GetAddr() == "" || req.GetCount() <= 0 || req.GetCount() > MaxLogsPerQuery {
+		return nil, types.ErrInvalidParam
+	}
+	if req.GetExecer() == "" && req.GetSymbol() != "" {
+		return nil, types.ErrInvalidParam
+	}
+	prefix := types.CalcBalanceHistoryKey(req.GetAddr(), req.GetExecer(), req.GetSymbol(), "")
+	var key []byte
+	if req.GetCursor() != "" {
+		key = types.CalcBalanceHistoryKey(req.GetAddr(), req.GetExecer(), req.GetSymbol(), req.GetCursor())
+	}
+	values, err := d.GetLocalDB().List(prefix, key, req.GetCount(), req.GetDirection())
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	var reply types.ReplyBalanceHistory
+	for _, value := range values {
+		var change types.BalanceChange
+		err := types.Decode(value, &change)
+		if err != nil {
+			return nil, err
+		}
+		reply.Changes = append(reply.Changes, &change)
+	}
+	if len(reply.Changes) == int(req.GetCount()) {
+		reply.Cursor = reply.Changes[len(reply.Changes)-1].Cursor
+	}
+	return &reply, nil
+}
+
 // Query defines query function
 func (d *DriverBase) Query(funcname string, params []byte) (msg types.Message, err error) {
 	funcmap := d.child.GetFuncMap()
@@ -55,6 +55,15 @@ func Register(name string, create DriverCreate, height int64) {
 	execDrivers[ExecAddress(name)] = driverWithHeight
 }
 
+// GetLocalDBVersions 所有注册的执行器的localdb版本
+func GetLocalDBVersions() map[string]int32 {
+	versions := make(map[string]int32)
+	for name, c := range registedExecDriver {
+		versions[name] = c.create().GetLocalDBVersion()
+	}
+	return versions
+}
+
 // LoadDriver load driver
 func LoadDriver(name string, height int64) (driver Driver, err error) {
 	// user.evm.xxxx 的交易，使用evm执行器
@@ -143,6 +143,36 @@ func (mem *Mempool) filterTxList(count int64, dupMap map[string]bool) (txs []*ty
 	return txs
 }
 
+// getShortTxs 获取短哈希对应的交易, 不需要把整个mempool发送给调用方. 短哈希冲突的交易都不返回
+func (mem *Mempool) getShortTxs(req *types.ReqShortTxs) []*types.Transaction {
+	wants := make(map[uint64]*types.Transaction, len(req.GetShortIDs()))
+	for _, id := range req.GetShortIDs() {
+		wants[id] = nil
+	}
+	dup := make(map[uint64]bool)
+	mem.cache.Walk(0, func(item *Item) bool {
+		id := types.ShortTxID(req.GetNonce(), item.Value.Hash())
+		tx, ok := wants[id]
+		if !ok || dup[id] {
+			return true
+		}
+		if tx != nil {
+			dup[id] = true
+			wants[id] = nil
+			return true
+		}
+		wants[id] = item.Value
+		return true
+	})
+	var txs []*types.Transaction
+	for _, tx := range wants {
+		if tx != nil {
+			txs = append(txs, tx)
+		}
+	}
+	return txs
+}
+
 // RemoveTxs 从mempool中删除给定Hash的txs
 func (mem *Mempool) RemoveTxs(hashList *types.TxHashList) error {
 	mem.proxyMtx.Lock()
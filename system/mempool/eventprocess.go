@@ -87,6 +87,9 @@ func (mem *Mempool) eventProcess() {
 		case types.EventGetAddrTxs:
 			// 获取mempool中对应账户（组）所有交易
 			mem.eventGetAddrTxs(msg)
+		case types.EventGetMempoolShortTxs:
+			// 按照紧凑区块的短哈希获取mempool中的交易
+			mem.eventGetShortTxs(msg)
 		default:
 		}
 		mlog.Debug("mempool", "cost", types.Since(beg), "msg", types.GetEventName(int(msg.Ty)))
@@ -186,6 +189,13 @@ func (mem *Mempool) eventGetAddrTxs(msg queue.Message) {
 	msg.Reply(mem.client.NewMessage("", types.EventReplyAddrTxs, txlist))
 }
 
+// eventGetShortTxs 按照紧凑区块的短哈希获取mempool中的交易
+func (mem *Mempool) eventGetShortTxs(msg queue.Message) {
+	req := msg.GetData().(*types.ReqShortTxs)
+	msg.Reply(mem.client.NewMessage("", types.EventReplyTxList,
+		&types.ReplyTxList{Txs: mem.getShortTxs(req)}))
+}
+
 func (mem *Mempool) checkSign(data queue.Message) queue.Message {
 	tx, ok := data.GetData().(types.TxGroup)
 	if ok && tx.CheckSign() {
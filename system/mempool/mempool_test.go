@@ -520,6 +520,33 @@ func TestDuplicateMempool(t *testing.T) {
 	}
 }
 
+func TestGetShortTxs(t *testing.T) {
+	q, mem := initEnv(0)
+	defer q.Close()
+	defer mem.Close()
+
+	err := add10Tx(mem.client)
+	if err != nil {
+		t.Error("add tx error", err.Error())
+		return
+	}
+	var nonce int64 = 7
+	req := &types.ReqShortTxs{Nonce: nonce, ShortIDs: []uint64{types.ShortTxID(nonce, tx2.Hash()), types.ShortTxID(nonce, tx11.Hash())}}
+	msg := mem.client.NewMessage("mempool", types.EventGetMempoolShortTxs, req)
+	mem.client.Send(msg, true)
+	reply, err := mem.client.Wait(msg)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	txs := reply.GetData().(*types.ReplyTxList).GetTxs()
+	if len(txs) != 1 {
+		t.Error("TestGetShortTxs failed", len(txs))
+		return
+	}
+	assert.Equal(t, tx2.Hash(), txs[0].Hash())
+}
+
 func TestGetLatestTx(t *testing.T) {
 	q, mem := initEnv(0)
 	defer q.Close()
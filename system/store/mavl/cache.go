@@ -0,0 +1,272 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mavl
+
+import (
+	"sort"
+	"sync"
+
+	mavl "github.com/33cn/chain33/system/store/mavl/db"
+	"github.com/33cn/chain33/types"
+)
+
+const (
+	// 默认最多缓存的未提交树的个数
+	defaultMemTreeCacheSize = 1000
+	// 默认缓存的已提交树的个数
+	defaultTreeCacheSize = 10
+)
+
+// memTree MemSet生成的尚未提交的树
+type memTree struct {
+	// Commit写入数据库时会修改树中的节点, 需要和读操作互斥
+	mtx sync.RWMutex
+	// kvset为空或者已经写入数据库时tree为nil
+	tree *mavl.Tree
+	// 使用这棵树的区块高度以及父状态hash, kvset为空的区块状态hash和父区块相同,
+	// 所以同一个hash可能对应多个高度
+	parents map[int64][]byte
+	// 加入缓存的顺序, 高度相同时先淘汰先加入的树
+	seq int64
+}
+
+// lowest 使用这棵树的最低区块高度
+func (t *memTree) lowest() int64 {
+	first := true
+	var height int64
+	for h := range t.parents {
+		if first || h < height {
+			height, first = h, false
+		}
+	}
+	return height
+}
+
+// treePos 树在链上的位置
+type treePos struct {
+	hash   string
+	height int64
+}
+
+// memTreeCache 缓存MemSet之后尚未提交的树, 并发安全,
+// 提交区块之后淘汰不在已提交链上的分叉树, 超过容量时淘汰不在已提交链上的高度最低的树
+type memTreeCache struct {
+	mtx     sync.Mutex
+	trees   map[string]*memTree
+	maxSize int
+	seq     int64
+	// 最近一次提交的状态hash和高度, 还没有提交过时为nil
+	committed       []byte
+	committedHeight int64
+
+	hits           int64
+	misses         int64
+	adds           int64
+	commits        int64
+	rollbacks      int64
+	staleEvicts    int64
+	overflowEvicts int64
+}
+
+func newMemTreeCache(maxSize int) *memTreeCache {
+	if maxSize <= 0 {
+		maxSize = defaultMemTreeCacheSize
+	}
+	return &memTreeCache{
+		trees:   make(map[string]*memTree),
+		maxSize: maxSize,
+	}
+}
+
+// Add 加入一棵未提交的树, 回滚区块之后重新执行的树高度可能低于已提交的高度,
+// 缓存已满并且没有可以淘汰的树时返回ErrMemTreeCacheFull
+func (c *memTreeCache) Add(hash, parent []byte, tree *mavl.Tree, height int64) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	t, ok := c.trees[string(hash)]
+	if !ok {
+		for len(c.trees) >= c.maxSize {
+			if !c.evictLowest() {
+				mlog.Error("store mavl mem tree cache full", "height", height, "size", c.maxSize)
+				return types.ErrMemTreeCacheFull
+			}
+		}
+	}
+	c.seq++
+	c.adds++
+	if !ok {
+		c.trees[string(hash)] = &memTree{tree: tree, parents: map[int64][]byte{height: parent}, seq: c.seq}
+	} else {
+		//状态相同的树只保留一棵, kvset为空的区块不能覆盖父区块尚未提交的树
+		t.mtx.Lock()
+		if t.tree == nil {
+			t.tree = tree
+		}
+		t.mtx.Unlock()
+		t.parents[height] = parent
+		t.seq = c.seq
+	}
+	return nil
+}
+
+// evictLowest 淘汰不在已提交链上的高度最低的树, 这些树以后还可能被提交, 不能淘汰
+func (c *memTreeCache) evictLowest() bool {
+	onPath := c.mainPath()
+	var key string
+	var lowest *memTree
+	for k, t := range c.trees {
+		if onPath[k] {
+			continue
+		}
+		if lowest == nil || t.lowest() < lowest.lowest() || (t.lowest() == lowest.lowest() && t.seq < lowest.seq) {
+			key, lowest = k, t
+		}
+	}
+	if lowest == nil {
+		return false
+	}
+	delete(c.trees, key)
+	c.overflowEvicts++
+	mlog.Error("store mavl mem tree cache full, evict tree", "height", lowest.lowest(), "size", c.maxSize)
+	return true
+}
+
+// mainPath 从最近一次提交的状态延伸出来的树, 还没有提交过时无法判断, 所有的树都可能被提交
+func (c *memTreeCache) mainPath() map[string]bool {
+	onPath := make(map[string]bool)
+	var positions []treePos
+	for k, t := range c.trees {
+		if c.committed == nil {
+			onPath[k] = true
+			continue
+		}
+		for h := range t.parents {
+			positions = append(positions, treePos{hash: k, height: h})
+		}
+	}
+	sort.Slice(positions, func(i, j int) bool { return positions[i].height < positions[j].height })
+	onMain := make(map[treePos]bool)
+	for _, pos := range positions {
+		parent := c.trees[pos.hash].parents[pos.height]
+		if (pos.height == c.committedHeight+1 && string(parent) == string(c.committed)) ||
+			onMain[treePos{hash: string(parent), height: pos.height - 1}] {
+			onMain[pos] = true
+			onPath[pos.hash] = true
+		}
+	}
+	return onPath
+}
+
+// Get 获取未提交的树
+func (c *memTreeCache) Get(hash []byte) (*memTree, bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	t, ok := c.trees[string(hash)]
+	if ok {
+		c.hits++
+	} else {
+		c.misses++
+	}
+	return t, ok
+}
+
+// Commit 树已经写入数据库之后删除最低高度的使用者, 并淘汰不在已提交链上的分叉树, 返回提交的高度
+func (c *memTreeCache) Commit(hash []byte) (int64, bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	t, ok := c.trees[string(hash)]
+	if !ok {
+		return 0, false
+	}
+	height := t.lowest()
+	delete(t.parents, height)
+	if len(t.parents) == 0 {
+		delete(c.trees, string(hash))
+	}
+	c.commits++
+	c.committed = append([]byte(nil), hash...)
+	c.committedHeight = height
+	c.evictForks(hash, height)
+	return height, true
+}
+
+// evictForks 提交hash之后, 高度不超过height的其他树以及父树不在已提交链上的树都不会再被提交,
+// 父树不在缓存中的树无法判断, 继续保留
+func (c *memTreeCache) evictForks(hash []byte, height int64) {
+	var positions []treePos
+	live := make(map[treePos]bool)
+	for k, t := range c.trees {
+		for h := range t.parents {
+			positions = append(positions, treePos{hash: k, height: h})
+			live[treePos{hash: k, height: h}] = true
+		}
+	}
+	//按高度从低到高检查, 检查到一棵树时父树的位置已经判断过
+	sort.Slice(positions, func(i, j int) bool { return positions[i].height < positions[j].height })
+	for _, pos := range positions {
+		parent := c.trees[pos.hash].parents[pos.height]
+		var keep bool
+		switch {
+		case pos.height <= height:
+			keep = false
+		case pos.height == height+1:
+			keep = string(parent) == string(hash)
+		default:
+			ppos := treePos{hash: string(parent), height: pos.height - 1}
+			alive, ok := live[ppos]
+			keep = !ok || alive
+		}
+		if keep {
+			continue
+		}
+		live[pos] = false
+		t := c.trees[pos.hash]
+		delete(t.parents, pos.height)
+		if len(t.parents) == 0 {
+			delete(c.trees, pos.hash)
+		}
+		c.staleEvicts++
+		mlog.Debug("store mavl evict stale fork tree", "height", pos.height, "committed", height)
+	}
+}
+
+// Remove 回滚时删除最高高度的使用者
+func (c *memTreeCache) Remove(hash []byte) bool {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	t, ok := c.trees[string(hash)]
+	if !ok {
+		return false
+	}
+	first := true
+	var height int64
+	for h := range t.parents {
+		if first || h > height {
+			height, first = h, false
+		}
+	}
+	delete(t.parents, height)
+	if len(t.parents) == 0 {
+		delete(c.trees, string(hash))
+	}
+	c.rollbacks++
+	return true
+}
+
+// Stats 获取缓存的统计信息
+func (c *memTreeCache) Stats() *types.StoreTreeCacheStats {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return &types.StoreTreeCacheStats{
+		Size:           int64(len(c.trees)),
+		Hits:           c.hits,
+		Misses:         c.misses,
+		Adds:           c.adds,
+		Commits:        c.commits,
+		Rollbacks:      c.rollbacks,
+		StaleEvicts:    c.staleEvicts,
+		OverflowEvicts: c.overflowEvicts,
+	}
+}
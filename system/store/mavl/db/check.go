@@ -0,0 +1,145 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mavl
+
+import (
+	"bytes"
+	"strconv"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	"github.com/golang/protobuf/proto"
+)
+
+// CheckResult 一棵mavl树的完整性检查结果
+type CheckResult struct {
+	Height int64
+	Root   []byte
+	// 本次检查的节点数, 和之前检查过的树共享的完整子树不再重复计数
+	NodeCount int64
+	// 叶子节点中没有保存value(开启MVCC), 无法校验hash的节点数
+	Unverified int64
+	// 数据库中不存在的节点
+	Missing [][]byte
+	// 无法解码或者hash校验失败的节点
+	Corrupt [][]byte
+}
+
+// OK 树是否完整
+func (r *CheckResult) OK() bool {
+	return len(r.Missing) == 0 && len(r.Corrupt) == 0
+}
+
+// TreeChecker 检查mavl树中每个节点是否存在以及hash是否正确,
+// 多棵树共享的完整子树只检查一次
+type TreeChecker struct {
+	db dbm.DB
+	// 检查过的节点, value表示以该节点为根的子树是否完整
+	nodes map[string]bool
+	// 检查过的树对应的区块高度
+	heights map[int64]bool
+}
+
+// NewTreeChecker 创建mavl树完整性检查
+func NewTreeChecker(db dbm.DB) *TreeChecker {
+	return &TreeChecker{
+		db:      db,
+		nodes:   make(map[string]bool),
+		heights: make(map[int64]bool),
+	}
+}
+
+// Check 检查指定区块高度对应的状态树
+func (c *TreeChecker) Check(root []byte, height int64) *CheckResult {
+	res := &CheckResult{Height: height, Root: root}
+	c.heights[height] = true
+	if len(root) == 0 || bytes.Equal(root, emptyRoot[:]) {
+		return res
+	}
+	c.checkNode(root, res)
+	return res
+}
+
+func (c *TreeChecker) checkNode(hash []byte, res *CheckResult) bool {
+	if c.nodes[string(hash)] {
+		return true
+	}
+	buf, err := c.db.Get(hash)
+	if len(buf) == 0 || err != nil {
+		res.Missing = append(res.Missing, hash)
+		return false
+	}
+	res.NodeCount++
+	var storeNode types.StoreNode
+	if err := proto.Unmarshal(buf, &storeNode); err != nil {
+		res.Corrupt = append(res.Corrupt, hash)
+		c.nodes[string(hash)] = false
+		return false
+	}
+	var calc []byte
+	if storeNode.Height == 0 {
+		if len(storeNode.Value) == 0 {
+			res.Unverified++
+		} else {
+			leafnode := &types.LeafNode{Height: storeNode.Height, Key: storeNode.Key, Size: storeNode.Size, Value: storeNode.Value}
+			calc = leafnode.Hash()
+		}
+	} else {
+		innernode := &types.InnerNode{Height: storeNode.Height, Size: storeNode.Size, LeftHash: storeNode.LeftHash, RightHash: storeNode.RightHash}
+		calc = innernode.Hash()
+	}
+	//开启mavl前缀后, 节点的key为前缀加上节点hash
+	if calc != nil && !bytes.HasSuffix(hash, calc) {
+		res.Corrupt = append(res.Corrupt, hash)
+		c.nodes[string(hash)] = false
+		return false
+	}
+	ok := true
+	if storeNode.Height > 0 {
+		if len(storeNode.LeftHash) == 0 || len(storeNode.RightHash) == 0 {
+			res.Corrupt = append(res.Corrupt, hash)
+			c.nodes[string(hash)] = false
+			return false
+		}
+		ok = c.checkNode(storeNode.LeftHash, res) && ok
+		ok = c.checkNode(storeNode.RightHash, res) && ok
+	}
+	c.nodes[string(hash)] = ok
+	return ok
+}
+
+// Orphans 查找已检查的区块高度上保存, 但是没有被任何一棵检查过的树引用的节点,
+// 只有开启mavl前缀时节点key中才带有区块高度, 未开启时返回空
+func (c *TreeChecker) Orphans() (orphans [][]byte) {
+	for _, prefix := range []string{hashNodePrefix, leafNodePrefix} {
+		it := c.db.Iterator([]byte(prefix+"-"), nil, false)
+		for it.Rewind(); it.Valid(); it.Next() {
+			key := it.Key()
+			height, ok := getHeightFromPrefixHashKey(key)
+			if !ok || !c.heights[height] {
+				continue
+			}
+			if _, exist := c.nodes[string(key)]; !exist {
+				orphans = append(orphans, dbm.CopyBytes(key))
+			}
+		}
+		it.Close()
+	}
+	return orphans
+}
+
+// 从genPrefixHashKey生成的key中解析区块高度
+func getHeightFromPrefixHashKey(key []byte) (int64, bool) {
+	start := len(hashNodePrefix) + 1
+	end := start + blockHeightStrLen
+	if len(key) <= end || key[end] != '-' {
+		return 0, false
+	}
+	height, err := strconv.ParseInt(string(key[start:end]), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return height, true
+}
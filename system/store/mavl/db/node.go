@@ -6,6 +6,7 @@ package mavl
 
 import (
 	"bytes"
+	"sync"
 
 	"github.com/33cn/chain33/types"
 	"github.com/golang/protobuf/proto"
@@ -198,34 +199,67 @@ func (node *Node) Hash(t *Tree) []byte {
 	return node.hash
 }
 
-// NOTE: clears leftNode/rigthNode recursively sets hashes recursively
-func (node *Node) save(t *Tree) int64 {
+// 并行计算hash时, 高度小于该值的子树不再拆分到新的goroutine中计算
+const parallelHashMinHeight = 6
+
+// parallelHash 并行计算节点的hash, 左右子树都需要重新计算时把左子树放到新的goroutine中,
+// level 表示还允许继续拆分的层数, 结果和Hash完全一致
+func (node *Node) parallelHash(t *Tree, level int) []byte {
+	if node.hash != nil {
+		return node.hash
+	}
+	if level > 0 && node.height >= parallelHashMinHeight {
+		leftDirty := node.leftNode != nil && node.leftNode.hash == nil
+		rightDirty := node.rightNode != nil && node.rightNode.hash == nil
+		if leftDirty && rightDirty {
+			var wg sync.WaitGroup
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				node.leftNode.parallelHash(t, level-1)
+			}()
+			node.rightNode.parallelHash(t, level-1)
+			wg.Wait()
+		} else if leftDirty {
+			node.leftNode.parallelHash(t, level)
+		} else if rightDirty {
+			node.rightNode.parallelHash(t, level)
+		}
+	}
+	return node.Hash(t)
+}
+
+// NOTE: clears leftNode/rigthNode recursively
+// collectUnsaved 按照后序遍历收集所有未保存的节点, 调用前需要计算好hash
+func (node *Node) collectUnsaved(nodes []*Node) []*Node {
 	if node.hash == nil {
-		node.hash = node.Hash(t)
+		panic("Expected to find node.hash, but none found.")
 	}
 	if node.persisted {
-		return 0
+		return nodes
 	}
-	var leftsaveNodeNo int64
-	var rightsaveNodeNo int64
-
 	// save children
 	if node.leftNode != nil {
-		leftsaveNodeNo = node.leftNode.save(t)
+		nodes = node.leftNode.collectUnsaved(nodes)
 		node.leftNode = nil
 	}
 	if node.rightNode != nil {
-		rightsaveNodeNo = node.rightNode.save(t)
+		nodes = node.rightNode.collectUnsaved(nodes)
 		node.rightNode = nil
 	}
-
-	// save node
-	t.ndb.SaveNode(t, node)
-	return leftsaveNodeNo + rightsaveNodeNo + 1
+	return append(nodes, node)
 }
 
 //将内存中的node转换成存储到db中的格式
 func (node *Node) storeNode(t *Tree) []byte {
+	storeNodebytes, err := proto.Marshal(node.makeStoreNode())
+	if err != nil {
+		panic(err)
+	}
+	return storeNodebytes
+}
+
+func (node *Node) makeStoreNode() *types.StoreNode {
 	var storeNode types.StoreNode
 
 	// node header
@@ -258,11 +292,7 @@ func (node *Node) storeNode(t *Tree) []byte {
 	if enablePrune {
 		storeNode.ParentHash = node.parentHash
 	}
-	storeNodebytes, err := proto.Marshal(&storeNode)
-	if err != nil {
-		panic(err)
-	}
-	return storeNodebytes
+	return &storeNode
 }
 
 //从指定node开始插入一个新的node，updated表示是否有叶子结点的value更新
@@ -24,10 +24,9 @@ const (
 	leafKeyCountPrefix     = "..mk.."
 	oldLeafKeyCountPrefix  = "..mok.."
 	secLvlPruningHeightKey = "_..mslphk.._"
+	curPruningHeightKey    = "_..mcphk.._"
 	delMapPoolPrefix       = "_..md.._"
 	blockHeightStrLen      = 10
-	pruningStateStart      = 1
-	pruningStateEnd        = 0
 	//删除节点pool以hash的首字母为key因此有256个
 	delNodeCacheSize = 256 + 1
 	//每个del Pool下存放默认4096个hash
@@ -39,17 +38,15 @@ const (
 	onceScanCount           = 100000
 )
 
+const (
+	// 默认每个10000裁剪一次
+	defaultPruneHeight = 10000
+)
+
 var (
-	// 是否开启mavl裁剪
-	enablePrune bool
-	// 每个10000裁剪一次
-	pruneHeight = 10000
-	// 裁剪状态
-	pruningState   int32
-	delPoolCache   *lru.Cache
-	wg             sync.WaitGroup
-	quit           bool
-	secLvlPruningH int64
+	// 是否开启mavl裁剪, 开启后节点中会存储裁剪需要的索引, 因此只能在启动时配置
+	enablePrune  bool
+	delPoolCache *lru.Cache
 )
 
 func init() {
@@ -87,17 +84,150 @@ func EnablePrune(enable bool) {
 	enableMavlPrefix = enable
 }
 
-// SetPruneHeight 设置每次裁剪高度
-func SetPruneHeight(height int) {
-	pruneHeight = height
+// PruneConfig mavl裁剪配置
+type PruneConfig struct {
+	// 每隔多少高度裁剪一次, 同时旧版本叶子节点至少保留该高度
+	PruneHeight int64
+	// 保留最近多少个高度的完整状态, 为0时只按PruneHeight裁剪
+	KeepHeight int64
+	// 需要永久保留状态的高度, 比如每月一次的检查点
+	PinnedHeights []int64
+}
+
+// Pruner mavl裁剪任务, 由store在区块提交后驱动, 在后台执行, 可以暂停和恢复
+type Pruner struct {
+	db  dbm.DB
+	cfg PruneConfig
+	wg  sync.WaitGroup
+
+	running int32
+	paused  int32
+	quit    int32
+	// 最近一次提交的区块高度
+	commitHeight int64
+
+	mtx            sync.Mutex
+	secLvlPruningH int64
+	status         types.StorePruneStatus
+}
+
+// NewPruner 创建mavl裁剪任务
+func NewPruner(db dbm.DB, cfg *PruneConfig) *Pruner {
+	p := &Pruner{db: db}
+	if cfg != nil {
+		p.cfg = *cfg
+	}
+	if p.cfg.PruneHeight <= 0 {
+		p.cfg.PruneHeight = defaultPruneHeight
+	}
+	if p.cfg.KeepHeight < 0 {
+		p.cfg.KeepHeight = 0
+	}
+	p.status.CurPruneHeight = getCurPruningHeight(db)
+	p.cfg.PinnedHeights = append([]int64(nil), p.cfg.PinnedHeights...)
+	sort.Slice(p.cfg.PinnedHeights, func(i, j int) bool { return p.cfg.PinnedHeights[i] < p.cfg.PinnedHeights[j] })
+	return p
+}
+
+// Notify 区块提交后通知裁剪任务, 到达裁剪间隔时在后台启动一次裁剪
+func (p *Pruner) Notify(height int64) {
+	if p == nil {
+		return
+	}
+	atomic.StoreInt64(&p.commitHeight, height)
+	if height%p.cfg.PruneHeight == 0 && height/p.cfg.PruneHeight > 1 {
+		p.start(height)
+	}
+}
+
+// Start 恢复裁剪, 并且在最近提交的高度上立即启动一次裁剪
+func (p *Pruner) Start() {
+	atomic.StoreInt32(&p.paused, 0)
+	height := atomic.LoadInt64(&p.commitHeight)
+	if height/p.cfg.PruneHeight > 1 {
+		p.start(height)
+	}
+}
+
+// Pause 暂停裁剪, 正在进行的裁剪会在当前批次结束后退出
+func (p *Pruner) Pause() {
+	atomic.StoreInt32(&p.paused, 1)
+}
+
+// Close 关闭裁剪, 等待正在进行的裁剪退出
+func (p *Pruner) Close() {
+	if p == nil {
+		return
+	}
+	atomic.StoreInt32(&p.quit, 1)
+	p.wg.Wait()
+}
+
+// Status 获取裁剪状态
+func (p *Pruner) Status() *types.StorePruneStatus {
+	p.mtx.Lock()
+	status := p.status
+	p.mtx.Unlock()
+	status.Enable = true
+	status.Running = atomic.LoadInt32(&p.running) == 1
+	status.Paused = atomic.LoadInt32(&p.paused) == 1
+	status.PruneHeight = p.cfg.PruneHeight
+	status.KeepHeight = p.cfg.KeepHeight
+	status.PinnedHeights = p.cfg.PinnedHeights
+	status.CommitHeight = atomic.LoadInt64(&p.commitHeight)
+	return &status
+}
+
+// Prune 在当前线程中裁剪curHeight之前的节点
+func (p *Pruner) Prune(curHeight int64) {
+	if !atomic.CompareAndSwapInt32(&p.running, 0, 1) {
+		return
+	}
+	defer atomic.StoreInt32(&p.running, 0)
+	p.pruningTree(curHeight)
+}
+
+// 该线程应只允许一个
+func (p *Pruner) start(height int64) {
+	if p.interrupted() || !atomic.CompareAndSwapInt32(&p.running, 0, 1) {
+		return
+	}
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		defer atomic.StoreInt32(&p.running, 0)
+		p.pruningTree(height)
+	}()
+}
+
+func (p *Pruner) interrupted() bool {
+	return atomic.LoadInt32(&p.quit) == 1 || atomic.LoadInt32(&p.paused) == 1
+}
+
+// canDelete 判断高度为height的旧版本叶子是否可以删除, nextHeight为该key下一个版本的高度
+func (p *Pruner) canDelete(curHeight, height, nextHeight int64) bool {
+	if curHeight < height+p.cfg.PruneHeight {
+		return false
+	}
+	//在[height, nextHeight)之间的状态都引用该版本
+	if p.cfg.KeepHeight > 0 && curHeight < nextHeight+p.cfg.KeepHeight {
+		return false
+	}
+	return !p.isPinned(height, nextHeight)
+}
+
+func (p *Pruner) isPinned(height, nextHeight int64) bool {
+	pinned := p.cfg.PinnedHeights
+	i := sort.Search(len(pinned), func(i int) bool { return pinned[i] >= height })
+	return i < len(pinned) && pinned[i] < nextHeight
 }
 
-// ClosePrune 关闭裁剪
-func ClosePrune() {
-	quit = true
-	wg.Wait()
-	//防止BaseStore没有关闭再次进入
-	setPruning(pruningStateStart)
+func (p *Pruner) addStat(scan, delCount, delBytes int64) {
+	p.mtx.Lock()
+	p.status.ScanLeafCount += scan
+	p.status.DeleteNodeCount += delCount
+	p.status.ReclaimedBytes += delBytes
+	p.mtx.Unlock()
 }
 
 func genLeafCountKey(key, hash []byte, height int64) (hashkey []byte) {
@@ -157,14 +287,6 @@ func genDeletePoolKey(hash []byte) (key, value []byte) {
 	return key, value
 }
 
-func isPruning() bool {
-	return atomic.LoadInt32(&pruningState) == 1
-}
-
-func setPruning(state int32) {
-	atomic.StoreInt32(&pruningState, state)
-}
-
 func getSecLvlPruningHeight(db dbm.DB) int64 {
 	value, err := db.Get([]byte(secLvlPruningHeightKey))
 	if len(value) == 0 || err != nil {
@@ -188,26 +310,68 @@ func setSecLvlPruningHeight(db dbm.DB, height int64) error {
 	return db.Set([]byte(secLvlPruningHeightKey), value)
 }
 
-func pruningTree(db dbm.DB, curHeight int64) {
-	wg.Add(1)
-	defer wg.Add(-1)
-	setPruning(pruningStateStart)
+// 记录开始裁剪的高度, 查询历史状态时据此判断状态是否已经被裁剪
+func getCurPruningHeight(db dbm.DB) int64 {
+	value, err := db.Get([]byte(curPruningHeightKey))
+	if len(value) == 0 || err != nil {
+		return 0
+	}
+	h := &types.Int64{}
+	err = proto.Unmarshal(value, h)
+	if err != nil {
+		return 0
+	}
+	return h.Data
+}
+
+// GetCurPruningHeight 获取持久化的裁剪高度, 关闭裁剪之后仍然可以据此判断历史状态是否被裁剪
+func GetCurPruningHeight(db dbm.DB) int64 {
+	return getCurPruningHeight(db)
+}
+
+func setCurPruningHeight(db dbm.DB, height int64) error {
+	value, err := proto.Marshal(&types.Int64{Data: height})
+	if err != nil {
+		return err
+	}
+	return db.Set([]byte(curPruningHeightKey), value)
+}
+
+func (p *Pruner) pruningTree(curHeight int64) {
+	start := time.Now()
+	if err := setCurPruningHeight(p.db, curHeight); err != nil {
+		treelog.Error("pruningTree setCurPruningHeight", "err", err)
+		return
+	}
+	p.mtx.Lock()
+	p.status.CurPruneHeight = curHeight
+	p.status.ScanLeafCount = 0
+	p.status.StartTime = start.Unix()
+	p.mtx.Unlock()
 	// 一级遍历
-	pruningFirstLevel(db, curHeight)
+	p.pruningFirstLevel(curHeight)
 	// 二级遍历
-	pruningSecondLevel(db, curHeight)
-	setPruning(pruningStateEnd)
+	p.pruningSecondLevel(curHeight)
+	if p.interrupted() {
+		treelog.Info("pruningTree interrupted", "curHeight", curHeight)
+		return
+	}
+	p.mtx.Lock()
+	p.status.LastPruneHeight = curHeight
+	p.status.LastCostMs = int64(time.Since(start) / time.Millisecond)
+	p.mtx.Unlock()
 }
 
-func pruningFirstLevel(db dbm.DB, curHeight int64) {
+func (p *Pruner) pruningFirstLevel(curHeight int64) {
 	treelog.Info("pruningTree pruningFirstLevel", "start curHeight:", curHeight)
 	start := time.Now()
-	pruningFirstLevelNode(db, curHeight)
+	p.pruningFirstLevelNode(curHeight)
 	end := time.Now()
 	treelog.Info("pruningTree pruningFirstLevel", "curHeight:", curHeight, "pruning leafNode cost time:", end.Sub(start))
 }
 
-func pruningFirstLevelNode(db dbm.DB, curHeight int64) {
+func (p *Pruner) pruningFirstLevelNode(curHeight int64) {
+	db := p.db
 	prefix := []byte(leafKeyCountPrefix)
 	it := db.Iterator(prefix, nil, true)
 	defer it.Close()
@@ -216,7 +380,7 @@ func pruningFirstLevelNode(db dbm.DB, curHeight int64) {
 	var kvs []*types.KeyValue
 	count := 0
 	for it.Rewind(); it.Valid(); it.Next() {
-		if quit {
+		if p.interrupted() {
 			//该处退出
 			return
 		}
@@ -248,7 +412,8 @@ func pruningFirstLevelNode(db dbm.DB, curHeight int64) {
 			kvs = append(kvs, &types.KeyValue{Key: hashK, Value: value})
 		}
 		if count >= onceScanCount {
-			deleteNode(db, mp, curHeight, key)
+			p.addStat(int64(count), 0, 0)
+			p.deleteNode(mp, curHeight, key)
 			count = 0
 		}
 		if len(kvs) >= onceScanCount/2 {
@@ -257,7 +422,8 @@ func pruningFirstLevelNode(db dbm.DB, curHeight int64) {
 		}
 	}
 	if count > 0 {
-		deleteNode(db, mp, curHeight, nil)
+		p.addStat(int64(count), 0, 0)
+		p.deleteNode(mp, curHeight, nil)
 	}
 	if len(kvs) > 0 {
 		addLeafCountKeyToSecondLevel(db, kvs)
@@ -273,10 +439,11 @@ func addLeafCountKeyToSecondLevel(db dbm.DB, kvs []*types.KeyValue) {
 	batch.Write()
 }
 
-func deleteNode(db dbm.DB, mp map[string][]hashData, curHeight int64, lastKey []byte) {
+func (p *Pruner) deleteNode(mp map[string][]hashData, curHeight int64, lastKey []byte) {
 	if len(mp) == 0 {
 		return
 	}
+	db := p.db
 	var tmp []hashData
 	//del
 	if lastKey != nil {
@@ -290,8 +457,8 @@ func deleteNode(db dbm.DB, mp map[string][]hashData, curHeight int64, lastKey []
 	for key, vals := range mp {
 		if len(vals) > 1 {
 			if vals[1].height != vals[0].height { //防止相同高度时候出现的误删除
-				for _, val := range vals[1:] { //从第二个开始判断
-					if curHeight >= val.height+int64(pruneHeight) {
+				for i, val := range vals[1:] { //从第二个开始判断, vals按高度从高到低排列
+					if p.canDelete(curHeight, val.height, vals[i].height) {
 						//batch.Delete(val.hash) //叶子节点hash值的删除放入pruningHashNode中
 						batch.Delete(genLeafCountKey([]byte(key), val.hash, val.height))
 						delMp[string(val.hash)] = true
@@ -309,13 +476,14 @@ func deleteNode(db dbm.DB, mp map[string][]hashData, curHeight int64, lastKey []
 		}
 	}
 	//裁剪hashNode
-	pruningHashNode(db, delMp)
+	p.pruningHashNode(delMp)
 }
 
-func pruningHashNode(db dbm.DB, mp map[string]bool) {
+func (p *Pruner) pruningHashNode(mp map[string]bool) {
 	if len(mp) == 0 {
 		return
 	}
+	db := p.db
 	//对mp排序
 	sortKeys := make([]string, len(mp)+1)
 	for key := range mp {
@@ -324,10 +492,13 @@ func pruningHashNode(db dbm.DB, mp map[string]bool) {
 	sort.Strings(sortKeys)
 	ndb := newMarkNodeDB(db, 1024*10)
 	var delNodeStrs []string
+	var delBytes int64
 	for _, key := range sortKeys {
 		mNode, err := ndb.LoadLeaf([]byte(key))
 		if err == nil {
-			delNodeStrs = append(delNodeStrs, mNode.getHashNode(ndb)...)
+			strs, size := mNode.getHashNode(ndb)
+			delNodeStrs = append(delNodeStrs, strs...)
+			delBytes += size
 		}
 	}
 	//根据keyMap进行归类
@@ -363,13 +534,15 @@ func pruningHashNode(db dbm.DB, mp map[string]bool) {
 		count++
 	}
 	batch.Write()
+	p.addStat(0, int64(count), delBytes)
 	//fmt.Printf("pruningHashNode ndb.count %d delete %d \n", ndb.count, count1)
-	treelog.Info("pruningHashNode ", "delNodeStrs", count1, "delete node mp count", count)
+	treelog.Info("pruningHashNode ", "delNodeStrs", count1, "delete node mp count", count, "reclaimed bytes", delBytes)
 }
 
-//获取要删除的hash节点
-func (node *MarkNode) getHashNode(ndb *markNodeDB) (delNodeStrs []string) {
+//获取要删除的hash节点以及这些节点占用的空间
+func (node *MarkNode) getHashNode(ndb *markNodeDB) (delNodeStrs []string, size int64) {
 	for {
+		size += int64(len(node.hash) + node.size)
 		parN := node.fetchParentNode(ndb)
 		if parN != nil {
 			delNodeStrs = append(delNodeStrs, string(node.hash))
@@ -379,7 +552,7 @@ func (node *MarkNode) getHashNode(ndb *markNodeDB) (delNodeStrs []string) {
 			break
 		}
 	}
-	return delNodeStrs
+	return delNodeStrs, size
 }
 
 func (ndb *markNodeDB) updateDelHash(batch dbm.Batch, key string, dep *delNodeValuePool) {
@@ -465,6 +638,8 @@ type MarkNode struct {
 	rightHash  []byte
 	parentHash []byte
 	parentNode *MarkNode
+	// 节点序列化后的大小
+	size int
 }
 
 type markNodeDB struct {
@@ -545,6 +720,7 @@ func (ndb *markNodeDB) fetchNode(hash []byte) (*MarkNode, error) {
 			leftHash:   node.leftHash,
 			rightHash:  node.rightHash,
 			parentHash: node.parentHash,
+			size:       len(buf),
 		}
 		if ndb.cache != nil {
 			ndb.cache.Add(string(hash), mNode)
@@ -553,31 +729,38 @@ func (ndb *markNodeDB) fetchNode(hash []byte) (*MarkNode, error) {
 	return mNode, nil
 }
 
-func pruningSecondLevel(db dbm.DB, curHeight int64) {
-	if secLvlPruningH == 0 {
-		secLvlPruningH = getSecLvlPruningHeight(db)
+func (p *Pruner) pruningSecondLevel(curHeight int64) {
+	if p.interrupted() {
+		return
+	}
+	db := p.db
+	if p.secLvlPruningH == 0 {
+		p.secLvlPruningH = getSecLvlPruningHeight(db)
 	}
 	if curHeight/secondLevelPruningHeight > 1 &&
-		curHeight/secondLevelPruningHeight != secLvlPruningH/secondLevelPruningHeight {
+		curHeight/secondLevelPruningHeight != p.secLvlPruningH/secondLevelPruningHeight {
 		treelog.Info("pruningTree pruningSecondLevel", "start curHeight:", curHeight)
 		start := time.Now()
-		pruningSecondLevelNode(db, curHeight)
+		p.pruningSecondLevelNode(curHeight)
 		end := time.Now()
 		treelog.Info("pruningTree pruningSecondLevel", "curHeight:", curHeight, "pruning leafNode cost time:", end.Sub(start))
+		if p.interrupted() {
+			return
+		}
 		setSecLvlPruningHeight(db, curHeight)
-		secLvlPruningH = curHeight
+		p.secLvlPruningH = curHeight
 	}
 }
 
-func pruningSecondLevelNode(db dbm.DB, curHeight int64) {
+func (p *Pruner) pruningSecondLevelNode(curHeight int64) {
 	prefix := []byte(oldLeafKeyCountPrefix)
-	it := db.Iterator(prefix, nil, true)
+	it := p.db.Iterator(prefix, nil, true)
 	defer it.Close()
 
 	mp := make(map[string][]hashData)
 	count := 0
 	for it.Rewind(); it.Valid(); it.Next() {
-		if quit {
+		if p.interrupted() {
 			//该处退出
 			return
 		}
@@ -601,20 +784,23 @@ func pruningSecondLevelNode(db dbm.DB, curHeight int64) {
 			mp[string(key)] = append(mp[string(key)], data)
 			count++
 			if count >= onceScanCount {
-				deleteOldNode(db, mp, curHeight, key)
+				p.addStat(int64(count), 0, 0)
+				p.deleteOldNode(mp, curHeight, key)
 				count = 0
 			}
 		}
 	}
 	if count > 0 {
-		deleteOldNode(db, mp, curHeight, nil)
+		p.addStat(int64(count), 0, 0)
+		p.deleteOldNode(mp, curHeight, nil)
 	}
 }
 
-func deleteOldNode(db dbm.DB, mp map[string][]hashData, curHeight int64, lastKey []byte) {
+func (p *Pruner) deleteOldNode(mp map[string][]hashData, curHeight int64, lastKey []byte) {
 	if len(mp) == 0 {
 		return
 	}
+	db := p.db
 	var tmp []hashData
 	//del
 	if lastKey != nil {
@@ -628,8 +814,8 @@ func deleteOldNode(db dbm.DB, mp map[string][]hashData, curHeight int64, lastKey
 	for key, vals := range mp {
 		if len(vals) > 1 {
 			if vals[1].height != vals[0].height { //防止相同高度时候出现的误删除
-				for _, val := range vals[1:] { //从第二个开始判断
-					if curHeight >= val.height+int64(pruneHeight) {
+				for i, val := range vals[1:] { //从第二个开始判断
+					if p.canDelete(curHeight, val.height, vals[i].height) {
 						batch.Delete(genOldLeafCountKey([]byte(key), val.hash, val.height))
 						delMp[string(val.hash)] = true
 					}
@@ -655,7 +841,7 @@ func deleteOldNode(db dbm.DB, mp map[string][]hashData, curHeight int64, lastKey
 		}
 	}
 	//裁剪hashNode
-	pruningHashNode(db, delMp)
+	p.pruningHashNode(delMp)
 }
 
 // PruningTreePrintDB pruning tree print db
@@ -696,7 +882,7 @@ func PruningTreePrintDB(db dbm.DB, prefix []byte) {
 	treelog.Info("pruningTree:", "prefix:", string(prefix), "All count", count)
 }
 
-// PruningTree 裁剪树
+// PruningTree 按默认配置裁剪树
 func PruningTree(db dbm.DB, curHeight int64) {
-	pruningTree(db, curHeight)
+	NewPruner(db, nil).Prune(curHeight)
 }
@@ -4,109 +4,271 @@
 
 // +build go1.8
 
-// package main 用于测试数据库中的MAVL节点数目
+// package main mavl数据库工具, 用于统计节点数目、检查状态树的完整性并修复缺失的状态
+//
+// 检查指定的状态树:
+//	tool -db datadir/mavltree -root 0x...
+// 检查最近N个区块的状态树, 并重新执行区块修复缺失的状态:
+//	tool -f chain33.toml -last 1000 -repair
 package main
 
 import (
+	"bytes"
+	"errors"
+	"flag"
 	"fmt"
 	"os"
+	"os/user"
 	"path/filepath"
+	"sort"
 
-	"bufio"
-	"os/signal"
-	"syscall"
-
+	"github.com/33cn/chain33/blockchain"
+	"github.com/33cn/chain33/client"
+	"github.com/33cn/chain33/common"
 	dbm "github.com/33cn/chain33/common/db"
 	clog "github.com/33cn/chain33/common/log"
 	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/executor"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/store"
 	mavl "github.com/33cn/chain33/system/store/mavl/db"
 	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+)
+
+var (
+	configPath = flag.String("f", "chain33.toml", "configfile, used with -last")
+	datadir    = flag.String("datadir", "", "data dir of chain33, include logs and datas")
+	dbPath     = flag.String("db", "", "path of mavl store db, used with -root or -count")
+	dbDriver   = flag.String("driver", "leveldb", "driver of mavl store db, used with -db")
+	rootHash   = flag.String("root", "", "state hash of the tree to check")
+	lastN      = flag.Int64("last", 0, "check state hash of the latest N blocks")
+	orphan     = flag.Bool("orphan", false, "report node records not referenced by any checked tree(need enableMavlPrefix)")
+	repair     = flag.Bool("repair", false, "re-execute blocks to rebuild broken state, used with -last")
+	count      = flag.Bool("count", false, "print count of mavl node records")
+	pruneH     = flag.Int64("prune", 0, "prune tree below the height")
+	logLevel   = flag.String("loglevel", "info", "log level(debug/info/warn/error/crit)")
+
+	tlog = log.New("module", "mavltool")
 )
 
 func main() {
-	stdin := bufio.NewReader(os.Stdin)
-	dir, err := filepath.Abs(filepath.Dir(os.Args[0]))
+	flag.Parse()
+	clog.SetLogLevel(*logLevel)
+	var err error
+	switch {
+	case *lastN > 0:
+		err = checkChain(*lastN, *repair)
+	case *dbPath != "":
+		err = checkDB()
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
 	if err != nil {
-		fmt.Println(err)
-		return
+		fmt.Println("error:", err)
+		os.Exit(1)
 	}
-	fmt.Println(dir)
+}
 
-	str := ""
-	fmt.Println("请输入打印日志级别(debug(dbug)/info/warn/error(eror)/crit)")
-	fmt.Fscan(stdin, &str)
-	stdin.ReadString('\n')
-	if str == "" {
-		str = "info"
+// checkDB 直接打开store数据库, 统计节点或者检查指定的状态树
+func checkDB() error {
+	db := dbm.NewDB("store", *dbDriver, *dbPath, 100)
+	defer db.Close()
+	if *count {
+		for _, prefix := range []string{"..mk..", "_mh_", "_mb_", "_..md.._", "..mok.."} {
+			mavl.PruningTreePrintDB(db, []byte(prefix))
+		}
+		mavl.PruningTreePrintDB(db, nil)
+	}
+	if *pruneH > 0 {
+		mavl.PruningTree(db, *pruneH)
+	}
+	if *rootHash == "" {
+		return nil
+	}
+	root, err := common.FromHex(*rootHash)
+	if err != nil {
+		return err
+	}
+	checker := mavl.NewTreeChecker(db)
+	res := checker.Check(root, -1)
+	printResult(res)
+	if *orphan {
+		printOrphans(checker)
+	}
+	if !res.OK() {
+		return errors.New("state tree is broken")
 	}
+	return nil
+}
 
-	log1 := &types.Log{
-		Loglevel:        str,
-		LogConsoleLevel: "info",
-		LogFile:         "logs/syc.log",
-		MaxFileSize:     400,
-		MaxBackups:      100,
-		MaxAge:          28,
-		LocalTime:       true,
-		Compress:        false,
-	}
-	clog.SetFileLog(log1)
+func resetDatadir(cfg *types.Config, datadir string) {
+	// Check in case of paths like "/something/~/something/"
+	if datadir[:2] == "~/" {
+		usr, _ := user.Current()
+		dir := usr.HomeDir
+		datadir = filepath.Join(dir, datadir[2:])
+	}
+	tlog.Info("current user data dir is ", "dir", datadir)
+	cfg.Log.LogFile = filepath.Join(datadir, cfg.Log.LogFile)
+	cfg.BlockChain.DbPath = filepath.Join(datadir, cfg.BlockChain.DbPath)
+	cfg.P2P.DbPath = filepath.Join(datadir, cfg.P2P.DbPath)
+	cfg.Wallet.DbPath = filepath.Join(datadir, cfg.Wallet.DbPath)
+	cfg.Store.DbPath = filepath.Join(datadir, cfg.Store.DbPath)
+}
 
-	log.Info("test", dir)
-	db := dbm.NewDB("store", "leveldb", dir, 100)
+func initEnv() (queue.Queue, queue.Module, queue.Module, queue.Module) {
+	var q = queue.New("channel")
+	cfg, sub := types.InitCfg(*configPath)
+	if *datadir != "" {
+		resetDatadir(cfg, *datadir)
+	}
+	cfg.Consensus.Minerstart = false
+	chain := blockchain.New(cfg.BlockChain)
+	chain.SetQueueClient(q.Client())
+	exec := executor.New(cfg.Exec, sub.Exec)
+	exec.SetQueueClient(q.Client())
+	types.SetMinFee(0)
+	s := store.New(cfg.Store, sub.Store)
+	s.SetQueueClient(q.Client())
+	return q, chain, exec, s
+}
 
-	a := 0
-	fmt.Println("是否需要查询叶子节点索引计数")
-	fmt.Fscan(stdin, &a)
-	stdin.ReadString('\n')
-	if a > 0 {
-		mavl.PruningTreePrintDB(db, []byte("..mk.."))
-	}
-	a = 0
-	fmt.Println("是否需要查询hash节点计数")
-	fmt.Fscan(stdin, &a)
-	stdin.ReadString('\n')
-	if a > 0 {
-		mavl.PruningTreePrintDB(db, []byte("_mh_"))
-	}
-	a = 0
-	fmt.Println("是否需要查询叶子节点计数")
-	fmt.Fscan(stdin, &a)
-	stdin.ReadString('\n')
-	if a > 0 {
-		mavl.PruningTreePrintDB(db, []byte("_mb_"))
-	}
-	a = 0
-	fmt.Println("是否需要查询全部节点计数")
-	fmt.Fscan(stdin, &a)
-	stdin.ReadString('\n')
-	if a > 0 {
-		mavl.PruningTreePrintDB(db, nil)
+// checkChain 检查最近n个区块的状态树, repair为true时从最低的损坏高度开始重新执行区块修复状态
+func checkChain(n int64, repair bool) error {
+	q, chain, exec, s := initEnv()
+	//和 RunChain33 的退出顺序一致: blockchain, executor, store, queue
+	defer q.Close()
+	defer s.Close()
+	defer exec.Close()
+	defer chain.Close()
+	dbs, ok := s.(interface{ GetDB() dbm.DB })
+	if !ok {
+		return errors.New("store is not a mavl store")
+	}
+	qclient, err := client.New(q.Client(), nil)
+	if err != nil {
+		return err
+	}
+	last, err := qclient.GetLastHeader()
+	if err != nil {
+		return err
+	}
+	start := last.Height - n + 1
+	if start < 0 {
+		start = 0
+	}
+	headers, err := getHeaders(qclient, start, last.Height)
+	if err != nil {
+		return err
+	}
+	checker := mavl.NewTreeChecker(dbs.GetDB())
+	var broken []int64
+	for _, header := range headers {
+		res := checker.Check(header.StateHash, header.Height)
+		if !res.OK() {
+			printResult(res)
+			broken = append(broken, header.Height)
+		}
+	}
+	fmt.Printf("checked %d state trees from height %d to %d, %d broken\n", len(headers), start, last.Height, len(broken))
+	if len(broken) > 0 {
+		fmt.Println("affected heights:", broken)
+	}
+	if *orphan {
+		printOrphans(checker)
+	}
+	if len(broken) == 0 {
+		return nil
+	}
+	if !repair {
+		return errors.New("state tree is broken")
+	}
+	sort.Slice(broken, func(i, j int) bool { return broken[i] < broken[j] })
+	for _, height := range broken {
+		header := headers[height-start]
+		//低高度修复之后, 共享的子树可能已经恢复
+		if mavl.NewTreeChecker(dbs.GetDB()).Check(header.StateHash, height).OK() {
+			continue
+		}
+		if err := reExecBlock(qclient, q.Client(), dbs.GetDB(), height); err != nil {
+			return err
+		}
+		fmt.Println("repaired state of height", height)
+	}
+	return nil
+}
+
+func getHeaders(qclient client.QueueProtocolAPI, start, end int64) ([]*types.Header, error) {
+	var headers []*types.Header
+	for i := start; i <= end; i += 1000 {
+		j := i + 999
+		if j > end {
+			j = end
+		}
+		items, err := qclient.GetHeaders(&types.ReqBlocks{Start: i, End: j})
+		if err != nil {
+			return nil, err
+		}
+		headers = append(headers, items.Items...)
+	}
+	return headers, nil
+}
+
+// reExecBlock 在上一个区块的状态上重新执行区块, 重新写入该区块的状态树
+func reExecBlock(qclient client.QueueProtocolAPI, c queue.Client, db dbm.DB, height int64) error {
+	if height == 0 {
+		return errors.New("can not re-execute genesis block")
+	}
+	blocks, err := qclient.GetBlocks(&types.ReqBlocks{Start: height - 1, End: height})
+	if err != nil {
+		return err
+	}
+	prevState := blocks.Items[0].Block.StateHash
+	block := blocks.Items[1].Block
+	if !mavl.NewTreeChecker(db).Check(prevState, height-1).OK() {
+		return fmt.Errorf("state of height %d is broken, can not re-execute block %d", height-1, height)
+	}
+	receipts := util.ExecTx(c, prevState, block)
+	var maplist = make(map[string]*types.KeyValue)
+	var kvset []*types.KeyValue
+	for _, receipt := range receipts.GetReceipts() {
+		if receipt.Ty == types.ExecErr {
+			return fmt.Errorf("exec tx err in block %d", height)
+		}
+		for _, kv := range receipt.KV {
+			if item, ok := maplist[string(kv.Key)]; ok {
+				item.Value = kv.Value
+			} else {
+				maplist[string(kv.Key)] = kv
+				kvset = append(kvset, kv)
+			}
+		}
+	}
+	stateHash := util.ExecKVMemSet(c, prevState, height, kvset, true)
+	if !bytes.Equal(stateHash, block.StateHash) {
+		util.ExecKVSetRollback(c, stateHash)
+		return fmt.Errorf("re-execute block %d state hash not match, calc %s", height, common.ToHex(stateHash))
+	}
+	return util.ExecKVSetCommit(c, stateHash)
+}
+
+func printResult(res *mavl.CheckResult) {
+	fmt.Printf("height:%d root:%s nodes:%d unverified:%d missing:%d corrupt:%d\n", res.Height, common.ToHex(res.Root),
+		res.NodeCount, res.Unverified, len(res.Missing), len(res.Corrupt))
+	for _, hash := range res.Missing {
+		fmt.Println("\tmissing node:", common.ToHex(hash))
+	}
+	for _, hash := range res.Corrupt {
+		fmt.Println("\tcorrupt node:", common.ToHex(hash))
+	}
+}
+
+func printOrphans(checker *mavl.TreeChecker) {
+	orphans := checker.Orphans()
+	fmt.Printf("orphan node records:%d\n", len(orphans))
+	for _, key := range orphans {
+		fmt.Println("\torphan node:", string(key[:len(key)-32]), common.ToHex(key[len(key)-32:]))
 	}
-	a = 0
-	fmt.Println("是否需要查询节点删除pool")
-	fmt.Fscan(stdin, &a)
-	stdin.ReadString('\n')
-	if a > 0 {
-		mavl.PruningTreePrintDB(db, []byte("_..md.._"))
-	}
-	a = 0
-	fmt.Println("是否需要查询二级叶子节点索引计数")
-	fmt.Fscan(stdin, &a)
-	stdin.ReadString('\n')
-	if a > 0 {
-		mavl.PruningTreePrintDB(db, []byte("..mok.."))
-	}
-	a = 0
-	fmt.Println("是否需要裁剪树,请输入最大裁剪数高度")
-	fmt.Fscan(stdin, &a)
-	stdin.ReadString('\n')
-	if a > 0 {
-		mavl.PruningTree(db, int64(a))
-	}
-	fmt.Println("over")
-	exit := make(chan os.Signal, 10)                     //初始化一个channel
-	signal.Notify(exit, syscall.SIGINT, syscall.SIGTERM) //notify方法用来监听收到的信号
-	sig := <-exit
-	fmt.Println(sig.String())
 }
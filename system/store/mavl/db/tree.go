@@ -9,6 +9,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"runtime"
 	"sync"
 
 	dbm "github.com/33cn/chain33/common/db"
@@ -31,6 +32,23 @@ var (
 	enableMavlPrefix bool
 	// 是否开启MVCC
 	enableMvcc bool
+	// 并行计算hash时允许拆分的层数, 最多同时有2^parallelHashLevel个goroutine计算hash
+	parallelHashLevel = log2Ceil(runtime.NumCPU())
+	// 并行编码节点的goroutine数
+	encodeWorkers = runtime.NumCPU()
+	// 节点编码时复用的缓冲区
+	storeNodeBufPool = sync.Pool{
+		New: func() interface{} {
+			return proto.NewBuffer(make([]byte, 0, 256))
+		},
+	}
+)
+
+const (
+	// 每个goroutine至少编码的节点数, 节点数少时直接在当前goroutine中编码
+	minEncodeNodesPerWorker = 256
+	// 预估每个节点编码后的大小, 用于预先分配内存
+	estimateStoreNodeSize = 128
 )
 
 // EnableMavlPrefix 使能mavl加前缀
@@ -123,28 +141,25 @@ func (t *Tree) Hash() []byte {
 	if t.root == nil {
 		return nil
 	}
-	hash := t.root.Hash(t)
+	hash := t.root.parallelHash(t, parallelHashLevel)
 	return hash
 }
 
 // Save 保存整个tree的节点信息到db中
+// 先并行计算所有变动子树的hash, 再并行编码节点, 最后一次性写入同一个batch
 func (t *Tree) Save() []byte {
 	if t.root == nil {
 		return nil
 	}
 	if t.ndb != nil {
-		saveNodeNo := t.root.save(t)
-		treelog.Debug("Tree.Save", "saveNodeNo", saveNodeNo, "tree height", t.blockHeight)
+		t.root.parallelHash(t, parallelHashLevel)
+		nodes := t.root.collectUnsaved(nil)
+		t.ndb.SaveNodes(t, nodes)
+		treelog.Debug("Tree.Save", "saveNodeNo", len(nodes), "tree height", t.blockHeight)
 		err := t.ndb.Commit()
 		if err != nil {
 			return nil
 		}
-		// 该线程应只允许一个
-		if enablePrune && !isPruning() &&
-			t.blockHeight%int64(pruneHeight) == 0 &&
-			t.blockHeight/int64(pruneHeight) > 1 {
-			go pruningTree(t.ndb.db, t.blockHeight)
-		}
 	}
 	return t.root.hash
 }
@@ -166,6 +181,11 @@ func (t *Tree) SetBlockHeight(height int64) {
 	t.blockHeight = height
 }
 
+// BlockHeight 获取tree对应的block高度
+func (t *Tree) BlockHeight() int64 {
+	return t.blockHeight
+}
+
 // Get 通过key获取leaf节点信息
 func (t *Tree) Get(key []byte) (index int32, value []byte, exists bool) {
 	if t.root == nil {
@@ -266,6 +286,8 @@ type nodeDB struct {
 	db      dbm.DB
 	batch   dbm.Batch
 	orphans map[string]struct{}
+	// 已经写入batch的节点, batch写入数据库之后才加入cache
+	saved []*Node
 }
 
 type nodeBatch struct {
@@ -325,11 +347,25 @@ func (ndb *nodeDB) SaveNode(t *Tree, node *Node) {
 	if node.hash == nil {
 		panic("Expected to find node.hash, but none found.")
 	}
+	ndb.saveNode(t, node, node.storeNode(t))
+}
+
+// SaveNodes 批量保存节点, 节点的编码并行完成, 然后按顺序写入batch
+func (ndb *nodeDB) SaveNodes(t *Tree, nodes []*Node) {
+	storenodes := encodeNodes(nodes)
+
+	ndb.mtx.Lock()
+	defer ndb.mtx.Unlock()
+	for i, node := range nodes {
+		ndb.saveNode(t, node, storenodes[i])
+	}
+}
+
+func (ndb *nodeDB) saveNode(t *Tree, node *Node, storenode []byte) {
 	if node.persisted {
 		panic("Shouldn't be calling save on an already persisted node.")
 	}
 	// Save node bytes to db
-	storenode := node.storeNode(t)
 	ndb.batch.Set(node.hash, storenode)
 	if enablePrune && node.height == 0 {
 		//save leafnode key&hash
@@ -345,11 +381,61 @@ func (ndb *nodeDB) SaveNode(t *Tree, node *Node) {
 		ndb.batch.Set(k, v)
 	}
 	node.persisted = true
-	ndb.cacheNode(node)
+	ndb.saved = append(ndb.saved, node)
 	delete(ndb.orphans, string(node.hash))
 	//treelog.Debug("SaveNode", "hash", node.hash, "height", node.height, "value", node.value)
 }
 
+// encodeNodes 并行编码节点, 每个goroutine负责连续的一段节点,
+// 编码结果先写入复用的缓冲区, 再拷贝到这一段共用的一块内存中, 减少内存分配
+func encodeNodes(nodes []*Node) [][]byte {
+	storenodes := make([][]byte, len(nodes))
+	workers := len(nodes) / minEncodeNodesPerWorker
+	if workers > encodeWorkers {
+		workers = encodeWorkers
+	}
+	if workers <= 1 {
+		encodeNodeRange(nodes, storenodes)
+		return storenodes
+	}
+	var wg sync.WaitGroup
+	step := (len(nodes) + workers - 1) / workers
+	for start := 0; start < len(nodes); start += step {
+		end := start + step
+		if end > len(nodes) {
+			end = len(nodes)
+		}
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+			encodeNodeRange(nodes[start:end], storenodes[start:end])
+		}(start, end)
+	}
+	wg.Wait()
+	return storenodes
+}
+
+func encodeNodeRange(nodes []*Node, storenodes [][]byte) {
+	buf := storeNodeBufPool.Get().(*proto.Buffer)
+	defer storeNodeBufPool.Put(buf)
+
+	data := make([]byte, 0, len(nodes)*estimateStoreNodeSize)
+	ends := make([]int, len(nodes))
+	for i, node := range nodes {
+		buf.Reset()
+		if err := buf.Marshal(node.makeStoreNode()); err != nil {
+			panic(err)
+		}
+		data = append(data, buf.Bytes()...)
+		ends[i] = len(data)
+	}
+	start := 0
+	for i, end := range ends {
+		storenodes[i] = data[start:end:end]
+		start = end
+	}
+}
+
 //cache缓存节点
 func (ndb *nodeDB) cacheNode(node *Node) {
 	//接进叶子节点，不容易命中cache，就不做cache
@@ -388,9 +474,15 @@ func (ndb *nodeDB) Commit() error {
 	err := ndb.batch.Write()
 	if err != nil {
 		treelog.Error("Commit batch.Write err", "err", err)
+	} else {
+		//节点写入数据库之后才能被其他树从cache中读到
+		for _, node := range ndb.saved {
+			ndb.cacheNode(node)
+		}
 	}
 
 	ndb.batch = nil
+	ndb.saved = nil
 	ndb.orphans = make(map[string]struct{})
 	return err
 }
@@ -499,6 +591,14 @@ func IterateRangeByStateHash(db dbm.DB, statehash, start, end []byte, ascending
 	tree.IterateRange(start, end, ascending, fn)
 }
 
+func log2Ceil(n int) int {
+	level := 0
+	for (1 << uint(level)) < n {
+		level++
+	}
+	return level
+}
+
 func genPrefixHashKey(node *Node, blockHeight int64) (key []byte) {
 	//leafnode
 	if node.height == 0 {
@@ -14,6 +14,7 @@ import (
 	"testing"
 
 	"os"
+	"runtime"
 
 	. "github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/db"
@@ -800,17 +801,15 @@ func TestPruningTree(t *testing.T) {
 	defer EnableMavlPrefix(false)
 	EnablePrune(true)
 	defer EnablePrune(false)
-	SetPruneHeight(preDel)
-	defer SetPruneHeight(0)
+	pruner := NewPruner(db, &PruneConfig{PruneHeight: preDel})
 
 	for j := 0; j < round; j++ {
 		for i := 0; i < preB; i++ {
-			setPruning(pruningStateStart)
 			prevHash, err = saveUpdateBlock(db, int64(i), prevHash, txN, j, int64(j*preB+i))
 			assert.Nil(t, err)
 			m := int64(j*preB + i)
 			if m/int64(preDel) > 1 && m%int64(preDel) == 0 {
-				pruningTree(db, m)
+				pruner.Prune(m)
 			}
 		}
 		fmt.Printf("round %d over \n", j)
@@ -833,6 +832,81 @@ func TestPruningTree(t *testing.T) {
 	PruningTreePrintDB(db, []byte(leafNodePrefix))
 }
 
+func TestPruningTreeKeepAndPinned(t *testing.T) {
+	const keyN = 10
+	const blockN = 300
+	dir, err := ioutil.TempDir("", "datastore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	db := db.NewDB("test", "leveldb", dir, 100)
+
+	EnableMavlPrefix(true)
+	defer EnableMavlPrefix(false)
+	EnablePrune(true)
+	defer EnablePrune(false)
+	pruner := NewPruner(db, &PruneConfig{PruneHeight: 50, KeepHeight: 80, PinnedHeights: []int64{100}})
+
+	roots := make(map[int64][]byte)
+	var prevHash []byte
+	for h := int64(1); h <= blockN; h++ {
+		tree := NewTree(db, true)
+		tree.SetBlockHeight(h)
+		err = tree.Load(prevHash)
+		require.NoError(t, err)
+		for i := 0; i < keyN; i++ {
+			tree.Set([]byte(fmt.Sprintf("key_%d", i)), []byte(fmt.Sprintf("value_%d_%d", i, h)))
+		}
+		prevHash = tree.Save()
+		roots[h] = prevHash
+	}
+	pruner.Prune(blockN)
+
+	status := pruner.Status()
+	assert.Equal(t, int64(blockN), status.LastPruneHeight)
+	assert.True(t, status.DeleteNodeCount > 0)
+	assert.True(t, status.ReclaimedBytes > 0)
+	assert.False(t, status.Running)
+	assert.False(t, status.IsPruned(230))
+	assert.False(t, status.IsPruned(100))
+	assert.True(t, status.IsPruned(150))
+	// 重启后仍然可以获取开始裁剪的高度
+	assert.Equal(t, int64(blockN), NewPruner(db, nil).Status().CurPruneHeight)
+
+	// 最新状态, 保留窗口内的状态以及指定高度的状态都可以查询
+	for _, h := range []int64{blockN, 230, 100} {
+		tree := NewTree(db, true)
+		err = tree.Load(roots[h])
+		require.NoError(t, err)
+		for i := 0; i < keyN; i++ {
+			_, v, exist := tree.Get([]byte(fmt.Sprintf("key_%d", i)))
+			assert.True(t, exist)
+			assert.Equal(t, []byte(fmt.Sprintf("value_%d_%d", i, h)), v)
+		}
+	}
+}
+
+func TestPrunerPause(t *testing.T) {
+	dir, err := ioutil.TempDir("", "datastore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	db := db.NewDB("test", "leveldb", dir, 100)
+
+	pruner := NewPruner(db, &PruneConfig{PruneHeight: 10, PinnedHeights: []int64{30, 10}})
+	assert.Equal(t, []int64{10, 30}, pruner.Status().PinnedHeights)
+	pruner.Pause()
+	pruner.Notify(100)
+	assert.True(t, pruner.Status().Paused)
+	assert.False(t, pruner.Status().Running)
+	assert.Equal(t, int64(0), pruner.Status().LastPruneHeight)
+
+	pruner.Start()
+	pruner.wg.Wait()
+	assert.False(t, pruner.Status().Paused)
+	assert.Equal(t, int64(100), pruner.Status().LastPruneHeight)
+	assert.True(t, pruner.isPinned(25, 31))
+	assert.False(t, pruner.isPinned(11, 30))
+}
+
 func genUpdateKV(height int64, txN int64, vIndex int) (kvs []*types.KeyValue) {
 	for i := int64(0); i < txN; i++ {
 		n := height*txN + i
@@ -996,7 +1070,7 @@ func TestPruningHashNode(t *testing.T) {
 		k, _ := FromHex(d.value)
 		mpleafHash[string(k)] = true
 	}
-	pruningHashNode(db, mpleafHash)
+	NewPruner(db, nil).pruningHashNode(mpleafHash)
 	tree2 := NewTree(db, true)
 	err = tree2.Load(hash1)
 	require.NoError(t, err)
@@ -1147,3 +1221,171 @@ func saveBlock(dbm db.DB, height int64, hash []byte, txN int64, mvcc bool) (newH
 	}
 	return newHash, nil
 }
+
+func setSerialSave(serial bool) {
+	if serial {
+		parallelHashLevel = 0
+		encodeWorkers = 1
+	} else {
+		parallelHashLevel = log2Ceil(runtime.NumCPU())
+		encodeWorkers = runtime.NumCPU()
+	}
+}
+
+//串行和并行保存的树, roothash和数据库中的内容必须完全一致
+func TestParallelSaveSameHash(t *testing.T) {
+	defer setSerialSave(false)
+	for _, prune := range []bool{false, true} {
+		EnableMavlPrefix(prune)
+		EnablePrune(prune)
+		serialDb := db.NewDB("mavltree", "memdb", "", 100)
+		parallelDb := db.NewDB("mavltree", "memdb", "", 100)
+		var serialHash, parallelHash []byte
+		for h := int64(0); h < 5; h++ {
+			kvs := make([]*types.KeyValue, 3000)
+			for i := range kvs {
+				key := []byte(fmt.Sprintf("key%d", rand.Intn(5000)))
+				kvs[i] = &types.KeyValue{Key: key, Value: []byte(RandStr(20))}
+			}
+			var err error
+			setSerialSave(true)
+			serialHash, err = SetKVPair(serialDb, &types.StoreSet{StateHash: serialHash, KV: kvs, Height: h}, true)
+			require.NoError(t, err)
+			setSerialSave(false)
+			parallelLevel := parallelHashLevel
+			parallelHashLevel = 4
+			encodeWorkers = 4
+			parallelHash, err = SetKVPair(parallelDb, &types.StoreSet{StateHash: parallelHash, KV: kvs, Height: h}, true)
+			parallelHashLevel = parallelLevel
+			require.NoError(t, err)
+			require.Equal(t, serialHash, parallelHash)
+		}
+		serialKvs := listDB(serialDb)
+		require.Equal(t, serialKvs, listDB(parallelDb))
+		require.NotEmpty(t, serialKvs)
+	}
+	EnableMavlPrefix(false)
+	EnablePrune(false)
+}
+
+func listDB(d db.DB) map[string]string {
+	kvs := make(map[string]string)
+	it := d.Iterator(nil, nil, false)
+	defer it.Close()
+	for it.Rewind(); it.Valid(); it.Next() {
+		kvs[string(it.Key())] = string(it.Value())
+	}
+	return kvs
+}
+
+func TestEncodeNodes(t *testing.T) {
+	nodes := make([]*Node, 1000)
+	for i := range nodes {
+		nodes[i] = NewNode([]byte(fmt.Sprintf("key%d", i)), []byte(RandStr(i%300+1)))
+	}
+	defer setSerialSave(false)
+	encodeWorkers = 3
+	storenodes := encodeNodes(nodes)
+	require.Equal(t, len(nodes), len(storenodes))
+	for i, node := range nodes {
+		require.Equal(t, node.storeNode(nil), storenodes[i])
+	}
+}
+
+func benchmarkTreeSave(b *testing.B, keys int, serial bool) {
+	setSerialSave(serial)
+	defer setSerialSave(false)
+	dir, err := ioutil.TempDir("", "datastore")
+	require.NoError(b, err)
+	defer os.RemoveAll(dir)
+	db := db.NewDB("test", "leveldb", dir, 100)
+	defer db.Close()
+	//先准备一棵较大的树, 每个区块修改其中keys个key
+	prevHash, err := saveBlock(db, 0, nil, 100000, false)
+	require.NoError(b, err)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		tree := NewTree(db, true)
+		tree.SetBlockHeight(int64(i + 1))
+		require.NoError(b, tree.Load(prevHash))
+		for j := 0; j < keys; j++ {
+			key := i2b(int32(rand.Intn(100000)))
+			tree.Set(key, Sha256(append(key, byte(i))))
+		}
+		b.StartTimer()
+		prevHash = tree.Save()
+	}
+}
+
+// BenchmarkTreeSave 对比不同修改key数量下, 串行和并行提交的耗时
+func BenchmarkTreeSave(b *testing.B) {
+	for _, keys := range []int{100, 1000, 10000} {
+		for _, serial := range []bool{true, false} {
+			name := fmt.Sprintf("keys-%d-parallel", keys)
+			if serial {
+				name = fmt.Sprintf("keys-%d-serial", keys)
+			}
+			b.Run(name, func(b *testing.B) {
+				benchmarkTreeSave(b, keys, serial)
+			})
+		}
+	}
+}
+
+func TestTreeChecker(t *testing.T) {
+	EnableMavlPrefix(true)
+	defer EnableMavlPrefix(false)
+	db := db.NewDB("mavltree", "memdb", "", 100)
+	var roots [][]byte
+	var prevHash []byte
+	for h := int64(0); h < 3; h++ {
+		var err error
+		prevHash, err = SetKVPair(db, &types.StoreSet{StateHash: prevHash, KV: genKVShort(h, 100), Height: h}, true)
+		require.NoError(t, err)
+		roots = append(roots, prevHash)
+	}
+	checker := NewTreeChecker(db)
+	for h, root := range roots {
+		res := checker.Check(root, int64(h))
+		require.True(t, res.OK())
+		require.True(t, res.NodeCount > 0)
+	}
+	require.Empty(t, checker.Orphans())
+
+	//高度1上保存的一个叶子节点丢失
+	var lost []byte
+	it := db.Iterator([]byte(fmt.Sprintf("%s-%010d-", leafNodePrefix, 1)), nil, false)
+	for it.Rewind(); it.Valid(); it.Next() {
+		lost = CopyBytes(it.Key())
+		break
+	}
+	it.Close()
+	require.NotNil(t, lost)
+	db.Delete(lost)
+
+	//写入一个没有被任何树引用的节点
+	orphan := []byte(fmt.Sprintf("%s-%010d-%s", hashNodePrefix, 2, RandStr(32)))
+	db.Set(orphan, []byte("orphan"))
+
+	checker = NewTreeChecker(db)
+	res := checker.Check(roots[0], 0)
+	require.True(t, res.OK())
+	res = checker.Check(roots[1], 1)
+	require.False(t, res.OK())
+	require.Equal(t, [][]byte{lost}, res.Missing)
+	//高度2的树共享了丢失的节点
+	res = checker.Check(roots[2], 2)
+	require.Equal(t, [][]byte{lost}, res.Missing)
+	require.Equal(t, [][]byte{orphan}, checker.Orphans())
+
+	//节点数据被修改
+	buf, err := db.Get(roots[2])
+	require.NoError(t, err)
+	var storeNode types.StoreNode
+	require.NoError(t, types.Decode(buf, &storeNode))
+	storeNode.Size++
+	db.Set(roots[2], types.Encode(&storeNode))
+	res = NewTreeChecker(db).Check(roots[2], 2)
+	require.Equal(t, [][]byte{roots[2]}, res.Corrupt)
+}
@@ -6,6 +6,8 @@
 package mavl
 
 import (
+	"sync/atomic"
+
 	"github.com/33cn/chain33/common"
 	clog "github.com/33cn/chain33/common/log"
 	log "github.com/33cn/chain33/common/log/log15"
@@ -31,12 +33,15 @@ func DisableLog() {
 // Store mavl store struct
 type Store struct {
 	*drivers.BaseStore
-	trees            map[string]*mavl.Tree
+	trees            *memTreeCache
 	cache            *lru.Cache
+	cacheHits        int64
+	cacheMisses      int64
 	enableMavlPrefix bool
 	enableMVCC       bool
 	enableMavlPrune  bool
 	pruneHeight      int32
+	pruner           *mavl.Pruner
 }
 
 func init() {
@@ -48,6 +53,14 @@ type subConfig struct {
 	EnableMVCC       bool  `json:"enableMVCC"`
 	EnableMavlPrune  bool  `json:"enableMavlPrune"`
 	PruneHeight      int32 `json:"pruneHeight"`
+	// 保留最近多少个高度的完整状态
+	PruneKeepHeight int64 `json:"pruneKeepHeight"`
+	// 不裁剪的高度列表
+	PrunePinnedHeights []int64 `json:"prunePinnedHeights"`
+	// 最多缓存的未提交树的个数
+	MemTreeCacheSize int `json:"memTreeCacheSize"`
+	// 缓存的已提交树的个数
+	TreeCacheSize int `json:"treeCacheSize"`
 }
 
 // New new mavl store module
@@ -57,8 +70,11 @@ func New(cfg *types.Store, sub []byte) queue.Module {
 	if sub != nil {
 		types.MustDecode(sub, &subcfg)
 	}
-	mavls := &Store{bs, make(map[string]*mavl.Tree), nil, subcfg.EnableMavlPrefix, subcfg.EnableMVCC, subcfg.EnableMavlPrune, subcfg.PruneHeight}
-	mavls.cache, _ = lru.New(10)
+	mavls := &Store{BaseStore: bs, trees: newMemTreeCache(subcfg.MemTreeCacheSize)}
+	if subcfg.TreeCacheSize <= 0 {
+		subcfg.TreeCacheSize = defaultTreeCacheSize
+	}
+	mavls.cache, _ = lru.New(subcfg.TreeCacheSize)
 	//使能前缀mavl以及MVCC
 
 	mavls.enableMavlPrefix = subcfg.EnableMavlPrefix
@@ -68,21 +84,31 @@ func New(cfg *types.Store, sub []byte) queue.Module {
 	mavl.EnableMavlPrefix(mavls.enableMavlPrefix)
 	mavl.EnableMVCC(mavls.enableMVCC)
 	mavl.EnablePrune(mavls.enableMavlPrune)
-	mavl.SetPruneHeight(int(mavls.pruneHeight))
+	if mavls.enableMavlPrune {
+		mavls.pruner = mavl.NewPruner(bs.GetDB(), &mavl.PruneConfig{
+			PruneHeight:   int64(mavls.pruneHeight),
+			KeepHeight:    subcfg.PruneKeepHeight,
+			PinnedHeights: subcfg.PrunePinnedHeights,
+		})
+	}
 	bs.SetChild(mavls)
 	return mavls
 }
 
 // Close close mavl store
 func (mavls *Store) Close() {
-	mavl.ClosePrune()
+	mavls.pruner.Close()
 	mavls.BaseStore.Close()
 	mlog.Info("store mavl closed")
 }
 
 // Set set k v to mavl store db; sync is true represent write sync
 func (mavls *Store) Set(datas *types.StoreSet, sync bool) ([]byte, error) {
-	return mavl.SetKVPair(mavls.GetDB(), datas, sync)
+	hash, err := mavl.SetKVPair(mavls.GetDB(), datas, sync)
+	if err == nil {
+		mavls.pruner.Notify(datas.Height)
+	}
+	return hash, err
 }
 
 // Get get values by keys
@@ -92,10 +118,18 @@ func (mavls *Store) Get(datas *types.StoreGet) [][]byte {
 	values := make([][]byte, len(datas.Keys))
 	search := string(datas.StateHash)
 	if data, ok := mavls.cache.Get(search); ok {
+		atomic.AddInt64(&mavls.cacheHits, 1)
 		tree = data.(*mavl.Tree)
-	} else if data, ok := mavls.trees[search]; ok {
-		tree = data
+	} else if data, ok := mavls.trees.Get(datas.StateHash); ok {
+		//Commit会修改树中的节点, 读取未提交的树时需要加锁
+		data.mtx.RLock()
+		defer data.mtx.RUnlock()
+		tree = data.tree
 	} else {
+		atomic.AddInt64(&mavls.cacheMisses, 1)
+	}
+	//kvset为空或者已经提交的树需要从数据库加载, 这时未提交的树已经命中, 不再记为已提交缓存的未命中
+	if tree == nil {
 		tree = mavl.NewTree(mavls.GetDB(), true)
 		//get接口也应该传入高度
 		//tree.SetBlockHeight(datas.Height)
@@ -120,7 +154,9 @@ func (mavls *Store) Get(datas *types.StoreGet) [][]byte {
 func (mavls *Store) MemSet(datas *types.StoreSet, sync bool) ([]byte, error) {
 	if len(datas.KV) == 0 {
 		mlog.Info("store mavl memset,use preStateHash as stateHash for kvset is null")
-		mavls.trees[string(datas.StateHash)] = nil
+		if err := mavls.trees.Add(datas.StateHash, datas.StateHash, nil, datas.Height); err != nil {
+			return nil, err
+		}
 		return datas.StateHash, nil
 	}
 	tree := mavl.NewTree(mavls.GetDB(), sync)
@@ -133,55 +169,137 @@ func (mavls *Store) MemSet(datas *types.StoreSet, sync bool) ([]byte, error) {
 		tree.Set(datas.KV[i].Key, datas.KV[i].Value)
 	}
 	hash := tree.Hash()
-	mavls.trees[string(hash)] = tree
-	if len(mavls.trees) > 1000 {
-		mlog.Error("too many trees in cache")
+	if err := mavls.trees.Add(hash, datas.StateHash, tree, datas.Height); err != nil {
+		return nil, err
 	}
 	return hash, nil
 }
 
 // Commit convert memcory mavl to storage db
 func (mavls *Store) Commit(req *types.ReqHash) ([]byte, error) {
-	tree, ok := mavls.trees[string(req.Hash)]
+	data, ok := mavls.trees.Get(req.Hash)
 	if !ok {
 		mlog.Error("store mavl commit", "err", types.ErrHashNotFound)
 		return nil, types.ErrHashNotFound
 	}
 
-	if tree == nil {
+	//写入数据库之后再从缓存中删除, 保证并发的读操作总能读到这棵树,
+	//同一棵树被多个区块使用时只写入一次
+	data.mtx.Lock()
+	if data.tree == nil {
+		data.mtx.Unlock()
 		mlog.Info("store mavl commit,do nothing for kvset is null")
-		delete(mavls.trees, string(req.Hash))
+		mavls.trees.Commit(req.Hash)
 		return req.Hash, nil
 	}
-
-	hash := tree.Save()
+	hash := data.tree.Save()
+	if hash != nil {
+		data.tree = nil
+	}
+	data.mtx.Unlock()
 	if hash == nil {
 		mlog.Error("store mavl commit", "err", types.ErrHashNotFound)
 		return nil, types.ErrDataBaseDamage
 	}
-	delete(mavls.trees, string(req.Hash))
+	height, _ := mavls.trees.Commit(req.Hash)
+	mavls.pruner.Notify(height)
+	stats := mavls.TreeCacheStats()
+	mlog.Debug("store mavl commit", "height", height, "memTrees", stats.Size, "staleEvicts", stats.StaleEvicts,
+		"overflowEvicts", stats.OverflowEvicts, "cacheHits", stats.CommittedHits, "cacheMisses", stats.CommittedMisses)
 	return req.Hash, nil
 }
 
 // Rollback 回退将缓存的mavl树删除掉
 func (mavls *Store) Rollback(req *types.ReqHash) ([]byte, error) {
-	_, ok := mavls.trees[string(req.Hash)]
-	if !ok {
+	if !mavls.trees.Remove(req.Hash) {
 		mlog.Error("store mavl rollback", "err", types.ErrHashNotFound)
 		return nil, types.ErrHashNotFound
 	}
-	delete(mavls.trees, string(req.Hash))
 	return req.Hash, nil
 }
 
+// TreeCacheStats 获取树缓存的统计信息
+func (mavls *Store) TreeCacheStats() *types.StoreTreeCacheStats {
+	stats := mavls.trees.Stats()
+	stats.CommittedSize = int64(mavls.cache.Len())
+	stats.CommittedHits = atomic.LoadInt64(&mavls.cacheHits)
+	stats.CommittedMisses = atomic.LoadInt64(&mavls.cacheMisses)
+	return stats
+}
+
 // IterateRangeByStateHash 迭代实现功能； statehash：当前状态hash, start：开始查找的key, end: 结束的key, ascending：升序，降序, fn 迭代回调函数
 func (mavls *Store) IterateRangeByStateHash(statehash []byte, start []byte, end []byte, ascending bool, fn func(key, value []byte) bool) {
 	mavl.IterateRangeByStateHash(mavls.GetDB(), statehash, start, end, ascending, fn)
 }
 
-// ProcEvent not support message
+// ProcEvent 处理mavl裁剪相关的消息
 func (mavls *Store) ProcEvent(msg queue.Message) {
-	msg.ReplyErr("Store", types.ErrActionNotSupport)
+	switch msg.Ty {
+	case types.EventStorePrune:
+		req := msg.GetData().(*types.ReqStorePrune)
+		status, err := mavls.SetPrune(req)
+		if err != nil {
+			msg.Reply(mavls.GetQueueClient().NewMessage("", types.EventStorePruneStatus, err))
+			return
+		}
+		msg.Reply(mavls.GetQueueClient().NewMessage("", types.EventStorePruneStatus, status))
+	case types.EventStorePruneStatus:
+		msg.Reply(mavls.GetQueueClient().NewMessage("", types.EventStorePruneStatus, mavls.GetPruneStatus()))
+	case types.EventStoreGetProof:
+		reply, err := mavls.GetProof(msg.GetData().(*types.StoreGet))
+		if err != nil {
+			msg.Reply(mavls.GetQueueClient().NewMessage("", types.EventStoreGetProof, err))
+			return
+		}
+		msg.Reply(mavls.GetQueueClient().NewMessage("", types.EventStoreGetProof, reply))
+	default:
+		msg.ReplyErr("Store", types.ErrActionNotSupport)
+	}
+}
+
+// GetProof 获取 keys 的值以及 mavl 证明, 供轻节点验证, key 不存在时值和证明都为空
+func (mavls *Store) GetProof(datas *types.StoreGet) (*types.StoreReplyProof, error) {
+	tree := mavl.NewTree(mavls.GetDB(), true)
+	err := tree.Load(datas.StateHash)
+	if err != nil {
+		return nil, err
+	}
+	reply := &types.StoreReplyProof{Values: make([][]byte, len(datas.Keys)), Proofs: make([][]byte, len(datas.Keys))}
+	for i, key := range datas.Keys {
+		value, proof, exist := tree.Proof(key)
+		if exist {
+			reply.Values[i] = value
+			reply.Proofs[i] = proof
+		}
+	}
+	return reply, nil
+}
+
+// SetPrune 启动或者暂停裁剪
+func (mavls *Store) SetPrune(req *types.ReqStorePrune) (*types.StorePruneStatus, error) {
+	if mavls.pruner == nil {
+		return nil, types.ErrPruneNotEnable
+	}
+	if req.GetPause() {
+		mavls.pruner.Pause()
+	} else {
+		mavls.pruner.Start()
+	}
+	mlog.Info("store mavl set prune", "pause", req.GetPause())
+	return mavls.pruner.Status(), nil
+}
+
+// GetPruneStatus 获取裁剪的进度以及回收的空间, 同时返回树缓存的统计信息
+func (mavls *Store) GetPruneStatus() *types.StorePruneStatus {
+	status := &types.StorePruneStatus{}
+	if mavls.pruner != nil {
+		status = mavls.pruner.Status()
+	} else {
+		//之前开启过裁剪时, 低于裁剪高度的状态已经不能查询
+		status.CurPruneHeight = mavl.GetCurPruningHeight(mavls.GetDB())
+	}
+	status.TreeCache = mavls.TreeCacheStats()
+	return status
 }
 
 // Del ...
@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/33cn/chain33/account"
@@ -85,6 +86,36 @@ func TestKvddbSetGet(t *testing.T) {
 	assert.Equal(t, []byte(nil), values3[0])
 }
 
+func TestKvdbGetProof(t *testing.T) {
+	dir, err := ioutil.TempDir("", "example")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir) // clean up
+	os.RemoveAll(dir)       //删除已存在目录
+	var storeCfg = newStoreCfg(dir)
+	store := New(storeCfg, nil).(*Store)
+	assert.NotNil(t, store)
+
+	var kv []*types.KeyValue
+	kv = append(kv, &types.KeyValue{Key: []byte("k1"), Value: []byte("v1")})
+	kv = append(kv, &types.KeyValue{Key: []byte("k2"), Value: []byte("v2")})
+	kv = append(kv, &types.KeyValue{Key: []byte("k3"), Value: []byte("v3")})
+	hash, err := store.Set(&types.StoreSet{StateHash: drivers.EmptyRoot[:], KV: kv}, true)
+	assert.Nil(t, err)
+
+	reply, err := store.GetProof(&types.StoreGet{StateHash: hash, Keys: [][]byte{[]byte("k2"), []byte("k4")}})
+	assert.Nil(t, err)
+	assert.Len(t, reply.Values, 2)
+	assert.Equal(t, []byte("v2"), reply.Values[0])
+	assert.True(t, mavldb.VerifyKVPairProof(nil, hash, types.KeyValue{Key: []byte("k2"), Value: []byte("v2")}, reply.Proofs[0]))
+	assert.False(t, mavldb.VerifyKVPairProof(nil, hash, types.KeyValue{Key: []byte("k2"), Value: []byte("v1")}, reply.Proofs[0]))
+	//不存在的 key
+	assert.Nil(t, reply.Values[1])
+	assert.Nil(t, reply.Proofs[1])
+
+	_, err = store.GetProof(&types.StoreGet{StateHash: common.Sha256([]byte("none")), Keys: [][]byte{[]byte("k1")}})
+	assert.NotNil(t, err)
+}
+
 func TestKvdbMemSet(t *testing.T) {
 	dir, err := ioutil.TempDir("", "example")
 	assert.Nil(t, err)
@@ -116,6 +147,164 @@ func TestKvdbMemSet(t *testing.T) {
 	assert.Nil(t, notExistHash)
 }
 
+func TestKvdbMemTreeCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "example")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir) // clean up
+	os.RemoveAll(dir)       //删除已存在目录
+	var storeCfg = newStoreCfg(dir)
+	store := New(storeCfg, []byte(`{"memTreeCacheSize":3,"treeCacheSize":5}`)).(*Store)
+	assert.NotNil(t, store)
+	defer store.Close()
+
+	memSet := func(prev []byte, height int64, value string) []byte {
+		kv := []*types.KeyValue{{Key: []byte("mk1"), Value: []byte(value)}}
+		hash, err := store.MemSet(&types.StoreSet{StateHash: prev, KV: kv, Height: height}, true)
+		assert.Nil(t, err)
+		return hash
+	}
+	// 高度1有两个分叉a和b, 高度2预先执行了a之上kvset为空的区块, 状态hash和a相同
+	a := memSet(drivers.EmptyRoot[:], 1, "a")
+	b := memSet(drivers.EmptyRoot[:], 1, "b")
+	hash, err := store.MemSet(&types.StoreSet{StateHash: a, Height: 2}, true)
+	assert.Nil(t, err)
+	assert.Equal(t, a, hash)
+	values := store.Get(&types.StoreGet{StateHash: b, Keys: [][]byte{[]byte("mk1")}})
+	assert.Equal(t, []byte("b"), values[0])
+
+	_, err = store.Commit(&types.ReqHash{Hash: a})
+	assert.Nil(t, err)
+	stats := store.TreeCacheStats()
+	assert.Equal(t, int64(1), stats.Size)
+	assert.Equal(t, int64(1), stats.StaleEvicts)
+	_, err = store.Rollback(&types.ReqHash{Hash: b})
+	assert.Equal(t, types.ErrHashNotFound, err)
+	values = store.Get(&types.StoreGet{StateHash: a, Keys: [][]byte{[]byte("mk1")}})
+	assert.Equal(t, []byte("a"), values[0])
+	assert.Equal(t, int64(0), store.TreeCacheStats().CommittedMisses)
+
+	// 高度2的另一个候选c, 提交空区块之后c被淘汰
+	memSet(a, 2, "c")
+	_, err = store.Commit(&types.ReqHash{Hash: a})
+	assert.Nil(t, err)
+	stats = store.TreeCacheStats()
+	assert.Equal(t, int64(0), stats.Size)
+	assert.Equal(t, int64(2), stats.StaleEvicts)
+
+	// 超过容量时淘汰不在已提交链上的高度最低的树, d在已提交的a之上, 不会被淘汰
+	d := memSet(a, 3, "d")
+	e := memSet(a, 4, "e")
+	memSet(a, 4, "f")
+	memSet(a, 4, "g")
+	stats = store.TreeCacheStats()
+	assert.Equal(t, int64(3), stats.Size)
+	assert.Equal(t, int64(1), stats.OverflowEvicts)
+	_, err = store.Commit(&types.ReqHash{Hash: e})
+	assert.Equal(t, types.ErrHashNotFound, err)
+	_, err = store.Commit(&types.ReqHash{Hash: d})
+	assert.Nil(t, err)
+
+	// 缓存中的树都在已提交的链上时拒绝MemSet
+	h := memSet(d, 4, "h")
+	memSet(d, 4, "i")
+	memSet(d, 4, "j")
+	kv := []*types.KeyValue{{Key: []byte("mk1"), Value: []byte("k")}}
+	_, err = store.MemSet(&types.StoreSet{StateHash: d, KV: kv, Height: 4}, true)
+	assert.Equal(t, types.ErrMemTreeCacheFull, err)
+	assert.Equal(t, int64(1), store.TreeCacheStats().OverflowEvicts)
+	_, err = store.Commit(&types.ReqHash{Hash: h})
+	assert.Nil(t, err)
+
+	// 已提交的树从数据库中加载之后进入缓存, 统计信息通过裁剪状态查询返回
+	values = store.Get(&types.StoreGet{StateHash: h, Keys: [][]byte{[]byte("mk1")}})
+	assert.Equal(t, []byte("h"), values[0])
+	values = store.Get(&types.StoreGet{StateHash: h, Keys: [][]byte{[]byte("mk1")}})
+	assert.Equal(t, []byte("h"), values[0])
+	status := store.GetPruneStatus()
+	assert.Equal(t, int64(2), status.TreeCache.CommittedSize)
+	assert.Equal(t, int64(1), status.TreeCache.CommittedHits)
+	assert.Equal(t, int64(1), status.TreeCache.CommittedMisses)
+}
+
+func TestMemTreeCacheCommit(t *testing.T) {
+	cache := newMemTreeCache(10)
+	root := []byte("root")
+	// a和b是高度1的候选, c、d在a之上, e在b之上, f在c之上, g的父树不在缓存中
+	cache.Add([]byte("a"), root, nil, 1)
+	cache.Add([]byte("b"), root, nil, 1)
+	cache.Add([]byte("c"), []byte("a"), nil, 2)
+	cache.Add([]byte("d"), []byte("a"), nil, 2)
+	cache.Add([]byte("e"), []byte("b"), nil, 2)
+	cache.Add([]byte("f"), []byte("c"), nil, 3)
+	cache.Add([]byte("h"), []byte("e"), nil, 3)
+	cache.Add([]byte("g"), []byte("x"), nil, 4)
+	height, ok := cache.Commit([]byte("a"))
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), height)
+	for _, hash := range []string{"c", "d", "f", "g"} {
+		_, ok := cache.trees[hash]
+		assert.True(t, ok, hash)
+	}
+	stats := cache.Stats()
+	assert.Equal(t, int64(4), stats.Size)
+	assert.Equal(t, int64(3), stats.StaleEvicts)
+
+	// 同一个hash在不同高度被使用时, 每次提交只删除最低的高度
+	cache.Add([]byte("c"), []byte("c"), nil, 3)
+	height, ok = cache.Commit([]byte("c"))
+	assert.True(t, ok)
+	assert.Equal(t, int64(2), height)
+	_, ok = cache.trees["d"]
+	assert.False(t, ok)
+	_, ok = cache.trees["f"]
+	assert.True(t, ok)
+	height, ok = cache.Commit([]byte("c"))
+	assert.True(t, ok)
+	assert.Equal(t, int64(3), height)
+	_, ok = cache.trees["f"]
+	assert.False(t, ok)
+	_, ok = cache.Commit([]byte("c"))
+	assert.False(t, ok)
+}
+
+func TestKvdbMemTreeConcurrent(t *testing.T) {
+	dir, err := ioutil.TempDir("", "example")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir) // clean up
+	os.RemoveAll(dir)       //删除已存在目录
+	var storeCfg = newStoreCfg(dir)
+	store := New(storeCfg, nil).(*Store)
+	assert.NotNil(t, store)
+	defer store.Close()
+
+	prev := drivers.EmptyRoot[:]
+	for h := int64(1); h <= 10; h++ {
+		var kv []*types.KeyValue
+		var keys [][]byte
+		for i := 0; i < 100; i++ {
+			key := []byte(fmt.Sprintf("mk%d", i))
+			kv = append(kv, &types.KeyValue{Key: key, Value: []byte(fmt.Sprintf("v%d_%d", i, h))})
+			keys = append(keys, key)
+		}
+		hash, err := store.MemSet(&types.StoreSet{StateHash: prev, KV: kv, Height: h}, true)
+		assert.Nil(t, err)
+		//提交的同时并发读取未提交的树
+		var wg sync.WaitGroup
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				values := store.Get(&types.StoreGet{StateHash: hash, Keys: keys})
+				assert.Equal(t, []byte(fmt.Sprintf("v0_%d", h)), values[0])
+			}()
+		}
+		_, err = store.Commit(&types.ReqHash{Hash: hash})
+		assert.Nil(t, err)
+		wg.Wait()
+		prev = hash
+	}
+}
+
 func TestKvdbRollback(t *testing.T) {
 	dir, err := ioutil.TempDir("", "example")
 	assert.Nil(t, err)
@@ -146,6 +335,51 @@ func TestKvdbRollback(t *testing.T) {
 	assert.Nil(t, notExistHash)
 }
 
+func TestKvdbPrune(t *testing.T) {
+	dir, err := ioutil.TempDir("", "example")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir) // clean up
+	os.RemoveAll(dir)       //删除已存在目录
+	var storeCfg = newStoreCfg(dir)
+	store := New(storeCfg, nil).(*Store)
+	assert.NotNil(t, store)
+	_, err = store.SetPrune(&types.ReqStorePrune{Pause: true})
+	assert.Equal(t, types.ErrPruneNotEnable, err)
+	assert.False(t, store.GetPruneStatus().Enable)
+	store.Close()
+
+	sub := []byte(`{"enableMavlPrune":true,"pruneHeight":10,"pruneKeepHeight":20,"prunePinnedHeights":[5]}`)
+	store = New(storeCfg, sub).(*Store)
+	defer mavldb.EnablePrune(false)
+	defer store.Close()
+	status, err := store.SetPrune(&types.ReqStorePrune{Pause: true})
+	assert.Nil(t, err)
+	assert.True(t, status.Enable)
+	assert.True(t, status.Paused)
+	assert.Equal(t, int64(10), status.PruneHeight)
+	assert.Equal(t, int64(20), status.KeepHeight)
+	assert.Equal(t, []int64{5}, status.PinnedHeights)
+
+	var kv []*types.KeyValue
+	kv = append(kv, &types.KeyValue{Key: []byte("mk1"), Value: []byte("v1")})
+	datas := &types.StoreSet{
+		StateHash: drivers.EmptyRoot[:],
+		KV:        kv,
+		Height:    30,
+	}
+	hash, err := store.MemSet(datas, true)
+	assert.Nil(t, err)
+	_, err = store.Commit(&types.ReqHash{Hash: hash})
+	assert.Nil(t, err)
+	status = store.GetPruneStatus()
+	assert.Equal(t, int64(30), status.CommitHeight)
+	assert.Equal(t, int64(0), status.LastPruneHeight)
+
+	status, err = store.SetPrune(&types.ReqStorePrune{Pause: false})
+	assert.Nil(t, err)
+	assert.False(t, status.Paused)
+}
+
 var checkKVResult []*types.KeyValue
 
 func checkKV(k, v []byte) bool {
@@ -203,8 +203,10 @@ type ReqBalance struct {
 	//地址列表
 	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
 	//执行器名称
-	Execer               string   `protobuf:"bytes,2,opt,name=execer,proto3" json:"execer,omitempty"`
-	StateHash            string   `protobuf:"bytes,3,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	Execer    string `protobuf:"bytes,2,opt,name=execer,proto3" json:"execer,omitempty"`
+	StateHash string `protobuf:"bytes,3,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	//查询指定区块的状态, 为空时查询stateHash或者最新的状态
+	At                   *StateAt `protobuf:"bytes,4,opt,name=at,proto3" json:"at,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -256,6 +258,61 @@ func (m *ReqBalance) GetStateHash() string {
 	return ""
 }
 
+func (m *ReqBalance) GetAt() *StateAt {
+	if m != nil {
+		return m.At
+	}
+	return nil
+}
+
+//指定查询的历史状态, 区块hash不为空时按区块hash查询, 否则按区块高度查询
+type StateAt struct {
+	Height               int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	BlockHash            string   `protobuf:"bytes,2,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StateAt) Reset()         { *m = StateAt{} }
+func (m *StateAt) String() string { return proto.CompactTextString(m) }
+func (*StateAt) ProtoMessage()    {}
+func (*StateAt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e28828dcb8d24f0, []int{4}
+}
+
+func (m *StateAt) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateAt.Unmarshal(m, b)
+}
+func (m *StateAt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateAt.Marshal(b, m, deterministic)
+}
+func (m *StateAt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateAt.Merge(m, src)
+}
+func (m *StateAt) XXX_Size() int {
+	return xxx_messageInfo_StateAt.Size(m)
+}
+func (m *StateAt) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateAt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateAt proto.InternalMessageInfo
+
+func (m *StateAt) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *StateAt) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
 // Account 的列表
 type Accounts struct {
 	Acc                  []*Account `protobuf:"bytes,1,rep,name=acc,proto3" json:"acc,omitempty"`
@@ -268,7 +325,7 @@ func (m *Accounts) Reset()         { *m = Accounts{} }
 func (m *Accounts) String() string { return proto.CompactTextString(m) }
 func (*Accounts) ProtoMessage()    {}
 func (*Accounts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8e28828dcb8d24f0, []int{4}
+	return fileDescriptor_8e28828dcb8d24f0, []int{5}
 }
 
 func (m *Accounts) XXX_Unmarshal(b []byte) error {
@@ -308,7 +365,7 @@ func (m *ExecAccount) Reset()         { *m = ExecAccount{} }
 func (m *ExecAccount) String() string { return proto.CompactTextString(m) }
 func (*ExecAccount) ProtoMessage()    {}
 func (*ExecAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8e28828dcb8d24f0, []int{5}
+	return fileDescriptor_8e28828dcb8d24f0, []int{6}
 }
 
 func (m *ExecAccount) XXX_Unmarshal(b []byte) error {
@@ -355,7 +412,7 @@ func (m *AllExecBalance) Reset()         { *m = AllExecBalance{} }
 func (m *AllExecBalance) String() string { return proto.CompactTextString(m) }
 func (*AllExecBalance) ProtoMessage()    {}
 func (*AllExecBalance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8e28828dcb8d24f0, []int{6}
+	return fileDescriptor_8e28828dcb8d24f0, []int{7}
 }
 
 func (m *AllExecBalance) XXX_Unmarshal(b []byte) error {
@@ -390,41 +447,308 @@ func (m *AllExecBalance) GetExecAccount() []*ExecAccount {
 	return nil
 }
 
+//地址的一次余额变化, 来自交易receipt中的 ReceiptAccountTransfer/ReceiptExecAccountTransfer
+type BalanceChange struct {
+	Hash     []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Height   int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Index    int64  `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	LogIndex int32  `protobuf:"varint,4,opt,name=logIndex,proto3" json:"logIndex,omitempty"`
+	Ty       int32  `protobuf:"varint,5,opt,name=ty,proto3" json:"ty,omitempty"`
+	Execer   string `protobuf:"bytes,6,opt,name=execer,proto3" json:"execer,omitempty"`
+	Symbol   string `protobuf:"bytes,7,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	//合约中的余额变化时, 合约的地址
+	ExecAddr             string   `protobuf:"bytes,8,opt,name=execAddr,proto3" json:"execAddr,omitempty"`
+	Prev                 *Account `protobuf:"bytes,9,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *Account `protobuf:"bytes,10,opt,name=current,proto3" json:"current,omitempty"`
+	Cursor               string   `protobuf:"bytes,11,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BalanceChange) Reset()         { *m = BalanceChange{} }
+func (m *BalanceChange) String() string { return proto.CompactTextString(m) }
+func (*BalanceChange) ProtoMessage()    {}
+func (*BalanceChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e28828dcb8d24f0, []int{8}
+}
+
+func (m *BalanceChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BalanceChange.Unmarshal(m, b)
+}
+func (m *BalanceChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BalanceChange.Marshal(b, m, deterministic)
+}
+func (m *BalanceChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceChange.Merge(m, src)
+}
+func (m *BalanceChange) XXX_Size() int {
+	return xxx_messageInfo_BalanceChange.Size(m)
+}
+func (m *BalanceChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceChange proto.InternalMessageInfo
+
+func (m *BalanceChange) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *BalanceChange) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BalanceChange) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *BalanceChange) GetLogIndex() int32 {
+	if m != nil {
+		return m.LogIndex
+	}
+	return 0
+}
+
+func (m *BalanceChange) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+func (m *BalanceChange) GetExecer() string {
+	if m != nil {
+		return m.Execer
+	}
+	return ""
+}
+
+func (m *BalanceChange) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *BalanceChange) GetExecAddr() string {
+	if m != nil {
+		return m.ExecAddr
+	}
+	return ""
+}
+
+func (m *BalanceChange) GetPrev() *Account {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *BalanceChange) GetCurrent() *Account {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+func (m *BalanceChange) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+//查询地址的余额变化历史, execer 和 symbol 为空时查询所有资产
+// cursor 为上一次查询返回的cursor, 用于翻页
+type ReqBalanceHistory struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Execer               string   `protobuf:"bytes,2,opt,name=execer,proto3" json:"execer,omitempty"`
+	Symbol               string   `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Count                int32    `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Direction            int32    `protobuf:"varint,5,opt,name=direction,proto3" json:"direction,omitempty"`
+	Cursor               string   `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqBalanceHistory) Reset()         { *m = ReqBalanceHistory{} }
+func (m *ReqBalanceHistory) String() string { return proto.CompactTextString(m) }
+func (*ReqBalanceHistory) ProtoMessage()    {}
+func (*ReqBalanceHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e28828dcb8d24f0, []int{9}
+}
+
+func (m *ReqBalanceHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqBalanceHistory.Unmarshal(m, b)
+}
+func (m *ReqBalanceHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqBalanceHistory.Marshal(b, m, deterministic)
+}
+func (m *ReqBalanceHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqBalanceHistory.Merge(m, src)
+}
+func (m *ReqBalanceHistory) XXX_Size() int {
+	return xxx_messageInfo_ReqBalanceHistory.Size(m)
+}
+func (m *ReqBalanceHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqBalanceHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqBalanceHistory proto.InternalMessageInfo
+
+func (m *ReqBalanceHistory) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ReqBalanceHistory) GetExecer() string {
+	if m != nil {
+		return m.Execer
+	}
+	return ""
+}
+
+func (m *ReqBalanceHistory) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *ReqBalanceHistory) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqBalanceHistory) GetDirection() int32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+func (m *ReqBalanceHistory) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type ReplyBalanceHistory struct {
+	Changes              []*BalanceChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	Cursor               string           `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReplyBalanceHistory) Reset()         { *m = ReplyBalanceHistory{} }
+func (m *ReplyBalanceHistory) String() string { return proto.CompactTextString(m) }
+func (*ReplyBalanceHistory) ProtoMessage()    {}
+func (*ReplyBalanceHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e28828dcb8d24f0, []int{10}
+}
+
+func (m *ReplyBalanceHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyBalanceHistory.Unmarshal(m, b)
+}
+func (m *ReplyBalanceHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyBalanceHistory.Marshal(b, m, deterministic)
+}
+func (m *ReplyBalanceHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyBalanceHistory.Merge(m, src)
+}
+func (m *ReplyBalanceHistory) XXX_Size() int {
+	return xxx_messageInfo_ReplyBalanceHistory.Size(m)
+}
+func (m *ReplyBalanceHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyBalanceHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyBalanceHistory proto.InternalMessageInfo
+
+func (m *ReplyBalanceHistory) GetChanges() []*BalanceChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+func (m *ReplyBalanceHistory) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Account)(nil), "types.Account")
 	proto.RegisterType((*ReceiptExecAccountTransfer)(nil), "types.ReceiptExecAccountTransfer")
 	proto.RegisterType((*ReceiptAccountTransfer)(nil), "types.ReceiptAccountTransfer")
 	proto.RegisterType((*ReqBalance)(nil), "types.ReqBalance")
+	proto.RegisterType((*StateAt)(nil), "types.StateAt")
 	proto.RegisterType((*Accounts)(nil), "types.Accounts")
 	proto.RegisterType((*ExecAccount)(nil), "types.ExecAccount")
 	proto.RegisterType((*AllExecBalance)(nil), "types.AllExecBalance")
+	proto.RegisterType((*BalanceChange)(nil), "types.BalanceChange")
+	proto.RegisterType((*ReqBalanceHistory)(nil), "types.ReqBalanceHistory")
+	proto.RegisterType((*ReplyBalanceHistory)(nil), "types.ReplyBalanceHistory")
 }
 
 func init() { proto.RegisterFile("account.proto", fileDescriptor_8e28828dcb8d24f0) }
 
 var fileDescriptor_8e28828dcb8d24f0 = []byte{
-	// 360 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x41, 0x4f, 0x32, 0x31,
-	0x10, 0x4d, 0x59, 0x60, 0xd9, 0x21, 0x1f, 0x87, 0x1e, 0xc8, 0x86, 0x7c, 0xc6, 0xcd, 0x9e, 0xf6,
-	0x60, 0x96, 0xc4, 0xf5, 0x0f, 0x40, 0x62, 0xe2, 0xcd, 0xa4, 0xf1, 0xc4, 0xc9, 0x52, 0x06, 0x21,
-	0x62, 0x77, 0x6d, 0x8b, 0x11, 0x7f, 0x80, 0xbf, 0xdb, 0xb4, 0x74, 0x61, 0x91, 0x68, 0xbc, 0xf5,
-	0xcd, 0xeb, 0xbc, 0xf7, 0x66, 0x5a, 0xf8, 0xc7, 0x85, 0x28, 0xb7, 0xd2, 0xe4, 0x95, 0x2a, 0x4d,
-	0x49, 0x3b, 0x66, 0x57, 0xa1, 0x4e, 0x9f, 0x21, 0x9c, 0xec, 0xeb, 0x74, 0x04, 0x3d, 0xb1, 0x55,
-	0x0a, 0xa5, 0xd8, 0xc5, 0x24, 0x21, 0x59, 0x87, 0x1d, 0x30, 0x8d, 0x21, 0x9c, 0xf3, 0x0d, 0x97,
-	0x02, 0xe3, 0x56, 0x42, 0xb2, 0x80, 0xd5, 0x90, 0x0e, 0xa1, 0xbb, 0x54, 0xe5, 0x07, 0xca, 0x38,
-	0x70, 0x84, 0x47, 0x94, 0x42, 0x9b, 0x2f, 0x16, 0x2a, 0x6e, 0x27, 0x24, 0x8b, 0x98, 0x3b, 0xa7,
-	0x9f, 0x04, 0x46, 0x0c, 0x05, 0xae, 0x2b, 0x73, 0xfb, 0x8e, 0xc2, 0x1b, 0x3f, 0x28, 0x2e, 0xf5,
-	0x12, 0x95, 0x0d, 0x80, 0xb6, 0x6c, 0xdb, 0x88, 0x6b, 0x3b, 0x60, 0x9a, 0x42, 0xbb, 0x52, 0xf8,
-	0xe6, 0xdc, 0xfb, 0xd7, 0x83, 0xdc, 0xa5, 0xcf, 0xbd, 0x02, 0x73, 0x1c, 0xcd, 0x20, 0xdc, 0x07,
-	0x36, 0x2e, 0xcb, 0xf9, 0xb5, 0x9a, 0x4e, 0x97, 0x30, 0xf4, 0x39, 0xbe, 0x67, 0xa8, 0x7d, 0xc8,
-	0xdf, 0x7c, 0x5a, 0xbf, 0xfb, 0x3c, 0x02, 0x30, 0x7c, 0x9d, 0xfa, 0x55, 0xfd, 0x87, 0xc8, 0xae,
-	0x01, 0xb5, 0x46, 0x1d, 0x93, 0x24, 0xc8, 0x22, 0x76, 0x2c, 0xd8, 0x45, 0xda, 0x69, 0x51, 0x39,
-	0xd1, 0x88, 0x79, 0x64, 0xbb, 0xb4, 0xe1, 0x06, 0xef, 0xb8, 0x5e, 0xb9, 0xb9, 0x22, 0x76, 0x2c,
-	0xa4, 0x57, 0xd0, 0xf3, 0xae, 0x9a, 0x26, 0x10, 0x70, 0x21, 0x9c, 0xf2, 0x79, 0x26, 0x4b, 0xa5,
-	0xf7, 0xd0, 0x6f, 0x2c, 0xbe, 0x61, 0x49, 0x4e, 0x2c, 0x33, 0x08, 0xfd, 0x67, 0xf9, 0x69, 0x40,
-	0x4f, 0xa7, 0x33, 0x18, 0x4c, 0x36, 0x1b, 0xab, 0x59, 0x0f, 0x59, 0xbf, 0x3b, 0x39, 0xbe, 0x3b,
-	0xbd, 0x39, 0xb1, 0x8d, 0x5b, 0x2e, 0x20, 0xf5, 0x9a, 0x0d, 0x86, 0x35, 0xaf, 0x4d, 0x2f, 0x67,
-	0x17, 0x4f, 0x6b, 0xb3, 0xda, 0xce, 0x73, 0x51, 0xbe, 0x8c, 0x8b, 0x42, 0xc8, 0xb1, 0x58, 0xf1,
-	0xb5, 0x2c, 0x8a, 0xb1, 0xeb, 0x9c, 0x77, 0xdd, 0x4f, 0x2e, 0xbe, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0x7b, 0xae, 0x1c, 0x1e, 0xda, 0x02, 0x00, 0x00,
+	// 583 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x6e, 0xdb, 0x3c,
+	0x10, 0x04, 0x25, 0xdb, 0x8a, 0x36, 0x5f, 0x0c, 0x7c, 0xac, 0x61, 0x10, 0x41, 0x7f, 0x0c, 0x9d,
+	0x74, 0x28, 0x6c, 0xa0, 0xee, 0xbd, 0x70, 0x8a, 0x02, 0xe9, 0xa9, 0x00, 0xdb, 0x53, 0x80, 0x1e,
+	0x68, 0x9a, 0xb6, 0x84, 0x28, 0x92, 0x4b, 0xd2, 0x45, 0xd4, 0x53, 0x4f, 0x7d, 0x91, 0x3e, 0x40,
+	0x5f, 0xb1, 0xe0, 0x8f, 0x22, 0x29, 0x8d, 0x83, 0xdc, 0x3c, 0xbb, 0xe2, 0xee, 0xcc, 0x70, 0x68,
+	0x38, 0x63, 0x9c, 0x57, 0x87, 0x52, 0xcf, 0xf7, 0xb2, 0xd2, 0x15, 0x1e, 0xea, 0x7a, 0x2f, 0x54,
+	0x72, 0x0d, 0xd1, 0xca, 0xd5, 0xf1, 0x39, 0x9c, 0xf0, 0x83, 0x94, 0xa2, 0xe4, 0x35, 0x41, 0x33,
+	0x94, 0x0e, 0xe9, 0x1d, 0xc6, 0x04, 0xa2, 0x35, 0x2b, 0x58, 0xc9, 0x05, 0x09, 0x66, 0x28, 0x0d,
+	0x69, 0x03, 0xf1, 0x14, 0x46, 0x5b, 0x59, 0xfd, 0x10, 0x25, 0x09, 0x6d, 0xc3, 0x23, 0x8c, 0x61,
+	0xc0, 0x36, 0x1b, 0x49, 0x06, 0x33, 0x94, 0xc6, 0xd4, 0xfe, 0x4e, 0x7e, 0x21, 0x38, 0xa7, 0x82,
+	0x8b, 0x7c, 0xaf, 0x3f, 0xdc, 0x0a, 0xee, 0x17, 0x7f, 0x91, 0xac, 0x54, 0x5b, 0x21, 0x0d, 0x01,
+	0x61, 0xca, 0xe6, 0x18, 0xb2, 0xc7, 0xee, 0x30, 0x4e, 0x60, 0xb0, 0x97, 0xe2, 0xbb, 0xdd, 0x7e,
+	0xfa, 0x66, 0x3c, 0xb7, 0xec, 0xe7, 0x7e, 0x02, 0xb5, 0x3d, 0x9c, 0x42, 0xe4, 0x08, 0x6b, 0x12,
+	0x3e, 0xf8, 0x59, 0xd3, 0x4e, 0xb6, 0x30, 0xf5, 0x3c, 0xee, 0x73, 0x68, 0xf6, 0xa0, 0xa7, 0xed,
+	0x09, 0x1e, 0xdf, 0xf3, 0x13, 0x01, 0x50, 0xf1, 0xed, 0xc2, 0x7b, 0xf5, 0x1c, 0x62, 0xe3, 0x83,
+	0x50, 0x4a, 0x28, 0x82, 0x66, 0x61, 0x1a, 0xd3, 0xb6, 0x60, 0x9c, 0x34, 0x72, 0x85, 0xb4, 0x53,
+	0x63, 0xea, 0x91, 0x39, 0xa5, 0x34, 0xd3, 0xe2, 0x92, 0xa9, 0xcc, 0x0a, 0x8b, 0x69, 0x5b, 0xc0,
+	0x2f, 0x21, 0x60, 0x9a, 0x0c, 0x7a, 0x3c, 0x3e, 0x9b, 0xee, 0x4a, 0xd3, 0x80, 0xe9, 0xe4, 0x1d,
+	0x44, 0x1e, 0x9a, 0x05, 0x99, 0xc8, 0x77, 0x99, 0xb6, 0xea, 0x42, 0xea, 0x91, 0x59, 0xb0, 0x2e,
+	0x2a, 0x7e, 0x6d, 0x17, 0xb8, 0xdd, 0x6d, 0x21, 0x79, 0x0d, 0x27, 0x5e, 0x97, 0xc2, 0x33, 0x08,
+	0x19, 0xe7, 0x96, 0xfa, 0xbf, 0xaa, 0x4d, 0x2b, 0xf9, 0x04, 0xa7, 0x9d, 0xab, 0xed, 0x68, 0x42,
+	0x3d, 0x4d, 0x29, 0x44, 0x3e, 0x8e, 0xc7, 0x2c, 0xf4, 0xed, 0xe4, 0x0a, 0xc6, 0xab, 0xa2, 0x30,
+	0x33, 0x1b, 0x17, 0x9b, 0x64, 0xa1, 0x36, 0x59, 0xf8, 0x6d, 0x6f, 0x2d, 0x09, 0x2c, 0x41, 0xec,
+	0x67, 0x76, 0x3a, 0xb4, 0xfb, 0x59, 0xf2, 0x27, 0x80, 0x33, 0x3f, 0xf5, 0x7d, 0xc6, 0xca, 0x9d,
+	0x9d, 0x9d, 0x19, 0x17, 0xcc, 0xec, 0xff, 0xa8, 0xfd, 0xdd, 0xb1, 0x2d, 0xe8, 0xd9, 0x36, 0x81,
+	0x61, 0x5e, 0x6e, 0xc4, 0xad, 0x0f, 0xbe, 0x03, 0x26, 0xc4, 0x45, 0xb5, 0xfb, 0x68, 0x1b, 0x03,
+	0xf7, 0x8a, 0x1a, 0x8c, 0xc7, 0x10, 0xe8, 0x9a, 0x0c, 0x6d, 0x35, 0xd0, 0x75, 0xc7, 0x9d, 0x51,
+	0xcf, 0x9d, 0x29, 0x8c, 0x54, 0x7d, 0xb3, 0xae, 0x0a, 0x12, 0xb9, 0xba, 0x43, 0xbd, 0x07, 0x72,
+	0x72, 0xe4, 0x81, 0xc4, 0x4f, 0x0b, 0x2e, 0x3c, 0x1a, 0x5c, 0xc3, 0x80, 0x1f, 0xa4, 0xaa, 0x24,
+	0x39, 0x75, 0x0c, 0x1c, 0x4a, 0x7e, 0x23, 0xf8, 0xbf, 0x0d, 0xf4, 0x65, 0xae, 0x74, 0x25, 0xeb,
+	0x07, 0x6f, 0xe4, 0x58, 0x9a, 0x5b, 0x6d, 0x61, 0x4f, 0xdb, 0x04, 0x86, 0xee, 0xee, 0x9c, 0x69,
+	0x0e, 0x98, 0x68, 0x6e, 0x72, 0x29, 0xb8, 0xce, 0xab, 0xd2, 0x1b, 0xd7, 0x16, 0x3a, 0x2c, 0x47,
+	0x3d, 0x96, 0x5f, 0xe1, 0x19, 0x15, 0xfb, 0xa2, 0xbe, 0x47, 0x73, 0x0e, 0x11, 0xb7, 0xd7, 0xac,
+	0x7c, 0x82, 0x27, 0x5e, 0x7e, 0x2f, 0x03, 0xb4, 0xf9, 0xa8, 0x33, 0x3e, 0xe8, 0x8e, 0xbf, 0x78,
+	0x75, 0xf5, 0x62, 0x97, 0xeb, 0xec, 0xb0, 0x9e, 0xf3, 0xea, 0x66, 0xb1, 0x5c, 0xf2, 0x72, 0xc1,
+	0x33, 0x96, 0x97, 0xcb, 0xe5, 0xc2, 0xce, 0x5b, 0x8f, 0xec, 0x5f, 0xec, 0xf2, 0xef, 0x00, 0x14,
+	0xb8, 0xa9, 0x21, 0x73, 0x05, 0x00, 0x00,
 }
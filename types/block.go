@@ -43,6 +43,26 @@ func (block *Block) GetHeader() *Header {
 	return head
 }
 
+// CalcHash 根据区块头的内容计算区块hash, 和 Block.Hash 的结果一致
+func (header *Header) CalcHash() []byte {
+	head := &Header{}
+	head.Version = header.Version
+	head.ParentHash = header.ParentHash
+	head.TxHash = header.TxHash
+	head.BlockTime = header.BlockTime
+	head.Height = header.Height
+	if IsFork(head.Height, "ForkBlockHash") {
+		head.Difficulty = header.Difficulty
+		head.StateHash = header.StateHash
+		head.TxCount = header.TxCount
+	}
+	data, err := proto.Marshal(head)
+	if err != nil {
+		panic(err)
+	}
+	return common.Sha256(data)
+}
+
 // CheckSign 检测block的签名
 func (block *Block) CheckSign() bool {
 	//检查区块的签名
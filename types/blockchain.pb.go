@@ -734,6 +734,54 @@ func (m *BlockDetail) GetPrevStatusHash() []byte {
 	return nil
 }
 
+// 只执行 execs 中执行器的 ExecLocal
+type ReqReIndexExecLocal struct {
+	Detail               *BlockDetail `protobuf:"bytes,1,opt,name=detail,proto3" json:"detail,omitempty"`
+	Execs                []string     `protobuf:"bytes,2,rep,name=execs,proto3" json:"execs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ReqReIndexExecLocal) Reset()         { *m = ReqReIndexExecLocal{} }
+func (m *ReqReIndexExecLocal) String() string { return proto.CompactTextString(m) }
+func (*ReqReIndexExecLocal) ProtoMessage()    {}
+func (*ReqReIndexExecLocal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9ac6287ce250c9a, []int{12}
+}
+
+func (m *ReqReIndexExecLocal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqReIndexExecLocal.Unmarshal(m, b)
+}
+func (m *ReqReIndexExecLocal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqReIndexExecLocal.Marshal(b, m, deterministic)
+}
+func (m *ReqReIndexExecLocal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqReIndexExecLocal.Merge(m, src)
+}
+func (m *ReqReIndexExecLocal) XXX_Size() int {
+	return xxx_messageInfo_ReqReIndexExecLocal.Size(m)
+}
+func (m *ReqReIndexExecLocal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqReIndexExecLocal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqReIndexExecLocal proto.InternalMessageInfo
+
+func (m *ReqReIndexExecLocal) GetDetail() *BlockDetail {
+	if m != nil {
+		return m.Detail
+	}
+	return nil
+}
+
+func (m *ReqReIndexExecLocal) GetExecs() []string {
+	if m != nil {
+		return m.Execs
+	}
+	return nil
+}
+
 type Receipts struct {
 	Receipts             []*Receipt `protobuf:"bytes,1,rep,name=receipts,proto3" json:"receipts,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
@@ -745,7 +793,7 @@ func (m *Receipts) Reset()         { *m = Receipts{} }
 func (m *Receipts) String() string { return proto.CompactTextString(m) }
 func (*Receipts) ProtoMessage()    {}
 func (*Receipts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e9ac6287ce250c9a, []int{12}
+	return fileDescriptor_e9ac6287ce250c9a, []int{13}
 }
 
 func (m *Receipts) XXX_Unmarshal(b []byte) error {
@@ -784,7 +832,7 @@ func (m *PrivacyKV) Reset()         { *m = PrivacyKV{} }
 func (m *PrivacyKV) String() string { return proto.CompactTextString(m) }
 func (*PrivacyKV) ProtoMessage()    {}
 func (*PrivacyKV) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e9ac6287ce250c9a, []int{13}
+	return fileDescriptor_e9ac6287ce250c9a, []int{14}
 }
 
 func (m *PrivacyKV) XXX_Unmarshal(b []byte) error {
@@ -826,7 +874,7 @@ func (m *PrivacyKVToken) Reset()         { *m = PrivacyKVToken{} }
 func (m *PrivacyKVToken) String() string { return proto.CompactTextString(m) }
 func (*PrivacyKVToken) ProtoMessage()    {}
 func (*PrivacyKVToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e9ac6287ce250c9a, []int{14}
+	return fileDescriptor_e9ac6287ce250c9a, []int{15}
 }
 
 func (m *PrivacyKVToken) XXX_Unmarshal(b []byte) error {
@@ -887,7 +935,7 @@ func (m *ReceiptsAndPrivacyKV) Reset()         { *m = ReceiptsAndPrivacyKV{} }
 func (m *ReceiptsAndPrivacyKV) String() string { return proto.CompactTextString(m) }
 func (*ReceiptsAndPrivacyKV) ProtoMessage()    {}
 func (*ReceiptsAndPrivacyKV) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e9ac6287ce250c9a, []int{15}
+	return fileDescriptor_e9ac6287ce250c9a, []int{16}
 }
 
 func (m *ReceiptsAndPrivacyKV) XXX_Unmarshal(b []byte) error {
@@ -933,7 +981,7 @@ func (m *ReceiptCheckTxList) Reset()         { *m = ReceiptCheckTxList{} }
 func (m *ReceiptCheckTxList) String() string { return proto.CompactTextString(m) }
 func (*ReceiptCheckTxList) ProtoMessage()    {}
 func (*ReceiptCheckTxList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e9ac6287ce250c9a, []int{16}
+	return fileDescriptor_e9ac6287ce250c9a, []int{17}
 }
 
 func (m *ReceiptCheckTxList) XXX_Unmarshal(b []byte) error {
@@ -978,7 +1026,7 @@ func (m *ChainStatus) Reset()         { *m = ChainStatus{} }
 func (m *ChainStatus) String() string { return proto.CompactTextString(m) }
 func (*ChainStatus) ProtoMessage()    {}
 func (*ChainStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e9ac6287ce250c9a, []int{17}
+	return fileDescriptor_e9ac6287ce250c9a, []int{18}
 }
 
 func (m *ChainStatus) XXX_Unmarshal(b []byte) error {
@@ -1039,7 +1087,7 @@ func (m *ReqBlocks) Reset()         { *m = ReqBlocks{} }
 func (m *ReqBlocks) String() string { return proto.CompactTextString(m) }
 func (*ReqBlocks) ProtoMessage()    {}
 func (*ReqBlocks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e9ac6287ce250c9a, []int{18}
+	return fileDescriptor_e9ac6287ce250c9a, []int{19}
 }
 
 func (m *ReqBlocks) XXX_Unmarshal(b []byte) error {
@@ -1099,7 +1147,7 @@ func (m *MempoolSize) Reset()         { *m = MempoolSize{} }
 func (m *MempoolSize) String() string { return proto.CompactTextString(m) }
 func (*MempoolSize) ProtoMessage()    {}
 func (*MempoolSize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e9ac6287ce250c9a, []int{19}
+	return fileDescriptor_e9ac6287ce250c9a, []int{20}
 }
 
 func (m *MempoolSize) XXX_Unmarshal(b []byte) error {
@@ -1138,7 +1186,7 @@ func (m *ReplyBlockHeight) Reset()         { *m = ReplyBlockHeight{} }
 func (m *ReplyBlockHeight) String() string { return proto.CompactTextString(m) }
 func (*ReplyBlockHeight) ProtoMessage()    {}
 func (*ReplyBlockHeight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e9ac6287ce250c9a, []int{20}
+	return fileDescriptor_e9ac6287ce250c9a, []int{21}
 }
 
 func (m *ReplyBlockHeight) XXX_Unmarshal(b []byte) error {
@@ -1181,7 +1229,7 @@ func (m *BlockBody) Reset()         { *m = BlockBody{} }
 func (m *BlockBody) String() string { return proto.CompactTextString(m) }
 func (*BlockBody) ProtoMessage()    {}
 func (*BlockBody) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e9ac6287ce250c9a, []int{21}
+	return fileDescriptor_e9ac6287ce250c9a, []int{22}
 }
 
 func (m *BlockBody) XXX_Unmarshal(b []byte) error {
@@ -1228,7 +1276,7 @@ func (m *IsCaughtUp) Reset()         { *m = IsCaughtUp{} }
 func (m *IsCaughtUp) String() string { return proto.CompactTextString(m) }
 func (*IsCaughtUp) ProtoMessage()    {}
 func (*IsCaughtUp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e9ac6287ce250c9a, []int{22}
+	return fileDescriptor_e9ac6287ce250c9a, []int{23}
 }
 
 func (m *IsCaughtUp) XXX_Unmarshal(b []byte) error {
@@ -1268,7 +1316,7 @@ func (m *IsNtpClockSync) Reset()         { *m = IsNtpClockSync{} }
 func (m *IsNtpClockSync) String() string { return proto.CompactTextString(m) }
 func (*IsNtpClockSync) ProtoMessage()    {}
 func (*IsNtpClockSync) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e9ac6287ce250c9a, []int{23}
+	return fileDescriptor_e9ac6287ce250c9a, []int{24}
 }
 
 func (m *IsNtpClockSync) XXX_Unmarshal(b []byte) error {
@@ -1312,7 +1360,7 @@ func (m *ChainExecutor) Reset()         { *m = ChainExecutor{} }
 func (m *ChainExecutor) String() string { return proto.CompactTextString(m) }
 func (*ChainExecutor) ProtoMessage()    {}
 func (*ChainExecutor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e9ac6287ce250c9a, []int{24}
+	return fileDescriptor_e9ac6287ce250c9a, []int{25}
 }
 
 func (m *ChainExecutor) XXX_Unmarshal(b []byte) error {
@@ -1381,7 +1429,7 @@ func (m *BlockSequence) Reset()         { *m = BlockSequence{} }
 func (m *BlockSequence) String() string { return proto.CompactTextString(m) }
 func (*BlockSequence) ProtoMessage()    {}
 func (*BlockSequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e9ac6287ce250c9a, []int{25}
+	return fileDescriptor_e9ac6287ce250c9a, []int{26}
 }
 
 func (m *BlockSequence) XXX_Unmarshal(b []byte) error {
@@ -1428,7 +1476,7 @@ func (m *BlockSequences) Reset()         { *m = BlockSequences{} }
 func (m *BlockSequences) String() string { return proto.CompactTextString(m) }
 func (*BlockSequences) ProtoMessage()    {}
 func (*BlockSequences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e9ac6287ce250c9a, []int{26}
+	return fileDescriptor_e9ac6287ce250c9a, []int{27}
 }
 
 func (m *BlockSequences) XXX_Unmarshal(b []byte) error {
@@ -1471,7 +1519,7 @@ func (m *ParaChainBlockDetail) Reset()         { *m = ParaChainBlockDetail{} }
 func (m *ParaChainBlockDetail) String() string { return proto.CompactTextString(m) }
 func (*ParaChainBlockDetail) ProtoMessage()    {}
 func (*ParaChainBlockDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e9ac6287ce250c9a, []int{27}
+	return fileDescriptor_e9ac6287ce250c9a, []int{28}
 }
 
 func (m *ParaChainBlockDetail) XXX_Unmarshal(b []byte) error {
@@ -1519,6 +1567,7 @@ func init() {
 	proto.RegisterType((*HeadersPid)(nil), "types.HeadersPid")
 	proto.RegisterType((*BlockOverview)(nil), "types.BlockOverview")
 	proto.RegisterType((*BlockDetail)(nil), "types.BlockDetail")
+	proto.RegisterType((*ReqReIndexExecLocal)(nil), "types.ReqReIndexExecLocal")
 	proto.RegisterType((*Receipts)(nil), "types.Receipts")
 	proto.RegisterType((*PrivacyKV)(nil), "types.PrivacyKV")
 	proto.RegisterType((*PrivacyKVToken)(nil), "types.PrivacyKVToken")
@@ -1540,74 +1589,76 @@ func init() {
 func init() { proto.RegisterFile("blockchain.proto", fileDescriptor_e9ac6287ce250c9a) }
 
 var fileDescriptor_e9ac6287ce250c9a = []byte{
-	// 1097 bytes of a gzipped FileDescriptorProto
+	// 1123 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x5f, 0x6f, 0xe3, 0x44,
-	0x10, 0x97, 0xf3, 0xaf, 0xc9, 0x24, 0x0d, 0xbd, 0x55, 0x40, 0x51, 0x05, 0x5c, 0x6e, 0x39, 0x1d,
-	0x51, 0x39, 0xa5, 0x52, 0x8b, 0x8e, 0x7b, 0x00, 0x09, 0x9a, 0x22, 0xb5, 0xd7, 0xe3, 0x28, 0xdb,
-	0x5e, 0x1f, 0x78, 0xdb, 0xda, 0xdb, 0xda, 0x6a, 0xfc, 0xa7, 0xde, 0x75, 0x88, 0xf9, 0x0e, 0x7c,
-	0x0a, 0xde, 0x10, 0x1f, 0x12, 0xed, 0xec, 0x3a, 0xb6, 0x43, 0x8b, 0x84, 0xc4, 0x0b, 0x6f, 0x3b,
-	0xff, 0x67, 0x7e, 0xe3, 0x99, 0x31, 0xec, 0x5c, 0x2f, 0x62, 0xf7, 0xce, 0xf5, 0x79, 0x10, 0xcd,
-	0x92, 0x34, 0x56, 0x31, 0x69, 0xab, 0x3c, 0x11, 0x72, 0xf7, 0x89, 0x4a, 0x79, 0x24, 0xb9, 0xab,
-	0x82, 0xd8, 0x4a, 0x76, 0x07, 0x6e, 0x1c, 0x86, 0x05, 0x45, 0xff, 0x6c, 0x40, 0xe7, 0x44, 0x70,
-	0x4f, 0xa4, 0x64, 0x0c, 0x5b, 0x4b, 0x91, 0xca, 0x20, 0x8e, 0xc6, 0xce, 0xc4, 0x99, 0x36, 0x59,
-	0x41, 0x92, 0x4f, 0x01, 0x12, 0x9e, 0x8a, 0x48, 0x9d, 0x70, 0xe9, 0x8f, 0x1b, 0x13, 0x67, 0x3a,
-	0x60, 0x15, 0x0e, 0xf9, 0x08, 0x3a, 0x6a, 0x85, 0xb2, 0x26, 0xca, 0x2c, 0x45, 0x3e, 0x86, 0x9e,
-	0x54, 0x5c, 0x09, 0x14, 0xb5, 0x50, 0x54, 0x32, 0xb4, 0x95, 0x2f, 0x82, 0x5b, 0x5f, 0x8d, 0xdb,
-	0x18, 0xce, 0x52, 0xda, 0x0a, 0xcb, 0xb9, 0x0c, 0x42, 0x31, 0xee, 0xa0, 0xa8, 0x64, 0xe8, 0x2c,
-	0xd5, 0x6a, 0x1e, 0x67, 0x91, 0x1a, 0xf7, 0x4c, 0x96, 0x96, 0x24, 0x04, 0x5a, 0xbe, 0x0e, 0x04,
-	0x18, 0x08, 0xdf, 0x3a, 0x73, 0x2f, 0xb8, 0xb9, 0x09, 0xdc, 0x6c, 0xa1, 0xf2, 0x71, 0x7f, 0xe2,
-	0x4c, 0xb7, 0x59, 0x85, 0x43, 0x66, 0xd0, 0x93, 0xc1, 0x6d, 0xc4, 0x55, 0x96, 0x8a, 0x71, 0x77,
-	0xe2, 0x4c, 0xfb, 0x07, 0x3b, 0x33, 0x84, 0x6e, 0x76, 0x51, 0xf0, 0x59, 0xa9, 0x42, 0x7f, 0x6f,
-	0x40, 0xfb, 0x48, 0xe7, 0xf2, 0x3f, 0x41, 0xeb, 0x3f, 0xae, 0x9f, 0x3c, 0x87, 0xa6, 0x5a, 0xc9,
-	0xf1, 0xd6, 0xa4, 0x39, 0xed, 0x1f, 0x10, 0xab, 0x79, 0x59, 0x7e, 0x63, 0x4c, 0x8b, 0xe9, 0x4b,
-	0xe8, 0x20, 0x48, 0x92, 0x50, 0x68, 0x07, 0x4a, 0x84, 0x72, 0xec, 0xa0, 0xc5, 0xc0, 0x5a, 0xa0,
-	0x94, 0x19, 0x11, 0x7d, 0x03, 0x80, 0xf4, 0x85, 0xb8, 0x9f, 0x1f, 0xe9, 0x2e, 0x46, 0x3c, 0x14,
-	0x08, 0x6a, 0x8f, 0xe1, 0x9b, 0xec, 0x40, 0xf3, 0x3d, 0x7b, 0x8b, 0x50, 0xf6, 0x98, 0x7e, 0x6a,
-	0x34, 0x44, 0xe4, 0xc6, 0x9e, 0x40, 0x0c, 0x7b, 0xcc, 0x52, 0xf4, 0x15, 0xf4, 0x4b, 0x5f, 0x92,
-	0x7c, 0x5e, 0x0f, 0xff, 0xa4, 0x1a, 0x1e, 0x55, 0x8a, 0x1c, 0x12, 0xe8, 0x16, 0x4c, 0x1d, 0x2d,
-	0xca, 0x42, 0xdb, 0x55, 0xfd, 0x24, 0x2f, 0xa0, 0x29, 0xc5, 0x3d, 0xc6, 0xef, 0x1f, 0x8c, 0x36,
-	0x9c, 0x64, 0x22, 0x72, 0x05, 0xd3, 0x0a, 0x64, 0x0f, 0x3a, 0x9e, 0x50, 0x3c, 0x58, 0x60, 0x56,
-	0x25, 0x40, 0xa8, 0x7a, 0x8c, 0x12, 0x66, 0x35, 0xe8, 0xb7, 0x36, 0xe2, 0x79, 0xe0, 0xe9, 0x88,
-	0x49, 0xe0, 0xd9, 0x92, 0xf5, 0x53, 0xe3, 0x86, 0x4d, 0xb4, 0x31, 0x37, 0x70, 0x43, 0x11, 0x7d,
-	0x0d, 0x83, 0x8a, 0x63, 0x49, 0xa6, 0xf5, 0x62, 0x1f, 0x0a, 0x6e, 0xab, 0x9d, 0xc1, 0x96, 0x99,
-	0x79, 0x49, 0x3e, 0xab, 0x1b, 0x6d, 0x5b, 0x23, 0x23, 0x2e, 0xf4, 0x4f, 0x00, 0xac, 0xfe, 0xc3,
-	0xd9, 0x4e, 0x61, 0xcb, 0x37, 0x72, 0x9b, 0xef, 0xb0, 0xe6, 0x46, 0xb2, 0x42, 0x4c, 0x7d, 0xd8,
-	0xc6, 0x7c, 0x7e, 0x5c, 0x8a, 0x74, 0x19, 0x88, 0x5f, 0xc8, 0x33, 0x68, 0x69, 0x19, 0x7a, 0xfb,
-	0x5b, 0x78, 0x14, 0x55, 0x27, 0xbe, 0x51, 0x9f, 0xf8, 0x5d, 0xe8, 0x9a, 0xd9, 0x11, 0x72, 0xdc,
-	0x9c, 0x34, 0xa7, 0x03, 0xb6, 0xa6, 0xe9, 0x1f, 0x8e, 0xfd, 0x14, 0x4c, 0xe9, 0x25, 0xa2, 0xce,
-	0xa3, 0x88, 0x92, 0x19, 0x74, 0x53, 0xe1, 0x8a, 0x20, 0x51, 0xba, 0x90, 0x2a, 0x88, 0xcc, 0xb0,
-	0x8f, 0xb9, 0xe2, 0x6c, 0xad, 0x43, 0x9e, 0x42, 0xe3, 0xec, 0x0a, 0x23, 0xf7, 0x0f, 0x3e, 0xb0,
-	0x9a, 0x67, 0x22, 0xbf, 0xe2, 0x8b, 0x4c, 0xb0, 0xc6, 0xd9, 0x15, 0x79, 0x01, 0xc3, 0x24, 0x15,
-	0xcb, 0x0b, 0xc5, 0x55, 0x26, 0x2b, 0x73, 0xbd, 0xc1, 0xa5, 0xaf, 0xa0, 0xcb, 0x0a, 0xa7, 0x7b,
-	0x95, 0x24, 0x4c, 0x53, 0x86, 0xf5, 0x24, 0xca, 0x04, 0xe8, 0x1b, 0xe8, 0x9d, 0xa7, 0xc1, 0x92,
-	0xbb, 0xf9, 0xd9, 0x15, 0xf9, 0x46, 0x07, 0xb3, 0xc4, 0x65, 0x7c, 0x27, 0x22, 0x6b, 0xfe, 0xa1,
-	0x35, 0x3f, 0xaf, 0x09, 0xd9, 0x86, 0x32, 0xcd, 0x61, 0x58, 0xd7, 0x20, 0x23, 0x68, 0x2b, 0xeb,
-	0x47, 0xb7, 0xda, 0x10, 0xa6, 0x1d, 0xa7, 0x91, 0x27, 0x56, 0xd8, 0x8e, 0x36, 0x2b, 0x48, 0xb3,
-	0xd8, 0xfc, 0xda, 0x62, 0xc3, 0x25, 0x6c, 0x60, 0x6a, 0x3d, 0x0a, 0x13, 0x95, 0x30, 0x2a, 0xca,
-	0xff, 0x2e, 0xf2, 0xca, 0x8a, 0xbe, 0xa8, 0x41, 0xe1, 0x54, 0xcc, 0x0b, 0xf5, 0x4a, 0x33, 0x66,
-	0xd0, 0x5b, 0x57, 0x64, 0x3f, 0xc3, 0x9d, 0xcd, 0xca, 0x59, 0xa9, 0x42, 0xa7, 0x40, 0xac, 0x97,
-	0xb9, 0x2f, 0xdc, 0xbb, 0xcb, 0xd5, 0xdb, 0x40, 0xe2, 0x11, 0x11, 0x69, 0x6a, 0x90, 0xef, 0x31,
-	0x7c, 0xd3, 0x1c, 0xfa, 0x73, 0x7d, 0x5a, 0x4d, 0xc3, 0xc8, 0x73, 0xd8, 0x76, 0xb3, 0x14, 0xd7,
-	0xb9, 0x59, 0xc8, 0x66, 0x53, 0xd4, 0x99, 0x64, 0x02, 0xfd, 0x50, 0x84, 0x49, 0x1c, 0x2f, 0x2e,
-	0x82, 0x5f, 0x85, 0xfd, 0x72, 0xab, 0x2c, 0x42, 0x61, 0x10, 0xca, 0xdb, 0x9f, 0x32, 0x91, 0x09,
-	0x54, 0x69, 0xa2, 0x4a, 0x8d, 0x47, 0x39, 0xf4, 0x98, 0xb8, 0xb7, 0xcb, 0x74, 0x04, 0x6d, 0xa9,
-	0x78, 0x5a, 0x04, 0x34, 0x84, 0x1e, 0x47, 0x11, 0x79, 0x36, 0x80, 0x7e, 0xea, 0xb1, 0x08, 0xe4,
-	0x71, 0xb9, 0x88, 0xba, 0x6c, 0x4d, 0x17, 0xc3, 0xdb, 0xc2, 0xf2, 0xf4, 0x93, 0x3e, 0x83, 0xfe,
-	0x0f, 0x95, 0xac, 0x08, 0xb4, 0xa4, 0xce, 0xc6, 0xc4, 0xc0, 0x37, 0xdd, 0x83, 0x1d, 0x26, 0x92,
-	0x45, 0x8e, 0x79, 0xd8, 0xfa, 0xca, 0x7b, 0xe4, 0x54, 0xef, 0x91, 0xce, 0x18, 0xd5, 0x8e, 0x62,
-	0x2f, 0x2f, 0xce, 0x85, 0xf3, 0x8f, 0xe7, 0xe2, 0xdf, 0x8e, 0x1d, 0x7d, 0x09, 0x70, 0x2a, 0xe7,
-	0x3c, 0xbb, 0xf5, 0xd5, 0xfb, 0x44, 0x9f, 0xb8, 0x53, 0xe9, 0x22, 0x95, 0x25, 0x98, 0x4c, 0x97,
-	0x55, 0x38, 0xf4, 0x35, 0x0c, 0x4f, 0xe5, 0x3b, 0x95, 0xcc, 0x71, 0x5f, 0xe7, 0x91, 0xab, 0xa7,
-	0x32, 0x90, 0x91, 0x4a, 0x5c, 0x84, 0x35, 0x8f, 0x5c, 0x6b, 0xb5, 0xc1, 0xa5, 0xbf, 0x39, 0xb0,
-	0x8d, 0x8d, 0xff, 0x7e, 0x25, 0xdc, 0x4c, 0xc5, 0xa9, 0x2e, 0xda, 0x4b, 0x83, 0xa5, 0x48, 0xed,
-	0x48, 0x58, 0x4a, 0x23, 0x7e, 0x93, 0x45, 0xee, 0x3b, 0x7d, 0xb8, 0xcc, 0x95, 0x5a, 0xd3, 0xf5,
-	0xb3, 0xde, 0xdc, 0x3c, 0xeb, 0x23, 0x68, 0x27, 0x3c, 0xe5, 0xa1, 0x5d, 0x0c, 0x86, 0xd0, 0x5c,
-	0xb1, 0x52, 0x29, 0xc7, 0x5b, 0x3f, 0x60, 0x86, 0xa0, 0x5f, 0xd9, 0xe5, 0x59, 0x1c, 0x1d, 0xdd,
-	0x2b, 0xf4, 0xea, 0x98, 0x3f, 0x1e, 0x74, 0x48, 0xa0, 0x75, 0x99, 0x27, 0xc5, 0x07, 0x87, 0x6f,
-	0xfa, 0x35, 0x0c, 0x6b, 0x86, 0x7a, 0xc9, 0xd4, 0xd6, 0xfe, 0xc3, 0x37, 0xcd, 0x6e, 0x7f, 0x1f,
-	0x46, 0xe7, 0x3c, 0xe5, 0x88, 0x44, 0x75, 0xa3, 0x7e, 0x09, 0x7d, 0x5c, 0x9b, 0xf6, 0xe4, 0x39,
-	0x8f, 0x9e, 0xbc, 0xaa, 0x9a, 0x86, 0x4a, 0xda, 0x00, 0x36, 0xc7, 0x35, 0x7d, 0xf4, 0xf4, 0xe7,
-	0x4f, 0x6e, 0x03, 0xe5, 0x67, 0xd7, 0x33, 0x37, 0x0e, 0xf7, 0x0f, 0x0f, 0xdd, 0x68, 0x1f, 0xff,
-	0x69, 0x0f, 0x0f, 0xf7, 0xd1, 0xeb, 0x75, 0x07, 0x7f, 0x5a, 0x0f, 0xff, 0x0a, 0x00, 0x00, 0xff,
-	0xff, 0x23, 0x1c, 0x7b, 0x8c, 0xf0, 0x0a, 0x00, 0x00,
+	0x10, 0x97, 0xf3, 0xaf, 0xc9, 0xe4, 0x0f, 0xbd, 0x25, 0x20, 0xab, 0x02, 0x2e, 0xb7, 0x9c, 0x8e,
+	0xa8, 0x9c, 0x52, 0xa9, 0x45, 0xc7, 0x3d, 0x80, 0x04, 0x4d, 0x91, 0xda, 0x6b, 0x39, 0xca, 0xb6,
+	0x57, 0x24, 0xde, 0xb6, 0xce, 0xb6, 0xb6, 0x9a, 0xd8, 0xae, 0x77, 0x1d, 0x62, 0xbe, 0x03, 0x9f,
+	0x82, 0x37, 0xc4, 0x87, 0x44, 0x3b, 0xbb, 0x8e, 0xed, 0xd0, 0xe3, 0x84, 0xc4, 0x0b, 0x6f, 0x3b,
+	0xff, 0x67, 0x7e, 0xbb, 0x33, 0xb3, 0xb0, 0x7d, 0x3d, 0x8f, 0xbc, 0x3b, 0xcf, 0xe7, 0x41, 0x38,
+	0x89, 0x93, 0x48, 0x45, 0xa4, 0xa9, 0xb2, 0x58, 0xc8, 0x9d, 0x47, 0x2a, 0xe1, 0xa1, 0xe4, 0x9e,
+	0x0a, 0x22, 0x2b, 0xd9, 0xe9, 0x79, 0xd1, 0x62, 0x91, 0x53, 0xf4, 0xcf, 0x1a, 0xb4, 0x8e, 0x05,
+	0x9f, 0x89, 0x84, 0xb8, 0xb0, 0xb5, 0x14, 0x89, 0x0c, 0xa2, 0xd0, 0x75, 0x46, 0xce, 0xb8, 0xce,
+	0x72, 0x92, 0x7c, 0x02, 0x10, 0xf3, 0x44, 0x84, 0xea, 0x98, 0x4b, 0xdf, 0xad, 0x8d, 0x9c, 0x71,
+	0x8f, 0x95, 0x38, 0xe4, 0x43, 0x68, 0xa9, 0x15, 0xca, 0xea, 0x28, 0xb3, 0x14, 0xf9, 0x08, 0x3a,
+	0x52, 0x71, 0x25, 0x50, 0xd4, 0x40, 0x51, 0xc1, 0xd0, 0x56, 0xbe, 0x08, 0x6e, 0x7d, 0xe5, 0x36,
+	0x31, 0x9c, 0xa5, 0xb4, 0x15, 0x96, 0x73, 0x19, 0x2c, 0x84, 0xdb, 0x42, 0x51, 0xc1, 0xd0, 0x59,
+	0xaa, 0xd5, 0x34, 0x4a, 0x43, 0xe5, 0x76, 0x4c, 0x96, 0x96, 0x24, 0x04, 0x1a, 0xbe, 0x0e, 0x04,
+	0x18, 0x08, 0xcf, 0x3a, 0xf3, 0x59, 0x70, 0x73, 0x13, 0x78, 0xe9, 0x5c, 0x65, 0x6e, 0x77, 0xe4,
+	0x8c, 0xfb, 0xac, 0xc4, 0x21, 0x13, 0xe8, 0xc8, 0xe0, 0x36, 0xe4, 0x2a, 0x4d, 0x84, 0xdb, 0x1e,
+	0x39, 0xe3, 0xee, 0xfe, 0xf6, 0x04, 0xa1, 0x9b, 0x5c, 0xe4, 0x7c, 0x56, 0xa8, 0xd0, 0xdf, 0x6b,
+	0xd0, 0x3c, 0xd4, 0xb9, 0xfc, 0x4f, 0xd0, 0xfa, 0x8f, 0xeb, 0x27, 0x4f, 0xa1, 0xae, 0x56, 0xd2,
+	0xdd, 0x1a, 0xd5, 0xc7, 0xdd, 0x7d, 0x62, 0x35, 0x2f, 0x8b, 0x37, 0xc6, 0xb4, 0x98, 0x3e, 0x87,
+	0x16, 0x82, 0x24, 0x09, 0x85, 0x66, 0xa0, 0xc4, 0x42, 0xba, 0x0e, 0x5a, 0xf4, 0xac, 0x05, 0x4a,
+	0x99, 0x11, 0xd1, 0x57, 0x00, 0x48, 0x5f, 0x88, 0xfb, 0xe9, 0xa1, 0xbe, 0xc5, 0x90, 0x2f, 0x04,
+	0x82, 0xda, 0x61, 0x78, 0x26, 0xdb, 0x50, 0x7f, 0xc3, 0xce, 0x10, 0xca, 0x0e, 0xd3, 0x47, 0x8d,
+	0x86, 0x08, 0xbd, 0x68, 0x26, 0x10, 0xc3, 0x0e, 0xb3, 0x14, 0x7d, 0x01, 0xdd, 0xc2, 0x97, 0x24,
+	0x9f, 0x55, 0xc3, 0x3f, 0x2a, 0x87, 0x47, 0x95, 0x3c, 0x87, 0x18, 0xda, 0x39, 0x53, 0x47, 0x0b,
+	0xd3, 0x85, 0xbd, 0x55, 0x7d, 0x24, 0xcf, 0xa0, 0x2e, 0xc5, 0x3d, 0xc6, 0xef, 0xee, 0x0f, 0x37,
+	0x9c, 0xa4, 0x22, 0xf4, 0x04, 0xd3, 0x0a, 0x64, 0x17, 0x5a, 0x33, 0xa1, 0x78, 0x30, 0xc7, 0xac,
+	0x0a, 0x80, 0x50, 0xf5, 0x08, 0x25, 0xcc, 0x6a, 0xd0, 0x6f, 0x6c, 0xc4, 0xf3, 0x60, 0xa6, 0x23,
+	0xc6, 0xc1, 0xcc, 0x96, 0xac, 0x8f, 0x1a, 0x37, 0xbc, 0x44, 0x1b, 0x73, 0x03, 0x37, 0x14, 0xd1,
+	0x97, 0xd0, 0x2b, 0x39, 0x96, 0x64, 0x5c, 0x2d, 0xf6, 0xa1, 0xe0, 0xb6, 0xda, 0x09, 0x6c, 0x99,
+	0x9e, 0x97, 0xe4, 0xd3, 0xaa, 0x51, 0xdf, 0x1a, 0x19, 0x71, 0xae, 0x7f, 0x0c, 0x60, 0xf5, 0x1f,
+	0xce, 0x76, 0x0c, 0x5b, 0xbe, 0x91, 0xdb, 0x7c, 0x07, 0x15, 0x37, 0x92, 0xe5, 0x62, 0xea, 0x43,
+	0x1f, 0xf3, 0xf9, 0x61, 0x29, 0x92, 0x65, 0x20, 0x7e, 0x21, 0x4f, 0xa0, 0xa1, 0x65, 0xe8, 0xed,
+	0x6f, 0xe1, 0x51, 0x54, 0xee, 0xf8, 0x5a, 0xb5, 0xe3, 0x77, 0xa0, 0x6d, 0x7a, 0x47, 0x48, 0xb7,
+	0x3e, 0xaa, 0x8f, 0x7b, 0x6c, 0x4d, 0xd3, 0x3f, 0x1c, 0xe8, 0x96, 0x4a, 0x2f, 0x10, 0x75, 0xde,
+	0x8a, 0x28, 0x99, 0x40, 0x3b, 0x11, 0x9e, 0x08, 0x62, 0xa5, 0x0b, 0x29, 0x83, 0xc8, 0x0c, 0xfb,
+	0x88, 0x2b, 0xce, 0xd6, 0x3a, 0xe4, 0x31, 0xd4, 0x4e, 0xaf, 0x30, 0x72, 0x77, 0xff, 0x3d, 0xab,
+	0x79, 0x2a, 0xb2, 0x2b, 0x3e, 0x4f, 0x05, 0xab, 0x9d, 0x5e, 0x91, 0x67, 0x30, 0x88, 0x13, 0xb1,
+	0xbc, 0x50, 0x5c, 0xa5, 0xb2, 0xd4, 0xd7, 0x1b, 0x5c, 0xfa, 0x13, 0xbc, 0xcf, 0xc4, 0x3d, 0x13,
+	0x27, 0xe1, 0x4c, 0xac, 0xbe, 0x5b, 0x09, 0xef, 0x2c, 0xf2, 0xf8, 0xbc, 0xf4, 0x9e, 0x9c, 0x77,
+	0xbd, 0x27, 0x32, 0x84, 0xa6, 0x58, 0x09, 0xcf, 0x24, 0xde, 0x61, 0x86, 0xa0, 0x2f, 0xa0, 0xcd,
+	0xf2, 0x6c, 0x77, 0x4b, 0xd5, 0x99, 0xdb, 0x1e, 0x54, 0xab, 0x2b, 0x2a, 0xa3, 0xaf, 0xa0, 0x73,
+	0x9e, 0x04, 0x4b, 0xee, 0x65, 0xa7, 0x57, 0xe4, 0x6b, 0x5d, 0x85, 0x25, 0x2e, 0xa3, 0x3b, 0x11,
+	0x5a, 0xf3, 0x0f, 0xac, 0xf9, 0x79, 0x45, 0xc8, 0x36, 0x94, 0x69, 0x06, 0x83, 0xaa, 0x86, 0xce,
+	0x55, 0x59, 0x3f, 0xfa, 0x0d, 0x19, 0xc2, 0xdc, 0x33, 0x22, 0x80, 0xf7, 0xdc, 0x64, 0x39, 0x69,
+	0x26, 0xa6, 0x5f, 0x99, 0x98, 0x9a, 0xb2, 0xf8, 0x37, 0xde, 0x8a, 0x3f, 0x95, 0x30, 0xcc, 0xcb,
+	0xff, 0x36, 0x9c, 0x15, 0x15, 0x7d, 0x5e, 0x81, 0xc2, 0x29, 0x99, 0xe7, 0xea, 0xa5, 0x5b, 0x9e,
+	0x40, 0x67, 0x5d, 0x91, 0x5b, 0xab, 0xcc, 0xc8, 0xb5, 0x47, 0x56, 0xa8, 0xd0, 0x31, 0x10, 0xeb,
+	0x65, 0xea, 0x0b, 0xef, 0xee, 0x72, 0x75, 0x16, 0x48, 0xdc, 0x4e, 0x22, 0x49, 0x0c, 0xf2, 0x1d,
+	0x86, 0x67, 0x9a, 0x41, 0x77, 0xaa, 0x77, 0xb6, 0x79, 0x09, 0xe4, 0x29, 0xf4, 0xbd, 0x34, 0xc1,
+	0x3d, 0x61, 0x26, 0xbd, 0x19, 0x41, 0x55, 0x26, 0x19, 0x41, 0x77, 0x21, 0x16, 0x71, 0x14, 0xcd,
+	0x2f, 0x82, 0x5f, 0x85, 0x6d, 0x89, 0x32, 0x8b, 0x50, 0xe8, 0x2d, 0xe4, 0xed, 0x8f, 0xa9, 0x48,
+	0x05, 0xaa, 0xd4, 0x51, 0xa5, 0xc2, 0xa3, 0x1c, 0x3a, 0x4c, 0xdc, 0xdb, 0x29, 0x3d, 0x84, 0xa6,
+	0x54, 0x3c, 0xc9, 0x03, 0x1a, 0x42, 0xf7, 0xb9, 0x08, 0x67, 0x36, 0x80, 0x3e, 0xea, 0x7e, 0x0b,
+	0xe4, 0x51, 0x31, 0xe1, 0xda, 0x6c, 0x4d, 0xe7, 0x53, 0xa1, 0x81, 0xe5, 0xe9, 0x23, 0x7d, 0x02,
+	0xdd, 0xef, 0x4b, 0x59, 0x11, 0x68, 0x48, 0x9d, 0x8d, 0x89, 0x81, 0x67, 0xba, 0x0b, 0xdb, 0x4c,
+	0xc4, 0xf3, 0x0c, 0xf3, 0xb0, 0xf5, 0x15, 0x8b, 0xce, 0x29, 0x2f, 0x3a, 0x9d, 0x31, 0xaa, 0x1d,
+	0x46, 0xb3, 0x2c, 0xdf, 0x43, 0xce, 0x3f, 0xee, 0xa1, 0x7f, 0xdb, 0xcf, 0xf4, 0x39, 0xc0, 0x89,
+	0x9c, 0xf2, 0xf4, 0xd6, 0x57, 0x6f, 0x62, 0xbd, 0x3b, 0x4f, 0xa4, 0x87, 0x54, 0x1a, 0x63, 0x32,
+	0x6d, 0x56, 0xe2, 0xd0, 0x97, 0x30, 0x38, 0x91, 0xaf, 0x55, 0x3c, 0xc5, 0x45, 0x90, 0x85, 0x9e,
+	0x6e, 0xf7, 0x40, 0x86, 0x2a, 0xf6, 0x34, 0x47, 0x66, 0xa1, 0x67, 0xad, 0x36, 0xb8, 0xf4, 0x37,
+	0x07, 0xfa, 0x78, 0xf1, 0xba, 0xd5, 0x53, 0x15, 0x25, 0xba, 0xe8, 0x59, 0x12, 0x2c, 0x45, 0x62,
+	0x5b, 0xc2, 0x52, 0x1a, 0xf1, 0x9b, 0x34, 0xf4, 0x5e, 0xeb, 0x8d, 0x68, 0xd6, 0xdf, 0x9a, 0xae,
+	0xfe, 0x17, 0xea, 0x9b, 0xff, 0x85, 0x21, 0x34, 0x63, 0x9e, 0xf0, 0x85, 0x9d, 0x38, 0x86, 0x30,
+	0x53, 0x42, 0x25, 0x1c, 0x3f, 0x11, 0x3d, 0x66, 0x08, 0xfa, 0x25, 0xf4, 0x2b, 0xdb, 0x4c, 0xdf,
+	0x15, 0x7a, 0x75, 0xcc, 0x57, 0x0a, 0x1d, 0x12, 0x68, 0x5c, 0x66, 0x71, 0xfe, 0xe0, 0xf0, 0x4c,
+	0xbf, 0x82, 0x41, 0xc5, 0x50, 0x0f, 0x99, 0xca, 0x3e, 0x79, 0x78, 0x59, 0xda, 0xb5, 0xe2, 0xc3,
+	0xf0, 0x9c, 0x27, 0x1c, 0x91, 0x28, 0x8f, 0xea, 0x2f, 0xa0, 0x8b, 0xf3, 0xf8, 0x9d, 0xb3, 0xaf,
+	0xac, 0xa6, 0xa1, 0x92, 0x36, 0x80, 0xcd, 0x71, 0x4d, 0x1f, 0x3e, 0xfe, 0xf9, 0xe3, 0xdb, 0x40,
+	0xf9, 0xe9, 0xf5, 0xc4, 0x8b, 0x16, 0x7b, 0x07, 0x07, 0x5e, 0xb8, 0x87, 0x9f, 0xe5, 0x83, 0x83,
+	0x3d, 0xf4, 0x7a, 0xdd, 0xc2, 0xdf, 0xf0, 0xc1, 0x5f, 0x03, 0x00, 0x6f, 0x00, 0x12, 0xe5, 0x49,
+	0x0b, 0x00, 0x00,
 }
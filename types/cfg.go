@@ -112,6 +112,10 @@ type BlockChain struct {
 	IsRecordBlockSequence bool   `protobuf:"varint,11,opt,name=isRecordBlockSequence" json:"isRecordBlockSequence,omitempty"`
 	IsParaChain           bool   `protobuf:"varint,12,opt,name=isParaChain" json:"isParaChain,omitempty"`
 	EnableTxQuickIndex    bool   `protobuf:"varint,13,opt,name=enableTxQuickIndex" json:"enableTxQuickIndex,omitempty"`
+	//LightClient 轻节点模式, 只同步区块头, 交易和余额通过其他节点提供的证明查询
+	LightClient bool `protobuf:"varint,14,opt,name=lightClient" json:"lightClient,omitempty"`
+	//LightCheckpoints 轻节点信任的区块, 格式为 height:hash, 分叉不能修改已经同步到的检查点之前的区块头
+	LightCheckpoints []string `protobuf:"bytes,15,rep,name=lightCheckpoints" json:"lightCheckpoints,omitempty"`
 }
 
 // P2P 配置
@@ -131,7 +135,25 @@ type P2P struct {
 	VerMax          int32    `protobuf:"varint,13,opt,name=verMax" json:"verMax,omitempty"`
 	InnerSeedEnable bool     `protobuf:"varint,14,opt,name=innerSeedEnable" json:"innerSeedEnable,omitempty"`
 	InnerBounds     int32    `protobuf:"varint,15,opt,name=innerBounds" json:"innerBounds,omitempty"`
-	UseGithub       bool     `protobuf:"varint,16,opt,name=useGithub" json:"useGithub,omitempty"`
+	//UseGithub 已经废弃, 使用 DNSSeeds 发现节点
+	UseGithub bool `protobuf:"varint,16,opt,name=useGithub" json:"useGithub,omitempty"`
+	//EnableTLS 节点之间使用 tls 连接, 用 addrbook 的公钥验证对方身份, 不开启 tls 的节点无法和开启的节点连接
+	EnableTLS bool `protobuf:"varint,17,opt,name=enableTLS" json:"enableTLS,omitempty"`
+	//Permissioned 许可网络, 只允许 AllowedNodes 和链上白名单中的节点公钥连接
+	Permissioned     bool     `protobuf:"varint,18,opt,name=permissioned" json:"permissioned,omitempty"`
+	AllowedNodes     []string `protobuf:"bytes,19,rep,name=allowedNodes" json:"allowedNodes,omitempty"`
+	AllowlistOnChain bool     `protobuf:"varint,20,opt,name=allowlistOnChain" json:"allowlistOnChain,omitempty"`
+	//DNSSeeds dns 种子域名, 没有连接任何节点时查询 <title>.<dnsSeed> 的 TXT 和 A 记录
+	DNSSeeds []string `protobuf:"bytes,21,rep,name=dnsSeeds" json:"dnsSeeds,omitempty"`
+	//EnableDHT 通过 Version2 交换的节点 ID 建立 kademlia 路由表, 连接的节点不够时用 FindNode 发现新的节点
+	EnableDHT bool `protobuf:"varint,22,opt,name=enableDHT" json:"enableDHT,omitempty"`
+	//上传和下载的总速率限制以及每个节点的速率限制, 单位 KB/s, 0 表示不限制
+	UploadRate       int64 `protobuf:"varint,23,opt,name=uploadRate" json:"uploadRate,omitempty"`
+	DownloadRate     int64 `protobuf:"varint,24,opt,name=downloadRate" json:"downloadRate,omitempty"`
+	PeerUploadRate   int64 `protobuf:"varint,25,opt,name=peerUploadRate" json:"peerUploadRate,omitempty"`
+	PeerDownloadRate int64 `protobuf:"varint,26,opt,name=peerDownloadRate" json:"peerDownloadRate,omitempty"`
+	//DisableCaps 不向其他节点声明的能力, 可选 compression, compactBlock, lightService
+	DisableCaps []string `protobuf:"bytes,27,rep,name=disableCaps" json:"disableCaps,omitempty"`
 }
 
 // RPC 配置
@@ -152,13 +174,16 @@ type RPC struct {
 
 // Exec 配置
 type Exec struct {
-	MinExecFee       int64    `protobuf:"varint,1,opt,name=minExecFee" json:"minExecFee,omitempty"`
-	IsFree           bool     `protobuf:"varint,2,opt,name=isFree" json:"isFree,omitempty"`
-	EnableStat       bool     `protobuf:"varint,3,opt,name=enableStat" json:"enableStat,omitempty"`
-	EnableMVCC       bool     `protobuf:"varint,4,opt,name=enableMVCC" json:"enableMVCC,omitempty"`
-	DisableAddrIndex bool     `protobuf:"varint,7,opt,name=disableAddrIndex" json:"disableAddrIndex,omitempty"`
-	Alias            []string `protobuf:"bytes,5,rep,name=alias" json:"alias,omitempty"`
-	SaveTokenTxList  bool     `protobuf:"varint,6,opt,name=saveTokenTxList" json:"saveTokenTxList,omitempty"`
+	MinExecFee           int64    `protobuf:"varint,1,opt,name=minExecFee" json:"minExecFee,omitempty"`
+	IsFree               bool     `protobuf:"varint,2,opt,name=isFree" json:"isFree,omitempty"`
+	EnableStat           bool     `protobuf:"varint,3,opt,name=enableStat" json:"enableStat,omitempty"`
+	EnableMVCC           bool     `protobuf:"varint,4,opt,name=enableMVCC" json:"enableMVCC,omitempty"`
+	DisableAddrIndex     bool     `protobuf:"varint,7,opt,name=disableAddrIndex" json:"disableAddrIndex,omitempty"`
+	Alias                []string `protobuf:"bytes,5,rep,name=alias" json:"alias,omitempty"`
+	SaveTokenTxList      bool     `protobuf:"varint,6,opt,name=saveTokenTxList" json:"saveTokenTxList,omitempty"`
+	EnableParallel       bool     `protobuf:"varint,8,opt,name=enableParallel" json:"enableParallel,omitempty"`
+	EnableLogIndex       bool     `protobuf:"varint,9,opt,name=enableLogIndex" json:"enableLogIndex,omitempty"`
+	EnableBalanceHistory bool     `protobuf:"varint,10,opt,name=enableBalanceHistory" json:"enableBalanceHistory,omitempty"`
 }
 
 // Pprof 配置
@@ -253,6 +253,9 @@ func Init(t string, cfg *Config) {
 		if cfg.Exec.MinExecFee > cfg.Mempool.MinTxFee || cfg.Mempool.MinTxFee > cfg.Wallet.MinFee {
 			panic("config must meet: wallet.minFee >= mempool.minTxFee >= exec.minExecFee")
 		}
+		if cfg.P2P != nil && cfg.P2P.Permissioned && !cfg.P2P.EnableTLS {
+			panic("config must meet: p2p.enableTLS = true when p2p.permissioned = true")
+		}
 		setMinFee(cfg.Exec.MinExecFee)
 		setChainConfig("FixTime", cfg.FixTime)
 	}
@@ -100,6 +100,7 @@ const (
 	TyLogExecActive      = 10
 	TyLogGenesisTransfer = 11
 	TyLogGenesisDeposit  = 12
+	TyLogTxCost          = 13
 )
 
 //SystemLog 系统log日志
@@ -116,6 +117,7 @@ var SystemLog = map[int64]*LogInfo{
 	TyLogExecActive:      {reflect.TypeOf(ReceiptExecAccountTransfer{}), "LogExecActive"},
 	TyLogGenesisTransfer: {reflect.TypeOf(ReceiptAccountTransfer{}), "LogGenesisTransfer"},
 	TyLogGenesisDeposit:  {reflect.TypeOf(ReceiptAccountTransfer{}), "LogGenesisDeposit"},
+	TyLogTxCost:          {reflect.TypeOf(ReceiptTxCost{}), "LogTxCost"},
 }
 
 //exec type
@@ -156,3 +158,11 @@ var LowAllowPackHeight int64 = 30
 
 //EnableTxGroupParaFork 默认情况下不开启fork
 var EnableTxGroupParaFork = false
+
+//上报给 p2p 的节点行为, 用于节点评分
+const (
+	PeerInvalidBlock = iota + 1
+	PeerBadTx
+	PeerTimeout
+	PeerUsefulData
+)
@@ -0,0 +1,60 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"sync"
+)
+
+//TxCostSchedule 执行器读写数据库的费用表
+//每次读写收取固定的费用, 另外按照读写数据的字节数收费
+type TxCostSchedule struct {
+	Read      int64
+	ReadByte  int64
+	Write     int64
+	WriteByte int64
+}
+
+//Cost 计算读写消耗的费用
+func (s *TxCostSchedule) Cost(reads, readBytes, writes, writeBytes int64) int64 {
+	return s.Read*reads + s.ReadByte*readBytes + s.Write*writes + s.WriteByte*writeBytes
+}
+
+var (
+	costMtx       sync.RWMutex
+	costSchedules = make(map[string]*TxCostSchedule)
+)
+
+func init() {
+	RegisterTxCostSchedule("ForkTxCost", &TxCostSchedule{Read: 100, ReadByte: 1, Write: 1000, WriteByte: 10})
+}
+
+//RegisterTxCostSchedule 注册在fork之后使用的费用表
+func RegisterTxCostSchedule(fork string, s *TxCostSchedule) {
+	costMtx.Lock()
+	defer costMtx.Unlock()
+	costSchedules[fork] = s
+}
+
+//GetTxCostSchedule 获取高度上使用的费用表, 使用最后开启的fork的费用表,
+//没有开启任何fork时返回nil, 表示不计算费用
+func GetTxCostSchedule(height int64) *TxCostSchedule {
+	costMtx.RLock()
+	defer costMtx.RUnlock()
+	var schedule *TxCostSchedule
+	name := ""
+	forkHeight := int64(-1)
+	for fork, s := range costSchedules {
+		if !IsFork(height, fork) {
+			continue
+		}
+		//高度相同的fork按照名字排序, 保证结果确定
+		h := GetFork(fork)
+		if h > forkHeight || (h == forkHeight && fork > name) {
+			schedule, name, forkHeight = s, fork, h
+		}
+	}
+	return schedule
+}
@@ -705,6 +705,53 @@ func (m *StoreReplyValue) GetValues() [][]byte {
 	return nil
 }
 
+type StoreReplyProof struct {
+	Values               [][]byte `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	Proofs               [][]byte `protobuf:"bytes,2,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StoreReplyProof) Reset()         { *m = StoreReplyProof{} }
+func (m *StoreReplyProof) String() string { return proto.CompactTextString(m) }
+func (*StoreReplyProof) ProtoMessage()    {}
+func (*StoreReplyProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8817812184a13374, []int{13}
+}
+
+func (m *StoreReplyProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StoreReplyProof.Unmarshal(m, b)
+}
+func (m *StoreReplyProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StoreReplyProof.Marshal(b, m, deterministic)
+}
+func (m *StoreReplyProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreReplyProof.Merge(m, src)
+}
+func (m *StoreReplyProof) XXX_Size() int {
+	return xxx_messageInfo_StoreReplyProof.Size(m)
+}
+func (m *StoreReplyProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreReplyProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreReplyProof proto.InternalMessageInfo
+
+func (m *StoreReplyProof) GetValues() [][]byte {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *StoreReplyProof) GetProofs() [][]byte {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
+}
+
 type StoreList struct {
 	StateHash            []byte   `protobuf:"bytes,1,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
 	Start                []byte   `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
@@ -721,7 +768,7 @@ func (m *StoreList) Reset()         { *m = StoreList{} }
 func (m *StoreList) String() string { return proto.CompactTextString(m) }
 func (*StoreList) ProtoMessage()    {}
 func (*StoreList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{13}
+	return fileDescriptor_8817812184a13374, []int{14}
 }
 
 func (m *StoreList) XXX_Unmarshal(b []byte) error {
@@ -803,7 +850,7 @@ func (m *StoreListReply) Reset()         { *m = StoreListReply{} }
 func (m *StoreListReply) String() string { return proto.CompactTextString(m) }
 func (*StoreListReply) ProtoMessage()    {}
 func (*StoreListReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{14}
+	return fileDescriptor_8817812184a13374, []int{15}
 }
 
 func (m *StoreListReply) XXX_Unmarshal(b []byte) error {
@@ -901,7 +948,7 @@ func (m *PruneData) Reset()         { *m = PruneData{} }
 func (m *PruneData) String() string { return proto.CompactTextString(m) }
 func (*PruneData) ProtoMessage()    {}
 func (*PruneData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{15}
+	return fileDescriptor_8817812184a13374, []int{16}
 }
 
 func (m *PruneData) XXX_Unmarshal(b []byte) error {
@@ -948,7 +995,7 @@ func (m *StoreValuePool) Reset()         { *m = StoreValuePool{} }
 func (m *StoreValuePool) String() string { return proto.CompactTextString(m) }
 func (*StoreValuePool) ProtoMessage()    {}
 func (*StoreValuePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{16}
+	return fileDescriptor_8817812184a13374, []int{17}
 }
 
 func (m *StoreValuePool) XXX_Unmarshal(b []byte) error {
@@ -976,6 +1023,326 @@ func (m *StoreValuePool) GetValues() [][]byte {
 	return nil
 }
 
+//mavl裁剪控制请求, pause为true时暂停裁剪, 否则启动(恢复)裁剪
+type ReqStorePrune struct {
+	Pause                bool     `protobuf:"varint,1,opt,name=pause,proto3" json:"pause,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqStorePrune) Reset()         { *m = ReqStorePrune{} }
+func (m *ReqStorePrune) String() string { return proto.CompactTextString(m) }
+func (*ReqStorePrune) ProtoMessage()    {}
+func (*ReqStorePrune) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8817812184a13374, []int{18}
+}
+
+func (m *ReqStorePrune) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqStorePrune.Unmarshal(m, b)
+}
+func (m *ReqStorePrune) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqStorePrune.Marshal(b, m, deterministic)
+}
+func (m *ReqStorePrune) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqStorePrune.Merge(m, src)
+}
+func (m *ReqStorePrune) XXX_Size() int {
+	return xxx_messageInfo_ReqStorePrune.Size(m)
+}
+func (m *ReqStorePrune) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqStorePrune.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqStorePrune proto.InternalMessageInfo
+
+func (m *ReqStorePrune) GetPause() bool {
+	if m != nil {
+		return m.Pause
+	}
+	return false
+}
+
+// mavl裁剪任务的运行状态, 以及树缓存的统计信息
+type StorePruneStatus struct {
+	Enable        bool    `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
+	Running       bool    `protobuf:"varint,2,opt,name=running,proto3" json:"running,omitempty"`
+	Paused        bool    `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
+	PruneHeight   int64   `protobuf:"varint,4,opt,name=pruneHeight,proto3" json:"pruneHeight,omitempty"`
+	KeepHeight    int64   `protobuf:"varint,5,opt,name=keepHeight,proto3" json:"keepHeight,omitempty"`
+	PinnedHeights []int64 `protobuf:"varint,6,rep,packed,name=pinnedHeights,proto3" json:"pinnedHeights,omitempty"`
+	// 最近一次提交的区块高度
+	CommitHeight int64 `protobuf:"varint,7,opt,name=commitHeight,proto3" json:"commitHeight,omitempty"`
+	// 当前(或最近一次)裁剪所处的区块高度
+	CurPruneHeight int64 `protobuf:"varint,8,opt,name=curPruneHeight,proto3" json:"curPruneHeight,omitempty"`
+	// 最近一次完整结束的裁剪高度
+	LastPruneHeight int64 `protobuf:"varint,9,opt,name=lastPruneHeight,proto3" json:"lastPruneHeight,omitempty"`
+	// 当前裁剪已经扫描的叶子索引数
+	ScanLeafCount int64 `protobuf:"varint,10,opt,name=scanLeafCount,proto3" json:"scanLeafCount,omitempty"`
+	// 累计删除的节点数以及回收的空间(字节)
+	DeleteNodeCount int64 `protobuf:"varint,11,opt,name=deleteNodeCount,proto3" json:"deleteNodeCount,omitempty"`
+	ReclaimedBytes  int64 `protobuf:"varint,12,opt,name=reclaimedBytes,proto3" json:"reclaimedBytes,omitempty"`
+	StartTime       int64 `protobuf:"varint,13,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	LastCostMs      int64 `protobuf:"varint,14,opt,name=lastCostMs,proto3" json:"lastCostMs,omitempty"`
+	// 树缓存的统计信息
+	TreeCache            *StoreTreeCacheStats `protobuf:"bytes,15,opt,name=treeCache,proto3" json:"treeCache,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *StorePruneStatus) Reset()         { *m = StorePruneStatus{} }
+func (m *StorePruneStatus) String() string { return proto.CompactTextString(m) }
+func (*StorePruneStatus) ProtoMessage()    {}
+func (*StorePruneStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8817812184a13374, []int{19}
+}
+
+func (m *StorePruneStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorePruneStatus.Unmarshal(m, b)
+}
+func (m *StorePruneStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorePruneStatus.Marshal(b, m, deterministic)
+}
+func (m *StorePruneStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorePruneStatus.Merge(m, src)
+}
+func (m *StorePruneStatus) XXX_Size() int {
+	return xxx_messageInfo_StorePruneStatus.Size(m)
+}
+func (m *StorePruneStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorePruneStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorePruneStatus proto.InternalMessageInfo
+
+func (m *StorePruneStatus) GetEnable() bool {
+	if m != nil {
+		return m.Enable
+	}
+	return false
+}
+
+func (m *StorePruneStatus) GetRunning() bool {
+	if m != nil {
+		return m.Running
+	}
+	return false
+}
+
+func (m *StorePruneStatus) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *StorePruneStatus) GetPruneHeight() int64 {
+	if m != nil {
+		return m.PruneHeight
+	}
+	return 0
+}
+
+func (m *StorePruneStatus) GetKeepHeight() int64 {
+	if m != nil {
+		return m.KeepHeight
+	}
+	return 0
+}
+
+func (m *StorePruneStatus) GetPinnedHeights() []int64 {
+	if m != nil {
+		return m.PinnedHeights
+	}
+	return nil
+}
+
+func (m *StorePruneStatus) GetCommitHeight() int64 {
+	if m != nil {
+		return m.CommitHeight
+	}
+	return 0
+}
+
+func (m *StorePruneStatus) GetCurPruneHeight() int64 {
+	if m != nil {
+		return m.CurPruneHeight
+	}
+	return 0
+}
+
+func (m *StorePruneStatus) GetLastPruneHeight() int64 {
+	if m != nil {
+		return m.LastPruneHeight
+	}
+	return 0
+}
+
+func (m *StorePruneStatus) GetScanLeafCount() int64 {
+	if m != nil {
+		return m.ScanLeafCount
+	}
+	return 0
+}
+
+func (m *StorePruneStatus) GetDeleteNodeCount() int64 {
+	if m != nil {
+		return m.DeleteNodeCount
+	}
+	return 0
+}
+
+func (m *StorePruneStatus) GetReclaimedBytes() int64 {
+	if m != nil {
+		return m.ReclaimedBytes
+	}
+	return 0
+}
+
+func (m *StorePruneStatus) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *StorePruneStatus) GetLastCostMs() int64 {
+	if m != nil {
+		return m.LastCostMs
+	}
+	return 0
+}
+
+func (m *StorePruneStatus) GetTreeCache() *StoreTreeCacheStats {
+	if m != nil {
+		return m.TreeCache
+	}
+	return nil
+}
+
+// mavl树缓存的统计信息
+type StoreTreeCacheStats struct {
+	// MemSet生成的尚未提交的树
+	Size           int64 `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	Hits           int64 `protobuf:"varint,2,opt,name=hits,proto3" json:"hits,omitempty"`
+	Misses         int64 `protobuf:"varint,3,opt,name=misses,proto3" json:"misses,omitempty"`
+	Adds           int64 `protobuf:"varint,4,opt,name=adds,proto3" json:"adds,omitempty"`
+	Commits        int64 `protobuf:"varint,5,opt,name=commits,proto3" json:"commits,omitempty"`
+	Rollbacks      int64 `protobuf:"varint,6,opt,name=rollbacks,proto3" json:"rollbacks,omitempty"`
+	StaleEvicts    int64 `protobuf:"varint,7,opt,name=staleEvicts,proto3" json:"staleEvicts,omitempty"`
+	OverflowEvicts int64 `protobuf:"varint,8,opt,name=overflowEvicts,proto3" json:"overflowEvicts,omitempty"`
+	// 已经提交的树
+	CommittedSize        int64    `protobuf:"varint,9,opt,name=committedSize,proto3" json:"committedSize,omitempty"`
+	CommittedHits        int64    `protobuf:"varint,10,opt,name=committedHits,proto3" json:"committedHits,omitempty"`
+	CommittedMisses      int64    `protobuf:"varint,11,opt,name=committedMisses,proto3" json:"committedMisses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StoreTreeCacheStats) Reset()         { *m = StoreTreeCacheStats{} }
+func (m *StoreTreeCacheStats) String() string { return proto.CompactTextString(m) }
+func (*StoreTreeCacheStats) ProtoMessage()    {}
+func (*StoreTreeCacheStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8817812184a13374, []int{20}
+}
+
+func (m *StoreTreeCacheStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StoreTreeCacheStats.Unmarshal(m, b)
+}
+func (m *StoreTreeCacheStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StoreTreeCacheStats.Marshal(b, m, deterministic)
+}
+func (m *StoreTreeCacheStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreTreeCacheStats.Merge(m, src)
+}
+func (m *StoreTreeCacheStats) XXX_Size() int {
+	return xxx_messageInfo_StoreTreeCacheStats.Size(m)
+}
+func (m *StoreTreeCacheStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreTreeCacheStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreTreeCacheStats proto.InternalMessageInfo
+
+func (m *StoreTreeCacheStats) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *StoreTreeCacheStats) GetHits() int64 {
+	if m != nil {
+		return m.Hits
+	}
+	return 0
+}
+
+func (m *StoreTreeCacheStats) GetMisses() int64 {
+	if m != nil {
+		return m.Misses
+	}
+	return 0
+}
+
+func (m *StoreTreeCacheStats) GetAdds() int64 {
+	if m != nil {
+		return m.Adds
+	}
+	return 0
+}
+
+func (m *StoreTreeCacheStats) GetCommits() int64 {
+	if m != nil {
+		return m.Commits
+	}
+	return 0
+}
+
+func (m *StoreTreeCacheStats) GetRollbacks() int64 {
+	if m != nil {
+		return m.Rollbacks
+	}
+	return 0
+}
+
+func (m *StoreTreeCacheStats) GetStaleEvicts() int64 {
+	if m != nil {
+		return m.StaleEvicts
+	}
+	return 0
+}
+
+func (m *StoreTreeCacheStats) GetOverflowEvicts() int64 {
+	if m != nil {
+		return m.OverflowEvicts
+	}
+	return 0
+}
+
+func (m *StoreTreeCacheStats) GetCommittedSize() int64 {
+	if m != nil {
+		return m.CommittedSize
+	}
+	return 0
+}
+
+func (m *StoreTreeCacheStats) GetCommittedHits() int64 {
+	if m != nil {
+		return m.CommittedHits
+	}
+	return 0
+}
+
+func (m *StoreTreeCacheStats) GetCommittedMisses() int64 {
+	if m != nil {
+		return m.CommittedMisses
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*LeafNode)(nil), "types.LeafNode")
 	proto.RegisterType((*InnerNode)(nil), "types.InnerNode")
@@ -990,55 +1357,82 @@ func init() {
 	proto.RegisterType((*StoreSetWithSync)(nil), "types.StoreSetWithSync")
 	proto.RegisterType((*StoreGet)(nil), "types.StoreGet")
 	proto.RegisterType((*StoreReplyValue)(nil), "types.StoreReplyValue")
+	proto.RegisterType((*StoreReplyProof)(nil), "types.StoreReplyProof")
 	proto.RegisterType((*StoreList)(nil), "types.StoreList")
 	proto.RegisterType((*StoreListReply)(nil), "types.StoreListReply")
 	proto.RegisterType((*PruneData)(nil), "types.PruneData")
 	proto.RegisterType((*StoreValuePool)(nil), "types.StoreValuePool")
+	proto.RegisterType((*ReqStorePrune)(nil), "types.ReqStorePrune")
+	proto.RegisterType((*StorePruneStatus)(nil), "types.StorePruneStatus")
+	proto.RegisterType((*StoreTreeCacheStats)(nil), "types.StoreTreeCacheStats")
 }
 
 func init() { proto.RegisterFile("db.proto", fileDescriptor_8817812184a13374) }
 
 var fileDescriptor_8817812184a13374 = []byte{
-	// 647 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xdd, 0x6a, 0xdb, 0x4c,
-	0x10, 0x45, 0x92, 0x95, 0x48, 0x93, 0xf0, 0xc5, 0x88, 0xf0, 0x61, 0x42, 0xda, 0x18, 0x5d, 0xb9,
-	0x94, 0x3a, 0xa5, 0xbe, 0x2a, 0xf4, 0xa2, 0x0d, 0x81, 0xb4, 0xd8, 0x2d, 0x41, 0x06, 0x17, 0x7a,
-	0x51, 0xd8, 0xc8, 0xe3, 0x58, 0x44, 0xde, 0x75, 0xa5, 0x55, 0x89, 0xfa, 0x1a, 0x7d, 0x9a, 0xbe,
-	0x46, 0x9f, 0xa8, 0xec, 0xec, 0xea, 0x27, 0xc5, 0x89, 0x9b, 0xbb, 0x39, 0xe3, 0xf1, 0x9c, 0x33,
-	0x67, 0x67, 0x6c, 0xf0, 0xe6, 0x57, 0xc3, 0x75, 0x26, 0xa4, 0x08, 0x5c, 0x59, 0xae, 0x31, 0x3f,
-	0xda, 0x8f, 0xc5, 0x6a, 0x25, 0xb8, 0x4e, 0x86, 0x5f, 0xc1, 0x9b, 0x20, 0x5b, 0x7c, 0x12, 0x73,
-	0x0c, 0xba, 0xe0, 0xdc, 0x60, 0xd9, 0xb3, 0xfa, 0xd6, 0x60, 0x3f, 0x52, 0x61, 0x70, 0x08, 0xee,
-	0x77, 0x96, 0x16, 0xd8, 0xb3, 0x29, 0xa7, 0x41, 0xf0, 0x3f, 0xec, 0x2c, 0x31, 0xb9, 0x5e, 0xca,
-	0x9e, 0xd3, 0xb7, 0x06, 0x6e, 0x64, 0x50, 0x10, 0x40, 0x27, 0x4f, 0x7e, 0x60, 0xaf, 0x43, 0x59,
-	0x8a, 0xc3, 0x6f, 0xe0, 0x7f, 0xe0, 0x1c, 0x33, 0x22, 0x38, 0x02, 0x2f, 0xc5, 0x85, 0x7c, 0xcf,
-	0xf2, 0xa5, 0x61, 0xa9, 0x71, 0x70, 0x0c, 0x7e, 0xa6, 0xba, 0xd0, 0x87, 0x9a, 0xae, 0x49, 0x3c,
-	0x8a, 0xb2, 0x00, 0xff, 0xe3, 0xbb, 0xd9, 0xe4, 0x32, 0x13, 0x62, 0xa1, 0x29, 0xd9, 0xe2, 0x2e,
-	0xa5, 0xc6, 0xc1, 0x4b, 0x80, 0xa4, 0xd2, 0x96, 0xf7, 0xec, 0xbe, 0x33, 0xd8, 0x7b, 0xd5, 0x1d,
-	0x92, 0x4b, 0xc3, 0x5a, 0x74, 0xd4, 0xaa, 0x51, 0xdd, 0x32, 0x21, 0xb4, 0x46, 0x47, 0x77, 0xab,
-	0x70, 0xf8, 0xcb, 0x02, 0x7f, 0x2a, 0x45, 0x86, 0x8f, 0xf2, 0xb2, 0x6d, 0x89, 0xf3, 0x90, 0x25,
-	0x9d, 0xfb, 0x2d, 0x71, 0x37, 0x5a, 0xb2, 0xd3, 0x58, 0x12, 0x3c, 0x05, 0x58, 0xb3, 0x0c, 0xb9,
-	0x6e, 0xb5, 0x4b, 0xad, 0x5a, 0x99, 0xf0, 0x05, 0xc0, 0x44, 0xc4, 0x2c, 0x3d, 0x3f, 0x9b, 0xa2,
-	0x0c, 0x4e, 0xc0, 0x1e, 0xcf, 0x8c, 0x1f, 0x07, 0xc6, 0x8f, 0x31, 0x96, 0x33, 0x25, 0x38, 0xb2,
-	0xc7, 0xb3, 0xf0, 0x06, 0xf6, 0x4c, 0xf9, 0x24, 0xc9, 0xa5, 0x52, 0xb2, 0xce, 0x70, 0x91, 0xdc,
-	0x9a, 0x71, 0x0d, 0xaa, 0x3c, 0xb0, 0x1b, 0x0f, 0x8e, 0xc1, 0x9f, 0x27, 0x19, 0xc6, 0x32, 0x11,
-	0xdc, 0xbc, 0x64, 0x93, 0x50, 0x0e, 0xc5, 0xa2, 0xe0, 0xd2, 0xbc, 0xa6, 0x06, 0x61, 0xbf, 0xd6,
-	0x76, 0x81, 0x34, 0xdd, 0x0d, 0x96, 0xfa, 0xb5, 0xf6, 0x23, 0x8a, 0xc3, 0x67, 0x70, 0x40, 0x15,
-	0x11, 0xae, 0x53, 0xad, 0x52, 0x49, 0x22, 0x7f, 0xab, 0x42, 0x83, 0x42, 0x06, 0x1e, 0xbd, 0x91,
-	0x1a, 0xf3, 0x18, 0xfc, 0x5c, 0x32, 0x89, 0xad, 0xdd, 0x68, 0x12, 0x5b, 0x4d, 0xf8, 0x6b, 0x25,
-	0x9d, 0xca, 0xff, 0xf0, 0xad, 0xa1, 0x38, 0xc7, 0x74, 0x0b, 0x45, 0xd3, 0xc1, 0xbe, 0xd3, 0x61,
-	0x0a, 0xdd, 0x4a, 0xe4, 0xe7, 0x44, 0x2e, 0xa7, 0x25, 0x8f, 0x83, 0xe7, 0xe0, 0xe5, 0x2a, 0x97,
-	0xa3, 0xa4, 0x46, 0x8d, 0xa8, 0xaa, 0x34, 0xaa, 0x0b, 0x68, 0x05, 0x4a, 0x1e, 0x53, 0x5b, 0x2f,
-	0xa2, 0x38, 0x7c, 0x63, 0x64, 0x5d, 0x6c, 0x9d, 0xfc, 0x1e, 0x8b, 0xe9, 0xdb, 0xff, 0x60, 0xf1,
-	0xcf, 0xea, 0x0e, 0x68, 0x37, 0x1e, 0xa6, 0x3a, 0x04, 0x37, 0x97, 0x2c, 0x93, 0xd5, 0x4d, 0x10,
-	0x50, 0x7b, 0x83, 0x7c, 0x6e, 0xce, 0x41, 0x85, 0x8a, 0x2b, 0x2f, 0x16, 0x6a, 0xc3, 0xf4, 0x19,
-	0x18, 0xd4, 0x6c, 0x8c, 0x4b, 0x06, 0x6a, 0xa0, 0x06, 0x58, 0x89, 0xb9, 0xbe, 0x00, 0x27, 0xa2,
-	0x38, 0xfc, 0x6d, 0xc1, 0x7f, 0xb5, 0x2a, 0x9a, 0xa2, 0x21, 0xb7, 0x36, 0x90, 0xdb, 0x9b, 0xc8,
-	0x9d, 0xcd, 0xe4, 0x9d, 0x36, 0x79, 0x17, 0x1c, 0x5e, 0xac, 0x8c, 0x20, 0x15, 0x6e, 0x92, 0x13,
-	0xf4, 0x60, 0x97, 0xe3, 0xad, 0x1c, 0x63, 0x69, 0xae, 0xb1, 0x82, 0xb5, 0xfb, 0x5e, 0xe3, 0x7e,
-	0xcb, 0x6a, 0xff, 0x8e, 0xd5, 0xaf, 0xc1, 0xbf, 0xcc, 0x0a, 0x8e, 0xe7, 0x4c, 0xb2, 0xd6, 0x36,
-	0x59, 0xed, 0x6d, 0x52, 0x32, 0x53, 0xe4, 0x52, 0xff, 0xa8, 0xba, 0x91, 0x06, 0xe1, 0xc0, 0xd8,
-	0x41, 0x6f, 0x79, 0x29, 0x44, 0xda, 0x22, 0xb1, 0xda, 0x24, 0x67, 0x27, 0x5f, 0x9e, 0x5c, 0x27,
-	0x72, 0x59, 0x5c, 0x0d, 0x63, 0xb1, 0x3a, 0x1d, 0x8d, 0x62, 0x7e, 0x1a, 0x2f, 0x59, 0xc2, 0x47,
-	0xa3, 0x53, 0x5a, 0xc0, 0xab, 0x1d, 0xfa, 0x27, 0x19, 0xfd, 0x09, 0x00, 0x00, 0xff, 0xff, 0x30,
-	0x37, 0x20, 0x41, 0x6a, 0x06, 0x00, 0x00,
+	// 1018 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xdf, 0x6e, 0xeb, 0xc4,
+	0x13, 0x56, 0xe2, 0xa6, 0x8d, 0xa7, 0x7f, 0xe5, 0xdf, 0xd1, 0x4f, 0x56, 0x55, 0x38, 0x91, 0x05,
+	0x28, 0x08, 0xd1, 0x22, 0x7a, 0x03, 0x12, 0x17, 0x9c, 0xb6, 0xe8, 0x14, 0xb5, 0x45, 0x95, 0x73,
+	0x54, 0x24, 0x2e, 0x90, 0xb6, 0xf6, 0xa4, 0xb1, 0xe2, 0xec, 0xe6, 0x78, 0xd7, 0xe5, 0x84, 0xd7,
+	0xe0, 0x2d, 0x78, 0x03, 0x5e, 0x83, 0x37, 0xe0, 0x4d, 0xd0, 0xcc, 0xae, 0x63, 0x3b, 0x4a, 0x4f,
+	0x39, 0x77, 0x33, 0x5f, 0xbe, 0xce, 0xcc, 0x7e, 0xf3, 0xed, 0xba, 0xd0, 0x4f, 0xef, 0x8f, 0xe7,
+	0x85, 0x32, 0x2a, 0xe8, 0x99, 0xc5, 0x1c, 0xf5, 0xe1, 0x4e, 0xa2, 0x66, 0x33, 0x25, 0x2d, 0x18,
+	0xfd, 0x0a, 0xfd, 0x6b, 0x14, 0xe3, 0x9f, 0x54, 0x8a, 0xc1, 0x01, 0x78, 0x53, 0x5c, 0x84, 0x9d,
+	0x41, 0x67, 0xb8, 0x13, 0x53, 0x18, 0xbc, 0x80, 0xde, 0xa3, 0xc8, 0x4b, 0x0c, 0xbb, 0x8c, 0xd9,
+	0x24, 0xf8, 0x3f, 0x6c, 0x4e, 0x30, 0x7b, 0x98, 0x98, 0xd0, 0x1b, 0x74, 0x86, 0xbd, 0xd8, 0x65,
+	0x41, 0x00, 0x1b, 0x3a, 0xfb, 0x1d, 0xc3, 0x0d, 0x46, 0x39, 0x8e, 0xde, 0x82, 0xff, 0xa3, 0x94,
+	0x58, 0x70, 0x83, 0x43, 0xe8, 0xe7, 0x38, 0x36, 0x97, 0x42, 0x4f, 0x5c, 0x97, 0x65, 0x1e, 0x1c,
+	0x81, 0x5f, 0x50, 0x15, 0xfe, 0xd1, 0xb6, 0xab, 0x81, 0x0f, 0x6a, 0x59, 0x82, 0x7f, 0xf3, 0xea,
+	0xee, 0xfa, 0xb6, 0x50, 0x6a, 0x6c, 0x5b, 0x8a, 0x71, 0xbb, 0xa5, 0xcd, 0x83, 0xaf, 0x00, 0xb2,
+	0x6a, 0x36, 0x1d, 0x76, 0x07, 0xde, 0x70, 0xfb, 0xeb, 0x83, 0x63, 0x56, 0xe9, 0x78, 0x39, 0x74,
+	0xdc, 0xe0, 0x50, 0xb5, 0x42, 0x29, 0x3b, 0xa3, 0x67, 0xab, 0x55, 0x79, 0xf4, 0x57, 0x07, 0xfc,
+	0x91, 0x51, 0x05, 0x7e, 0x90, 0x96, 0x4d, 0x49, 0xbc, 0xf7, 0x49, 0xb2, 0xf1, 0xb4, 0x24, 0xbd,
+	0xb5, 0x92, 0x6c, 0xd6, 0x92, 0x04, 0x1f, 0x03, 0xcc, 0x45, 0x81, 0xd2, 0x96, 0xda, 0xe2, 0x52,
+	0x0d, 0x24, 0xfa, 0x12, 0xe0, 0x5a, 0x25, 0x22, 0xbf, 0x38, 0x1b, 0xa1, 0x09, 0x5e, 0x42, 0xf7,
+	0xea, 0xce, 0xe9, 0xb1, 0xef, 0xf4, 0xb8, 0xc2, 0xc5, 0x1d, 0x0d, 0x1c, 0x77, 0xaf, 0xee, 0xa2,
+	0x29, 0x6c, 0x3b, 0xfa, 0x75, 0xa6, 0x0d, 0x4d, 0x32, 0x2f, 0x70, 0x9c, 0xbd, 0x73, 0xc7, 0x75,
+	0x59, 0xa5, 0x41, 0xb7, 0xd6, 0xe0, 0x08, 0xfc, 0x34, 0x2b, 0x30, 0x31, 0x99, 0x92, 0x6e, 0x93,
+	0x35, 0x40, 0x0a, 0x25, 0xaa, 0x94, 0xc6, 0x6d, 0xd3, 0x26, 0xd1, 0x60, 0x39, 0xdb, 0x6b, 0xe4,
+	0xd3, 0x4d, 0x71, 0x61, 0xb7, 0xb5, 0x13, 0x73, 0x1c, 0x7d, 0x0e, 0xfb, 0xcc, 0x88, 0x71, 0x9e,
+	0xdb, 0x29, 0x69, 0x24, 0xd6, 0xb7, 0x22, 0xba, 0x2c, 0x12, 0xd0, 0xe7, 0x1d, 0xd1, 0x31, 0x8f,
+	0xc0, 0xd7, 0x46, 0x18, 0x6c, 0x78, 0xa3, 0x06, 0x9e, 0x15, 0x61, 0xc5, 0x92, 0x5e, 0xa5, 0x7f,
+	0xf4, 0xbd, 0x6b, 0x71, 0x81, 0xf9, 0x33, 0x2d, 0xea, 0x0a, 0xdd, 0x56, 0x85, 0x11, 0x1c, 0x54,
+	0x43, 0xfe, 0x9c, 0x99, 0xc9, 0x68, 0x21, 0x93, 0xe0, 0x0b, 0xe8, 0x6b, 0xc2, 0x34, 0x1a, 0x2e,
+	0x54, 0x0f, 0x55, 0x51, 0xe3, 0x25, 0x81, 0x2d, 0xb0, 0x90, 0x09, 0x97, 0xed, 0xc7, 0x1c, 0x47,
+	0xdf, 0xb9, 0xb1, 0x5e, 0x3f, 0x7b, 0xf2, 0x27, 0x24, 0xe6, 0xbf, 0xfe, 0x0f, 0x12, 0xbf, 0x6a,
+	0x52, 0xed, 0x25, 0xac, 0xa9, 0x9d, 0x26, 0xd5, 0x1a, 0x47, 0xa9, 0xf1, 0xb2, 0x84, 0xcd, 0xa2,
+	0x3f, 0xaa, 0xab, 0xc4, 0xf6, 0x7a, 0xff, 0xb4, 0x2f, 0xa0, 0xa7, 0x8d, 0x28, 0x4c, 0x75, 0xad,
+	0x38, 0x21, 0xeb, 0xa1, 0x4c, 0xdd, 0x8d, 0xa2, 0x90, 0x7a, 0xe9, 0x72, 0x4c, 0x26, 0xb5, 0x37,
+	0xc9, 0x65, 0xb5, 0xe9, 0x7a, 0xbc, 0x03, 0x9b, 0x90, 0x06, 0x33, 0x95, 0xda, 0x4b, 0xe4, 0xc5,
+	0x1c, 0x47, 0x7f, 0x77, 0x60, 0x6f, 0x39, 0x15, 0x9f, 0xae, 0x6e, 0xde, 0x59, 0xd3, 0xbc, 0xbb,
+	0xae, 0xb9, 0xb7, 0xbe, 0xf9, 0x46, 0xb3, 0xf9, 0x01, 0x78, 0xb2, 0x9c, 0xb9, 0x81, 0x28, 0x5c,
+	0x37, 0x4e, 0x10, 0xc2, 0x96, 0xc4, 0x77, 0xe6, 0x0a, 0x17, 0xee, 0x42, 0x57, 0xe9, 0x72, 0x81,
+	0xfd, 0x7a, 0x81, 0x8d, 0x15, 0xf8, 0xad, 0x6d, 0x7d, 0x0b, 0xfe, 0x6d, 0x51, 0x4a, 0xbc, 0x10,
+	0x46, 0x34, 0x0c, 0xd9, 0x69, 0x1a, 0x92, 0xc6, 0xcc, 0x51, 0x1a, 0xfb, 0x2e, 0xf7, 0x62, 0x9b,
+	0x44, 0x43, 0x27, 0x07, 0xdb, 0xe1, 0x56, 0xa9, 0xfc, 0xa9, 0x3d, 0x47, 0x9f, 0xc2, 0x6e, 0x8c,
+	0x6f, 0x99, 0xcc, 0xcd, 0xa8, 0xe0, 0x5c, 0x94, 0x1a, 0xb9, 0x4f, 0x3f, 0xb6, 0x49, 0xf4, 0xe7,
+	0x06, 0x1c, 0xd4, 0xa4, 0x91, 0x11, 0xa6, 0xe4, 0xc1, 0x51, 0x8a, 0xfb, 0xbc, 0xe2, 0xba, 0x8c,
+	0x8e, 0x5f, 0x94, 0x52, 0x66, 0xf2, 0xc1, 0xd9, 0xbc, 0x4a, 0xd9, 0x55, 0x54, 0xcf, 0xae, 0xbf,
+	0x1f, 0xbb, 0x2c, 0x18, 0xc0, 0xf6, 0x9c, 0x0a, 0x5f, 0xda, 0x23, 0x5a, 0xc9, 0x9b, 0x10, 0x3d,
+	0x93, 0x53, 0xc4, 0xf9, 0x65, 0xfd, 0xac, 0x7a, 0x71, 0x03, 0x09, 0x3e, 0x81, 0xdd, 0x39, 0x7d,
+	0x0d, 0x52, 0x9b, 0xeb, 0x70, 0x73, 0xe0, 0x0d, 0xbd, 0xb8, 0x0d, 0x06, 0x11, 0xf0, 0x27, 0x36,
+	0x33, 0xae, 0xce, 0x16, 0xd7, 0x69, 0x61, 0xc1, 0x67, 0xb0, 0x97, 0x94, 0xc5, 0x6d, 0x63, 0x9c,
+	0x3e, 0xb3, 0x56, 0xd0, 0x60, 0x08, 0xfb, 0xb9, 0xd0, 0xa6, 0x49, 0xf4, 0x99, 0xb8, 0x0a, 0xd3,
+	0x6c, 0x3a, 0x11, 0x92, 0x3e, 0xe6, 0xe7, 0x6c, 0x29, 0x60, 0x5e, 0x1b, 0xa4, 0x7a, 0x29, 0xe6,
+	0x68, 0xf8, 0x23, 0x65, 0x79, 0xdb, 0xb6, 0xde, 0x0a, 0x4c, 0x13, 0x16, 0x98, 0xe4, 0x22, 0x9b,
+	0x61, 0x7a, 0xb6, 0x30, 0xa8, 0xc3, 0x1d, 0x3b, 0x61, 0x1b, 0x75, 0xb7, 0xb3, 0x30, 0x6f, 0xb2,
+	0x19, 0x86, 0xbb, 0x4c, 0xa9, 0x01, 0x52, 0x94, 0x06, 0x3d, 0x57, 0xda, 0xdc, 0xe8, 0x70, 0xcf,
+	0x2a, 0x5a, 0x23, 0xc1, 0x37, 0xe0, 0x9b, 0x02, 0xf1, 0x5c, 0x24, 0x13, 0x0c, 0xf7, 0xf9, 0x5d,
+	0x3b, 0x6c, 0xbe, 0x6b, 0x6f, 0xaa, 0x1f, 0xc9, 0x0d, 0x3a, 0xae, 0xc9, 0xd1, 0x3f, 0x5d, 0xf8,
+	0xdf, 0x1a, 0xca, 0xf2, 0xf3, 0x67, 0x1d, 0xcc, 0x31, 0x61, 0x93, 0xcc, 0x68, 0xf7, 0xcc, 0x72,
+	0x4c, 0x2e, 0x99, 0x65, 0x5a, 0xa3, 0xae, 0x9e, 0x6f, 0x9b, 0x11, 0x57, 0xa4, 0xa9, 0x76, 0xf6,
+	0xe0, 0x98, 0xbc, 0x66, 0xb7, 0xa7, 0x9d, 0x29, 0xaa, 0x94, 0x3f, 0xd1, 0x2a, 0xcf, 0xef, 0x45,
+	0x32, 0xd5, 0xee, 0x76, 0xd6, 0x00, 0x39, 0x4e, 0x1b, 0x91, 0xe3, 0x0f, 0x8f, 0x59, 0x62, 0xb4,
+	0x33, 0x42, 0x13, 0x22, 0x95, 0xd5, 0x23, 0x16, 0xe3, 0x5c, 0xfd, 0xe6, 0x48, 0xce, 0x07, 0x6d,
+	0x94, 0xb6, 0x6b, 0x5b, 0x1a, 0x4c, 0x47, 0x74, 0x3c, 0xeb, 0x82, 0x36, 0xd8, 0x62, 0x5d, 0xd2,
+	0xb4, 0xb0, 0xc2, 0x22, 0x90, 0x3c, 0xb0, 0x04, 0x6e, 0xac, 0x04, 0xce, 0x03, 0x2b, 0xf0, 0xd9,
+	0xcb, 0x5f, 0x3e, 0x7a, 0xc8, 0xcc, 0xa4, 0xbc, 0x3f, 0x4e, 0xd4, 0xec, 0xe4, 0xf4, 0x34, 0x91,
+	0x27, 0xc9, 0x44, 0x64, 0xf2, 0xf4, 0xf4, 0x84, 0x77, 0x74, 0xbf, 0xc9, 0xff, 0x44, 0x9e, 0xfe,
+	0x3b, 0x00, 0x89, 0x76, 0x4c, 0x60, 0x65, 0x0a, 0x00, 0x00,
 }
@@ -137,10 +137,14 @@ var (
 	ErrParentHash         = errors.New("ErrParentHash")
 
 	//ErrPing p2p模块错误类型
-	ErrPing       = errors.New("ErrPingSignature")
-	ErrVersion    = errors.New("ErrVersionNoSupport")
-	ErrStreamPing = errors.New("ErrStreamPing")
-	ErrPeerStop   = errors.New("ErrPeerStop")
+	ErrPing         = errors.New("ErrPingSignature")
+	ErrVersion      = errors.New("ErrVersionNoSupport")
+	ErrStreamPing   = errors.New("ErrStreamPing")
+	ErrPeerStop     = errors.New("ErrPeerStop")
+	ErrPeerPubKey   = errors.New("ErrPeerPubKey")
+	ErrCompactBlock = errors.New("ErrCompactBlock")
+	//ErrPermissionedTLS 许可网络必须开启 tls
+	ErrPermissionedTLS = errors.New("ErrPermissionedTLS")
 
 	ErrBlockSize                  = errors.New("ErrBlockSize")
 	ErrTxGroupIndex               = errors.New("ErrTxGroupIndex")
@@ -173,4 +177,25 @@ var (
 	ErrCloneForkFrom      = errors.New("ErrCloneForkFrom")
 	ErrCloneForkToExist   = errors.New("ErrCloneForkToExist")
 	ErrQueryThistIsNotSet = errors.New("ErrQueryThistIsNotSet")
+
+	//ErrPruneNotEnable store模块的错误类型
+	ErrPruneNotEnable = errors.New("ErrPruneNotEnable")
+	//ErrStatePruned 查询的历史状态已经被裁剪
+	ErrStatePruned = errors.New("ErrStatePruned")
+	//ErrMemTreeCacheFull 未提交的树缓存已满, 并且缓存中的树都可能被提交
+	ErrMemTreeCacheFull = errors.New("ErrMemTreeCacheFull")
+	//ErrTxCostExceed 交易执行消耗的资源超过了交易的手续费
+	ErrTxCostExceed = errors.New("ErrTxCostExceed")
+	//ErrTxProof 轻节点验证交易的默克尔证明失败
+	ErrTxProof = errors.New("ErrTxProof")
+	//ErrStateProof 轻节点验证状态证明失败
+	ErrStateProof = errors.New("ErrStateProof")
+	//ErrLightGenesisStateHash 创世区块的 hash 包含执行之后的状态 hash 时, 轻节点不能在本地生成创世区块
+	ErrLightGenesisStateHash = errors.New("ErrLightGenesisStateHash")
+	//ErrLightGenesisNotMatch 轻节点数据库中的创世区块和配置生成的不一致
+	ErrLightGenesisNotMatch = errors.New("ErrLightGenesisNotMatch")
+	//ErrLightTooManyQueries 轻节点同时等待证明的查询太多
+	ErrLightTooManyQueries = errors.New("ErrLightTooManyQueries")
+	//ErrLightCheckpoint 区块头和轻节点信任的检查点不一致, 或者分叉要修改检查点之前的区块头
+	ErrLightCheckpoint = errors.New("ErrLightCheckpoint")
 )
@@ -138,6 +138,21 @@ const (
 	EventStoreListReply          = 131
 	EventListBlockSeqCB          = 132
 	EventGetSeqCBLastNum         = 133
+	EventStorePrune              = 134
+	EventStorePruneStatus        = 135
+	EventSimulateTx              = 136
+	EventTraceTx                 = 137
+	EventExecLocalDBVersions     = 138
+	EventReIndexExecLocal        = 139
+	EventGetExecLocalDBMetas     = 140
+	EventReportPeer              = 141
+	EventPeerScores              = 142
+	EventBanPeer                 = 143
+	EventUnbanPeer               = 144
+	EventGetMempoolShortTxs      = 145
+	EventStoreGetProof           = 146
+	EventGetTxProof              = 147
+	EventGetStateProof           = 148
 
 	//exec
 	EventBlockChainQuery = 212
@@ -270,9 +285,24 @@ var eventName = map[int]string{
 	127: "EventGetSeqByHash",
 	128: "EventLocalPrefixCount",
 	//todo: 这个可能后面会删除
-	EventWalletCreateTx: "EventWalletCreateTx",
-	EventStoreList:      "EventStoreList",
-	EventStoreListReply: "EventStoreListReply",
+	EventWalletCreateTx:      "EventWalletCreateTx",
+	EventStoreList:           "EventStoreList",
+	EventStoreListReply:      "EventStoreListReply",
+	EventStorePrune:          "EventStorePrune",
+	EventStorePruneStatus:    "EventStorePruneStatus",
+	EventSimulateTx:          "EventSimulateTx",
+	EventTraceTx:             "EventTraceTx",
+	EventExecLocalDBVersions: "EventExecLocalDBVersions",
+	EventReIndexExecLocal:    "EventReIndexExecLocal",
+	EventGetExecLocalDBMetas: "EventGetExecLocalDBMetas",
+	EventReportPeer:          "EventReportPeer",
+	EventPeerScores:          "EventPeerScores",
+	EventBanPeer:             "EventBanPeer",
+	EventUnbanPeer:           "EventUnbanPeer",
+	EventGetMempoolShortTxs:  "EventGetMempoolShortTxs",
+	EventStoreGetProof:       "EventStoreGetProof",
+	EventGetTxProof:          "EventGetTxProof",
+	EventGetStateProof:       "EventGetStateProof",
 	// Token
 	EventBlockChainQuery: "EventBlockChainQuery",
 	EventConsensusQuery:  "EventConsensusQuery",
@@ -139,6 +139,396 @@ func (m *ExecTxList) GetIsMempool() bool {
 	return false
 }
 
+// 在最新区块的状态上模拟执行交易, 交易可以没有签名
+type ReqSimulateTx struct {
+	// 交易或者交易组
+	Tx *Transaction `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	// 交易没有签名时, 用来计算from地址的公钥
+	Pubkey               []byte   `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqSimulateTx) Reset()         { *m = ReqSimulateTx{} }
+func (m *ReqSimulateTx) String() string { return proto.CompactTextString(m) }
+func (*ReqSimulateTx) ProtoMessage()    {}
+func (*ReqSimulateTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12d1cdcda51e000f, []int{2}
+}
+
+func (m *ReqSimulateTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqSimulateTx.Unmarshal(m, b)
+}
+func (m *ReqSimulateTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqSimulateTx.Marshal(b, m, deterministic)
+}
+func (m *ReqSimulateTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqSimulateTx.Merge(m, src)
+}
+func (m *ReqSimulateTx) XXX_Size() int {
+	return xxx_messageInfo_ReqSimulateTx.Size(m)
+}
+func (m *ReqSimulateTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqSimulateTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqSimulateTx proto.InternalMessageInfo
+
+func (m *ReqSimulateTx) GetTx() *Transaction {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+func (m *ReqSimulateTx) GetPubkey() []byte {
+	if m != nil {
+		return m.Pubkey
+	}
+	return nil
+}
+
+type ReplySimulateTx struct {
+	Height               int64          `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	StateHash            []byte         `protobuf:"bytes,2,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	Fee                  int64          `protobuf:"varint,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	Txs                  []*Transaction `protobuf:"bytes,4,rep,name=txs,proto3" json:"txs,omitempty"`
+	Receipts             []*Receipt     `protobuf:"bytes,5,rep,name=receipts,proto3" json:"receipts,omitempty"`
+	ReadKeys             [][]byte       `protobuf:"bytes,6,rep,name=readKeys,proto3" json:"readKeys,omitempty"`
+	WriteKeys            [][]byte       `protobuf:"bytes,7,rep,name=writeKeys,proto3" json:"writeKeys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ReplySimulateTx) Reset()         { *m = ReplySimulateTx{} }
+func (m *ReplySimulateTx) String() string { return proto.CompactTextString(m) }
+func (*ReplySimulateTx) ProtoMessage()    {}
+func (*ReplySimulateTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12d1cdcda51e000f, []int{3}
+}
+
+func (m *ReplySimulateTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplySimulateTx.Unmarshal(m, b)
+}
+func (m *ReplySimulateTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplySimulateTx.Marshal(b, m, deterministic)
+}
+func (m *ReplySimulateTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplySimulateTx.Merge(m, src)
+}
+func (m *ReplySimulateTx) XXX_Size() int {
+	return xxx_messageInfo_ReplySimulateTx.Size(m)
+}
+func (m *ReplySimulateTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplySimulateTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplySimulateTx proto.InternalMessageInfo
+
+func (m *ReplySimulateTx) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ReplySimulateTx) GetStateHash() []byte {
+	if m != nil {
+		return m.StateHash
+	}
+	return nil
+}
+
+func (m *ReplySimulateTx) GetFee() int64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *ReplySimulateTx) GetTxs() []*Transaction {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func (m *ReplySimulateTx) GetReceipts() []*Receipt {
+	if m != nil {
+		return m.Receipts
+	}
+	return nil
+}
+
+func (m *ReplySimulateTx) GetReadKeys() [][]byte {
+	if m != nil {
+		return m.ReadKeys
+	}
+	return nil
+}
+
+func (m *ReplySimulateTx) GetWriteKeys() [][]byte {
+	if m != nil {
+		return m.WriteKeys
+	}
+	return nil
+}
+
+// 交易执行过程中的一次数据库读写或者函数调用
+type TraceOp struct {
+	// statedb, localdb, exec
+	Db string `protobuf:"bytes,1,opt,name=db,proto3" json:"db,omitempty"`
+	// get, set, list, begin, commit, rollback, call
+	Op                   string   `protobuf:"bytes,2,opt,name=op,proto3" json:"op,omitempty"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Key                  []byte   `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	Prev                 []byte   `protobuf:"bytes,5,opt,name=prev,proto3" json:"prev,omitempty"`
+	Value                []byte   `protobuf:"bytes,6,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TraceOp) Reset()         { *m = TraceOp{} }
+func (m *TraceOp) String() string { return proto.CompactTextString(m) }
+func (*TraceOp) ProtoMessage()    {}
+func (*TraceOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12d1cdcda51e000f, []int{4}
+}
+
+func (m *TraceOp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TraceOp.Unmarshal(m, b)
+}
+func (m *TraceOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TraceOp.Marshal(b, m, deterministic)
+}
+func (m *TraceOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraceOp.Merge(m, src)
+}
+func (m *TraceOp) XXX_Size() int {
+	return xxx_messageInfo_TraceOp.Size(m)
+}
+func (m *TraceOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraceOp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TraceOp proto.InternalMessageInfo
+
+func (m *TraceOp) GetDb() string {
+	if m != nil {
+		return m.Db
+	}
+	return ""
+}
+
+func (m *TraceOp) GetOp() string {
+	if m != nil {
+		return m.Op
+	}
+	return ""
+}
+
+func (m *TraceOp) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TraceOp) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *TraceOp) GetPrev() []byte {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *TraceOp) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+// 在父区块的状态上重新执行区块到指定的交易, 交易组整体执行
+type ReplyTraceTx struct {
+	Height               int64        `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Index                int64        `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	StateHash            []byte       `protobuf:"bytes,3,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	Tx                   *Transaction `protobuf:"bytes,4,opt,name=tx,proto3" json:"tx,omitempty"`
+	Receipt              *Receipt     `protobuf:"bytes,5,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	Ops                  []*TraceOp   `protobuf:"bytes,6,rep,name=ops,proto3" json:"ops,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ReplyTraceTx) Reset()         { *m = ReplyTraceTx{} }
+func (m *ReplyTraceTx) String() string { return proto.CompactTextString(m) }
+func (*ReplyTraceTx) ProtoMessage()    {}
+func (*ReplyTraceTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12d1cdcda51e000f, []int{5}
+}
+
+func (m *ReplyTraceTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyTraceTx.Unmarshal(m, b)
+}
+func (m *ReplyTraceTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyTraceTx.Marshal(b, m, deterministic)
+}
+func (m *ReplyTraceTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyTraceTx.Merge(m, src)
+}
+func (m *ReplyTraceTx) XXX_Size() int {
+	return xxx_messageInfo_ReplyTraceTx.Size(m)
+}
+func (m *ReplyTraceTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyTraceTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyTraceTx proto.InternalMessageInfo
+
+func (m *ReplyTraceTx) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ReplyTraceTx) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ReplyTraceTx) GetStateHash() []byte {
+	if m != nil {
+		return m.StateHash
+	}
+	return nil
+}
+
+func (m *ReplyTraceTx) GetTx() *Transaction {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+func (m *ReplyTraceTx) GetReceipt() *Receipt {
+	if m != nil {
+		return m.Receipt
+	}
+	return nil
+}
+
+func (m *ReplyTraceTx) GetOps() []*TraceOp {
+	if m != nil {
+		return m.Ops
+	}
+	return nil
+}
+
+// 交易执行过程中读写数据库的资源消耗, 按照fork配置的费用表计算cost
+type ReceiptTxCost struct {
+	Reads      int64 `protobuf:"varint,1,opt,name=reads,proto3" json:"reads,omitempty"`
+	ReadBytes  int64 `protobuf:"varint,2,opt,name=readBytes,proto3" json:"readBytes,omitempty"`
+	Writes     int64 `protobuf:"varint,3,opt,name=writes,proto3" json:"writes,omitempty"`
+	WriteBytes int64 `protobuf:"varint,4,opt,name=writeBytes,proto3" json:"writeBytes,omitempty"`
+	// 这笔交易的cost
+	Cost int64 `protobuf:"varint,5,opt,name=cost,proto3" json:"cost,omitempty"`
+	// 交易组中到这笔交易为止的cost
+	Total int64 `protobuf:"varint,6,opt,name=total,proto3" json:"total,omitempty"`
+	// 交易声明的手续费, -1 表示没有限制
+	Budget               int64    `protobuf:"varint,7,opt,name=budget,proto3" json:"budget,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReceiptTxCost) Reset()         { *m = ReceiptTxCost{} }
+func (m *ReceiptTxCost) String() string { return proto.CompactTextString(m) }
+func (*ReceiptTxCost) ProtoMessage()    {}
+func (*ReceiptTxCost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12d1cdcda51e000f, []int{6}
+}
+
+func (m *ReceiptTxCost) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptTxCost.Unmarshal(m, b)
+}
+func (m *ReceiptTxCost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptTxCost.Marshal(b, m, deterministic)
+}
+func (m *ReceiptTxCost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTxCost.Merge(m, src)
+}
+func (m *ReceiptTxCost) XXX_Size() int {
+	return xxx_messageInfo_ReceiptTxCost.Size(m)
+}
+func (m *ReceiptTxCost) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptTxCost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptTxCost proto.InternalMessageInfo
+
+func (m *ReceiptTxCost) GetReads() int64 {
+	if m != nil {
+		return m.Reads
+	}
+	return 0
+}
+
+func (m *ReceiptTxCost) GetReadBytes() int64 {
+	if m != nil {
+		return m.ReadBytes
+	}
+	return 0
+}
+
+func (m *ReceiptTxCost) GetWrites() int64 {
+	if m != nil {
+		return m.Writes
+	}
+	return 0
+}
+
+func (m *ReceiptTxCost) GetWriteBytes() int64 {
+	if m != nil {
+		return m.WriteBytes
+	}
+	return 0
+}
+
+func (m *ReceiptTxCost) GetCost() int64 {
+	if m != nil {
+		return m.Cost
+	}
+	return 0
+}
+
+func (m *ReceiptTxCost) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *ReceiptTxCost) GetBudget() int64 {
+	if m != nil {
+		return m.Budget
+	}
+	return 0
+}
+
 type Query struct {
 	Execer               []byte   `protobuf:"bytes,1,opt,name=execer,proto3" json:"execer,omitempty"`
 	FuncName             string   `protobuf:"bytes,2,opt,name=funcName,proto3" json:"funcName,omitempty"`
@@ -152,7 +542,7 @@ func (m *Query) Reset()         { *m = Query{} }
 func (m *Query) String() string { return proto.CompactTextString(m) }
 func (*Query) ProtoMessage()    {}
 func (*Query) Descriptor() ([]byte, []int) {
-	return fileDescriptor_12d1cdcda51e000f, []int{2}
+	return fileDescriptor_12d1cdcda51e000f, []int{7}
 }
 
 func (m *Query) XXX_Unmarshal(b []byte) error {
@@ -207,7 +597,7 @@ func (m *CreateTxIn) Reset()         { *m = CreateTxIn{} }
 func (m *CreateTxIn) String() string { return proto.CompactTextString(m) }
 func (*CreateTxIn) ProtoMessage()    {}
 func (*CreateTxIn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_12d1cdcda51e000f, []int{3}
+	return fileDescriptor_12d1cdcda51e000f, []int{8}
 }
 
 func (m *CreateTxIn) XXX_Unmarshal(b []byte) error {
@@ -261,7 +651,7 @@ func (m *ArrayConfig) Reset()         { *m = ArrayConfig{} }
 func (m *ArrayConfig) String() string { return proto.CompactTextString(m) }
 func (*ArrayConfig) ProtoMessage()    {}
 func (*ArrayConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_12d1cdcda51e000f, []int{4}
+	return fileDescriptor_12d1cdcda51e000f, []int{9}
 }
 
 func (m *ArrayConfig) XXX_Unmarshal(b []byte) error {
@@ -300,7 +690,7 @@ func (m *StringConfig) Reset()         { *m = StringConfig{} }
 func (m *StringConfig) String() string { return proto.CompactTextString(m) }
 func (*StringConfig) ProtoMessage()    {}
 func (*StringConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_12d1cdcda51e000f, []int{5}
+	return fileDescriptor_12d1cdcda51e000f, []int{10}
 }
 
 func (m *StringConfig) XXX_Unmarshal(b []byte) error {
@@ -339,7 +729,7 @@ func (m *Int32Config) Reset()         { *m = Int32Config{} }
 func (m *Int32Config) String() string { return proto.CompactTextString(m) }
 func (*Int32Config) ProtoMessage()    {}
 func (*Int32Config) Descriptor() ([]byte, []int) {
-	return fileDescriptor_12d1cdcda51e000f, []int{6}
+	return fileDescriptor_12d1cdcda51e000f, []int{11}
 }
 
 func (m *Int32Config) XXX_Unmarshal(b []byte) error {
@@ -385,7 +775,7 @@ func (m *ConfigItem) Reset()         { *m = ConfigItem{} }
 func (m *ConfigItem) String() string { return proto.CompactTextString(m) }
 func (*ConfigItem) ProtoMessage()    {}
 func (*ConfigItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_12d1cdcda51e000f, []int{7}
+	return fileDescriptor_12d1cdcda51e000f, []int{12}
 }
 
 func (m *ConfigItem) XXX_Unmarshal(b []byte) error {
@@ -584,7 +974,7 @@ func (m *ModifyConfig) Reset()         { *m = ModifyConfig{} }
 func (m *ModifyConfig) String() string { return proto.CompactTextString(m) }
 func (*ModifyConfig) ProtoMessage()    {}
 func (*ModifyConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_12d1cdcda51e000f, []int{8}
+	return fileDescriptor_12d1cdcda51e000f, []int{13}
 }
 
 func (m *ModifyConfig) XXX_Unmarshal(b []byte) error {
@@ -645,7 +1035,7 @@ func (m *ReceiptConfig) Reset()         { *m = ReceiptConfig{} }
 func (m *ReceiptConfig) String() string { return proto.CompactTextString(m) }
 func (*ReceiptConfig) ProtoMessage()    {}
 func (*ReceiptConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_12d1cdcda51e000f, []int{9}
+	return fileDescriptor_12d1cdcda51e000f, []int{14}
 }
 
 func (m *ReceiptConfig) XXX_Unmarshal(b []byte) error {
@@ -680,6 +1070,182 @@ func (m *ReceiptConfig) GetCurrent() *ConfigItem {
 	return nil
 }
 
+// 执行器的运行状态, height 高度开始 paused 生效, 之前的状态是 prevPaused
+type ExecStatus struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Paused               bool     `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	Height               int64    `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	PrevPaused           bool     `protobuf:"varint,4,opt,name=prevPaused,proto3" json:"prevPaused,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExecStatus) Reset()         { *m = ExecStatus{} }
+func (m *ExecStatus) String() string { return proto.CompactTextString(m) }
+func (*ExecStatus) ProtoMessage()    {}
+func (*ExecStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12d1cdcda51e000f, []int{15}
+}
+
+func (m *ExecStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExecStatus.Unmarshal(m, b)
+}
+func (m *ExecStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExecStatus.Marshal(b, m, deterministic)
+}
+func (m *ExecStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecStatus.Merge(m, src)
+}
+func (m *ExecStatus) XXX_Size() int {
+	return xxx_messageInfo_ExecStatus.Size(m)
+}
+func (m *ExecStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecStatus proto.InternalMessageInfo
+
+func (m *ExecStatus) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ExecStatus) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *ExecStatus) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ExecStatus) GetPrevPaused() bool {
+	if m != nil {
+		return m.PrevPaused
+	}
+	return false
+}
+
+// 允许调用的执行器列表, height 高度开始生效, 之前使用 prevExecs, 列表为空时不限制
+type ExecWhitelist struct {
+	Execs                []string `protobuf:"bytes,1,rep,name=execs,proto3" json:"execs,omitempty"`
+	Height               int64    `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	PrevExecs            []string `protobuf:"bytes,3,rep,name=prevExecs,proto3" json:"prevExecs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExecWhitelist) Reset()         { *m = ExecWhitelist{} }
+func (m *ExecWhitelist) String() string { return proto.CompactTextString(m) }
+func (*ExecWhitelist) ProtoMessage()    {}
+func (*ExecWhitelist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12d1cdcda51e000f, []int{16}
+}
+
+func (m *ExecWhitelist) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExecWhitelist.Unmarshal(m, b)
+}
+func (m *ExecWhitelist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExecWhitelist.Marshal(b, m, deterministic)
+}
+func (m *ExecWhitelist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecWhitelist.Merge(m, src)
+}
+func (m *ExecWhitelist) XXX_Size() int {
+	return xxx_messageInfo_ExecWhitelist.Size(m)
+}
+func (m *ExecWhitelist) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecWhitelist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecWhitelist proto.InternalMessageInfo
+
+func (m *ExecWhitelist) GetExecs() []string {
+	if m != nil {
+		return m.Execs
+	}
+	return nil
+}
+
+func (m *ExecWhitelist) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ExecWhitelist) GetPrevExecs() []string {
+	if m != nil {
+		return m.PrevExecs
+	}
+	return nil
+}
+
+// 通过链上管理设置的dapp fork高度
+type DappForkHeight struct {
+	Dapp                 string   `protobuf:"bytes,1,opt,name=dapp,proto3" json:"dapp,omitempty"`
+	Fork                 string   `protobuf:"bytes,2,opt,name=fork,proto3" json:"fork,omitempty"`
+	Height               int64    `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DappForkHeight) Reset()         { *m = DappForkHeight{} }
+func (m *DappForkHeight) String() string { return proto.CompactTextString(m) }
+func (*DappForkHeight) ProtoMessage()    {}
+func (*DappForkHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12d1cdcda51e000f, []int{17}
+}
+
+func (m *DappForkHeight) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DappForkHeight.Unmarshal(m, b)
+}
+func (m *DappForkHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DappForkHeight.Marshal(b, m, deterministic)
+}
+func (m *DappForkHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DappForkHeight.Merge(m, src)
+}
+func (m *DappForkHeight) XXX_Size() int {
+	return xxx_messageInfo_DappForkHeight.Size(m)
+}
+func (m *DappForkHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_DappForkHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DappForkHeight proto.InternalMessageInfo
+
+func (m *DappForkHeight) GetDapp() string {
+	if m != nil {
+		return m.Dapp
+	}
+	return ""
+}
+
+func (m *DappForkHeight) GetFork() string {
+	if m != nil {
+		return m.Fork
+	}
+	return ""
+}
+
+func (m *DappForkHeight) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 type ReplyConfig struct {
 	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
@@ -692,7 +1258,7 @@ func (m *ReplyConfig) Reset()         { *m = ReplyConfig{} }
 func (m *ReplyConfig) String() string { return proto.CompactTextString(m) }
 func (*ReplyConfig) ProtoMessage()    {}
 func (*ReplyConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_12d1cdcda51e000f, []int{10}
+	return fileDescriptor_12d1cdcda51e000f, []int{18}
 }
 
 func (m *ReplyConfig) XXX_Unmarshal(b []byte) error {
@@ -742,7 +1308,7 @@ func (m *HistoryCertStore) Reset()         { *m = HistoryCertStore{} }
 func (m *HistoryCertStore) String() string { return proto.CompactTextString(m) }
 func (*HistoryCertStore) ProtoMessage()    {}
 func (*HistoryCertStore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_12d1cdcda51e000f, []int{11}
+	return fileDescriptor_12d1cdcda51e000f, []int{19}
 }
 
 func (m *HistoryCertStore) XXX_Unmarshal(b []byte) error {
@@ -801,6 +1367,11 @@ func (m *HistoryCertStore) GetNxtHeight() int64 {
 func init() {
 	proto.RegisterType((*Genesis)(nil), "types.Genesis")
 	proto.RegisterType((*ExecTxList)(nil), "types.ExecTxList")
+	proto.RegisterType((*ReqSimulateTx)(nil), "types.ReqSimulateTx")
+	proto.RegisterType((*ReplySimulateTx)(nil), "types.ReplySimulateTx")
+	proto.RegisterType((*TraceOp)(nil), "types.TraceOp")
+	proto.RegisterType((*ReplyTraceTx)(nil), "types.ReplyTraceTx")
+	proto.RegisterType((*ReceiptTxCost)(nil), "types.ReceiptTxCost")
 	proto.RegisterType((*Query)(nil), "types.Query")
 	proto.RegisterType((*CreateTxIn)(nil), "types.CreateTxIn")
 	proto.RegisterType((*ArrayConfig)(nil), "types.ArrayConfig")
@@ -809,6 +1380,9 @@ func init() {
 	proto.RegisterType((*ConfigItem)(nil), "types.ConfigItem")
 	proto.RegisterType((*ModifyConfig)(nil), "types.ModifyConfig")
 	proto.RegisterType((*ReceiptConfig)(nil), "types.ReceiptConfig")
+	proto.RegisterType((*ExecStatus)(nil), "types.ExecStatus")
+	proto.RegisterType((*ExecWhitelist)(nil), "types.ExecWhitelist")
+	proto.RegisterType((*DappForkHeight)(nil), "types.DappForkHeight")
 	proto.RegisterType((*ReplyConfig)(nil), "types.ReplyConfig")
 	proto.RegisterType((*HistoryCertStore)(nil), "types.HistoryCertStore")
 }
@@ -816,45 +1390,69 @@ func init() {
 func init() { proto.RegisterFile("executor.proto", fileDescriptor_12d1cdcda51e000f) }
 
 var fileDescriptor_12d1cdcda51e000f = []byte{
-	// 633 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x61, 0x6b, 0xdb, 0x48,
-	0x10, 0x3d, 0x59, 0x76, 0x1c, 0x8f, 0x7d, 0x21, 0xd9, 0x3b, 0x0e, 0x11, 0xae, 0x89, 0x51, 0xd2,
-	0xd4, 0xd0, 0xe2, 0x80, 0x4d, 0x7f, 0x40, 0x63, 0x4a, 0x1d, 0x68, 0x0a, 0x55, 0xdc, 0x2f, 0xf9,
-	0x50, 0xd8, 0xac, 0xc7, 0xf6, 0x12, 0x7b, 0x57, 0xec, 0x8e, 0x82, 0xf5, 0xf7, 0x4a, 0x7f, 0x58,
-	0xd9, 0xb5, 0x6c, 0x89, 0x26, 0x2d, 0xf4, 0x9b, 0xe6, 0xcd, 0xe3, 0xcd, 0xbc, 0x19, 0xcd, 0xc2,
-	0x01, 0xae, 0x51, 0x64, 0xa4, 0x4d, 0x3f, 0x35, 0x9a, 0x34, 0x6b, 0x50, 0x9e, 0xa2, 0x3d, 0x3e,
-	0x22, 0xc3, 0x95, 0xe5, 0x82, 0xa4, 0x56, 0x9b, 0x4c, 0x7c, 0x0a, 0xcd, 0x0f, 0xa8, 0xd0, 0x4a,
-	0xcb, 0xfe, 0x85, 0x86, 0xb4, 0x26, 0x53, 0x51, 0xd0, 0x0d, 0x7a, 0xfb, 0xc9, 0x26, 0x88, 0xbf,
-	0x07, 0x00, 0xef, 0xd7, 0x28, 0x26, 0xeb, 0x8f, 0xd2, 0x12, 0xfb, 0x1f, 0x5a, 0x96, 0x38, 0xe1,
-	0x98, 0xdb, 0x85, 0x27, 0x76, 0x92, 0x12, 0x60, 0xe7, 0x10, 0xd2, 0xda, 0x46, 0xb5, 0x6e, 0xd8,
-	0x6b, 0x0f, 0x58, 0xdf, 0x57, 0xed, 0x4f, 0xca, 0xa2, 0x89, 0x4b, 0x3b, 0x8d, 0xfb, 0xa5, 0x16,
-	0x0f, 0x13, 0xb9, 0xc2, 0x28, 0xec, 0x06, 0xbd, 0x30, 0x29, 0x01, 0xf6, 0x1f, 0xec, 0x2d, 0x50,
-	0xce, 0x17, 0x14, 0xd5, 0x7d, 0xaa, 0x88, 0xd8, 0x09, 0xc0, 0x54, 0xce, 0x66, 0x52, 0x64, 0x4b,
-	0xca, 0xa3, 0x46, 0x37, 0xe8, 0xd5, 0x93, 0x0a, 0xe2, 0x54, 0xa5, 0xbd, 0xc1, 0x55, 0xaa, 0xf5,
-	0x32, 0xda, 0xf3, 0x16, 0x4a, 0x20, 0xfe, 0x02, 0x8d, 0xcf, 0x19, 0x9a, 0xdc, 0xc9, 0xbb, 0xe1,
-	0xa0, 0x29, 0xba, 0x2f, 0x22, 0x76, 0x0c, 0xfb, 0xb3, 0x4c, 0x89, 0x4f, 0x7c, 0x85, 0x51, 0xad,
-	0x1b, 0xf4, 0x5a, 0xc9, 0x2e, 0x66, 0x11, 0x34, 0x53, 0x9e, 0x2f, 0x35, 0x9f, 0xfa, 0x76, 0x3b,
-	0xc9, 0x36, 0x8c, 0xbf, 0x02, 0x8c, 0x0c, 0x72, 0xc2, 0xc9, 0xfa, 0x5a, 0xfd, 0x52, 0xfb, 0x04,
-	0x60, 0xe3, 0xbf, 0xa2, 0x5e, 0x41, 0x7e, 0xa3, 0x7f, 0x06, 0xed, 0x77, 0xc6, 0xf0, 0x7c, 0xa4,
-	0xd5, 0x4c, 0xce, 0xdd, 0x8a, 0x1e, 0xf9, 0x32, 0x73, 0x53, 0x0b, 0x7b, 0xad, 0x64, 0x13, 0xc4,
-	0xe7, 0xd0, 0xb9, 0x25, 0x23, 0xd5, 0xfc, 0x29, 0x2b, 0x28, 0x59, 0x67, 0xd0, 0xbe, 0x56, 0x34,
-	0x1c, 0x3c, 0x47, 0x6a, 0x6c, 0x49, 0x6e, 0xdb, 0x1b, 0xc2, 0x35, 0xe1, 0x8a, 0x1d, 0x42, 0xf8,
-	0x80, 0xb9, 0x77, 0xd3, 0x4a, 0xdc, 0x27, 0x63, 0x50, 0xe7, 0xd3, 0xa9, 0x29, 0x4c, 0xf8, 0x6f,
-	0x76, 0x01, 0x21, 0x37, 0xc6, 0x0b, 0x95, 0x5b, 0xaf, 0xb4, 0x3d, 0xfe, 0x2b, 0x71, 0x04, 0xf6,
-	0x0a, 0x42, 0x4b, 0xc6, 0xaf, 0xb5, 0x3d, 0xf8, 0xa7, 0xe0, 0x55, 0x3b, 0x77, 0x44, 0x4b, 0x5e,
-	0x50, 0x2a, 0xf2, 0x3b, 0x2e, 0x05, 0x2b, 0xcd, 0x3b, 0x9e, 0x54, 0xc4, 0x0e, 0xa0, 0x36, 0xc9,
-	0xa3, 0xb6, 0x37, 0x50, 0x9b, 0xe4, 0x57, 0xcd, 0xc2, 0x53, 0x7c, 0x07, 0x9d, 0x1b, 0x3d, 0x95,
-	0xb3, 0xed, 0xdc, 0x9e, 0xfa, 0xd8, 0xd9, 0xaf, 0x55, 0x66, 0xe4, 0x04, 0x75, 0x5a, 0x8c, 0xad,
-	0xa6, 0xd3, 0x9d, 0xdb, 0x7a, 0xe9, 0x36, 0x16, 0xf0, 0x77, 0x82, 0x02, 0x65, 0x4a, 0x85, 0xf8,
-	0x4b, 0xa8, 0xa7, 0x06, 0x1f, 0xbd, 0x7a, 0x7b, 0x70, 0x54, 0xb4, 0x5b, 0x4e, 0x31, 0xf1, 0x69,
-	0xf6, 0x1a, 0x9a, 0x22, 0x33, 0x06, 0x15, 0xf9, 0x9a, 0xcf, 0x32, 0xb7, 0x8c, 0xf8, 0x2d, 0xb4,
-	0x13, 0x4c, 0x97, 0x7f, 0xd8, 0x7f, 0xfc, 0x2d, 0x80, 0xc3, 0xb1, 0xb4, 0xa4, 0x4d, 0x3e, 0x42,
-	0x43, 0xb7, 0xa4, 0x0d, 0xba, 0xc3, 0x30, 0x5a, 0x93, 0x40, 0x43, 0x36, 0x0a, 0xba, 0xa1, 0x3b,
-	0xd9, 0x1d, 0xc0, 0xde, 0xc0, 0x91, 0x54, 0x84, 0x66, 0x85, 0x53, 0xc9, 0x09, 0x47, 0x9e, 0x55,
-	0xf3, 0xac, 0xa7, 0x09, 0x76, 0x01, 0x07, 0x06, 0x1f, 0xb5, 0xe0, 0xee, 0xdf, 0x75, 0x0f, 0x82,
-	0xff, 0x13, 0x3b, 0xc9, 0x4f, 0xa8, 0xab, 0x29, 0x32, 0x33, 0x46, 0x39, 0xa7, 0x45, 0x71, 0xc7,
-	0x25, 0xe0, 0xb2, 0x6a, 0x4d, 0xe3, 0xcd, 0x95, 0x37, 0x36, 0xd9, 0x1d, 0x70, 0x75, 0x7a, 0xf7,
-	0x62, 0x2e, 0x69, 0x91, 0xdd, 0xf7, 0x85, 0x5e, 0x5d, 0x0e, 0x87, 0x42, 0x5d, 0x8a, 0x05, 0x97,
-	0x6a, 0x38, 0xbc, 0xf4, 0x03, 0xbb, 0xdf, 0xf3, 0x4f, 0xd7, 0xf0, 0x47, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x95, 0x0a, 0xd4, 0x55, 0xe6, 0x04, 0x00, 0x00,
+	// 1020 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xef, 0x6e, 0xe3, 0x44,
+	0x10, 0xc7, 0x76, 0xd2, 0xb4, 0xd3, 0x5c, 0xb9, 0x1a, 0x84, 0xac, 0x13, 0xdc, 0x45, 0xbe, 0xe3,
+	0x88, 0x00, 0xb5, 0xd2, 0x45, 0x3c, 0x00, 0x2d, 0x07, 0xad, 0x8e, 0x83, 0x63, 0x1b, 0x84, 0x74,
+	0x48, 0x48, 0x1b, 0x7b, 0xd3, 0xac, 0x9a, 0x78, 0xcd, 0xee, 0xba, 0xc4, 0x0f, 0xc7, 0x17, 0xc4,
+	0x27, 0xde, 0x83, 0xf7, 0x40, 0x33, 0xbb, 0x8e, 0xdd, 0xf4, 0x5a, 0x89, 0x6f, 0x3b, 0xb3, 0x3f,
+	0xcf, 0xce, 0xef, 0x37, 0x7f, 0x12, 0x38, 0x10, 0x6b, 0x91, 0x55, 0x56, 0xe9, 0xa3, 0x52, 0x2b,
+	0xab, 0xe2, 0xbe, 0xad, 0x4b, 0x61, 0x1e, 0x1d, 0x5a, 0xcd, 0x0b, 0xc3, 0x33, 0x2b, 0x55, 0xe1,
+	0x6e, 0xd2, 0x27, 0x30, 0xf8, 0x4e, 0x14, 0xc2, 0x48, 0x13, 0x7f, 0x08, 0x7d, 0x69, 0x74, 0x55,
+	0x24, 0xc1, 0x28, 0x18, 0xef, 0x32, 0x67, 0xa4, 0x7f, 0x07, 0x00, 0x2f, 0xd7, 0x22, 0x9b, 0xae,
+	0xbf, 0x97, 0xc6, 0xc6, 0x1f, 0xc3, 0x9e, 0xb1, 0xdc, 0x8a, 0x33, 0x6e, 0x16, 0x04, 0x1c, 0xb2,
+	0xd6, 0x11, 0x3f, 0x83, 0xc8, 0xae, 0x4d, 0x12, 0x8e, 0xa2, 0xf1, 0xfe, 0x8b, 0xf8, 0x88, 0x5e,
+	0x3d, 0x9a, 0xb6, 0x8f, 0x32, 0xbc, 0xc6, 0x18, 0xb3, 0xa5, 0xca, 0xae, 0xa6, 0x72, 0x25, 0x92,
+	0x68, 0x14, 0x8c, 0x23, 0xd6, 0x3a, 0xe2, 0x8f, 0x60, 0x67, 0x21, 0xe4, 0xe5, 0xc2, 0x26, 0x3d,
+	0xba, 0xf2, 0x56, 0xfc, 0x18, 0x20, 0x97, 0xf3, 0xb9, 0xcc, 0xaa, 0xa5, 0xad, 0x93, 0xfe, 0x28,
+	0x18, 0xf7, 0x58, 0xc7, 0x83, 0x51, 0xa5, 0x79, 0x2d, 0x56, 0xa5, 0x52, 0xcb, 0x64, 0x87, 0x28,
+	0xb4, 0x8e, 0xf4, 0x15, 0x3c, 0x60, 0xe2, 0xf7, 0x0b, 0xb9, 0xaa, 0x96, 0xdc, 0x8a, 0xe9, 0x3a,
+	0x4e, 0x21, 0xb4, 0x6b, 0x62, 0xf0, 0xee, 0x4c, 0x43, 0xbb, 0xc6, 0x54, 0xca, 0x6a, 0x76, 0x25,
+	0xea, 0x24, 0x24, 0xa6, 0xde, 0x4a, 0xff, 0x0d, 0xe0, 0x7d, 0x26, 0xca, 0x65, 0xdd, 0x89, 0xd7,
+	0xa6, 0x1d, 0xdc, 0x48, 0xfb, 0x86, 0x60, 0xe1, 0xb6, 0x60, 0x0f, 0x21, 0x9a, 0x8b, 0x46, 0x04,
+	0x3c, 0x36, 0x12, 0xf6, 0xee, 0x97, 0xf0, 0x73, 0xd8, 0xd5, 0x22, 0x13, 0xb2, 0xb4, 0x26, 0xe9,
+	0x13, 0xf4, 0xc0, 0x43, 0x99, 0x73, 0xb3, 0xcd, 0x7d, 0xfc, 0x08, 0xb1, 0x3c, 0x7f, 0x25, 0x6a,
+	0x93, 0xec, 0x8c, 0xa2, 0xf1, 0x90, 0x6d, 0x6c, 0xcc, 0xee, 0x0f, 0x2d, 0xad, 0xa0, 0xcb, 0x01,
+	0x5d, 0xb6, 0x8e, 0xb4, 0x86, 0xc1, 0x54, 0xf3, 0x4c, 0xfc, 0x58, 0xc6, 0x07, 0x10, 0xe6, 0x33,
+	0xa2, 0xb6, 0xc7, 0xc2, 0x7c, 0x86, 0xb6, 0x2a, 0x89, 0xcf, 0x1e, 0x0b, 0x55, 0x19, 0xc7, 0xd0,
+	0x2b, 0xb8, 0x2f, 0xe7, 0x1e, 0xa3, 0x33, 0x92, 0x43, 0xed, 0x7a, 0x44, 0x1a, 0x8f, 0x88, 0x2a,
+	0xb5, 0xb8, 0xa6, 0xea, 0x0d, 0x19, 0x9d, 0xb1, 0xed, 0xae, 0xf9, 0xb2, 0x12, 0x54, 0xb3, 0x21,
+	0x73, 0x46, 0xfa, 0x4f, 0x00, 0x43, 0x92, 0x98, 0x12, 0xb8, 0x47, 0x5f, 0xec, 0xda, 0x22, 0x17,
+	0x6b, 0xca, 0x25, 0x62, 0xce, 0xb8, 0xa9, 0x7a, 0xb4, 0xad, 0xba, 0xab, 0x7d, 0xef, 0xde, 0xda,
+	0x8f, 0x61, 0xe0, 0x15, 0xa4, 0x6c, 0x6f, 0x0b, 0xdc, 0x5c, 0xc7, 0x23, 0x88, 0x54, 0xe9, 0xa4,
+	0x6d, 0x51, 0x5e, 0x37, 0x86, 0x57, 0xe9, 0x9f, 0x01, 0x76, 0x1f, 0xa1, 0xa7, 0xeb, 0x53, 0x65,
+	0x28, 0x6b, 0xac, 0x81, 0xf1, 0x64, 0x9c, 0x81, 0x59, 0xe3, 0xe1, 0xa4, 0xb6, 0xc2, 0x78, 0x3e,
+	0xad, 0x03, 0x15, 0xa0, 0xd2, 0x18, 0xdf, 0x2e, 0xde, 0xc2, 0xc1, 0xa0, 0x93, 0xfb, 0xcc, 0x0d,
+	0x4d, 0xc7, 0x83, 0xa2, 0x67, 0xca, 0x38, 0x1a, 0x11, 0xeb, 0x65, 0xfe, 0x7d, 0xab, 0x2c, 0x77,
+	0x83, 0x12, 0x31, 0x67, 0xe0, 0x0b, 0xb3, 0x2a, 0xbf, 0x14, 0x36, 0x19, 0xb8, 0x17, 0x9c, 0x95,
+	0xfe, 0x0c, 0xfd, 0x9f, 0x2a, 0xa1, 0x6b, 0x04, 0xe0, 0x66, 0x11, 0xda, 0x8f, 0xbe, 0xb7, 0xb0,
+	0xc5, 0xe6, 0x55, 0x91, 0xfd, 0x80, 0x1d, 0xe0, 0x7a, 0x62, 0x63, 0xc7, 0x09, 0x0c, 0x4a, 0x5e,
+	0x2f, 0x15, 0xcf, 0x7d, 0x21, 0x1a, 0x33, 0xfd, 0x0d, 0xe0, 0x54, 0x0b, 0x1a, 0x9f, 0xf3, 0xe2,
+	0xce, 0xd8, 0x8f, 0x01, 0x5c, 0x59, 0x3a, 0xd1, 0x3b, 0x9e, 0x7b, 0xe2, 0x3f, 0x85, 0xfd, 0xaf,
+	0xb5, 0xe6, 0xf5, 0xa9, 0x2a, 0xe6, 0xf2, 0xb2, 0x6d, 0xb4, 0x68, 0x14, 0x8d, 0xf7, 0x9a, 0x46,
+	0x7b, 0x06, 0xc3, 0x0b, 0xab, 0x65, 0x71, 0x79, 0x1b, 0x15, 0xb4, 0xa8, 0xa7, 0xb0, 0x7f, 0x5e,
+	0xd8, 0xc9, 0x8b, 0x77, 0x81, 0xfa, 0x0d, 0x08, 0x57, 0xa5, 0x03, 0x9c, 0x5b, 0xb1, 0x6a, 0xda,
+	0xdf, 0xcd, 0x4c, 0xd3, 0xfe, 0x3c, 0xcf, 0xb5, 0x27, 0x41, 0xe7, 0xf8, 0x39, 0x44, 0x5c, 0xeb,
+	0x24, 0xba, 0xd1, 0x8c, 0x9d, 0xb4, 0xcf, 0xde, 0x63, 0x08, 0x88, 0x3f, 0x83, 0xc8, 0x58, 0xed,
+	0x9b, 0xf6, 0x03, 0x8f, 0xeb, 0x66, 0x8e, 0x40, 0x63, 0x29, 0xa0, 0x2c, 0x9a, 0xa6, 0x6d, 0x02,
+	0x76, 0x92, 0x47, 0x9c, 0x2c, 0x2c, 0x4e, 0xf0, 0xb4, 0x4e, 0xf6, 0x89, 0x40, 0x38, 0xad, 0x4f,
+	0x06, 0x9e, 0x53, 0xfa, 0x16, 0x86, 0xaf, 0x55, 0x2e, 0xe7, 0x8d, 0x6e, 0xb7, 0x79, 0x6c, 0xe8,
+	0x87, 0x1d, 0x8d, 0xfc, 0x4a, 0x88, 0xba, 0x2b, 0x81, 0xd8, 0xf6, 0x5a, 0xb6, 0x69, 0xb6, 0x19,
+	0x04, 0x1f, 0xfc, 0x53, 0xbf, 0x11, 0xdc, 0x22, 0x3e, 0xf4, 0xe9, 0xb6, 0x2a, 0xfa, 0x25, 0xf1,
+	0x05, 0x0c, 0xb2, 0x4a, 0x6b, 0x51, 0xd8, 0x24, 0xbc, 0x0b, 0xd9, 0x20, 0xd2, 0xd2, 0xfd, 0x62,
+	0x5d, 0x58, 0x6e, 0x2b, 0xb3, 0xd9, 0x4c, 0x41, 0x67, 0x33, 0xe1, 0x62, 0xe7, 0x95, 0x11, 0x39,
+	0x45, 0xdb, 0x65, 0xde, 0xea, 0x2c, 0x99, 0x68, 0xfb, 0xb7, 0x07, 0xd3, 0x78, 0xe3, 0xbe, 0xe9,
+	0xd1, 0x37, 0x1d, 0x4f, 0xfa, 0x2b, 0x3c, 0xc0, 0x17, 0x7f, 0x59, 0x48, 0x2b, 0x96, 0xd2, 0xcd,
+	0x17, 0xb6, 0x2f, 0xce, 0x37, 0xf5, 0x1a, 0x19, 0x9d, 0xf0, 0xe1, 0xf6, 0x6f, 0x04, 0x06, 0x7b,
+	0x49, 0x5f, 0xb8, 0xee, 0x6c, 0x1d, 0xe9, 0x1b, 0x38, 0xf8, 0x86, 0x97, 0xe5, 0xb7, 0x4a, 0x5f,
+	0x9d, 0x39, 0x7c, 0x0c, 0xbd, 0x9c, 0x97, 0x65, 0x43, 0x09, 0xcf, 0xe8, 0x9b, 0x2b, 0x7d, 0xd5,
+	0xf4, 0x16, 0x9e, 0xef, 0xa2, 0x93, 0x7e, 0x05, 0xfb, 0xb4, 0x5b, 0xff, 0x5f, 0x81, 0xd3, 0xbf,
+	0x02, 0x78, 0x78, 0x26, 0x8d, 0x55, 0xba, 0x3e, 0x15, 0xda, 0x5e, 0x58, 0xa5, 0x05, 0xed, 0x2c,
+	0xa5, 0x6c, 0x26, 0xb4, 0x75, 0x6c, 0x87, 0xac, 0x75, 0xc4, 0x5f, 0xc2, 0xa1, 0x2c, 0xac, 0xd0,
+	0x2b, 0x91, 0x4b, 0x6e, 0xc5, 0x29, 0xa1, 0x42, 0x42, 0xdd, 0xbe, 0x88, 0x9f, 0xc3, 0x81, 0x16,
+	0xd7, 0x2a, 0xe3, 0x38, 0xdc, 0xf8, 0x77, 0x83, 0xc4, 0x18, 0xb2, 0x2d, 0x2f, 0xbe, 0x99, 0x55,
+	0x1a, 0xc5, 0xb0, 0x0b, 0xbf, 0xf0, 0x5a, 0x07, 0xde, 0x16, 0x6b, 0xeb, 0xa4, 0xf2, 0x4b, 0xaf,
+	0x75, 0x9c, 0x3c, 0x79, 0xfb, 0xc9, 0xa5, 0xb4, 0x8b, 0x6a, 0x76, 0x94, 0xa9, 0xd5, 0xf1, 0x64,
+	0x92, 0x15, 0xc7, 0xd9, 0x82, 0xcb, 0x62, 0x32, 0x39, 0xa6, 0x8e, 0x9a, 0xed, 0xd0, 0x1f, 0xa3,
+	0xc9, 0x7f, 0x03, 0x00, 0x28, 0x3d, 0xc0, 0x26, 0x44, 0x09, 0x00, 0x00,
 }
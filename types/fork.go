@@ -205,6 +205,7 @@ func SetTestNetFork() {
 	systemFork.SetFork("chain33", "ForkTxHeight", 806578)
 	systemFork.SetFork("chain33", "ForkTxGroupPara", 806578)
 	systemFork.SetFork("chain33", "ForkCheckBlockTime", 1200000)
+	systemFork.SetFork("chain33", "ForkTxCost", MaxHeight)
 }
 
 func setLocalFork() {
@@ -213,12 +214,15 @@ func setLocalFork() {
 		panic(err)
 	}
 	systemFork.ReplaceFork("local", "ForkBlockHash", 1)
+	//资源计量需要明确开启
+	systemFork.ReplaceFork("local", "ForkTxCost", MaxHeight)
 }
 
 //paraName not used currently
 func setForkForPara(paraName string) {
 	systemFork.CloneZero("chain33", paraName)
 	systemFork.ReplaceFork(paraName, "ForkBlockHash", 1)
+	systemFork.ReplaceFork(paraName, "ForkTxCost", MaxHeight)
 }
 
 // IsFork 是否系统 fork高度
@@ -241,6 +245,11 @@ func SetDappFork(title, dapp, fork string, height int64) {
 	systemFork.SetDappFork(title, dapp, fork, height)
 }
 
+// ReplaceFork 替换系统fork高度, 一般用于测试中开启默认关闭的fork
+func ReplaceFork(title, fork string, height int64) {
+	systemFork.ReplaceFork(title, fork, height)
+}
+
 // RegisterDappFork 注册dapp fork高度
 func RegisterDappFork(dapp, fork string, height int64) {
 	systemFork.SetDappFork("chain33", dapp, fork, height)
@@ -266,6 +275,7 @@ func IsEnableFork(height int64, fork string, enable bool) bool {
 
 //fork 设置规则：
 //所有的fork都需要有明确的配置，不开启fork 配置为 -1
+//默认不开启(高度为 MaxHeight)的fork 可以不配置, 这样增加新的fork之后, 旧的配置文件仍然可以使用
 func initForkConfig(title string, forks *ForkList) {
 	if title == "chain33" { //chain33 fork is default set in code
 		return
@@ -279,6 +289,10 @@ func initForkConfig(title string, forks *ForkList) {
 	for k := range chain33fork {
 		if !strings.Contains(k, ".") {
 			if _, ok := forks.System[k]; !ok {
+				if chain33fork[k] == MaxHeight {
+					systemFork.SetFork(title, k, MaxHeight)
+					continue
+				}
 				s += "system fork " + k + " not config\n"
 			}
 		}
@@ -302,6 +316,10 @@ func initForkConfig(title string, forks *ForkList) {
 				continue
 			}
 		}
+		if chain33fork[k] == MaxHeight {
+			systemFork.SetDappFork(title, exec, name, MaxHeight)
+			continue
+		}
 		s += "exec " + exec + " name " + name + " not config\n"
 	}
 	//配置检查没有问题后，开始设置配置
@@ -5,6 +5,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -19,3 +20,23 @@ func TestForks(t *testing.T) {
 	assert.Equal(t, systemFork.IsFork("local", 1, "ForkBlockHash"), true)
 	assert.Equal(t, systemFork.IsFork("local", 1, "ForkTransferExec"), true)
 }
+
+func TestInitForkConfigDefaultOff(t *testing.T) {
+	allow := AllowUserExec
+	defer func() { AllowUserExec = allow }()
+	//默认不开启的fork没有配置时不开启
+	forks := &ForkList{System: make(map[string]int64), Sub: make(map[string]map[string]int64)}
+	for k, v := range systemFork.GetAll("chain33") {
+		if !strings.Contains(k, ".") && k != "ForkTxCost" {
+			forks.System[k] = v
+		}
+	}
+	assert.Equal(t, int64(MaxHeight), systemFork.GetFork("chain33", "ForkTxCost"))
+	initForkConfig("forktest", forks)
+	assert.Equal(t, int64(MaxHeight), systemFork.GetFork("forktest", "ForkTxCost"))
+	assert.False(t, systemFork.IsFork("forktest", 1000000, "ForkTxCost"))
+
+	//默认开启的fork必须配置
+	delete(forks.System, "ForkBlockHash")
+	assert.Panics(t, func() { initForkConfig("forktest2", forks) })
+}
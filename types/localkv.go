@@ -11,14 +11,24 @@ import (
 
 // 定义key值
 var (
-	LocalPrefix       = []byte("LODB")
-	FlagTxQuickIndex  = []byte("FLAG:FlagTxQuickIndex")
-	FlagKeyMVCC       = []byte("FLAG:keyMVCCFlag")
-	TxHashPerfix      = []byte("TX:")
-	TxShortHashPerfix = []byte("STX:")
-	TxAddrHash        = []byte("TxAddrHash:")
-	TxAddrDirHash     = []byte("TxAddrDirHash:")
-	AddrTxsCount      = []byte("AddrTxsCount:")
+	LocalPrefix        = []byte("LODB")
+	FlagTxQuickIndex   = []byte("FLAG:FlagTxQuickIndex")
+	FlagKeyMVCC        = []byte("FLAG:keyMVCCFlag")
+	TxHashPerfix       = []byte("TX:")
+	TxShortHashPerfix  = []byte("STX:")
+	TxAddrHash         = []byte("TxAddrHash:")
+	TxAddrDirHash      = []byte("TxAddrDirHash:")
+	AddrTxsCount       = []byte("AddrTxsCount:")
+	FlagLogIndex       = []byte("FLAG:FlagLogIndex")
+	LogIndex           = []byte("LogIndex:")
+	LogAddrIndex       = []byte("LogAddrIndex:")
+	FlagBalanceHistory = []byte("FLAG:FlagBalanceHistory")
+	BalanceHistory     = []byte("BalanceHistory:")
+	ExecLocalDBVersion = []byte("ExecLocalDBVersion:")
+
+	//按照执行器和资产划分的余额变化
+	BalanceHistoryExec  = []byte("BalanceHistoryExec:")
+	BalanceHistoryAsset = []byte("BalanceHistoryAsset:")
 )
 
 // GetLocalDBKeyList 获取localdb的key列表
@@ -58,6 +68,30 @@ func CalcAddrTxsCountKey(addr string) []byte {
 	return append(AddrTxsCount, []byte(addr)...)
 }
 
+//CalcLogIndexKey 执行器某个类型的log, key=LogIndex:execer:ty:height*100000+index:logindex
+func CalcLogIndexKey(execer string, ty int32, heightindex string) []byte {
+	return append(LogIndex, []byte(fmt.Sprintf("%s:%d:%s", execer, ty, heightindex))...)
+}
+
+//CalcLogAddrIndexKey 地址相关的某个类型的log, key=LogAddrIndex:addr:execer:ty:height*100000+index:logindex
+func CalcLogAddrIndexKey(addr string, execer string, ty int32, heightindex string) []byte {
+	return append(LogAddrIndex, []byte(fmt.Sprintf("%s:%s:%d:%s", addr, execer, ty, heightindex))...)
+}
+
+//CalcBalanceHistoryKey 地址的余额变化, 三种索引都按照高度排序, heightindex 为空时得到的是查询用的前缀
+//所有资产: key=BalanceHistory:addr:height*100000+index:logindex
+//执行器的资产: key=BalanceHistoryExec:addr:execer:height*100000+index:logindex
+//某个资产: key=BalanceHistoryAsset:addr:execer:symbol:height*100000+index:logindex
+func CalcBalanceHistoryKey(addr, execer, symbol, heightindex string) []byte {
+	if execer == "" {
+		return append(BalanceHistory, []byte(fmt.Sprintf("%s:%s", addr, heightindex))...)
+	}
+	if symbol == "" {
+		return append(BalanceHistoryExec, []byte(fmt.Sprintf("%s:%s:%s", addr, execer, heightindex))...)
+	}
+	return append(BalanceHistoryAsset, []byte(fmt.Sprintf("%s:%s:%s:%s", addr, execer, symbol, heightindex))...)
+}
+
 //StatisticFlag 用于记录统计的key
 func StatisticFlag() []byte {
 	return []byte("Statistics:Flag")
@@ -83,3 +117,8 @@ func TotalFeeKey(hash []byte) []byte {
 	key := []byte("TotalFeeKey:")
 	return append(key, hash...)
 }
+
+//CalcExecLocalDBVersionKey 执行器localdb版本的key
+func CalcExecLocalDBVersionKey(name string) []byte {
+	return append(ExecLocalDBVersion, []byte(name)...)
+}
@@ -161,7 +161,11 @@ type P2PVersion struct {
 	///用户代理
 	UserAgent string `protobuf:"bytes,7,opt,name=userAgent,proto3" json:"userAgent,omitempty"`
 	///当前节点的高度
-	StartHeight          int64    `protobuf:"varint,8,opt,name=startHeight,proto3" json:"startHeight,omitempty"`
+	StartHeight int64 `protobuf:"varint,8,opt,name=startHeight,proto3" json:"startHeight,omitempty"`
+	///节点 ID, 节点公钥的 sha256
+	NodeID []byte `protobuf:"bytes,10,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	///节点支持的能力, 每一位表示一种能力
+	Capabilities         uint64   `protobuf:"varint,11,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -248,7 +252,20 @@ func (m *P2PVersion) GetStartHeight() int64 {
 	return 0
 }
 
-//*
+func (m *P2PVersion) GetNodeID() []byte {
+	if m != nil {
+		return m.NodeID
+	}
+	return nil
+}
+
+func (m *P2PVersion) GetCapabilities() uint64 {
+	if m != nil {
+		return m.Capabilities
+	}
+	return 0
+}
+
 // P2P 版本返回
 type P2PVerAck struct {
 	Version              int32    `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
@@ -553,8 +570,301 @@ func (m *P2PAddrList) GetPeerinfo() []*P2PPeerInfo {
 	return nil
 }
 
-//*
-// 节点外网信息
+//查找和 target 距离最近的节点
+type P2PFindNode struct {
+	Version              int32    `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Target               []byte   `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *P2PFindNode) Reset()         { *m = P2PFindNode{} }
+func (m *P2PFindNode) String() string { return proto.CompactTextString(m) }
+func (*P2PFindNode) ProtoMessage()    {}
+func (*P2PFindNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{9}
+}
+
+func (m *P2PFindNode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_P2PFindNode.Unmarshal(m, b)
+}
+func (m *P2PFindNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_P2PFindNode.Marshal(b, m, deterministic)
+}
+func (m *P2PFindNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_P2PFindNode.Merge(m, src)
+}
+func (m *P2PFindNode) XXX_Size() int {
+	return xxx_messageInfo_P2PFindNode.Size(m)
+}
+func (m *P2PFindNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_P2PFindNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_P2PFindNode proto.InternalMessageInfo
+
+func (m *P2PFindNode) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *P2PFindNode) GetTarget() []byte {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+type P2PNode struct {
+	NodeID               []byte   `protobuf:"bytes,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *P2PNode) Reset()         { *m = P2PNode{} }
+func (m *P2PNode) String() string { return proto.CompactTextString(m) }
+func (*P2PNode) ProtoMessage()    {}
+func (*P2PNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{10}
+}
+
+func (m *P2PNode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_P2PNode.Unmarshal(m, b)
+}
+func (m *P2PNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_P2PNode.Marshal(b, m, deterministic)
+}
+func (m *P2PNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_P2PNode.Merge(m, src)
+}
+func (m *P2PNode) XXX_Size() int {
+	return xxx_messageInfo_P2PNode.Size(m)
+}
+func (m *P2PNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_P2PNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_P2PNode proto.InternalMessageInfo
+
+func (m *P2PNode) GetNodeID() []byte {
+	if m != nil {
+		return m.NodeID
+	}
+	return nil
+}
+
+func (m *P2PNode) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+type P2PNodes struct {
+	Nodes                []*P2PNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *P2PNodes) Reset()         { *m = P2PNodes{} }
+func (m *P2PNodes) String() string { return proto.CompactTextString(m) }
+func (*P2PNodes) ProtoMessage()    {}
+func (*P2PNodes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{11}
+}
+
+func (m *P2PNodes) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_P2PNodes.Unmarshal(m, b)
+}
+func (m *P2PNodes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_P2PNodes.Marshal(b, m, deterministic)
+}
+func (m *P2PNodes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_P2PNodes.Merge(m, src)
+}
+func (m *P2PNodes) XXX_Size() int {
+	return xxx_messageInfo_P2PNodes.Size(m)
+}
+func (m *P2PNodes) XXX_DiscardUnknown() {
+	xxx_messageInfo_P2PNodes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_P2PNodes proto.InternalMessageInfo
+
+func (m *P2PNodes) GetNodes() []*P2PNode {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+//请求交易的默克尔证明
+type P2PGetTxProof struct {
+	Version              int32    `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Hash                 []byte   `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *P2PGetTxProof) Reset()         { *m = P2PGetTxProof{} }
+func (m *P2PGetTxProof) String() string { return proto.CompactTextString(m) }
+func (*P2PGetTxProof) ProtoMessage()    {}
+func (*P2PGetTxProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{12}
+}
+
+func (m *P2PGetTxProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_P2PGetTxProof.Unmarshal(m, b)
+}
+func (m *P2PGetTxProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_P2PGetTxProof.Marshal(b, m, deterministic)
+}
+func (m *P2PGetTxProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_P2PGetTxProof.Merge(m, src)
+}
+func (m *P2PGetTxProof) XXX_Size() int {
+	return xxx_messageInfo_P2PGetTxProof.Size(m)
+}
+func (m *P2PGetTxProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_P2PGetTxProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_P2PGetTxProof proto.InternalMessageInfo
+
+func (m *P2PGetTxProof) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *P2PGetTxProof) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+//请求指定高度的状态证明, 证明的根为该高度区块头中的 stateHash
+type P2PGetStateProof struct {
+	Version              int32    `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Height               int64    `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Keys                 [][]byte `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *P2PGetStateProof) Reset()         { *m = P2PGetStateProof{} }
+func (m *P2PGetStateProof) String() string { return proto.CompactTextString(m) }
+func (*P2PGetStateProof) ProtoMessage()    {}
+func (*P2PGetStateProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{13}
+}
+
+func (m *P2PGetStateProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_P2PGetStateProof.Unmarshal(m, b)
+}
+func (m *P2PGetStateProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_P2PGetStateProof.Marshal(b, m, deterministic)
+}
+func (m *P2PGetStateProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_P2PGetStateProof.Merge(m, src)
+}
+func (m *P2PGetStateProof) XXX_Size() int {
+	return xxx_messageInfo_P2PGetStateProof.Size(m)
+}
+func (m *P2PGetStateProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_P2PGetStateProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_P2PGetStateProof proto.InternalMessageInfo
+
+func (m *P2PGetStateProof) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *P2PGetStateProof) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *P2PGetStateProof) GetKeys() [][]byte {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+//状态证明, key 不存在时 value 和 proof 都为空
+type P2PStateProof struct {
+	StateHash            []byte   `protobuf:"bytes,1,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	Values               [][]byte `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	Proofs               [][]byte `protobuf:"bytes,3,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *P2PStateProof) Reset()         { *m = P2PStateProof{} }
+func (m *P2PStateProof) String() string { return proto.CompactTextString(m) }
+func (*P2PStateProof) ProtoMessage()    {}
+func (*P2PStateProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{14}
+}
+
+func (m *P2PStateProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_P2PStateProof.Unmarshal(m, b)
+}
+func (m *P2PStateProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_P2PStateProof.Marshal(b, m, deterministic)
+}
+func (m *P2PStateProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_P2PStateProof.Merge(m, src)
+}
+func (m *P2PStateProof) XXX_Size() int {
+	return xxx_messageInfo_P2PStateProof.Size(m)
+}
+func (m *P2PStateProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_P2PStateProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_P2PStateProof proto.InternalMessageInfo
+
+func (m *P2PStateProof) GetStateHash() []byte {
+	if m != nil {
+		return m.StateHash
+	}
+	return nil
+}
+
+func (m *P2PStateProof) GetValues() [][]byte {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *P2PStateProof) GetProofs() [][]byte {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
+}
+
+//节点外网信息
 type P2PExternalInfo struct {
 	///节点的外网地址
 	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
@@ -569,7 +879,7 @@ func (m *P2PExternalInfo) Reset()         { *m = P2PExternalInfo{} }
 func (m *P2PExternalInfo) String() string { return proto.CompactTextString(m) }
 func (*P2PExternalInfo) ProtoMessage()    {}
 func (*P2PExternalInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{9}
+	return fileDescriptor_e7fdddb109e6467a, []int{15}
 }
 
 func (m *P2PExternalInfo) XXX_Unmarshal(b []byte) error {
@@ -619,7 +929,7 @@ func (m *P2PGetBlocks) Reset()         { *m = P2PGetBlocks{} }
 func (m *P2PGetBlocks) String() string { return proto.CompactTextString(m) }
 func (*P2PGetBlocks) ProtoMessage()    {}
 func (*P2PGetBlocks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{10}
+	return fileDescriptor_e7fdddb109e6467a, []int{16}
 }
 
 func (m *P2PGetBlocks) XXX_Unmarshal(b []byte) error {
@@ -674,7 +984,7 @@ func (m *P2PGetMempool) Reset()         { *m = P2PGetMempool{} }
 func (m *P2PGetMempool) String() string { return proto.CompactTextString(m) }
 func (*P2PGetMempool) ProtoMessage()    {}
 func (*P2PGetMempool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{11}
+	return fileDescriptor_e7fdddb109e6467a, []int{17}
 }
 
 func (m *P2PGetMempool) XXX_Unmarshal(b []byte) error {
@@ -713,7 +1023,7 @@ func (m *P2PInv) Reset()         { *m = P2PInv{} }
 func (m *P2PInv) String() string { return proto.CompactTextString(m) }
 func (*P2PInv) ProtoMessage()    {}
 func (*P2PInv) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{12}
+	return fileDescriptor_e7fdddb109e6467a, []int{18}
 }
 
 func (m *P2PInv) XXX_Unmarshal(b []byte) error {
@@ -741,14 +1051,16 @@ func (m *P2PInv) GetInvs() []*Inventory {
 	return nil
 }
 
-// ty=MSG_TX MSG_BLOCK
+// ty=MSG_TX MSG_BLOCK MSG_BLOCKTX
 type Inventory struct {
-	//类型，数据类型，MSG_TX MSG_BLOCK
+	//类型，数据类型，MSG_TX MSG_BLOCK MSG_BLOCKTX
 	Ty int32 `protobuf:"varint,1,opt,name=ty,proto3" json:"ty,omitempty"`
 	///哈希
 	Hash []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
 	//高度
-	Height               int64    `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	//交易在区块中的序号, 只用于 MSG_BLOCKTX
+	Index                int32    `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -758,7 +1070,7 @@ func (m *Inventory) Reset()         { *m = Inventory{} }
 func (m *Inventory) String() string { return proto.CompactTextString(m) }
 func (*Inventory) ProtoMessage()    {}
 func (*Inventory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{13}
+	return fileDescriptor_e7fdddb109e6467a, []int{19}
 }
 
 func (m *Inventory) XXX_Unmarshal(b []byte) error {
@@ -800,6 +1112,13 @@ func (m *Inventory) GetHeight() int64 {
 	return 0
 }
 
+func (m *Inventory) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
 //*
 // 通过invs 下载数据
 type P2PGetData struct {
@@ -816,7 +1135,7 @@ func (m *P2PGetData) Reset()         { *m = P2PGetData{} }
 func (m *P2PGetData) String() string { return proto.CompactTextString(m) }
 func (*P2PGetData) ProtoMessage()    {}
 func (*P2PGetData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{14}
+	return fileDescriptor_e7fdddb109e6467a, []int{20}
 }
 
 func (m *P2PGetData) XXX_Unmarshal(b []byte) error {
@@ -864,7 +1183,7 @@ func (m *P2PTx) Reset()         { *m = P2PTx{} }
 func (m *P2PTx) String() string { return proto.CompactTextString(m) }
 func (*P2PTx) ProtoMessage()    {}
 func (*P2PTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{15}
+	return fileDescriptor_e7fdddb109e6467a, []int{21}
 }
 
 func (m *P2PTx) XXX_Unmarshal(b []byte) error {
@@ -905,7 +1224,7 @@ func (m *P2PBlock) Reset()         { *m = P2PBlock{} }
 func (m *P2PBlock) String() string { return proto.CompactTextString(m) }
 func (*P2PBlock) ProtoMessage()    {}
 func (*P2PBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{16}
+	return fileDescriptor_e7fdddb109e6467a, []int{22}
 }
 
 func (m *P2PBlock) XXX_Unmarshal(b []byte) error {
@@ -948,7 +1267,7 @@ func (m *Versions) Reset()         { *m = Versions{} }
 func (m *Versions) String() string { return proto.CompactTextString(m) }
 func (*Versions) ProtoMessage()    {}
 func (*Versions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{17}
+	return fileDescriptor_e7fdddb109e6467a, []int{23}
 }
 
 func (m *Versions) XXX_Unmarshal(b []byte) error {
@@ -998,6 +1317,7 @@ type BroadCastData struct {
 	//	*BroadCastData_Block
 	//	*BroadCastData_Ping
 	//	*BroadCastData_Version
+	//	*BroadCastData_CompactBlock
 	Value                isBroadCastData_Value `protobuf_oneof:"value"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
@@ -1008,7 +1328,7 @@ func (m *BroadCastData) Reset()         { *m = BroadCastData{} }
 func (m *BroadCastData) String() string { return proto.CompactTextString(m) }
 func (*BroadCastData) ProtoMessage()    {}
 func (*BroadCastData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{18}
+	return fileDescriptor_e7fdddb109e6467a, []int{24}
 }
 
 func (m *BroadCastData) XXX_Unmarshal(b []byte) error {
@@ -1049,6 +1369,10 @@ type BroadCastData_Version struct {
 	Version *Versions `protobuf:"bytes,4,opt,name=version,proto3,oneof"`
 }
 
+type BroadCastData_CompactBlock struct {
+	CompactBlock *P2PCompactBlock `protobuf:"bytes,5,opt,name=compactBlock,proto3,oneof"`
+}
+
 func (*BroadCastData_Tx) isBroadCastData_Value() {}
 
 func (*BroadCastData_Block) isBroadCastData_Value() {}
@@ -1057,6 +1381,8 @@ func (*BroadCastData_Ping) isBroadCastData_Value() {}
 
 func (*BroadCastData_Version) isBroadCastData_Value() {}
 
+func (*BroadCastData_CompactBlock) isBroadCastData_Value() {}
+
 func (m *BroadCastData) GetValue() isBroadCastData_Value {
 	if m != nil {
 		return m.Value
@@ -1092,6 +1418,13 @@ func (m *BroadCastData) GetVersion() *Versions {
 	return nil
 }
 
+func (m *BroadCastData) GetCompactBlock() *P2PCompactBlock {
+	if x, ok := m.GetValue().(*BroadCastData_CompactBlock); ok {
+		return x.CompactBlock
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*BroadCastData) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _BroadCastData_OneofMarshaler, _BroadCastData_OneofUnmarshaler, _BroadCastData_OneofSizer, []interface{}{
@@ -1099,6 +1432,7 @@ func (*BroadCastData) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer)
 		(*BroadCastData_Block)(nil),
 		(*BroadCastData_Ping)(nil),
 		(*BroadCastData_Version)(nil),
+		(*BroadCastData_CompactBlock)(nil),
 	}
 }
 
@@ -1126,6 +1460,11 @@ func _BroadCastData_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.Version); err != nil {
 			return err
 		}
+	case *BroadCastData_CompactBlock:
+		b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CompactBlock); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("BroadCastData.Value has unexpected type %T", x)
@@ -1168,6 +1507,14 @@ func _BroadCastData_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.
 		err := b.DecodeMessage(msg)
 		m.Value = &BroadCastData_Version{msg}
 		return true, err
+	case 5: // value.compactBlock
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(P2PCompactBlock)
+		err := b.DecodeMessage(msg)
+		m.Value = &BroadCastData_CompactBlock{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -1197,6 +1544,11 @@ func _BroadCastData_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *BroadCastData_CompactBlock:
+		s := proto.Size(x.CompactBlock)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -1205,53 +1557,225 @@ func _BroadCastData_OneofSizer(msg proto.Message) (n int) {
 }
 
 //*
-// p2p 获取区块区间头部信息协议
-type P2PGetHeaders struct {
-	Version              int32    `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
-	StartHeight          int64    `protobuf:"varint,2,opt,name=startHeight,proto3" json:"startHeight,omitempty"`
-	EndHeight            int64    `protobuf:"varint,3,opt,name=endHeight,proto3" json:"endHeight,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// 紧凑区块, 只包含区块头和交易的短哈希, 接收方从 mempool 中恢复区块
+type P2PCompactBlock struct {
+	//不包含交易的区块
+	Header *Block `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	//区块哈希
+	Hash []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	//计算短哈希的随机数
+	Nonce int64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	//交易的短哈希, 顺序和区块中的交易相同
+	ShortIDs []uint64 `protobuf:"varint,4,rep,packed,name=shortIDs,proto3" json:"shortIDs,omitempty"`
+	//发送方认为接收方没有的交易
+	PrefilledTxs         []*PrefilledTx `protobuf:"bytes,5,rep,name=prefilledTxs,proto3" json:"prefilledTxs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *P2PGetHeaders) Reset()         { *m = P2PGetHeaders{} }
-func (m *P2PGetHeaders) String() string { return proto.CompactTextString(m) }
-func (*P2PGetHeaders) ProtoMessage()    {}
-func (*P2PGetHeaders) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{19}
+func (m *P2PCompactBlock) Reset()         { *m = P2PCompactBlock{} }
+func (m *P2PCompactBlock) String() string { return proto.CompactTextString(m) }
+func (*P2PCompactBlock) ProtoMessage()    {}
+func (*P2PCompactBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{25}
 }
 
-func (m *P2PGetHeaders) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_P2PGetHeaders.Unmarshal(m, b)
+func (m *P2PCompactBlock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_P2PCompactBlock.Unmarshal(m, b)
 }
-func (m *P2PGetHeaders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_P2PGetHeaders.Marshal(b, m, deterministic)
+func (m *P2PCompactBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_P2PCompactBlock.Marshal(b, m, deterministic)
 }
-func (m *P2PGetHeaders) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_P2PGetHeaders.Merge(m, src)
+func (m *P2PCompactBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_P2PCompactBlock.Merge(m, src)
 }
-func (m *P2PGetHeaders) XXX_Size() int {
-	return xxx_messageInfo_P2PGetHeaders.Size(m)
+func (m *P2PCompactBlock) XXX_Size() int {
+	return xxx_messageInfo_P2PCompactBlock.Size(m)
 }
-func (m *P2PGetHeaders) XXX_DiscardUnknown() {
-	xxx_messageInfo_P2PGetHeaders.DiscardUnknown(m)
+func (m *P2PCompactBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_P2PCompactBlock.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_P2PGetHeaders proto.InternalMessageInfo
+var xxx_messageInfo_P2PCompactBlock proto.InternalMessageInfo
 
-func (m *P2PGetHeaders) GetVersion() int32 {
+func (m *P2PCompactBlock) GetHeader() *Block {
 	if m != nil {
-		return m.Version
+		return m.Header
 	}
-	return 0
+	return nil
 }
 
-func (m *P2PGetHeaders) GetStartHeight() int64 {
+func (m *P2PCompactBlock) GetHash() []byte {
 	if m != nil {
-		return m.StartHeight
+		return m.Hash
 	}
-	return 0
+	return nil
+}
+
+func (m *P2PCompactBlock) GetNonce() int64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *P2PCompactBlock) GetShortIDs() []uint64 {
+	if m != nil {
+		return m.ShortIDs
+	}
+	return nil
+}
+
+func (m *P2PCompactBlock) GetPrefilledTxs() []*PrefilledTx {
+	if m != nil {
+		return m.PrefilledTxs
+	}
+	return nil
+}
+
+type PrefilledTx struct {
+	Index                int32        `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Tx                   *Transaction `protobuf:"bytes,2,opt,name=tx,proto3" json:"tx,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *PrefilledTx) Reset()         { *m = PrefilledTx{} }
+func (m *PrefilledTx) String() string { return proto.CompactTextString(m) }
+func (*PrefilledTx) ProtoMessage()    {}
+func (*PrefilledTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{26}
+}
+
+func (m *PrefilledTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrefilledTx.Unmarshal(m, b)
+}
+func (m *PrefilledTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrefilledTx.Marshal(b, m, deterministic)
+}
+func (m *PrefilledTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrefilledTx.Merge(m, src)
+}
+func (m *PrefilledTx) XXX_Size() int {
+	return xxx_messageInfo_PrefilledTx.Size(m)
+}
+func (m *PrefilledTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrefilledTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrefilledTx proto.InternalMessageInfo
+
+func (m *PrefilledTx) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *PrefilledTx) GetTx() *Transaction {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+//按照紧凑区块的短哈希查询 mempool 中的交易
+type ReqShortTxs struct {
+	Nonce                int64    `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	ShortIDs             []uint64 `protobuf:"varint,2,rep,packed,name=shortIDs,proto3" json:"shortIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqShortTxs) Reset()         { *m = ReqShortTxs{} }
+func (m *ReqShortTxs) String() string { return proto.CompactTextString(m) }
+func (*ReqShortTxs) ProtoMessage()    {}
+func (*ReqShortTxs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{27}
+}
+
+func (m *ReqShortTxs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqShortTxs.Unmarshal(m, b)
+}
+func (m *ReqShortTxs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqShortTxs.Marshal(b, m, deterministic)
+}
+func (m *ReqShortTxs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqShortTxs.Merge(m, src)
+}
+func (m *ReqShortTxs) XXX_Size() int {
+	return xxx_messageInfo_ReqShortTxs.Size(m)
+}
+func (m *ReqShortTxs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqShortTxs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqShortTxs proto.InternalMessageInfo
+
+func (m *ReqShortTxs) GetNonce() int64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *ReqShortTxs) GetShortIDs() []uint64 {
+	if m != nil {
+		return m.ShortIDs
+	}
+	return nil
+}
+
+// p2p 获取区块区间头部信息协议
+type P2PGetHeaders struct {
+	Version              int32    `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	StartHeight          int64    `protobuf:"varint,2,opt,name=startHeight,proto3" json:"startHeight,omitempty"`
+	EndHeight            int64    `protobuf:"varint,3,opt,name=endHeight,proto3" json:"endHeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *P2PGetHeaders) Reset()         { *m = P2PGetHeaders{} }
+func (m *P2PGetHeaders) String() string { return proto.CompactTextString(m) }
+func (*P2PGetHeaders) ProtoMessage()    {}
+func (*P2PGetHeaders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{28}
+}
+
+func (m *P2PGetHeaders) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_P2PGetHeaders.Unmarshal(m, b)
+}
+func (m *P2PGetHeaders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_P2PGetHeaders.Marshal(b, m, deterministic)
+}
+func (m *P2PGetHeaders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_P2PGetHeaders.Merge(m, src)
+}
+func (m *P2PGetHeaders) XXX_Size() int {
+	return xxx_messageInfo_P2PGetHeaders.Size(m)
+}
+func (m *P2PGetHeaders) XXX_DiscardUnknown() {
+	xxx_messageInfo_P2PGetHeaders.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_P2PGetHeaders proto.InternalMessageInfo
+
+func (m *P2PGetHeaders) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *P2PGetHeaders) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
 }
 
 func (m *P2PGetHeaders) GetEndHeight() int64 {
@@ -1274,7 +1798,7 @@ func (m *P2PHeaders) Reset()         { *m = P2PHeaders{} }
 func (m *P2PHeaders) String() string { return proto.CompactTextString(m) }
 func (*P2PHeaders) ProtoMessage()    {}
 func (*P2PHeaders) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{20}
+	return fileDescriptor_e7fdddb109e6467a, []int{29}
 }
 
 func (m *P2PHeaders) XXX_Unmarshal(b []byte) error {
@@ -1319,7 +1843,7 @@ func (m *InvData) Reset()         { *m = InvData{} }
 func (m *InvData) String() string { return proto.CompactTextString(m) }
 func (*InvData) ProtoMessage()    {}
 func (*InvData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{21}
+	return fileDescriptor_e7fdddb109e6467a, []int{30}
 }
 
 func (m *InvData) XXX_Unmarshal(b []byte) error {
@@ -1471,7 +1995,7 @@ func (m *InvDatas) Reset()         { *m = InvDatas{} }
 func (m *InvDatas) String() string { return proto.CompactTextString(m) }
 func (*InvDatas) ProtoMessage()    {}
 func (*InvDatas) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{22}
+	return fileDescriptor_e7fdddb109e6467a, []int{31}
 }
 
 func (m *InvDatas) XXX_Unmarshal(b []byte) error {
@@ -1502,12 +2026,15 @@ func (m *InvDatas) GetItems() []*InvData {
 //*
 // peer 信息
 type Peer struct {
-	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
-	Port                 int32    `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
-	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Self                 bool     `protobuf:"varint,4,opt,name=self,proto3" json:"self,omitempty"`
-	MempoolSize          int32    `protobuf:"varint,5,opt,name=mempoolSize,proto3" json:"mempoolSize,omitempty"`
-	Header               *Header  `protobuf:"bytes,6,opt,name=header,proto3" json:"header,omitempty"`
+	Addr        string  `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Port        int32   `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	Name        string  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Self        bool    `protobuf:"varint,4,opt,name=self,proto3" json:"self,omitempty"`
+	MempoolSize int32   `protobuf:"varint,5,opt,name=mempoolSize,proto3" json:"mempoolSize,omitempty"`
+	Header      *Header `protobuf:"bytes,6,opt,name=header,proto3" json:"header,omitempty"`
+	///本节点和这个节点之间发送和接收的字节数
+	BytesSent            int64    `protobuf:"varint,7,opt,name=bytesSent,proto3" json:"bytesSent,omitempty"`
+	BytesRecv            int64    `protobuf:"varint,8,opt,name=bytesRecv,proto3" json:"bytesRecv,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1517,7 +2044,7 @@ func (m *Peer) Reset()         { *m = Peer{} }
 func (m *Peer) String() string { return proto.CompactTextString(m) }
 func (*Peer) ProtoMessage()    {}
 func (*Peer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{23}
+	return fileDescriptor_e7fdddb109e6467a, []int{32}
 }
 
 func (m *Peer) XXX_Unmarshal(b []byte) error {
@@ -1580,7 +2107,20 @@ func (m *Peer) GetHeader() *Header {
 	return nil
 }
 
-//*
+func (m *Peer) GetBytesSent() int64 {
+	if m != nil {
+		return m.BytesSent
+	}
+	return 0
+}
+
+func (m *Peer) GetBytesRecv() int64 {
+	if m != nil {
+		return m.BytesRecv
+	}
+	return 0
+}
+
 // peer 列表
 type PeerList struct {
 	Peers                []*Peer  `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
@@ -1593,7 +2133,7 @@ func (m *PeerList) Reset()         { *m = PeerList{} }
 func (m *PeerList) String() string { return proto.CompactTextString(m) }
 func (*PeerList) ProtoMessage()    {}
 func (*PeerList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{24}
+	return fileDescriptor_e7fdddb109e6467a, []int{33}
 }
 
 func (m *PeerList) XXX_Unmarshal(b []byte) error {
@@ -1624,21 +2164,26 @@ func (m *PeerList) GetPeers() []*Peer {
 //*
 //当前节点的网络信息
 type NodeNetInfo struct {
-	Externaladdr         string   `protobuf:"bytes,1,opt,name=externaladdr,proto3" json:"externaladdr,omitempty"`
-	Localaddr            string   `protobuf:"bytes,2,opt,name=localaddr,proto3" json:"localaddr,omitempty"`
-	Service              bool     `protobuf:"varint,3,opt,name=service,proto3" json:"service,omitempty"`
-	Outbounds            int32    `protobuf:"varint,4,opt,name=outbounds,proto3" json:"outbounds,omitempty"`
-	Inbounds             int32    `protobuf:"varint,5,opt,name=inbounds,proto3" json:"inbounds,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Externaladdr string `protobuf:"bytes,1,opt,name=externaladdr,proto3" json:"externaladdr,omitempty"`
+	Localaddr    string `protobuf:"bytes,2,opt,name=localaddr,proto3" json:"localaddr,omitempty"`
+	Service      bool   `protobuf:"varint,3,opt,name=service,proto3" json:"service,omitempty"`
+	Outbounds    int32  `protobuf:"varint,4,opt,name=outbounds,proto3" json:"outbounds,omitempty"`
+	Inbounds     int32  `protobuf:"varint,5,opt,name=inbounds,proto3" json:"inbounds,omitempty"`
+	///启动以来发送和接收的总字节数
+	BytesSent            int64          `protobuf:"varint,6,opt,name=bytesSent,proto3" json:"bytesSent,omitempty"`
+	BytesRecv            int64          `protobuf:"varint,7,opt,name=bytesRecv,proto3" json:"bytesRecv,omitempty"`
+	Peers                []*PeerTraffic `protobuf:"bytes,8,rep,name=peers,proto3" json:"peers,omitempty"`
+	Msgs                 []*MsgTraffic  `protobuf:"bytes,9,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *NodeNetInfo) Reset()         { *m = NodeNetInfo{} }
 func (m *NodeNetInfo) String() string { return proto.CompactTextString(m) }
 func (*NodeNetInfo) ProtoMessage()    {}
 func (*NodeNetInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{25}
+	return fileDescriptor_e7fdddb109e6467a, []int{34}
 }
 
 func (m *NodeNetInfo) XXX_Unmarshal(b []byte) error {
@@ -1694,6 +2239,154 @@ func (m *NodeNetInfo) GetInbounds() int32 {
 	return 0
 }
 
+func (m *NodeNetInfo) GetBytesSent() int64 {
+	if m != nil {
+		return m.BytesSent
+	}
+	return 0
+}
+
+func (m *NodeNetInfo) GetBytesRecv() int64 {
+	if m != nil {
+		return m.BytesRecv
+	}
+	return 0
+}
+
+func (m *NodeNetInfo) GetPeers() []*PeerTraffic {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+func (m *NodeNetInfo) GetMsgs() []*MsgTraffic {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+//一个连接的流量, inbound 表示对方连接本节点
+type PeerTraffic struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Inbound              bool     `protobuf:"varint,2,opt,name=inbound,proto3" json:"inbound,omitempty"`
+	BytesSent            int64    `protobuf:"varint,3,opt,name=bytesSent,proto3" json:"bytesSent,omitempty"`
+	BytesRecv            int64    `protobuf:"varint,4,opt,name=bytesRecv,proto3" json:"bytesRecv,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PeerTraffic) Reset()         { *m = PeerTraffic{} }
+func (m *PeerTraffic) String() string { return proto.CompactTextString(m) }
+func (*PeerTraffic) ProtoMessage()    {}
+func (*PeerTraffic) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{35}
+}
+
+func (m *PeerTraffic) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerTraffic.Unmarshal(m, b)
+}
+func (m *PeerTraffic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerTraffic.Marshal(b, m, deterministic)
+}
+func (m *PeerTraffic) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerTraffic.Merge(m, src)
+}
+func (m *PeerTraffic) XXX_Size() int {
+	return xxx_messageInfo_PeerTraffic.Size(m)
+}
+func (m *PeerTraffic) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerTraffic.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerTraffic proto.InternalMessageInfo
+
+func (m *PeerTraffic) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *PeerTraffic) GetInbound() bool {
+	if m != nil {
+		return m.Inbound
+	}
+	return false
+}
+
+func (m *PeerTraffic) GetBytesSent() int64 {
+	if m != nil {
+		return m.BytesSent
+	}
+	return 0
+}
+
+func (m *PeerTraffic) GetBytesRecv() int64 {
+	if m != nil {
+		return m.BytesRecv
+	}
+	return 0
+}
+
+//每种消息的流量
+type MsgTraffic struct {
+	Msg                  string   `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	BytesSent            int64    `protobuf:"varint,2,opt,name=bytesSent,proto3" json:"bytesSent,omitempty"`
+	BytesRecv            int64    `protobuf:"varint,3,opt,name=bytesRecv,proto3" json:"bytesRecv,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MsgTraffic) Reset()         { *m = MsgTraffic{} }
+func (m *MsgTraffic) String() string { return proto.CompactTextString(m) }
+func (*MsgTraffic) ProtoMessage()    {}
+func (*MsgTraffic) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{36}
+}
+
+func (m *MsgTraffic) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MsgTraffic.Unmarshal(m, b)
+}
+func (m *MsgTraffic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MsgTraffic.Marshal(b, m, deterministic)
+}
+func (m *MsgTraffic) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTraffic.Merge(m, src)
+}
+func (m *MsgTraffic) XXX_Size() int {
+	return xxx_messageInfo_MsgTraffic.Size(m)
+}
+func (m *MsgTraffic) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTraffic.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTraffic proto.InternalMessageInfo
+
+func (m *MsgTraffic) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *MsgTraffic) GetBytesSent() int64 {
+	if m != nil {
+		return m.BytesSent
+	}
+	return 0
+}
+
+func (m *MsgTraffic) GetBytesRecv() int64 {
+	if m != nil {
+		return m.BytesRecv
+	}
+	return 0
+}
+
 type PeersReply struct {
 	Peers                []*PeersInfo `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
@@ -1705,7 +2398,7 @@ func (m *PeersReply) Reset()         { *m = PeersReply{} }
 func (m *PeersReply) String() string { return proto.CompactTextString(m) }
 func (*PeersReply) ProtoMessage()    {}
 func (*PeersReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{26}
+	return fileDescriptor_e7fdddb109e6467a, []int{37}
 }
 
 func (m *PeersReply) XXX_Unmarshal(b []byte) error {
@@ -1748,7 +2441,7 @@ func (m *PeersInfo) Reset()         { *m = PeersInfo{} }
 func (m *PeersInfo) String() string { return proto.CompactTextString(m) }
 func (*PeersInfo) ProtoMessage()    {}
 func (*PeersInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{27}
+	return fileDescriptor_e7fdddb109e6467a, []int{38}
 }
 
 func (m *PeersInfo) XXX_Unmarshal(b []byte) error {
@@ -1804,6 +2497,295 @@ func (m *PeersInfo) GetP2Pversion() int32 {
 	return 0
 }
 
+//*
+// 上报节点的行为, 用于节点评分
+type ReportPeer struct {
+	Pid                  string   `protobuf:"bytes,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	Kind                 int32    `protobuf:"varint,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReportPeer) Reset()         { *m = ReportPeer{} }
+func (m *ReportPeer) String() string { return proto.CompactTextString(m) }
+func (*ReportPeer) ProtoMessage()    {}
+func (*ReportPeer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{39}
+}
+
+func (m *ReportPeer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReportPeer.Unmarshal(m, b)
+}
+func (m *ReportPeer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReportPeer.Marshal(b, m, deterministic)
+}
+func (m *ReportPeer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportPeer.Merge(m, src)
+}
+func (m *ReportPeer) XXX_Size() int {
+	return xxx_messageInfo_ReportPeer.Size(m)
+}
+func (m *ReportPeer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportPeer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportPeer proto.InternalMessageInfo
+
+func (m *ReportPeer) GetPid() string {
+	if m != nil {
+		return m.Pid
+	}
+	return ""
+}
+
+func (m *ReportPeer) GetKind() int32 {
+	if m != nil {
+		return m.Kind
+	}
+	return 0
+}
+
+func (m *ReportPeer) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+//*
+// 节点评分, pid 是节点公钥
+type PeerScore struct {
+	Pid                  string   `protobuf:"bytes,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Score                int64    `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+	InvalidBlocks        int64    `protobuf:"varint,4,opt,name=invalidBlocks,proto3" json:"invalidBlocks,omitempty"`
+	BadTxs               int64    `protobuf:"varint,5,opt,name=badTxs,proto3" json:"badTxs,omitempty"`
+	Timeouts             int64    `protobuf:"varint,6,opt,name=timeouts,proto3" json:"timeouts,omitempty"`
+	Useful               int64    `protobuf:"varint,7,opt,name=useful,proto3" json:"useful,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PeerScore) Reset()         { *m = PeerScore{} }
+func (m *PeerScore) String() string { return proto.CompactTextString(m) }
+func (*PeerScore) ProtoMessage()    {}
+func (*PeerScore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{40}
+}
+
+func (m *PeerScore) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerScore.Unmarshal(m, b)
+}
+func (m *PeerScore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerScore.Marshal(b, m, deterministic)
+}
+func (m *PeerScore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerScore.Merge(m, src)
+}
+func (m *PeerScore) XXX_Size() int {
+	return xxx_messageInfo_PeerScore.Size(m)
+}
+func (m *PeerScore) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerScore.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerScore proto.InternalMessageInfo
+
+func (m *PeerScore) GetPid() string {
+	if m != nil {
+		return m.Pid
+	}
+	return ""
+}
+
+func (m *PeerScore) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *PeerScore) GetScore() int64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *PeerScore) GetInvalidBlocks() int64 {
+	if m != nil {
+		return m.InvalidBlocks
+	}
+	return 0
+}
+
+func (m *PeerScore) GetBadTxs() int64 {
+	if m != nil {
+		return m.BadTxs
+	}
+	return 0
+}
+
+func (m *PeerScore) GetTimeouts() int64 {
+	if m != nil {
+		return m.Timeouts
+	}
+	return 0
+}
+
+func (m *PeerScore) GetUseful() int64 {
+	if m != nil {
+		return m.Useful
+	}
+	return 0
+}
+
+//*
+// 黑名单中的节点, key 是节点公钥或者地址, until 是解除的时间
+type BannedPeer struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Until                int64    `protobuf:"varint,2,opt,name=until,proto3" json:"until,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BannedPeer) Reset()         { *m = BannedPeer{} }
+func (m *BannedPeer) String() string { return proto.CompactTextString(m) }
+func (*BannedPeer) ProtoMessage()    {}
+func (*BannedPeer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{41}
+}
+
+func (m *BannedPeer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BannedPeer.Unmarshal(m, b)
+}
+func (m *BannedPeer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BannedPeer.Marshal(b, m, deterministic)
+}
+func (m *BannedPeer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BannedPeer.Merge(m, src)
+}
+func (m *BannedPeer) XXX_Size() int {
+	return xxx_messageInfo_BannedPeer.Size(m)
+}
+func (m *BannedPeer) XXX_DiscardUnknown() {
+	xxx_messageInfo_BannedPeer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BannedPeer proto.InternalMessageInfo
+
+func (m *BannedPeer) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *BannedPeer) GetUntil() int64 {
+	if m != nil {
+		return m.Until
+	}
+	return 0
+}
+
+type PeerScores struct {
+	Scores               []*PeerScore  `protobuf:"bytes,1,rep,name=scores,proto3" json:"scores,omitempty"`
+	Bans                 []*BannedPeer `protobuf:"bytes,2,rep,name=bans,proto3" json:"bans,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *PeerScores) Reset()         { *m = PeerScores{} }
+func (m *PeerScores) String() string { return proto.CompactTextString(m) }
+func (*PeerScores) ProtoMessage()    {}
+func (*PeerScores) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{42}
+}
+
+func (m *PeerScores) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerScores.Unmarshal(m, b)
+}
+func (m *PeerScores) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerScores.Marshal(b, m, deterministic)
+}
+func (m *PeerScores) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerScores.Merge(m, src)
+}
+func (m *PeerScores) XXX_Size() int {
+	return xxx_messageInfo_PeerScores.Size(m)
+}
+func (m *PeerScores) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerScores.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerScores proto.InternalMessageInfo
+
+func (m *PeerScores) GetScores() []*PeerScore {
+	if m != nil {
+		return m.Scores
+	}
+	return nil
+}
+
+func (m *PeerScores) GetBans() []*BannedPeer {
+	if m != nil {
+		return m.Bans
+	}
+	return nil
+}
+
+type ReqBanPeer struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Seconds              int64    `protobuf:"varint,2,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqBanPeer) Reset()         { *m = ReqBanPeer{} }
+func (m *ReqBanPeer) String() string { return proto.CompactTextString(m) }
+func (*ReqBanPeer) ProtoMessage()    {}
+func (*ReqBanPeer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{43}
+}
+
+func (m *ReqBanPeer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqBanPeer.Unmarshal(m, b)
+}
+func (m *ReqBanPeer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqBanPeer.Marshal(b, m, deterministic)
+}
+func (m *ReqBanPeer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqBanPeer.Merge(m, src)
+}
+func (m *ReqBanPeer) XXX_Size() int {
+	return xxx_messageInfo_ReqBanPeer.Size(m)
+}
+func (m *ReqBanPeer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqBanPeer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqBanPeer proto.InternalMessageInfo
+
+func (m *ReqBanPeer) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ReqBanPeer) GetSeconds() int64 {
+	if m != nil {
+		return m.Seconds
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*P2PGetPeerInfo)(nil), "types.P2PGetPeerInfo")
 	proto.RegisterType((*P2PPeerInfo)(nil), "types.P2PPeerInfo")
@@ -1814,6 +2796,12 @@ func init() {
 	proto.RegisterType((*P2PGetAddr)(nil), "types.P2PGetAddr")
 	proto.RegisterType((*P2PAddr)(nil), "types.P2PAddr")
 	proto.RegisterType((*P2PAddrList)(nil), "types.P2PAddrList")
+	proto.RegisterType((*P2PFindNode)(nil), "types.P2PFindNode")
+	proto.RegisterType((*P2PNode)(nil), "types.P2PNode")
+	proto.RegisterType((*P2PNodes)(nil), "types.P2PNodes")
+	proto.RegisterType((*P2PGetTxProof)(nil), "types.P2PGetTxProof")
+	proto.RegisterType((*P2PGetStateProof)(nil), "types.P2PGetStateProof")
+	proto.RegisterType((*P2PStateProof)(nil), "types.P2PStateProof")
 	proto.RegisterType((*P2PExternalInfo)(nil), "types.P2PExternalInfo")
 	proto.RegisterType((*P2PGetBlocks)(nil), "types.P2PGetBlocks")
 	proto.RegisterType((*P2PGetMempool)(nil), "types.P2PGetMempool")
@@ -1824,6 +2812,9 @@ func init() {
 	proto.RegisterType((*P2PBlock)(nil), "types.P2PBlock")
 	proto.RegisterType((*Versions)(nil), "types.Versions")
 	proto.RegisterType((*BroadCastData)(nil), "types.BroadCastData")
+	proto.RegisterType((*P2PCompactBlock)(nil), "types.P2PCompactBlock")
+	proto.RegisterType((*PrefilledTx)(nil), "types.PrefilledTx")
+	proto.RegisterType((*ReqShortTxs)(nil), "types.ReqShortTxs")
 	proto.RegisterType((*P2PGetHeaders)(nil), "types.P2PGetHeaders")
 	proto.RegisterType((*P2PHeaders)(nil), "types.P2PHeaders")
 	proto.RegisterType((*InvData)(nil), "types.InvData")
@@ -1831,95 +2822,142 @@ func init() {
 	proto.RegisterType((*Peer)(nil), "types.Peer")
 	proto.RegisterType((*PeerList)(nil), "types.PeerList")
 	proto.RegisterType((*NodeNetInfo)(nil), "types.NodeNetInfo")
+	proto.RegisterType((*PeerTraffic)(nil), "types.PeerTraffic")
+	proto.RegisterType((*MsgTraffic)(nil), "types.MsgTraffic")
 	proto.RegisterType((*PeersReply)(nil), "types.PeersReply")
 	proto.RegisterType((*PeersInfo)(nil), "types.PeersInfo")
+	proto.RegisterType((*ReportPeer)(nil), "types.ReportPeer")
+	proto.RegisterType((*PeerScore)(nil), "types.PeerScore")
+	proto.RegisterType((*BannedPeer)(nil), "types.BannedPeer")
+	proto.RegisterType((*PeerScores)(nil), "types.PeerScores")
+	proto.RegisterType((*ReqBanPeer)(nil), "types.ReqBanPeer")
 }
 
 func init() { proto.RegisterFile("p2p.proto", fileDescriptor_e7fdddb109e6467a) }
 
 var fileDescriptor_e7fdddb109e6467a = []byte{
-	// 1294 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x72, 0x1b, 0x45,
-	0x13, 0xdf, 0xd5, 0x1f, 0x4b, 0x6a, 0xd9, 0xb2, 0x33, 0x5f, 0xbe, 0xaf, 0x54, 0xaa, 0x7c, 0x49,
-	0x98, 0x0a, 0xc4, 0x90, 0x8a, 0x92, 0xac, 0x20, 0x54, 0x11, 0x2e, 0x76, 0x80, 0xd8, 0x55, 0x21,
-	0xb5, 0xb5, 0x32, 0x1c, 0xb8, 0xad, 0x57, 0x63, 0x69, 0x2a, 0xd2, 0xcc, 0xb2, 0x3b, 0x52, 0xd9,
-	0xdc, 0xb9, 0x71, 0xe2, 0x05, 0x38, 0xf0, 0x0a, 0x3c, 0x16, 0x0f, 0x41, 0x4d, 0xef, 0xcc, 0xfe,
-	0x91, 0x64, 0x1d, 0xa0, 0xb8, 0x6d, 0xff, 0xba, 0x7b, 0xfa, 0x7f, 0xb7, 0x04, 0x9d, 0xd8, 0x8b,
-	0x87, 0x71, 0x22, 0x95, 0x24, 0x4d, 0x75, 0x13, 0xb3, 0x74, 0x70, 0x47, 0x25, 0xa1, 0x48, 0xc3,
-	0x48, 0x71, 0x29, 0x32, 0xce, 0x60, 0x3f, 0x92, 0x8b, 0x45, 0x4e, 0x1d, 0x5d, 0xce, 0x65, 0xf4,
-	0x3e, 0x9a, 0x85, 0xdc, 0x20, 0xf4, 0x13, 0xe8, 0xf9, 0x9e, 0xff, 0x86, 0x29, 0x9f, 0xb1, 0xe4,
-	0x5c, 0x5c, 0x49, 0xd2, 0x87, 0xd6, 0x8a, 0x25, 0x29, 0x97, 0xa2, 0xef, 0x3e, 0x74, 0x8f, 0x9b,
-	0x81, 0x25, 0xe9, 0xaf, 0x2e, 0x74, 0x7d, 0xcf, 0xcf, 0x25, 0x09, 0x34, 0xc2, 0xc9, 0x24, 0x41,
-	0xb1, 0x4e, 0x80, 0xdf, 0x1a, 0x8b, 0x65, 0xa2, 0xfa, 0x35, 0x54, 0xc5, 0x6f, 0x8d, 0x89, 0x70,
-	0xc1, 0xfa, 0xf5, 0x4c, 0x4e, 0x7f, 0x93, 0x87, 0xd0, 0x5d, 0xb0, 0x45, 0x2c, 0xe5, 0x7c, 0xcc,
-	0x7f, 0x62, 0xfd, 0x06, 0x8a, 0x97, 0x21, 0xf2, 0x21, 0xec, 0xcd, 0x58, 0x38, 0x61, 0x49, 0xbf,
-	0xf9, 0xd0, 0x3d, 0xee, 0x7a, 0x07, 0x43, 0x0c, 0x72, 0x78, 0x86, 0x60, 0x60, 0x98, 0xf4, 0x4f,
-	0x17, 0xc0, 0xf7, 0xfc, 0xef, 0x33, 0x1f, 0x6f, 0xf7, 0x5e, 0x73, 0x52, 0x96, 0xac, 0x78, 0xc4,
-	0xd0, 0xb9, 0x7a, 0x60, 0x49, 0x72, 0x0f, 0x3a, 0x8a, 0x2f, 0x58, 0xaa, 0xc2, 0x45, 0x8c, 0x4e,
-	0xd6, 0x83, 0x02, 0x20, 0x03, 0x68, 0xeb, 0xc8, 0x02, 0x16, 0xad, 0xd0, 0xcd, 0x4e, 0x90, 0xd3,
-	0x96, 0xf7, 0x4d, 0x22, 0x17, 0xe8, 0xa5, 0xe1, 0x69, 0x9a, 0xdc, 0x85, 0xa6, 0x90, 0x22, 0x62,
-	0xfd, 0x3d, 0x7c, 0x31, 0x23, 0xb4, 0xad, 0x65, 0xca, 0x92, 0x93, 0x29, 0x13, 0xaa, 0xdf, 0x42,
-	0x95, 0x02, 0xd0, 0x59, 0x49, 0x55, 0x98, 0xa8, 0x33, 0xc6, 0xa7, 0x33, 0xd5, 0x6f, 0xa3, 0x66,
-	0x19, 0xa2, 0xdf, 0x41, 0x27, 0x8b, 0xf6, 0x24, 0x7a, 0xff, 0xb7, 0x82, 0xcd, 0xdd, 0xaa, 0x97,
-	0xdc, 0xa2, 0x0b, 0x68, 0xe9, 0xca, 0x72, 0x31, 0x2d, 0x04, 0xdc, 0xb2, 0xdf, 0xb6, 0xd6, 0xb5,
-	0x2d, 0xb5, 0xae, 0x97, 0x6a, 0xfd, 0x08, 0x1a, 0x29, 0x9f, 0x0a, 0xcc, 0x54, 0xd7, 0x3b, 0x32,
-	0x35, 0x1b, 0xf3, 0xa9, 0x08, 0xd5, 0x32, 0x61, 0x01, 0x72, 0xe9, 0x83, 0xcc, 0x9c, 0xbc, 0xcd,
-	0x1c, 0xa5, 0x58, 0xd4, 0x37, 0x4c, 0x9d, 0x68, 0x43, 0xdb, 0x65, 0x5e, 0xe1, 0x23, 0xb7, 0x0b,
-	0xd8, 0xea, 0xcc, 0x79, 0xaa, 0xfb, 0xb1, 0x6e, 0xab, 0xa3, 0x69, 0x3a, 0xc6, 0x56, 0xd6, 0xca,
-	0x6f, 0x79, 0xaa, 0x6e, 0x79, 0x60, 0x08, 0xed, 0x98, 0xb1, 0x84, 0x8b, 0x2b, 0x89, 0x0f, 0x74,
-	0x3d, 0x62, 0x02, 0x2a, 0x8d, 0x41, 0x90, 0xcb, 0xd0, 0xd7, 0x70, 0xe8, 0x7b, 0xfe, 0xd7, 0xd7,
-	0x8a, 0x25, 0x22, 0x9c, 0xdf, 0x3a, 0x23, 0xf7, 0xa0, 0xc3, 0x53, 0xb9, 0x54, 0x29, 0x9f, 0x64,
-	0xe5, 0x69, 0x07, 0x05, 0x40, 0x67, 0xb0, 0x9f, 0x85, 0x7e, 0xaa, 0x67, 0x35, 0xdd, 0x51, 0xe4,
-	0xb5, 0x6e, 0xa9, 0x6d, 0x74, 0x8b, 0xb6, 0xc4, 0xc4, 0xc4, 0xf0, 0x4d, 0x67, 0xe7, 0x00, 0xfd,
-	0x18, 0x0e, 0x32, 0x4b, 0xdf, 0x66, 0x63, 0xb7, 0x63, 0xf4, 0x87, 0xb0, 0xe7, 0x7b, 0xfe, 0xb9,
-	0x58, 0xe9, 0x02, 0x73, 0xb1, 0x4a, 0xfb, 0x2e, 0xe6, 0xc3, 0x16, 0xf8, 0x5c, 0xac, 0x98, 0x50,
-	0x32, 0xb9, 0x09, 0x90, 0x4b, 0xdf, 0x40, 0x27, 0x87, 0x48, 0x0f, 0x6a, 0xea, 0xc6, 0xbc, 0x58,
-	0x53, 0x37, 0x3a, 0x27, 0xb3, 0x30, 0x9d, 0xa1, 0xc3, 0xfb, 0x01, 0x7e, 0x93, 0xff, 0xe9, 0x69,
-	0x2f, 0xb9, 0x69, 0x28, 0xfa, 0xd6, 0x36, 0xc2, 0x57, 0xa1, 0x0a, 0x77, 0xe4, 0xc2, 0xba, 0x55,
-	0xdb, 0xe9, 0xd6, 0x13, 0x68, 0xfa, 0x9e, 0x7f, 0x71, 0x4d, 0x28, 0xd4, 0xd4, 0x35, 0xbe, 0x51,
-	0xd4, 0xf4, 0xa2, 0x58, 0x9e, 0x41, 0x4d, 0x5d, 0xd3, 0x21, 0xb4, 0x7d, 0xcf, 0xc7, 0x2a, 0x10,
-	0x0a, 0x4d, 0x5c, 0x9d, 0x46, 0x65, 0xdf, 0xa8, 0x20, 0x33, 0xc8, 0x58, 0x74, 0x06, 0x6d, 0xb3,
-	0x85, 0x52, 0x72, 0x1f, 0x20, 0xf6, 0xe2, 0xaa, 0xaf, 0x25, 0x04, 0x4b, 0x27, 0xaf, 0x94, 0x15,
-	0xc8, 0xa6, 0xaa, 0x0c, 0xe9, 0xe6, 0xd5, 0x7d, 0x55, 0x5a, 0x9c, 0x39, 0x4d, 0xff, 0x70, 0xe1,
-	0xe0, 0x34, 0x91, 0xe1, 0xe4, 0x75, 0x98, 0x66, 0x89, 0xb9, 0x5f, 0x8a, 0x67, 0xbf, 0xe8, 0xd1,
-	0x8b, 0xeb, 0x33, 0x47, 0xc7, 0x42, 0x1e, 0x5b, 0xff, 0x6b, 0x28, 0x72, 0x58, 0x88, 0x60, 0x08,
-	0x67, 0x8e, 0x09, 0x42, 0xe7, 0x31, 0xe6, 0x62, 0x8a, 0x26, 0xbb, 0x5e, 0xaf, 0xd4, 0xee, 0x5c,
-	0x4c, 0xcf, 0x9c, 0x00, 0xb9, 0xe4, 0x49, 0x51, 0x87, 0x46, 0xe5, 0x41, 0x9b, 0x80, 0x33, 0x27,
-	0x2f, 0xcd, 0x69, 0x0b, 0x9a, 0xab, 0x70, 0xbe, 0x64, 0x94, 0xdb, 0x7e, 0xcb, 0x56, 0xf8, 0xbf,
-	0xd9, 0xda, 0x9f, 0x61, 0xdb, 0x58, 0x3b, 0x8f, 0xa1, 0x95, 0x5d, 0x0b, 0xdb, 0xb6, 0x6b, 0xb7,
-	0xc4, 0x72, 0xa9, 0x80, 0xd6, 0xb9, 0x58, 0x61, 0x46, 0x1f, 0xed, 0xee, 0x10, 0x93, 0xd7, 0x47,
-	0xd5, 0xbc, 0x56, 0xfa, 0xa2, 0x48, 0x6a, 0x36, 0x00, 0x75, 0x3b, 0x00, 0x45, 0x46, 0x9e, 0x43,
-	0xdb, 0xd8, 0x4b, 0xf5, 0x53, 0x5c, 0xb1, 0x85, 0x75, 0xb1, 0x57, 0xb4, 0xb0, 0xe6, 0x07, 0x19,
-	0x93, 0xfe, 0xe6, 0x42, 0x43, 0x6f, 0x9e, 0x7f, 0x74, 0x7c, 0x09, 0x34, 0x52, 0x36, 0xbf, 0xc2,
-	0xda, 0xb5, 0x03, 0xfc, 0x5e, 0x3f, 0xc8, 0xcd, 0x5d, 0x07, 0x79, 0x6f, 0xd7, 0x41, 0x7e, 0x0a,
-	0x6d, 0xed, 0x20, 0xae, 0xd5, 0x0f, 0xa0, 0xa9, 0x9b, 0xd6, 0xc6, 0xd4, 0xb5, 0xed, 0xc4, 0x58,
-	0x12, 0x64, 0x1c, 0xfa, 0xbb, 0x0b, 0xdd, 0x77, 0x72, 0xc2, 0xde, 0x31, 0x85, 0x0b, 0x93, 0xc2,
-	0x3e, 0x33, 0x0b, 0xb4, 0x14, 0x5f, 0x05, 0xd3, 0xb5, 0x9f, 0xcb, 0xc8, 0x08, 0x64, 0xb3, 0x53,
-	0x00, 0xe5, 0xdb, 0x57, 0xc7, 0x00, 0xcb, 0x87, 0x5e, 0x2e, 0xd5, 0xa5, 0x5c, 0x8a, 0x49, 0x6a,
-	0x7e, 0x72, 0x14, 0x80, 0x9e, 0x38, 0x2e, 0x0c, 0x33, 0x0b, 0x3f, 0xa7, 0xe9, 0xa7, 0x00, 0xda,
-	0xe9, 0x34, 0x60, 0xf1, 0xfc, 0x86, 0x7c, 0x54, 0x0d, 0xeb, 0xa8, 0x14, 0x56, 0x8a, 0x27, 0xc1,
-	0xc4, 0xf6, 0xb3, 0x0b, 0x9d, 0x1c, 0xcc, 0x2b, 0xe1, 0x96, 0x2a, 0xd1, 0x83, 0x1a, 0x8f, 0x4d,
-	0x08, 0x35, 0x1e, 0x6f, 0x3d, 0xa9, 0x6b, 0xbb, 0xa2, 0xb1, 0xb9, 0x2b, 0xaa, 0xdb, 0xa6, 0xb9,
-	0xbe, 0x6d, 0xbc, 0x5f, 0x5a, 0xd0, 0x8d, 0xbd, 0x78, 0x6a, 0xf3, 0xf0, 0x04, 0xba, 0xf9, 0xfa,
-	0xb8, 0xb8, 0x26, 0x95, 0x85, 0x31, 0xb0, 0x14, 0x86, 0x4a, 0x1d, 0xf2, 0x02, 0x7a, 0xb9, 0x70,
-	0xb6, 0x0c, 0xd7, 0xb7, 0xc7, 0x86, 0xca, 0x31, 0x34, 0xf0, 0xa7, 0xc4, 0xda, 0xfa, 0x18, 0x94,
-	0x69, 0x29, 0xa6, 0xd4, 0x21, 0x43, 0x68, 0xd9, 0x23, 0x7f, 0xa7, 0x60, 0x1a, 0xa8, 0x2c, 0xaf,
-	0x69, 0xea, 0x90, 0x97, 0xd0, 0x35, 0x4c, 0xec, 0xaf, 0x2d, 0x3a, 0xa4, 0xaa, 0xa3, 0xc5, 0xa8,
-	0x43, 0x9e, 0x43, 0xcb, 0xfe, 0x42, 0x2c, 0xe9, 0x18, 0x68, 0x70, 0x54, 0x81, 0x4e, 0xa2, 0xf7,
-	0xd4, 0x21, 0x5e, 0xbe, 0xcd, 0xbd, 0x6d, 0x2a, 0x9b, 0x10, 0x75, 0xc8, 0x53, 0xe8, 0x8e, 0xe5,
-	0x95, 0xb2, 0x96, 0xd6, 0xc3, 0xdf, 0xcc, 0x6c, 0xa7, 0x38, 0xf3, 0xff, 0xa9, 0x84, 0x92, 0x81,
-	0x83, 0x83, 0x02, 0x3c, 0x17, 0x2b, 0xea, 0x90, 0x11, 0x40, 0x76, 0xaf, 0x7d, 0x7d, 0xaf, 0xef,
-	0x56, 0x74, 0xcc, 0x15, 0xdf, 0x54, 0x7a, 0x81, 0x49, 0xc6, 0xad, 0x56, 0x4d, 0x98, 0x86, 0x06,
-	0x87, 0xd5, 0x45, 0x93, 0x52, 0xe7, 0xb9, 0x4b, 0x3e, 0x47, 0x3b, 0x76, 0x7f, 0x56, 0xed, 0x18,
-	0xb4, 0x9c, 0x02, 0x03, 0x51, 0x87, 0x7c, 0x81, 0x05, 0xca, 0xff, 0x22, 0xfc, 0xb7, 0xa2, 0x69,
-	0xe1, 0xc1, 0x96, 0x9f, 0x51, 0xd4, 0x21, 0xaf, 0xe0, 0x68, 0xcc, 0x92, 0x15, 0x4b, 0xc6, 0x2a,
-	0x61, 0xe1, 0x22, 0x60, 0xe1, 0x24, 0x37, 0x5d, 0x39, 0x77, 0x79, 0x88, 0x01, 0xfb, 0xf1, 0x1d,
-	0x9f, 0x53, 0xe7, 0xd8, 0x25, 0x5f, 0x56, 0x95, 0xc7, 0x4c, 0x4c, 0x36, 0x0a, 0xb0, 0xf5, 0x31,
-	0x8c, 0x77, 0x04, 0xbd, 0xd7, 0x72, 0x3e, 0x67, 0x91, 0x3a, 0x17, 0x38, 0xb1, 0x1b, 0xba, 0x87,
-	0xa5, 0x21, 0x37, 0x4d, 0xf5, 0x12, 0x0e, 0xab, 0x4a, 0xde, 0x86, 0xd6, 0x9d, 0xf2, 0x6a, 0x30,
-	0x75, 0x3f, 0x7d, 0xf0, 0xc3, 0xff, 0xa7, 0x5c, 0xcd, 0x96, 0x97, 0xc3, 0x48, 0x2e, 0x9e, 0x8d,
-	0x46, 0x91, 0x78, 0x86, 0x7f, 0xc9, 0x46, 0xa3, 0x67, 0x28, 0x7d, 0xb9, 0x87, 0xff, 0xcd, 0x46,
-	0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x73, 0x56, 0x36, 0x8a, 0xe2, 0x0d, 0x00, 0x00,
+	// 1935 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x18, 0xdb, 0x8e, 0x23, 0x47,
+	0xd5, 0xd7, 0xb1, 0x7d, 0xec, 0xb9, 0x6c, 0xb1, 0x2c, 0x2d, 0x6b, 0x49, 0x86, 0xd2, 0x84, 0x0c,
+	0xac, 0x32, 0xbb, 0xf1, 0x90, 0x05, 0x91, 0x40, 0xd8, 0xd9, 0x25, 0x3b, 0x13, 0x25, 0x2b, 0xab,
+	0x3d, 0x20, 0x14, 0x69, 0x1f, 0x7a, 0xba, 0xcb, 0x9e, 0xd2, 0xd8, 0xd5, 0xbd, 0x5d, 0x65, 0x63,
+	0xf3, 0xce, 0x0f, 0xf0, 0x21, 0x88, 0x17, 0x1e, 0x78, 0xe4, 0x3b, 0xf8, 0x0b, 0xbe, 0x00, 0xd5,
+	0xe9, 0xaa, 0xee, 0x2e, 0xdb, 0x63, 0xa4, 0x95, 0xf2, 0xd6, 0xe7, 0x5a, 0xe7, 0x5e, 0xa7, 0x1a,
+	0x3a, 0xc9, 0x20, 0x39, 0x4b, 0xd2, 0x58, 0xc5, 0xa4, 0xa9, 0x56, 0x09, 0x93, 0xfd, 0x07, 0x2a,
+	0x0d, 0x84, 0x0c, 0x42, 0xc5, 0x63, 0x91, 0x51, 0xfa, 0xbd, 0x30, 0x9e, 0xcd, 0x72, 0xe8, 0xe8,
+	0x66, 0x1a, 0x87, 0x77, 0xe1, 0x6d, 0xc0, 0x0d, 0x86, 0xfe, 0x1c, 0x0e, 0x86, 0x83, 0xe1, 0x6b,
+	0xa6, 0x86, 0x8c, 0xa5, 0x57, 0x62, 0x1c, 0x13, 0x0f, 0x5a, 0x0b, 0x96, 0x4a, 0x1e, 0x0b, 0xaf,
+	0x7a, 0x5c, 0x3d, 0x6d, 0xfa, 0x16, 0xa4, 0x7f, 0xab, 0x42, 0x77, 0x38, 0x18, 0xe6, 0x9c, 0x04,
+	0x1a, 0x41, 0x14, 0xa5, 0xc8, 0xd6, 0xf1, 0xf1, 0x5b, 0xe3, 0x92, 0x38, 0x55, 0x5e, 0x0d, 0x45,
+	0xf1, 0x5b, 0xe3, 0x44, 0x30, 0x63, 0x5e, 0x3d, 0xe3, 0xd3, 0xdf, 0xe4, 0x18, 0xba, 0x33, 0x36,
+	0x4b, 0xe2, 0x78, 0x3a, 0xe2, 0x7f, 0x61, 0x5e, 0x03, 0xd9, 0xcb, 0x28, 0xf2, 0x11, 0xec, 0xdd,
+	0xb2, 0x20, 0x62, 0xa9, 0xd7, 0x3c, 0xae, 0x9e, 0x76, 0x07, 0xfb, 0x67, 0xe8, 0xe4, 0xd9, 0x25,
+	0x22, 0x7d, 0x43, 0xa4, 0x7f, 0xaf, 0x01, 0x0c, 0x07, 0xc3, 0x3f, 0x66, 0x36, 0xde, 0x6f, 0xbd,
+	0xa6, 0x48, 0x96, 0x2e, 0x78, 0xc8, 0xd0, 0xb8, 0xba, 0x6f, 0x41, 0xf2, 0x18, 0x3a, 0x8a, 0xcf,
+	0x98, 0x54, 0xc1, 0x2c, 0x41, 0x23, 0xeb, 0x7e, 0x81, 0x20, 0x7d, 0x68, 0x6b, 0xcf, 0x7c, 0x16,
+	0x2e, 0xd0, 0xcc, 0x8e, 0x9f, 0xc3, 0x96, 0xf6, 0x55, 0x1a, 0xcf, 0xbc, 0x66, 0x41, 0xd3, 0x30,
+	0x79, 0x08, 0x4d, 0x11, 0x8b, 0x90, 0x79, 0x7b, 0xa8, 0x31, 0x03, 0xf4, 0x59, 0x73, 0xc9, 0xd2,
+	0x17, 0x13, 0x26, 0x94, 0xd7, 0x42, 0x91, 0x02, 0xa1, 0xa3, 0x22, 0x55, 0x90, 0xaa, 0x4b, 0xc6,
+	0x27, 0xb7, 0xca, 0x6b, 0xa3, 0x64, 0x19, 0x45, 0x1e, 0xc1, 0x9e, 0x88, 0x23, 0x76, 0xf5, 0xca,
+	0x83, 0xe3, 0xea, 0x69, 0xcf, 0x37, 0x10, 0xa1, 0xd0, 0x0b, 0x83, 0x24, 0xb8, 0xe1, 0x53, 0xae,
+	0x38, 0x93, 0x5e, 0xf7, 0xb8, 0x7a, 0xda, 0xf0, 0x1d, 0xdc, 0xd7, 0x8d, 0x76, 0xe7, 0x08, 0xe8,
+	0x1f, 0xa0, 0x93, 0xc5, 0xeb, 0x45, 0x78, 0xf7, 0x5e, 0xe1, 0xca, 0x1d, 0xab, 0x97, 0x1c, 0xa3,
+	0x33, 0x68, 0xe9, 0xda, 0xe0, 0x62, 0x52, 0x30, 0x54, 0xcb, 0x9e, 0xdb, 0x6a, 0xa9, 0x6d, 0xa9,
+	0x96, 0x7a, 0xa9, 0x5a, 0x4e, 0xa0, 0x21, 0xf9, 0x44, 0x60, 0xac, 0xbb, 0x83, 0x23, 0x93, 0xf5,
+	0x11, 0x9f, 0x88, 0x40, 0xcd, 0x53, 0xe6, 0x23, 0x95, 0x7e, 0x98, 0x1d, 0x17, 0xdf, 0x77, 0x1c,
+	0xa5, 0x58, 0x16, 0xaf, 0x99, 0x7a, 0xa1, 0x0f, 0xda, 0xce, 0xf3, 0x39, 0x2a, 0xb9, 0x9f, 0xc1,
+	0xe6, 0x77, 0xca, 0xa5, 0xae, 0xe8, 0xba, 0xcd, 0xaf, 0x86, 0xe9, 0x08, 0xba, 0x46, 0xf8, 0x1b,
+	0x2e, 0xd5, 0x3d, 0x0a, 0xce, 0xa0, 0x9d, 0x30, 0x96, 0x72, 0x31, 0x8e, 0x51, 0x41, 0x77, 0x40,
+	0x8c, 0x43, 0xa5, 0x46, 0xf2, 0x73, 0x1e, 0xfa, 0x25, 0x2a, 0xfd, 0x8a, 0x8b, 0xe8, 0x4d, 0x1c,
+	0xb1, 0x1d, 0xe9, 0x79, 0x04, 0x7b, 0x2a, 0x48, 0x27, 0x2c, 0xeb, 0xb4, 0x9e, 0x6f, 0x20, 0xfa,
+	0x19, 0xba, 0x84, 0xc2, 0x45, 0xa9, 0x54, 0x9d, 0x52, 0xd9, 0x92, 0x08, 0xfa, 0x0c, 0xda, 0x46,
+	0x4c, 0x92, 0x13, 0xed, 0x49, 0xc4, 0xa4, 0x57, 0x45, 0x83, 0x0f, 0x0a, 0x83, 0x35, 0xdd, 0xcf,
+	0x88, 0xf4, 0x37, 0xb0, 0x9f, 0xc5, 0xf7, 0x7a, 0x39, 0x4c, 0xe3, 0x78, 0xbc, 0xc3, 0x56, 0x02,
+	0x8d, 0xdb, 0x40, 0xde, 0x1a, 0x4b, 0xf1, 0x9b, 0xfe, 0x09, 0x8e, 0x32, 0xf1, 0x91, 0x0a, 0x14,
+	0xfb, 0x7f, 0x1a, 0x1e, 0xe9, 0x59, 0x80, 0x2d, 0x91, 0xd5, 0xa2, 0x81, 0xb4, 0xe6, 0x3b, 0xb6,
+	0x92, 0x5e, 0xfd, 0xb8, 0xae, 0x35, 0xeb, 0x6f, 0xfa, 0x16, 0x0d, 0x2b, 0xa9, 0x7d, 0x0c, 0x1d,
+	0xa9, 0xa1, 0x4b, 0x6d, 0x43, 0x16, 0x8a, 0x02, 0xa1, 0x55, 0x2f, 0x82, 0xe9, 0x9c, 0x49, 0xcc,
+	0x4f, 0xcf, 0x37, 0x90, 0xc6, 0x27, 0x5a, 0xdc, 0x2a, 0x37, 0x10, 0x7d, 0x09, 0x87, 0xc3, 0xc1,
+	0xf0, 0xf7, 0x4b, 0xc5, 0x52, 0x11, 0x4c, 0xef, 0x9d, 0x83, 0x8f, 0xa1, 0xc3, 0x65, 0x3c, 0x57,
+	0x92, 0x47, 0x59, 0x03, 0xb5, 0xfd, 0x02, 0x41, 0x6f, 0xa1, 0x97, 0x79, 0x7f, 0xa1, 0xe7, 0xb1,
+	0xdc, 0xe1, 0xf9, 0xda, 0x44, 0xa8, 0x6d, 0x4e, 0x84, 0xc7, 0xd0, 0x61, 0x22, 0x32, 0x74, 0x33,
+	0xbd, 0x72, 0x04, 0xfd, 0x99, 0x4d, 0xd3, 0xb7, 0xd9, 0x68, 0xdd, 0x31, 0xde, 0xcf, 0x60, 0x6f,
+	0x38, 0x18, 0x5e, 0x89, 0x85, 0x6e, 0x41, 0x2e, 0x16, 0xb6, 0x00, 0x6c, 0x0b, 0x5e, 0x89, 0x05,
+	0x13, 0x2a, 0x4e, 0x57, 0x3e, 0x52, 0xe9, 0x5b, 0xe8, 0xe4, 0x28, 0x72, 0x00, 0x35, 0xb5, 0x32,
+	0x1a, 0x6b, 0x6a, 0xb5, 0x2d, 0xe7, 0xa5, 0x2c, 0xd6, 0x9d, 0x2c, 0x3e, 0x84, 0x26, 0x17, 0x11,
+	0x5b, 0x9a, 0x5b, 0x20, 0x03, 0xe8, 0x37, 0xb6, 0x81, 0x5f, 0x05, 0x2a, 0xd8, 0x11, 0x21, 0x6b,
+	0x6c, 0x6d, 0xa7, 0xb1, 0x4f, 0xa0, 0x39, 0x1c, 0x0c, 0xaf, 0x97, 0x84, 0x42, 0x4d, 0x2d, 0x51,
+	0x47, 0xd1, 0x8b, 0xd7, 0xc5, 0xb5, 0xe9, 0xd7, 0xd4, 0x92, 0x9e, 0x61, 0x37, 0x60, 0x6e, 0x08,
+	0x85, 0x26, 0x5e, 0x9a, 0x46, 0xa4, 0x67, 0x44, 0x90, 0xe8, 0x67, 0x24, 0x7a, 0x0b, 0x6d, 0x73,
+	0xff, 0x48, 0xf2, 0x01, 0x40, 0x32, 0x48, 0x5c, 0x5b, 0x4b, 0x18, 0x4c, 0x68, 0x3c, 0x56, 0x96,
+	0x21, 0x6b, 0xc2, 0x32, 0x4a, 0x0f, 0x1d, 0x3d, 0x0f, 0x4a, 0x57, 0x66, 0x0e, 0xd3, 0xff, 0x56,
+	0x61, 0xff, 0x22, 0x8d, 0x83, 0xe8, 0x65, 0x20, 0xb3, 0xc0, 0x7c, 0x50, 0xf2, 0xa7, 0x57, 0xb4,
+	0xea, 0xf5, 0xf2, 0xb2, 0xa2, 0x7d, 0x21, 0x1f, 0x5b, 0xfb, 0x6b, 0xc8, 0x72, 0x58, 0xb0, 0xa0,
+	0x0b, 0x97, 0x15, 0xe3, 0x84, 0x8e, 0x63, 0xc2, 0xc5, 0x04, 0x8f, 0x74, 0xba, 0x5e, 0xcf, 0xf4,
+	0xcb, 0x8a, 0x8f, 0x54, 0xf2, 0xa4, 0xc8, 0x43, 0xc3, 0x51, 0x68, 0x03, 0x70, 0x59, 0x29, 0x52,
+	0xf3, 0x05, 0xe8, 0xf5, 0x23, 0x09, 0xc2, 0xac, 0xce, 0xcd, 0x45, 0xfe, 0xa8, 0x50, 0xfd, 0xb2,
+	0x44, 0xbd, 0xac, 0xf8, 0x0e, 0xf7, 0x45, 0x0b, 0x9a, 0xd8, 0x8b, 0xf4, 0x9f, 0x55, 0x38, 0x5c,
+	0x63, 0x26, 0x27, 0xf9, 0x76, 0xb0, 0x2d, 0x2f, 0x86, 0xb6, 0xb5, 0x0a, 0xb7, 0x5e, 0x5f, 0x3a,
+	0xe8, 0xf2, 0x36, 0x4e, 0xd5, 0xd5, 0x2b, 0xe9, 0x35, 0x8e, 0xeb, 0xa7, 0x0d, 0x3f, 0x87, 0xc9,
+	0x73, 0xe8, 0x25, 0x29, 0x1b, 0xf3, 0xe9, 0x94, 0x45, 0xd7, 0x4b, 0xe9, 0x35, 0xdd, 0x41, 0x5e,
+	0x90, 0x7c, 0x87, 0x8f, 0xbe, 0x86, 0x6e, 0x89, 0x58, 0x94, 0x79, 0xb5, 0x54, 0xe6, 0xa6, 0x1e,
+	0x6b, 0x3b, 0xeb, 0xf1, 0x4b, 0xe8, 0xfa, 0xec, 0xdd, 0x48, 0xdb, 0x73, 0xbd, 0x94, 0xf7, 0xdf,
+	0x55, 0xb9, 0x07, 0x35, 0xd7, 0x03, 0xca, 0xed, 0x14, 0xc8, 0x96, 0xa7, 0xef, 0x73, 0xe0, 0x7c,
+	0x86, 0x6d, 0x6b, 0xcf, 0xf9, 0x18, 0x5a, 0x59, 0x2a, 0xec, 0x30, 0x59, 0xdb, 0xe2, 0x2c, 0x95,
+	0x0a, 0x68, 0x5d, 0x89, 0x05, 0x56, 0xf4, 0xc9, 0xee, 0x0e, 0x35, 0x75, 0x7d, 0xe2, 0xd6, 0xb5,
+	0x93, 0xff, 0xa2, 0xa8, 0xb3, 0xb1, 0x54, 0xb7, 0x63, 0xa9, 0xa8, 0xa9, 0x67, 0xd0, 0x36, 0xe7,
+	0xe1, 0x85, 0xc7, 0x15, 0x9b, 0xad, 0x5f, 0x78, 0x86, 0xee, 0x67, 0x44, 0xfa, 0x9f, 0x2a, 0x34,
+	0x86, 0x2c, 0x2b, 0xaa, 0xf7, 0x5e, 0x7b, 0x09, 0x34, 0x24, 0x9b, 0x8e, 0xb1, 0x77, 0xda, 0x3e,
+	0x7e, 0xaf, 0xaf, 0xc2, 0xcd, 0x5d, 0xab, 0xf0, 0xde, 0x8e, 0x55, 0x58, 0x27, 0xe6, 0x66, 0xa5,
+	0x98, 0x1c, 0xd9, 0xdd, 0xb2, 0xee, 0x17, 0x88, 0x9c, 0x8a, 0x8b, 0x6c, 0xbb, 0x44, 0xd5, 0x08,
+	0xfa, 0x09, 0xb4, 0xb5, 0x73, 0xb8, 0xca, 0xfc, 0x04, 0x9a, 0x7a, 0xe0, 0xd8, 0x78, 0x74, 0x6d,
+	0xa1, 0x33, 0x96, 0xfa, 0x19, 0x85, 0xfe, 0xa3, 0x06, 0x5d, 0xbd, 0x0d, 0xbc, 0x61, 0x0a, 0xaf,
+	0x40, 0x0a, 0x3d, 0x66, 0xae, 0xc4, 0x52, 0x6c, 0x1c, 0x9c, 0x36, 0x60, 0x1a, 0x87, 0x86, 0x21,
+	0x9b, 0x7b, 0x05, 0xa2, 0xbc, 0x6f, 0xd6, 0x31, 0x38, 0xe5, 0xf5, 0x3c, 0x9e, 0xab, 0x9b, 0x78,
+	0x2e, 0x22, 0x69, 0xae, 0x88, 0x02, 0xa1, 0xcb, 0x9e, 0x0b, 0x43, 0xcc, 0x42, 0x97, 0xc3, 0x6e,
+	0x40, 0xf6, 0x76, 0x06, 0xa4, 0xb5, 0x16, 0x10, 0x72, 0x6a, 0x83, 0xd0, 0x76, 0xbb, 0x9d, 0xb1,
+	0xf4, 0x3a, 0x0d, 0xc6, 0x63, 0x1e, 0x9a, 0x58, 0x90, 0x8f, 0xa0, 0x31, 0x93, 0x13, 0xe9, 0x75,
+	0x90, 0xf1, 0x81, 0x61, 0xfc, 0x56, 0x4e, 0x2c, 0x1f, 0x92, 0xe9, 0x9f, 0xa1, 0x5b, 0x12, 0xde,
+	0x5a, 0x45, 0x1e, 0xb4, 0x8c, 0xed, 0x66, 0x65, 0xb0, 0xa0, 0xeb, 0x49, 0x7d, 0xa7, 0x27, 0x8d,
+	0xf5, 0xd4, 0x7e, 0x07, 0x50, 0x18, 0x43, 0x8e, 0xa0, 0x3e, 0x93, 0x13, 0x73, 0xac, 0xfe, 0x74,
+	0x75, 0xd7, 0x76, 0xea, 0xae, 0xaf, 0xeb, 0xfe, 0x05, 0x80, 0x76, 0x4a, 0xfa, 0x2c, 0x99, 0xae,
+	0xc8, 0x4f, 0xdd, 0xc2, 0x39, 0x2a, 0xc5, 0x4c, 0xe2, 0xa2, 0x6b, 0xaa, 0xe7, 0xaf, 0x55, 0xe8,
+	0xe4, 0xc8, 0xbc, 0x4f, 0xaa, 0xa5, 0x3e, 0x39, 0x80, 0x1a, 0x4f, 0x4c, 0x91, 0xd4, 0x78, 0xb2,
+	0xf5, 0xa1, 0xb0, 0x76, 0x93, 0x36, 0x36, 0x6f, 0x52, 0xf7, 0x2e, 0x6e, 0xae, 0xdf, 0xc5, 0xf4,
+	0x6b, 0x00, 0x9f, 0x69, 0x5d, 0xd8, 0xd7, 0x47, 0x50, 0x4f, 0x78, 0x64, 0x23, 0x93, 0xf0, 0x08,
+	0xd7, 0x4b, 0x6e, 0x92, 0xd1, 0xf4, 0xf1, 0x5b, 0x2f, 0x31, 0x29, 0x0b, 0x64, 0x2c, 0x4c, 0x5f,
+	0x1b, 0x88, 0xfe, 0xcb, 0xf8, 0x34, 0x0a, 0xe3, 0x94, 0x6d, 0xd7, 0xb5, 0xf1, 0xfc, 0x79, 0x08,
+	0x4d, 0xa9, 0xd9, 0xed, 0x55, 0x84, 0x00, 0x39, 0x81, 0x7d, 0x2e, 0x16, 0xc1, 0x94, 0x47, 0xd9,
+	0x76, 0x68, 0x32, 0xea, 0x22, 0xb5, 0x1d, 0x37, 0x81, 0xb9, 0x8e, 0x34, 0xd9, 0x40, 0xba, 0x1f,
+	0xf4, 0xdb, 0x55, 0x6f, 0x9a, 0xa6, 0xe4, 0x73, 0x58, 0xcb, 0xcc, 0x25, 0x1b, 0xcf, 0xa7, 0xa6,
+	0xdc, 0x0d, 0xa4, 0xb3, 0x78, 0x11, 0x08, 0xc1, 0x22, 0x1b, 0x87, 0x3b, 0xb6, 0xb2, 0xb6, 0xdf,
+	0xb1, 0x95, 0xb6, 0x73, 0x2e, 0x14, 0x9f, 0x9a, 0xea, 0xc8, 0x00, 0xfa, 0x16, 0x20, 0x77, 0x58,
+	0x92, 0x53, 0xd8, 0x43, 0xf3, 0xb7, 0x25, 0x1f, 0x59, 0x7c, 0x43, 0xd7, 0xfd, 0x72, 0x13, 0x08,
+	0xbb, 0xb0, 0xd9, 0x7e, 0x29, 0x0c, 0xf0, 0x91, 0x4c, 0x7f, 0xa5, 0x93, 0xf3, 0xee, 0x22, 0x10,
+	0xf7, 0x18, 0x85, 0x03, 0x23, 0x8c, 0x75, 0xdf, 0xe7, 0x0f, 0x54, 0x04, 0x07, 0xff, 0x6e, 0x43,
+	0x37, 0x19, 0x24, 0x13, 0x3b, 0x40, 0x9e, 0x40, 0x37, 0xdf, 0x99, 0xae, 0x97, 0xc4, 0xd9, 0x92,
+	0xfa, 0x16, 0xc2, 0x0a, 0xa6, 0x15, 0xf2, 0x29, 0x1c, 0xe4, 0xcc, 0xd9, 0xaa, 0xb1, 0xbe, 0x32,
+	0x6d, 0x88, 0x9c, 0x42, 0x03, 0xdf, 0xbd, 0x6b, 0x3b, 0x53, 0xbf, 0x0c, 0xc7, 0x62, 0x42, 0x2b,
+	0xe4, 0x0c, 0x5a, 0xf6, 0x45, 0xfa, 0xa0, 0x20, 0x1a, 0x54, 0x99, 0x5f, 0xc3, 0xb4, 0x42, 0x9e,
+	0x43, 0xd7, 0x10, 0x71, 0x30, 0x6f, 0x91, 0x21, 0xae, 0x8c, 0x66, 0xa3, 0x15, 0xf2, 0x0c, 0x5a,
+	0xf6, 0x87, 0x48, 0x49, 0xc6, 0xa0, 0xfa, 0x47, 0x0e, 0xea, 0x45, 0x78, 0x47, 0x2b, 0x64, 0x90,
+	0xaf, 0xb0, 0x83, 0x6d, 0x22, 0x9b, 0x28, 0x5a, 0x21, 0x9f, 0x40, 0x77, 0x14, 0x8f, 0x95, 0x3d,
+	0x69, 0xdd, 0xfd, 0xcd, 0xc8, 0x76, 0x8a, 0x17, 0xcf, 0x0f, 0x1c, 0x57, 0x32, 0x64, 0x7f, 0xbf,
+	0x40, 0x5e, 0x89, 0x05, 0xad, 0x90, 0x73, 0x80, 0xec, 0xe9, 0x32, 0xd4, 0x4f, 0x97, 0x87, 0x8e,
+	0x8c, 0x79, 0xd0, 0x6c, 0x0a, 0x7d, 0x8a, 0x41, 0xc6, 0x55, 0xc2, 0x0d, 0x98, 0x46, 0xf5, 0x0f,
+	0xdd, 0xdb, 0x5d, 0xd2, 0xca, 0xb3, 0x2a, 0xf9, 0x25, 0x9e, 0x63, 0x97, 0x16, 0xf7, 0x1c, 0x83,
+	0x2d, 0x87, 0xc0, 0xa0, 0x68, 0x85, 0xfc, 0x1a, 0x13, 0x94, 0xff, 0x11, 0xfb, 0xa1, 0x23, 0x69,
+	0xd1, 0xfd, 0x2d, 0x6f, 0x7e, 0x5a, 0x21, 0x9f, 0xc3, 0xd1, 0x88, 0xa5, 0x0b, 0x96, 0x8e, 0x54,
+	0xca, 0x82, 0x99, 0xcf, 0x82, 0x28, 0x3f, 0xda, 0xd9, 0xf1, 0x73, 0x17, 0x7d, 0xf6, 0xee, 0x0d,
+	0x9f, 0xd2, 0xca, 0x69, 0x95, 0x7c, 0xe1, 0x0a, 0x8f, 0x98, 0x88, 0x36, 0x12, 0xb0, 0x55, 0x19,
+	0xfa, 0x7b, 0x0e, 0x07, 0x2f, 0xe3, 0xe9, 0x94, 0x85, 0xea, 0x0a, 0xdb, 0x4b, 0x6e, 0xc8, 0x1e,
+	0x96, 0xda, 0xd7, 0x14, 0xd5, 0x73, 0x38, 0x74, 0x85, 0x06, 0x1b, 0x52, 0x0f, 0x4a, 0x52, 0xb2,
+	0xc8, 0x7b, 0x3b, 0xff, 0xa1, 0x51, 0x8a, 0x84, 0xc5, 0xf5, 0x0f, 0xdd, 0x1f, 0x0c, 0x3a, 0xac,
+	0xbf, 0x05, 0x28, 0xfd, 0x59, 0x70, 0xf3, 0x61, 0xb0, 0x7d, 0x6f, 0x73, 0x35, 0x7c, 0xc5, 0x54,
+	0xa0, 0xe3, 0x43, 0x7e, 0x07, 0xfb, 0xee, 0xaf, 0x85, 0x1f, 0x39, 0x2a, 0x0a, 0x42, 0xbf, 0xa4,
+	0xbb, 0xc0, 0xd2, 0xca, 0xc5, 0x87, 0xdf, 0xfd, 0x78, 0xc2, 0xd5, 0xed, 0xfc, 0xe6, 0x2c, 0x8c,
+	0x67, 0x4f, 0xcf, 0xcf, 0x43, 0xf1, 0x14, 0x7f, 0x9b, 0x9e, 0x9f, 0x3f, 0x45, 0x81, 0x9b, 0x3d,
+	0xfc, 0x7f, 0x7a, 0xfe, 0xbf, 0x01, 0x00, 0xa4, 0x78, 0xaa, 0x96, 0x86, 0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1966,6 +3004,12 @@ type P2PgserviceClient interface {
 	// grpc 收集inpeers
 	CollectInPeers(ctx context.Context, in *P2PPing, opts ...grpc.CallOption) (*PeerList, error)
 	CollectInPeers2(ctx context.Context, in *P2PPing, opts ...grpc.CallOption) (*PeersReply, error)
+	//按节点 ID 查找距离最近的节点
+	FindNode(ctx context.Context, in *P2PFindNode, opts ...grpc.CallOption) (*P2PNodes, error)
+	//轻节点获取交易以及交易的默克尔证明
+	GetTxProof(ctx context.Context, in *P2PGetTxProof, opts ...grpc.CallOption) (*TransactionDetail, error)
+	//轻节点获取指定高度的状态证明
+	GetStateProof(ctx context.Context, in *P2PGetStateProof, opts ...grpc.CallOption) (*P2PStateProof, error)
 }
 
 type p2PgserviceClient struct {
@@ -2200,6 +3244,33 @@ func (c *p2PgserviceClient) CollectInPeers2(ctx context.Context, in *P2PPing, op
 	return out, nil
 }
 
+func (c *p2PgserviceClient) FindNode(ctx context.Context, in *P2PFindNode, opts ...grpc.CallOption) (*P2PNodes, error) {
+	out := new(P2PNodes)
+	err := c.cc.Invoke(ctx, "/types.p2pgservice/FindNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *p2PgserviceClient) GetTxProof(ctx context.Context, in *P2PGetTxProof, opts ...grpc.CallOption) (*TransactionDetail, error) {
+	out := new(TransactionDetail)
+	err := c.cc.Invoke(ctx, "/types.p2pgservice/GetTxProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *p2PgserviceClient) GetStateProof(ctx context.Context, in *P2PGetStateProof, opts ...grpc.CallOption) (*P2PStateProof, error) {
+	out := new(P2PStateProof)
+	err := c.cc.Invoke(ctx, "/types.p2pgservice/GetStateProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // P2PgserviceServer is the server API for P2Pgservice service.
 type P2PgserviceServer interface {
 	//广播交易
@@ -2234,6 +3305,12 @@ type P2PgserviceServer interface {
 	// grpc 收集inpeers
 	CollectInPeers(context.Context, *P2PPing) (*PeerList, error)
 	CollectInPeers2(context.Context, *P2PPing) (*PeersReply, error)
+	//按节点 ID 查找距离最近的节点
+	FindNode(context.Context, *P2PFindNode) (*P2PNodes, error)
+	//轻节点获取交易以及交易的默克尔证明
+	GetTxProof(context.Context, *P2PGetTxProof) (*TransactionDetail, error)
+	//轻节点获取指定高度的状态证明
+	GetStateProof(context.Context, *P2PGetStateProof) (*P2PStateProof, error)
 }
 
 func RegisterP2PgserviceServer(s *grpc.Server, srv P2PgserviceServer) {
@@ -2560,6 +3637,60 @@ func _P2Pgservice_CollectInPeers2_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _P2Pgservice_FindNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(P2PFindNode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(P2PgserviceServer).FindNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.p2pgservice/FindNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(P2PgserviceServer).FindNode(ctx, req.(*P2PFindNode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _P2Pgservice_GetTxProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(P2PGetTxProof)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(P2PgserviceServer).GetTxProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.p2pgservice/GetTxProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(P2PgserviceServer).GetTxProof(ctx, req.(*P2PGetTxProof))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _P2Pgservice_GetStateProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(P2PGetStateProof)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(P2PgserviceServer).GetStateProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.p2pgservice/GetStateProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(P2PgserviceServer).GetStateProof(ctx, req.(*P2PGetStateProof))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _P2Pgservice_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "types.p2pgservice",
 	HandlerType: (*P2PgserviceServer)(nil),
@@ -2620,6 +3751,18 @@ var _P2Pgservice_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CollectInPeers2",
 			Handler:    _P2Pgservice_CollectInPeers2_Handler,
 		},
+		{
+			MethodName: "FindNode",
+			Handler:    _P2Pgservice_FindNode_Handler,
+		},
+		{
+			MethodName: "GetTxProof",
+			Handler:    _P2Pgservice_GetTxProof_Handler,
+		},
+		{
+			MethodName: "GetStateProof",
+			Handler:    _P2Pgservice_GetStateProof_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -26,72 +26,72 @@ const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 func init() { proto.RegisterFile("rpc.proto", fileDescriptor_77a6da22d6a3feb1) }
 
 var fileDescriptor_77a6da22d6a3feb1 = []byte{
-	// 1031 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xdd, 0x6f, 0xe2, 0x46,
-	0x10, 0xf7, 0x43, 0x1b, 0x8e, 0x3d, 0x20, 0x64, 0x43, 0xa2, 0x3b, 0xab, 0xa7, 0x93, 0x2c, 0x55,
-	0x7d, 0xa8, 0x0e, 0x7a, 0xd0, 0xa6, 0x1f, 0xd7, 0x56, 0x0a, 0x49, 0x21, 0x48, 0x1c, 0xe5, 0x62,
-	0xd2, 0x4a, 0x7d, 0x5b, 0xcc, 0x94, 0x58, 0x31, 0x6b, 0xc7, 0xbb, 0x0e, 0xe6, 0xcf, 0xeb, 0x7f,
-	0x56, 0xed, 0xda, 0xeb, 0x6f, 0x92, 0xdc, 0x9b, 0x77, 0x66, 0x7e, 0xf3, 0xe1, 0xfd, 0xcd, 0xcc,
-	0xa2, 0xba, 0xef, 0x59, 0x5d, 0xcf, 0x77, 0xb9, 0x8b, 0xbf, 0xe4, 0x3b, 0x0f, 0x98, 0xde, 0xb0,
-	0xdc, 0xcd, 0xc6, 0xa5, 0x91, 0x50, 0x3f, 0xe2, 0x3e, 0xa1, 0x8c, 0x58, 0xdc, 0x4e, 0x44, 0xed,
-	0xa5, 0xe3, 0x5a, 0x77, 0xd6, 0x2d, 0xb1, 0x95, 0xa4, 0xb1, 0x25, 0x8e, 0x03, 0x3c, 0x3e, 0xd5,
-	0xbd, 0xbe, 0x17, 0x7f, 0x36, 0x89, 0x65, 0xb9, 0x01, 0x55, 0x9a, 0x16, 0x84, 0x60, 0x05, 0xdc,
-	0xf5, 0xa3, 0x73, 0xff, 0xbf, 0x53, 0x54, 0x93, 0x7e, 0x06, 0x03, 0xfc, 0x0e, 0xd5, 0xc7, 0xc0,
-	0x87, 0xc2, 0x35, 0xc3, 0xed, 0xae, 0xcc, 0xa5, 0x7b, 0x0d, 0xf7, 0x91, 0x44, 0x6f, 0x24, 0x12,
-	0xcf, 0xd9, 0x19, 0x1a, 0xee, 0xa1, 0xe6, 0x18, 0xf8, 0x94, 0x30, 0x7e, 0x05, 0x64, 0x05, 0x3e,
-	0x6e, 0xa6, 0x90, 0x99, 0xed, 0xe8, 0xea, 0x18, 0x69, 0x0d, 0x0d, 0xff, 0x82, 0x3a, 0x17, 0x3e,
-	0x10, 0x0e, 0xd7, 0x64, 0xbb, 0x48, 0x6b, 0xc2, 0x87, 0xb1, 0x61, 0xa4, 0x5c, 0x84, 0xba, 0x12,
-	0xdc, 0x50, 0x66, 0xaf, 0xe9, 0x22, 0x34, 0x34, 0x7c, 0x89, 0xda, 0x29, 0x36, 0x1c, 0xfb, 0x6e,
-	0xe0, 0xe1, 0x37, 0x79, 0x5c, 0xea, 0x51, 0xaa, 0xab, 0xbc, 0xfc, 0x80, 0xb0, 0x09, 0x74, 0xb5,
-	0x27, 0xbe, 0x69, 0xaf, 0x29, 0xac, 0x16, 0x61, 0xa9, 0xd2, 0xdf, 0x51, 0xfb, 0x53, 0x00, 0xfe,
-	0x2e, 0x0b, 0x6a, 0xa5, 0xc5, 0x5e, 0x11, 0x76, 0xab, 0xbf, 0x8a, 0xcf, 0x19, 0x9b, 0x4b, 0xe0,
-	0xc4, 0x76, 0x64, 0xd8, 0x43, 0x11, 0x36, 0x0b, 0xc7, 0x65, 0xf3, 0x52, 0xd8, 0xdf, 0x50, 0x67,
-	0x0c, 0x3c, 0x63, 0x31, 0xdc, 0x9d, 0xaf, 0x56, 0x7e, 0x36, 0xb4, 0x38, 0xeb, 0xc7, 0x59, 0xdc,
-	0x22, 0x9c, 0xd0, 0x7f, 0x5d, 0x66, 0x68, 0x78, 0x8c, 0x4e, 0x8b, 0x70, 0x91, 0x29, 0xe4, 0xee,
-	0x36, 0x92, 0xe8, 0xaf, 0xf7, 0x65, 0x2f, 0x1c, 0xbd, 0x47, 0x68, 0x0c, 0xfc, 0x23, 0x6c, 0xe6,
-	0xae, 0xeb, 0x14, 0x6f, 0x19, 0xe7, 0x83, 0x4f, 0x6d, 0xc6, 0x65, 0xc5, 0x2f, 0xc7, 0xc0, 0xcf,
-	0x23, 0xea, 0xb1, 0x22, 0xe6, 0x24, 0x3e, 0xfe, 0x2d, 0x39, 0xab, 0xac, 0x24, 0x43, 0xd0, 0x0c,
-	0xb6, 0xb1, 0x00, 0x77, 0x32, 0xa8, 0x44, 0xaa, 0x77, 0xaa, 0xc0, 0x86, 0x86, 0xaf, 0xd1, 0x49,
-	0x24, 0xca, 0xd4, 0x20, 0xb2, 0xc1, 0x6f, 0x53, 0x37, 0x95, 0x06, 0xfa, 0x69, 0xce, 0xe3, 0x22,
-	0x4c, 0x2b, 0x1f, 0xa1, 0xe6, 0x64, 0xe3, 0xb9, 0x3e, 0x9f, 0xfb, 0xf6, 0xc3, 0x1d, 0xec, 0x12,
-	0xca, 0x25, 0xbe, 0x72, 0xea, 0xbd, 0xb9, 0x0d, 0x51, 0x53, 0x12, 0xc0, 0x15, 0xf7, 0x05, 0x8c,
-	0x95, 0xfd, 0xe4, 0xd4, 0x7a, 0x3b, 0xfb, 0x53, 0xc5, 0x15, 0x19, 0x1a, 0xee, 0xa3, 0x17, 0xa6,
-	0xc8, 0x6e, 0x04, 0x80, 0x4f, 0xcb, 0x70, 0x3e, 0x02, 0x28, 0x31, 0xe8, 0x03, 0xaa, 0x99, 0xa2,
-	0x45, 0x97, 0x0e, 0x7e, 0x55, 0x01, 0x99, 0x92, 0x25, 0x38, 0x8f, 0x24, 0xdd, 0xf8, 0x08, 0xfe,
-	0x1a, 0x86, 0xc4, 0x21, 0xd4, 0x02, 0xfc, 0x55, 0xd1, 0x43, 0x56, 0x9b, 0xe7, 0x41, 0xc4, 0x2a,
-	0x43, 0xc3, 0x67, 0xa8, 0x6e, 0x02, 0x9f, 0x13, 0xc6, 0xb6, 0x2b, 0xfc, 0xba, 0x22, 0x85, 0x48,
-	0x55, 0x4a, 0xfc, 0x6b, 0xf4, 0xc5, 0xd4, 0xb5, 0xee, 0x8a, 0xc4, 0x29, 0x9a, 0xbd, 0x43, 0x07,
-	0x37, 0x54, 0x1a, 0x1e, 0xe7, 0x8a, 0x88, 0x84, 0x15, 0x13, 0x4b, 0xb0, 0x72, 0x0e, 0xe0, 0x8b,
-	0x1e, 0x29, 0x3a, 0x57, 0x63, 0x40, 0xe8, 0x13, 0x1a, 0xb7, 0xe2, 0x11, 0xf7, 0x59, 0xec, 0xff,
-	0x11, 0x1d, 0x8e, 0x81, 0xc7, 0x35, 0x72, 0xc2, 0x83, 0x52, 0x07, 0xe4, 0xd3, 0x8d, 0x6c, 0x24,
-	0xff, 0xdb, 0x6a, 0x02, 0xff, 0xf9, 0x00, 0xfe, 0x83, 0x0d, 0xdb, 0xd2, 0xa0, 0x51, 0xd7, 0x95,
-	0xb3, 0x32, 0x34, 0xfc, 0x93, 0x0c, 0x2a, 0x18, 0x54, 0x05, 0xcd, 0x0d, 0x8a, 0xac, 0x91, 0xec,
-	0xef, 0x86, 0x8a, 0x2a, 0x22, 0x64, 0x73, 0x9d, 0x50, 0x5e, 0x49, 0xc6, 0xf7, 0xa8, 0x36, 0x06,
-	0x6a, 0x02, 0xac, 0x92, 0x49, 0x16, 0x9f, 0xa7, 0x84, 0xae, 0xf3, 0x10, 0x21, 0x55, 0x10, 0x5e,
-	0x80, 0xc8, 0xf3, 0x70, 0x37, 0xdf, 0x56, 0x42, 0x7a, 0xe8, 0x85, 0x49, 0x1e, 0x40, 0x62, 0x54,
-	0xee, 0x4a, 0x20, 0x41, 0xc5, 0x0b, 0xee, 0xcb, 0x49, 0xa5, 0x08, 0x7b, 0x94, 0x59, 0x61, 0x31,
-	0x4b, 0xd5, 0x1d, 0x67, 0x66, 0x4e, 0x1f, 0x21, 0x39, 0xdc, 0x2f, 0xc4, 0x16, 0x4c, 0x66, 0x8e,
-	0x3c, 0xfd, 0x11, 0xef, 0xca, 0xaa, 0x38, 0x42, 0x17, 0xdd, 0xde, 0x33, 0x31, 0x67, 0xa8, 0x15,
-	0xc5, 0x71, 0x29, 0x03, 0xca, 0x02, 0xf6, 0x4c, 0xdc, 0xcf, 0xe8, 0xa8, 0xb4, 0xe0, 0x92, 0xd2,
-	0xd4, 0xca, 0x9c, 0xd0, 0xaa, 0x75, 0xf7, 0x9d, 0xa4, 0xef, 0x15, 0x84, 0x8b, 0x30, 0x9a, 0xfd,
-	0x25, 0x32, 0x35, 0x92, 0x1d, 0x1d, 0xc6, 0x0b, 0xf2, 0xe5, 0x65, 0xb0, 0xf1, 0xd4, 0xb8, 0xcb,
-	0x2c, 0x0a, 0x93, 0xfb, 0x36, 0x5d, 0xe7, 0x09, 0x1f, 0xc9, 0x0c, 0x0d, 0x77, 0x51, 0xed, 0x2f,
-	0xf0, 0x99, 0xc8, 0x6c, 0x4f, 0x83, 0xc4, 0x6a, 0xd1, 0x77, 0x86, 0x86, 0xbf, 0x41, 0x07, 0x13,
-	0x66, 0xee, 0xa8, 0xf5, 0x54, 0x83, 0xf7, 0x50, 0x6b, 0xc2, 0x66, 0xdc, 0xbb, 0x10, 0xe4, 0x7c,
-	0x0e, 0xa0, 0x8b, 0x6a, 0x33, 0xe0, 0x55, 0xed, 0xad, 0x32, 0x99, 0xb9, 0x2b, 0x88, 0x4d, 0xe4,
-	0x2f, 0x12, 0x5d, 0x33, 0x22, 0x9c, 0x38, 0x23, 0x62, 0x3b, 0x81, 0x0f, 0xfb, 0x22, 0x4c, 0x28,
-	0x1f, 0xf4, 0xe5, 0x2f, 0xea, 0xc4, 0x33, 0x41, 0x76, 0x8c, 0x09, 0xf7, 0x01, 0x08, 0xb6, 0xed,
-	0x87, 0x9d, 0x7d, 0x2f, 0xdf, 0x10, 0x47, 0xaa, 0xc9, 0x14, 0xa4, 0xea, 0x91, 0x75, 0x92, 0xed,
-	0xee, 0xc4, 0xd0, 0xd0, 0xf0, 0x40, 0xe2, 0x95, 0xe4, 0x89, 0xeb, 0x54, 0x41, 0x3f, 0xa4, 0xf3,
-	0xe4, 0x91, 0xe5, 0x7f, 0x9c, 0x8d, 0x99, 0x2e, 0xbf, 0x6f, 0x11, 0xba, 0x70, 0x5c, 0x06, 0x9f,
-	0x02, 0x08, 0xe0, 0xa9, 0xff, 0xfe, 0xab, 0x4c, 0xef, 0xdc, 0x71, 0x04, 0x8f, 0x55, 0x03, 0x16,
-	0xe7, 0x8f, 0x2a, 0x2e, 0x6f, 0x26, 0x39, 0x5e, 0x17, 0x8f, 0x2f, 0xf9, 0xb6, 0xc3, 0xc7, 0x19,
-	0xd2, 0x29, 0x61, 0x02, 0x8d, 0x78, 0xa7, 0xc4, 0x86, 0x86, 0x27, 0x48, 0x8f, 0x9a, 0x60, 0xe6,
-	0xc6, 0xfe, 0xaa, 0x9e, 0x59, 0xa9, 0xf2, 0x11, 0x57, 0x67, 0xa8, 0x21, 0x3b, 0xf4, 0x9a, 0xd0,
-	0xd5, 0x2c, 0xd8, 0xe0, 0x94, 0xeb, 0xf7, 0x42, 0x24, 0xff, 0x70, 0xc5, 0x30, 0x1c, 0xbe, 0xfd,
-	0xe7, 0xcd, 0xda, 0xe6, 0xb7, 0xc1, 0xb2, 0x6b, 0xb9, 0x9b, 0xde, 0x60, 0x60, 0xd1, 0x5e, 0xfc,
-	0xa4, 0xee, 0x49, 0xe3, 0xe5, 0x81, 0x7c, 0x6b, 0x0f, 0xfe, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x2a,
-	0x38, 0xe4, 0x13, 0xea, 0x0b, 0x00, 0x00,
+	// 1027 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xdd, 0x6f, 0xdb, 0x36,
+	0x10, 0xd7, 0xc3, 0x96, 0x34, 0xac, 0xed, 0x38, 0x8c, 0x13, 0xb4, 0xc2, 0x82, 0x02, 0x02, 0x86,
+	0x3d, 0x0c, 0xb5, 0x57, 0x7b, 0xcb, 0x3e, 0xba, 0x0d, 0x88, 0x93, 0x59, 0x31, 0xe0, 0x7a, 0x6e,
+	0xe4, 0x6e, 0xc0, 0xde, 0x68, 0xf9, 0xe6, 0x08, 0x91, 0x29, 0x45, 0xa4, 0x62, 0xf9, 0xcf, 0xdb,
+	0x7f, 0x36, 0x90, 0x12, 0xf5, 0xed, 0x24, 0x7d, 0x13, 0xef, 0xee, 0x77, 0x1f, 0xe2, 0xef, 0xee,
+	0x88, 0x0e, 0x02, 0xdf, 0xee, 0xfa, 0x81, 0xc7, 0x3d, 0xfc, 0x25, 0xdf, 0xfa, 0xc0, 0xf4, 0x86,
+	0xed, 0xad, 0xd7, 0x1e, 0x8d, 0x85, 0xfa, 0x11, 0x0f, 0x08, 0x65, 0xc4, 0xe6, 0x4e, 0x2a, 0x6a,
+	0x2f, 0x5c, 0xcf, 0xbe, 0xb3, 0x6f, 0x89, 0xa3, 0x24, 0x8d, 0x0d, 0x71, 0x5d, 0xe0, 0xc9, 0xe9,
+	0xc0, 0xef, 0xfb, 0xc9, 0x67, 0x93, 0xd8, 0xb6, 0x17, 0x52, 0xa5, 0x69, 0x41, 0x04, 0x76, 0xc8,
+	0xbd, 0x20, 0x3e, 0xf7, 0xff, 0x3b, 0x45, 0xfb, 0xd2, 0xcf, 0x60, 0x80, 0xdf, 0xa2, 0x03, 0x13,
+	0xf8, 0x50, 0xb8, 0x66, 0xb8, 0xdd, 0x95, 0xb9, 0x74, 0x6f, 0xe0, 0x3e, 0x96, 0xe8, 0x8d, 0x54,
+	0xe2, 0xbb, 0x5b, 0x43, 0xc3, 0x3d, 0xd4, 0x34, 0x81, 0x4f, 0x08, 0xe3, 0xd7, 0x40, 0x96, 0x10,
+	0xe0, 0x66, 0x06, 0x99, 0x3a, 0xae, 0xae, 0x8e, 0xb1, 0xd6, 0xd0, 0xf0, 0x2f, 0xa8, 0x73, 0x19,
+	0x00, 0xe1, 0x70, 0x43, 0x36, 0xf3, 0xac, 0x26, 0x7c, 0x98, 0x18, 0xc6, 0xca, 0x79, 0xa4, 0x2b,
+	0xc1, 0x27, 0xca, 0x9c, 0x15, 0x9d, 0x47, 0x86, 0x86, 0xaf, 0x50, 0x3b, 0xc3, 0x46, 0x66, 0xe0,
+	0x85, 0x3e, 0x3e, 0x2b, 0xe2, 0x32, 0x8f, 0x52, 0x5d, 0xe7, 0xe5, 0x07, 0x84, 0x2d, 0xa0, 0xcb,
+	0x1d, 0xf1, 0x2d, 0x67, 0x45, 0x61, 0x39, 0x8f, 0x2a, 0x95, 0xfe, 0x8e, 0xda, 0x1f, 0x43, 0x08,
+	0xb6, 0x79, 0x50, 0x2b, 0x2b, 0xf6, 0x9a, 0xb0, 0x5b, 0xfd, 0x55, 0x72, 0xce, 0xd9, 0x5c, 0x01,
+	0x27, 0x8e, 0x2b, 0xc3, 0x1e, 0x8a, 0xb0, 0x79, 0x38, 0xae, 0x9a, 0x57, 0xc2, 0xfe, 0x86, 0x3a,
+	0x26, 0xf0, 0x9c, 0xc5, 0x70, 0x7b, 0xb1, 0x5c, 0x06, 0xf9, 0xd0, 0xe2, 0xac, 0x1f, 0xe7, 0x71,
+	0xf3, 0x68, 0x4c, 0xff, 0xf5, 0x98, 0xa1, 0x61, 0x13, 0x9d, 0x96, 0xe1, 0x22, 0x53, 0x28, 0xdc,
+	0x6d, 0x2c, 0xd1, 0x5f, 0xef, 0xca, 0x5e, 0x38, 0x7a, 0x87, 0x90, 0x09, 0xfc, 0x03, 0xac, 0x67,
+	0x9e, 0xe7, 0x96, 0x6f, 0x19, 0x17, 0x83, 0x4f, 0x1c, 0xc6, 0x65, 0xc5, 0x2f, 0x4d, 0xe0, 0x17,
+	0x31, 0xf5, 0x58, 0x19, 0x73, 0x92, 0x1c, 0xff, 0x96, 0x9c, 0x55, 0x56, 0x92, 0x21, 0x68, 0x0a,
+	0x9b, 0x44, 0x80, 0x3b, 0x39, 0x54, 0x2a, 0xd5, 0x3b, 0x75, 0x60, 0x43, 0xc3, 0x37, 0xe8, 0x24,
+	0x16, 0xe5, 0x6a, 0x10, 0xd9, 0xe0, 0x37, 0x99, 0x9b, 0x5a, 0x03, 0xfd, 0xb4, 0xe0, 0x71, 0x1e,
+	0x65, 0x95, 0x8f, 0x50, 0x73, 0xbc, 0xf6, 0xbd, 0x80, 0xcf, 0x02, 0xe7, 0xe1, 0x0e, 0xb6, 0xf8,
+	0xac, 0xec, 0xab, 0xa0, 0xde, 0x99, 0xdb, 0x10, 0x35, 0x25, 0x01, 0x3c, 0x71, 0x5f, 0xc0, 0x58,
+	0xd5, 0x4f, 0x41, 0xad, 0xb7, 0xf3, 0x3f, 0x55, 0x5c, 0x91, 0xa1, 0xe1, 0x3e, 0x7a, 0x61, 0x89,
+	0xec, 0x46, 0x00, 0xf8, 0xb4, 0x0a, 0xe7, 0x23, 0x80, 0x0a, 0x83, 0xde, 0xa3, 0x7d, 0x4b, 0xb4,
+	0xe8, 0xc2, 0xc5, 0xaf, 0x6a, 0x20, 0x13, 0xb2, 0x00, 0xf7, 0x91, 0xa4, 0x1b, 0x1f, 0x20, 0x58,
+	0xc1, 0x90, 0xb8, 0x84, 0xda, 0x80, 0xbf, 0x2a, 0x7b, 0xc8, 0x6b, 0x75, 0x5c, 0x4e, 0x19, 0xc4,
+	0x0f, 0x3c, 0x47, 0x07, 0x16, 0xf0, 0x19, 0x61, 0x6c, 0xb3, 0xc4, 0xaf, 0x6b, 0x52, 0x88, 0x55,
+	0x95, 0xc4, 0xbf, 0x46, 0x5f, 0x4c, 0x3c, 0xfb, 0xae, 0x4c, 0x9c, 0xb2, 0xd9, 0x5b, 0xb4, 0xf7,
+	0x89, 0x4a, 0xc3, 0xe3, 0x42, 0x11, 0xb1, 0xb0, 0x66, 0x62, 0x09, 0x56, 0xce, 0x00, 0x02, 0xd1,
+	0x23, 0x65, 0xe7, 0x6a, 0x0c, 0x08, 0x7d, 0x4a, 0xe3, 0x56, 0x32, 0xe2, 0x3e, 0x8b, 0xfd, 0x3f,
+	0xa2, 0x43, 0x13, 0x78, 0x52, 0x23, 0x27, 0x3c, 0xac, 0x74, 0x40, 0x31, 0xdd, 0xd8, 0x46, 0xf2,
+	0xbf, 0xad, 0x26, 0xf0, 0x9f, 0x0f, 0x10, 0x3c, 0x38, 0xb0, 0xa9, 0x0c, 0x1a, 0x75, 0x5d, 0x05,
+	0x2b, 0x43, 0xc3, 0x3f, 0xc9, 0xa0, 0x82, 0x41, 0x75, 0xd0, 0xc2, 0xa0, 0xc8, 0x1b, 0xc9, 0xfe,
+	0x6e, 0xa8, 0xa8, 0x22, 0x42, 0x3e, 0xd7, 0x31, 0xe5, 0xb5, 0x64, 0x7c, 0x87, 0xf6, 0x4d, 0xa0,
+	0x16, 0xc0, 0x32, 0x9d, 0x64, 0xc9, 0x79, 0x42, 0xe8, 0xaa, 0x08, 0x11, 0x52, 0x05, 0xe1, 0x25,
+	0x88, 0x3c, 0x0f, 0xb7, 0xb3, 0x4d, 0x2d, 0xa4, 0x87, 0x5e, 0x58, 0xe4, 0x01, 0x24, 0x46, 0xe5,
+	0xae, 0x04, 0x12, 0x54, 0xbe, 0xe0, 0xbe, 0x9c, 0x54, 0x8a, 0xb0, 0x47, 0xb9, 0x15, 0x96, 0xb0,
+	0x54, 0xdd, 0x71, 0x6e, 0xe6, 0xf4, 0x11, 0x92, 0xc3, 0xfd, 0x52, 0x6c, 0xc1, 0x74, 0xe6, 0xc8,
+	0xd3, 0x1f, 0xc9, 0xae, 0xac, 0x8b, 0x23, 0x74, 0xf1, 0xed, 0x3d, 0x13, 0x73, 0x8e, 0x5a, 0x71,
+	0x1c, 0x8f, 0x32, 0xa0, 0x2c, 0x64, 0xcf, 0xc4, 0xfd, 0x8c, 0x8e, 0x2a, 0x0b, 0x2e, 0x2d, 0x4d,
+	0xad, 0xcc, 0x31, 0xad, 0x5b, 0x77, 0xdf, 0x49, 0xfa, 0x5e, 0x43, 0x34, 0x8f, 0xe2, 0xd9, 0x5f,
+	0x21, 0x53, 0x23, 0xdd, 0xd1, 0x51, 0xb2, 0x20, 0x5f, 0x5e, 0x85, 0x6b, 0x5f, 0x8d, 0xbb, 0xdc,
+	0xa2, 0xb0, 0x78, 0xe0, 0xd0, 0x55, 0x91, 0xf0, 0xb1, 0xcc, 0xd0, 0x70, 0x17, 0xed, 0xff, 0x05,
+	0x01, 0x13, 0x99, 0xed, 0x68, 0x90, 0x44, 0x2d, 0xfa, 0xce, 0xd0, 0xf0, 0x37, 0x68, 0x6f, 0xcc,
+	0xac, 0x2d, 0xb5, 0x9f, 0x6a, 0xf0, 0x1e, 0x6a, 0x8d, 0xd9, 0x94, 0xfb, 0x97, 0x82, 0x9c, 0xcf,
+	0x01, 0x74, 0xd1, 0xfe, 0x14, 0x78, 0x5d, 0x7b, 0xab, 0x4c, 0xa6, 0xde, 0x12, 0x12, 0x13, 0xf9,
+	0x8b, 0x44, 0xd7, 0x8c, 0x08, 0x27, 0xee, 0x88, 0x38, 0x6e, 0x18, 0xc0, 0xae, 0x08, 0x63, 0xca,
+	0x07, 0x7d, 0xf9, 0x8b, 0x3a, 0xc9, 0x4c, 0x90, 0x1d, 0x63, 0xc1, 0x7d, 0x08, 0xd4, 0x7e, 0x0c,
+	0x76, 0xfe, 0xbd, 0x7c, 0x43, 0x1c, 0x99, 0x50, 0x84, 0xd4, 0x3d, 0xb2, 0x4e, 0xf2, 0xdd, 0x9d,
+	0x1a, 0x1a, 0x1a, 0x1e, 0x48, 0xbc, 0x92, 0x3c, 0x71, 0x9d, 0x2a, 0xe8, 0xfb, 0x6c, 0x9e, 0x3c,
+	0xb2, 0xfc, 0x8f, 0xf3, 0x31, 0xb3, 0xe5, 0xf7, 0x2d, 0x42, 0x97, 0xae, 0xc7, 0xe0, 0x63, 0x08,
+	0x21, 0x3c, 0xf5, 0xdf, 0x7f, 0x95, 0xe9, 0x5d, 0xb8, 0xae, 0xe0, 0xb1, 0x6a, 0xc0, 0xf2, 0xfc,
+	0x51, 0xc5, 0x15, 0xcd, 0x24, 0xc7, 0x0f, 0xc4, 0xe3, 0x4b, 0xbe, 0xed, 0xf0, 0x71, 0x8e, 0x74,
+	0x4a, 0xa8, 0x9f, 0xe4, 0xe3, 0xa5, 0x62, 0x43, 0xc3, 0x63, 0xa4, 0xc7, 0x4d, 0x30, 0xf5, 0x12,
+	0x7f, 0x75, 0xcf, 0xac, 0x4c, 0xf9, 0x88, 0xab, 0x73, 0xd4, 0x90, 0x1d, 0x7a, 0x43, 0xe8, 0x72,
+	0x1a, 0xae, 0x71, 0xc6, 0xf5, 0x7b, 0x21, 0x92, 0x7f, 0xb8, 0x66, 0x18, 0x0e, 0xdf, 0xfc, 0x73,
+	0xb6, 0x72, 0xf8, 0x6d, 0xb8, 0xe8, 0xda, 0xde, 0xba, 0x37, 0x18, 0xd8, 0xb4, 0x97, 0x3c, 0xa9,
+	0x7b, 0xd2, 0x78, 0xb1, 0x27, 0xdf, 0xda, 0x83, 0xff, 0x07, 0x00, 0x2a, 0x38, 0xe4, 0x13, 0xea,
+	0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
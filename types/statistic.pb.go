@@ -76,6 +76,7 @@ type ReqGetTotalCoins struct {
 	StartKey             []byte   `protobuf:"bytes,3,opt,name=startKey,proto3" json:"startKey,omitempty"`
 	Count                int64    `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
 	Execer               string   `protobuf:"bytes,5,opt,name=execer,proto3" json:"execer,omitempty"`
+	At                   *StateAt `protobuf:"bytes,6,opt,name=at,proto3" json:"at,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -141,6 +142,13 @@ func (m *ReqGetTotalCoins) GetExecer() string {
 	return ""
 }
 
+func (m *ReqGetTotalCoins) GetAt() *StateAt {
+	if m != nil {
+		return m.At
+	}
+	return nil
+}
+
 //查询symbol代币总额应答
 type ReplyGetTotalCoins struct {
 	Count                int64    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
@@ -485,6 +493,7 @@ type ReqGetExecBalance struct {
 	Execer               string   `protobuf:"bytes,5,opt,name=execer,proto3" json:"execer,omitempty"`
 	Count                int64    `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
 	NextKey              []byte   `protobuf:"bytes,7,opt,name=nextKey,proto3" json:"nextKey,omitempty"`
+	At                   *StateAt `protobuf:"bytes,8,opt,name=at,proto3" json:"at,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -564,6 +573,13 @@ func (m *ReqGetExecBalance) GetNextKey() []byte {
 	return nil
 }
 
+func (m *ReqGetExecBalance) GetAt() *StateAt {
+	if m != nil {
+		return m.At
+	}
+	return nil
+}
+
 type ExecBalanceItem struct {
 	ExecAddr             []byte   `protobuf:"bytes,1,opt,name=execAddr,proto3" json:"execAddr,omitempty"`
 	Frozen               int64    `protobuf:"varint,2,opt,name=frozen,proto3" json:"frozen,omitempty"`
@@ -707,45 +723,47 @@ func init() {
 func init() { proto.RegisterFile("statistic.proto", fileDescriptor_405f6cee9ed2da7e) }
 
 var fileDescriptor_405f6cee9ed2da7e = []byte{
-	// 637 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x96, 0xeb, 0x3a, 0x4d, 0xa7, 0x95, 0x12, 0x96, 0xaa, 0x58, 0x88, 0x9f, 0xca, 0x5c, 0x2a,
-	0x84, 0x52, 0x89, 0x48, 0xdc, 0xdb, 0x88, 0x96, 0x08, 0x21, 0x24, 0xb7, 0xe2, 0x80, 0xc4, 0x61,
-	0xbb, 0x99, 0xb6, 0x16, 0xf6, 0x3a, 0x78, 0xc7, 0x55, 0xc2, 0x63, 0xc0, 0x23, 0xf0, 0x1c, 0x1c,
-	0x78, 0x33, 0xb4, 0xe3, 0x8d, 0x7f, 0x52, 0x7a, 0xe1, 0xb6, 0xdf, 0x37, 0x3b, 0xbf, 0x3b, 0xdf,
-	0xc2, 0xc0, 0x90, 0xa4, 0xc4, 0x50, 0xa2, 0x46, 0xf3, 0x22, 0xa7, 0x5c, 0x04, 0xb4, 0x9c, 0xa3,
-	0x89, 0xde, 0x40, 0xff, 0x22, 0x27, 0x99, 0x9e, 0x22, 0x8a, 0x21, 0xf8, 0x57, 0x88, 0xa1, 0x77,
-	0xe0, 0x1d, 0xfa, 0xb1, 0x3d, 0x8a, 0x10, 0xb6, 0x68, 0x31, 0xc9, 0x4b, 0x4d, 0xe1, 0x06, 0xb3,
-	0x2b, 0x18, 0xfd, 0xf0, 0x60, 0x18, 0xe3, 0xb7, 0x33, 0x24, 0x76, 0x9f, 0xe4, 0x89, 0x36, 0x62,
-	0x1f, 0x7a, 0x66, 0x99, 0x5d, 0xe6, 0x29, 0xc7, 0xd8, 0x8e, 0x1d, 0x12, 0x4f, 0x60, 0xdb, 0xa6,
-	0xc7, 0x77, 0xd2, 0xdc, 0x70, 0xa0, 0xdd, 0xb8, 0x21, 0xc4, 0x63, 0xe8, 0x1b, 0x92, 0x05, 0xbd,
-	0xc7, 0x65, 0xe8, 0xb3, 0xb1, 0xc6, 0x62, 0x0f, 0x02, 0xc5, 0xe9, 0x37, 0x39, 0x7d, 0x05, 0x6c,
-	0x1e, 0x5c, 0xa0, 0xc2, 0x22, 0x0c, 0xaa, 0x3c, 0x15, 0x8a, 0x34, 0x88, 0x18, 0xe7, 0xe9, 0xb2,
-	0x5b, 0x55, 0x1d, 0xc3, 0x6b, 0xc7, 0x18, 0x82, 0xaf, 0xcb, 0xcc, 0xb5, 0x65, 0x8f, 0x36, 0xaa,
-	0xcc, 0xf8, 0xa2, 0xcf, 0xa4, 0x43, 0x76, 0x08, 0x1a, 0x17, 0x5c, 0xde, 0x26, 0x97, 0xb7, 0x82,
-	0x51, 0x09, 0x8f, 0xa6, 0x84, 0x85, 0x24, 0x8c, 0xa5, 0xbe, 0xc6, 0x93, 0xe5, 0x79, 0xdd, 0x54,
-	0xa7, 0x65, 0x6f, 0xbd, 0xe5, 0x3d, 0x08, 0xb8, 0x45, 0x37, 0x8c, 0x0a, 0xd8, 0x92, 0x50, 0xcf,
-	0xdc, 0x0c, 0xec, 0xf1, 0xdf, 0xed, 0x47, 0x3f, 0x3d, 0x18, 0x5c, 0x24, 0xea, 0x2b, 0xd2, 0xf9,
-	0xea, 0x51, 0xc5, 0x4b, 0x18, 0xaa, 0xb2, 0x28, 0x50, 0xd3, 0xc7, 0x39, 0xea, 0x49, 0xab, 0xdf,
-	0x3b, 0xbc, 0x38, 0x84, 0x01, 0xd9, 0xf1, 0x7c, 0x48, 0x34, 0x16, 0xed, 0xd7, 0x5d, 0xa7, 0x6d,
-	0x54, 0xa6, 0x26, 0x52, 0xab, 0x14, 0x27, 0xad, 0xe1, 0xdc, 0xe1, 0xa3, 0x5f, 0x1b, 0xab, 0xaa,
-	0x38, 0xc0, 0x54, 0x5f, 0xe5, 0xf6, 0x69, 0x89, 0xa9, 0xe9, 0xcc, 0xad, 0x44, 0x8d, 0x79, 0x59,
-	0x48, 0x52, 0x69, 0x38, 0x79, 0x10, 0x3b, 0x24, 0x9e, 0x01, 0xcc, 0x0b, 0xbc, 0x3d, 0xaf, 0x6c,
-	0x3e, 0xdb, 0x5a, 0x8c, 0x9d, 0x6c, 0x62, 0xce, 0x50, 0xa3, 0x49, 0x0c, 0xcf, 0xa5, 0x1f, 0x37,
-	0x84, 0xf5, 0x56, 0x05, 0x4a, 0xc2, 0x8b, 0x24, 0x43, 0x5e, 0x0f, 0x3f, 0x6e, 0x31, 0xd6, 0x3b,
-	0xb3, 0xe5, 0xb1, 0xb9, 0xc7, 0xe6, 0x86, 0xb0, 0x56, 0x95, 0xe6, 0xa6, 0x72, 0xde, 0xaa, 0xac,
-	0x35, 0x61, 0x63, 0xf3, 0xd5, 0x4f, 0x32, 0x2d, 0x31, 0xec, 0x57, 0xb1, 0x1b, 0x46, 0x44, 0xb0,
-	0xcb, 0xe8, 0x78, 0x36, 0x2b, 0xd0, 0x98, 0x70, 0x9b, 0x3b, 0xee, 0x70, 0xd1, 0x0b, 0xd8, 0xe1,
-	0xd5, 0x3c, 0xae, 0x76, 0x6b, 0x0f, 0x02, 0x1e, 0xe4, 0x6a, 0x37, 0x19, 0x44, 0x7f, 0x3c, 0x78,
-	0x50, 0x89, 0xeb, 0xed, 0x02, 0xd5, 0x89, 0x4c, 0xa5, 0x56, 0xf8, 0x9f, 0xea, 0x12, 0xb0, 0x29,
-	0x67, 0xb3, 0xc2, 0x6d, 0x15, 0x9f, 0xed, 0xb3, 0x58, 0xc5, 0xd8, 0x9a, 0xdc, 0x4a, 0xd7, 0xf8,
-	0x3e, 0x6d, 0x35, 0xab, 0xd8, 0x6b, 0xab, 0xa8, 0xa5, 0x8d, 0xad, 0xae, 0x36, 0xbe, 0xc0, 0xa0,
-	0x55, 0xfc, 0x94, 0x30, 0xeb, 0xa4, 0xf5, 0xee, 0xa6, 0xbd, 0x2a, 0xf2, 0xef, 0xa8, 0xdd, 0x2a,
-	0x3a, 0xc4, 0xa2, 0x54, 0x94, 0xdc, 0x62, 0x2d, 0x4a, 0x46, 0xd1, 0x6f, 0x0f, 0x1e, 0xae, 0xb4,
-	0xbe, 0x36, 0x24, 0x27, 0x62, 0xaf, 0x23, 0xe2, 0x08, 0x76, 0xab, 0xd3, 0x69, 0x3b, 0x4b, 0x87,
-	0x6b, 0xee, 0x1c, 0xb7, 0x33, 0x76, 0xb8, 0xfb, 0x3f, 0x03, 0xf1, 0x0a, 0x82, 0x84, 0x30, 0x33,
-	0x61, 0x70, 0xe0, 0x1f, 0xee, 0xbc, 0xde, 0x1f, 0xf1, 0x07, 0x3b, 0x5a, 0x1b, 0x42, 0x5c, 0x5d,
-	0x3a, 0x79, 0xfe, 0xf9, 0xe9, 0x75, 0x42, 0x37, 0xe5, 0xe5, 0x48, 0xe5, 0xd9, 0xd1, 0x78, 0xac,
-	0xf4, 0x91, 0xba, 0x91, 0x89, 0x1e, 0x8f, 0x8f, 0xd8, 0xef, 0xb2, 0xc7, 0xdf, 0xf4, 0xf8, 0x6f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xbf, 0x11, 0x9f, 0xa6, 0xb9, 0x05, 0x00, 0x00,
+	// 672 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0x96, 0xe3, 0x3a, 0x4d, 0xa7, 0xfd, 0xfd, 0x12, 0x96, 0xaa, 0x58, 0x15, 0x94, 0xca, 0x5c,
+	0x22, 0x84, 0x52, 0xa9, 0x91, 0xb8, 0xa7, 0x11, 0x2d, 0x11, 0x42, 0x48, 0x4e, 0xc5, 0x01, 0x89,
+	0xc3, 0x76, 0x33, 0x6d, 0x2d, 0xe2, 0x75, 0xf0, 0x4e, 0xaa, 0x84, 0xd7, 0xe0, 0x11, 0x78, 0x03,
+	0xee, 0x3c, 0x0c, 0x6f, 0x82, 0x76, 0xbc, 0x71, 0xec, 0x94, 0x5e, 0xb8, 0xed, 0xf7, 0xcd, 0xee,
+	0xfc, 0xf9, 0x3c, 0x9f, 0xa1, 0x6d, 0x48, 0x52, 0x62, 0x28, 0x51, 0xbd, 0x59, 0x9e, 0x51, 0x26,
+	0x02, 0x5a, 0xce, 0xd0, 0x1c, 0xfe, 0x27, 0x95, 0xca, 0xe6, 0x9a, 0x0a, 0x36, 0x7a, 0x0d, 0xad,
+	0xcb, 0x8c, 0xe4, 0xf4, 0x1c, 0x51, 0x74, 0xc0, 0xbf, 0x46, 0x0c, 0xbd, 0x63, 0xaf, 0xeb, 0xc7,
+	0xf6, 0x28, 0x42, 0xd8, 0xa6, 0xc5, 0xd0, 0x5e, 0x0f, 0x1b, 0xcc, 0xae, 0x60, 0xf4, 0xd3, 0x83,
+	0x4e, 0x8c, 0x5f, 0x2f, 0x90, 0xf8, 0xf9, 0x30, 0x4b, 0xb4, 0x11, 0x07, 0xd0, 0x34, 0xcb, 0xf4,
+	0x2a, 0x9b, 0x72, 0x8e, 0x9d, 0xd8, 0x21, 0xf1, 0x14, 0x76, 0x6c, 0x37, 0xf8, 0x56, 0x9a, 0x5b,
+	0x4e, 0xb4, 0x17, 0xaf, 0x09, 0x71, 0x08, 0x2d, 0x43, 0x32, 0xa7, 0x77, 0xb8, 0x0c, 0x7d, 0x0e,
+	0x96, 0x58, 0xec, 0x43, 0xc0, 0xdd, 0x86, 0x5b, 0x5c, 0xbe, 0x00, 0xb6, 0x0e, 0x2e, 0x50, 0x61,
+	0x1e, 0x06, 0x45, 0x9d, 0x02, 0x89, 0x23, 0x68, 0x48, 0x0a, 0x9b, 0xc7, 0x5e, 0x77, 0xf7, 0xf4,
+	0xff, 0x1e, 0xcf, 0xdb, 0x1b, 0xdb, 0x3a, 0x03, 0x8a, 0x1b, 0x92, 0x22, 0x0d, 0x22, 0xc6, 0xd9,
+	0x74, 0x59, 0xef, 0xba, 0xac, 0xe1, 0x55, 0x6b, 0x74, 0xc0, 0xd7, 0xf3, 0xd4, 0x8d, 0x6d, 0x8f,
+	0xb6, 0xaa, 0x4c, 0xf9, 0xa2, 0xcf, 0xa4, 0x43, 0x56, 0x24, 0x8d, 0x0b, 0x6e, 0x7f, 0x8b, 0xdb,
+	0x5f, 0xc1, 0x68, 0x0e, 0x4f, 0x46, 0x84, 0xb9, 0x24, 0x8c, 0xa5, 0xbe, 0xc1, 0xb3, 0xe5, 0xb8,
+	0x1c, 0xba, 0x26, 0x89, 0xb7, 0x29, 0xc9, 0x3e, 0x04, 0x2c, 0x81, 0x13, 0xab, 0x00, 0xb6, 0x25,
+	0xd4, 0x13, 0xa7, 0x91, 0x3d, 0xfe, 0x5d, 0x9e, 0xe8, 0xbb, 0x07, 0xed, 0xcb, 0x44, 0x7d, 0x41,
+	0x1a, 0xaf, 0x76, 0x40, 0xbc, 0x84, 0x8e, 0x9a, 0xe7, 0x39, 0x6a, 0xfa, 0x30, 0x43, 0x3d, 0xac,
+	0xcc, 0x7b, 0x8f, 0x17, 0x5d, 0x68, 0x93, 0x95, 0xe7, 0x7d, 0xa2, 0x31, 0xaf, 0x7e, 0xfd, 0x4d,
+	0xda, 0x66, 0x65, 0x6a, 0x28, 0xb5, 0x9a, 0xe2, 0xb0, 0x22, 0xce, 0x3d, 0x3e, 0xfa, 0xd1, 0x58,
+	0x75, 0xc5, 0x09, 0x46, 0xfa, 0x3a, 0xb3, 0x9f, 0x9e, 0x98, 0x1a, 0x4d, 0xdc, 0xca, 0x94, 0x98,
+	0x97, 0x89, 0x24, 0xcd, 0x0d, 0x17, 0x0f, 0x62, 0x87, 0xc4, 0x11, 0xc0, 0x2c, 0xc7, 0xbb, 0x71,
+	0x11, 0xf3, 0x39, 0x56, 0x61, 0xac, 0xb2, 0x89, 0xb9, 0x40, 0x8d, 0x26, 0x31, 0xac, 0x4b, 0x2b,
+	0x5e, 0x13, 0xf6, 0xb5, 0xca, 0x51, 0x12, 0x5e, 0x26, 0x29, 0xf2, 0xfa, 0xf8, 0x71, 0x85, 0xb1,
+	0xaf, 0x53, 0xdb, 0x1e, 0x87, 0x9b, 0x1c, 0x5e, 0x13, 0x36, 0xaa, 0xa6, 0x99, 0x29, 0x1e, 0x6f,
+	0x17, 0xd1, 0x92, 0xb0, 0xb9, 0xf9, 0xea, 0x47, 0x39, 0x9d, 0x63, 0xd8, 0x2a, 0x72, 0xaf, 0x19,
+	0x11, 0xc1, 0x1e, 0xa3, 0xc1, 0x64, 0x92, 0xa3, 0x31, 0xe1, 0x0e, 0x4f, 0x5c, 0xe3, 0xa2, 0x17,
+	0xb0, 0xcb, 0xab, 0x39, 0x28, 0x76, 0x6b, 0x1f, 0x02, 0x16, 0x72, 0xb5, 0x9b, 0x0c, 0xa2, 0xdf,
+	0x1e, 0x3c, 0x2a, 0xcc, 0xf7, 0x66, 0x81, 0xea, 0x4c, 0x4e, 0xa5, 0x56, 0xf8, 0x8f, 0xee, 0x13,
+	0xb0, 0x25, 0x27, 0x93, 0xdc, 0x6d, 0x15, 0x9f, 0xed, 0x67, 0xb1, 0x8e, 0xb2, 0x3d, 0xb9, 0x95,
+	0x2e, 0xf1, 0x83, 0xde, 0x2b, 0x57, 0xb1, 0x59, 0x75, 0x51, 0xc5, 0x1b, 0xdb, 0x35, 0x6f, 0x38,
+	0xaf, 0xb6, 0x1e, 0xf4, 0xea, 0x67, 0x68, 0x57, 0x86, 0x1b, 0x11, 0xa6, 0xb5, 0xb6, 0xbc, 0xfb,
+	0x6d, 0x5d, 0xe7, 0xd9, 0x37, 0xd4, 0x6e, 0x55, 0x1d, 0xb2, 0xbc, 0x54, 0x94, 0xdc, 0x61, 0x69,
+	0x5a, 0x46, 0xd1, 0x2f, 0x0f, 0x1e, 0xaf, 0xfe, 0x05, 0x1b, 0x22, 0x3a, 0x93, 0x7b, 0x35, 0x93,
+	0x47, 0xb0, 0x57, 0x9c, 0xce, 0xab, 0x55, 0x6a, 0xdc, 0xfa, 0xce, 0xa0, 0x5a, 0xb1, 0xc6, 0x3d,
+	0xfc, 0xb3, 0x10, 0xaf, 0x20, 0x48, 0x08, 0x53, 0x13, 0x06, 0xc7, 0x7e, 0x77, 0xf7, 0xf4, 0xc0,
+	0x69, 0xb2, 0x21, 0x42, 0x5c, 0x5c, 0x3a, 0x7b, 0xfe, 0xe9, 0xd9, 0x4d, 0x42, 0xb7, 0xf3, 0xab,
+	0x9e, 0xca, 0xd2, 0x93, 0x7e, 0x5f, 0xe9, 0x13, 0x75, 0x2b, 0x13, 0xdd, 0xef, 0x9f, 0xf0, 0xbb,
+	0xab, 0x26, 0xff, 0xdf, 0xfb, 0x7f, 0x06, 0x00, 0x8d, 0x65, 0x12, 0xe3, 0x08, 0x06, 0x00, 0x00,
 }
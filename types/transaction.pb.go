@@ -1001,11 +1001,13 @@ func (m *AddrOverview) GetTxCount() int64 {
 type ReqAddr struct {
 	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
 	//表示取所有/from/to/其他的hash列表
-	Flag                 int32    `protobuf:"varint,2,opt,name=flag,proto3" json:"flag,omitempty"`
-	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
-	Direction            int32    `protobuf:"varint,4,opt,name=direction,proto3" json:"direction,omitempty"`
-	Height               int64    `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
-	Index                int64    `protobuf:"varint,6,opt,name=index,proto3" json:"index,omitempty"`
+	Flag      int32 `protobuf:"varint,2,opt,name=flag,proto3" json:"flag,omitempty"`
+	Count     int32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Direction int32 `protobuf:"varint,4,opt,name=direction,proto3" json:"direction,omitempty"`
+	Height    int64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Index     int64 `protobuf:"varint,6,opt,name=index,proto3" json:"index,omitempty"`
+	//GetAllExecBalance 查询指定区块的状态, 为空时查询最新的状态
+	At                   *StateAt `protobuf:"bytes,7,opt,name=at,proto3" json:"at,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1078,6 +1080,13 @@ func (m *ReqAddr) GetIndex() int64 {
 	return 0
 }
 
+func (m *ReqAddr) GetAt() *StateAt {
+	if m != nil {
+		return m.At
+	}
+	return nil
+}
+
 type ReqPrivacy struct {
 	Count                int32    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 	Direction            int32    `protobuf:"varint,2,opt,name=direction,proto3" json:"direction,omitempty"`
@@ -1454,6 +1463,237 @@ func (m *ReceiptLog) GetLog() []byte {
 	return nil
 }
 
+//按照执行器, log类型和地址查询receipt log, 地址为空时查询所有的log
+// cursor 为上一次查询返回的cursor, 用于翻页
+type ReqLogs struct {
+	Execer               string   `protobuf:"bytes,1,opt,name=execer,proto3" json:"execer,omitempty"`
+	Ty                   int32    `protobuf:"varint,2,opt,name=ty,proto3" json:"ty,omitempty"`
+	Addr                 string   `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
+	StartHeight          int64    `protobuf:"varint,4,opt,name=startHeight,proto3" json:"startHeight,omitempty"`
+	EndHeight            int64    `protobuf:"varint,5,opt,name=endHeight,proto3" json:"endHeight,omitempty"`
+	Count                int32    `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	Direction            int32    `protobuf:"varint,7,opt,name=direction,proto3" json:"direction,omitempty"`
+	Cursor               string   `protobuf:"bytes,8,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqLogs) Reset()         { *m = ReqLogs{} }
+func (m *ReqLogs) String() string { return proto.CompactTextString(m) }
+func (*ReqLogs) ProtoMessage()    {}
+func (*ReqLogs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cc4e03d2c28c490, []int{25}
+}
+
+func (m *ReqLogs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqLogs.Unmarshal(m, b)
+}
+func (m *ReqLogs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqLogs.Marshal(b, m, deterministic)
+}
+func (m *ReqLogs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqLogs.Merge(m, src)
+}
+func (m *ReqLogs) XXX_Size() int {
+	return xxx_messageInfo_ReqLogs.Size(m)
+}
+func (m *ReqLogs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqLogs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqLogs proto.InternalMessageInfo
+
+func (m *ReqLogs) GetExecer() string {
+	if m != nil {
+		return m.Execer
+	}
+	return ""
+}
+
+func (m *ReqLogs) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+func (m *ReqLogs) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ReqLogs) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *ReqLogs) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *ReqLogs) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqLogs) GetDirection() int32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+func (m *ReqLogs) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type LogIndexInfo struct {
+	Hash                 []byte      `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Height               int64       `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Index                int64       `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	LogIndex             int32       `protobuf:"varint,4,opt,name=logIndex,proto3" json:"logIndex,omitempty"`
+	Execer               string      `protobuf:"bytes,5,opt,name=execer,proto3" json:"execer,omitempty"`
+	Log                  *ReceiptLog `protobuf:"bytes,6,opt,name=log,proto3" json:"log,omitempty"`
+	Cursor               string      `protobuf:"bytes,7,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *LogIndexInfo) Reset()         { *m = LogIndexInfo{} }
+func (m *LogIndexInfo) String() string { return proto.CompactTextString(m) }
+func (*LogIndexInfo) ProtoMessage()    {}
+func (*LogIndexInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cc4e03d2c28c490, []int{26}
+}
+
+func (m *LogIndexInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogIndexInfo.Unmarshal(m, b)
+}
+func (m *LogIndexInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogIndexInfo.Marshal(b, m, deterministic)
+}
+func (m *LogIndexInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogIndexInfo.Merge(m, src)
+}
+func (m *LogIndexInfo) XXX_Size() int {
+	return xxx_messageInfo_LogIndexInfo.Size(m)
+}
+func (m *LogIndexInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogIndexInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogIndexInfo proto.InternalMessageInfo
+
+func (m *LogIndexInfo) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *LogIndexInfo) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *LogIndexInfo) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *LogIndexInfo) GetLogIndex() int32 {
+	if m != nil {
+		return m.LogIndex
+	}
+	return 0
+}
+
+func (m *LogIndexInfo) GetExecer() string {
+	if m != nil {
+		return m.Execer
+	}
+	return ""
+}
+
+func (m *LogIndexInfo) GetLog() *ReceiptLog {
+	if m != nil {
+		return m.Log
+	}
+	return nil
+}
+
+func (m *LogIndexInfo) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type ReplyLogs struct {
+	Logs                 []*LogIndexInfo `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	Cursor               string          `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ReplyLogs) Reset()         { *m = ReplyLogs{} }
+func (m *ReplyLogs) String() string { return proto.CompactTextString(m) }
+func (*ReplyLogs) ProtoMessage()    {}
+func (*ReplyLogs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cc4e03d2c28c490, []int{27}
+}
+
+func (m *ReplyLogs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyLogs.Unmarshal(m, b)
+}
+func (m *ReplyLogs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyLogs.Marshal(b, m, deterministic)
+}
+func (m *ReplyLogs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyLogs.Merge(m, src)
+}
+func (m *ReplyLogs) XXX_Size() int {
+	return xxx_messageInfo_ReplyLogs.Size(m)
+}
+func (m *ReplyLogs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyLogs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyLogs proto.InternalMessageInfo
+
+func (m *ReplyLogs) GetLogs() []*LogIndexInfo {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+func (m *ReplyLogs) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
 // ty = 0 -> error Receipt
 // ty = 1 -> CutFee //cut fee ,bug exec not ok
 // ty = 2 -> exec ok
@@ -1470,7 +1710,7 @@ func (m *Receipt) Reset()         { *m = Receipt{} }
 func (m *Receipt) String() string { return proto.CompactTextString(m) }
 func (*Receipt) ProtoMessage()    {}
 func (*Receipt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{25}
+	return fileDescriptor_2cc4e03d2c28c490, []int{28}
 }
 
 func (m *Receipt) XXX_Unmarshal(b []byte) error {
@@ -1524,7 +1764,7 @@ func (m *ReceiptData) Reset()         { *m = ReceiptData{} }
 func (m *ReceiptData) String() string { return proto.CompactTextString(m) }
 func (*ReceiptData) ProtoMessage()    {}
 func (*ReceiptData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{26}
+	return fileDescriptor_2cc4e03d2c28c490, []int{29}
 }
 
 func (m *ReceiptData) XXX_Unmarshal(b []byte) error {
@@ -1575,7 +1815,7 @@ func (m *TxResult) Reset()         { *m = TxResult{} }
 func (m *TxResult) String() string { return proto.CompactTextString(m) }
 func (*TxResult) ProtoMessage()    {}
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{27}
+	return fileDescriptor_2cc4e03d2c28c490, []int{30}
 }
 
 func (m *TxResult) XXX_Unmarshal(b []byte) error {
@@ -1658,7 +1898,7 @@ func (m *TransactionDetail) Reset()         { *m = TransactionDetail{} }
 func (m *TransactionDetail) String() string { return proto.CompactTextString(m) }
 func (*TransactionDetail) ProtoMessage()    {}
 func (*TransactionDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{28}
+	return fileDescriptor_2cc4e03d2c28c490, []int{31}
 }
 
 func (m *TransactionDetail) XXX_Unmarshal(b []byte) error {
@@ -1760,7 +2000,7 @@ func (m *TransactionDetails) Reset()         { *m = TransactionDetails{} }
 func (m *TransactionDetails) String() string { return proto.CompactTextString(m) }
 func (*TransactionDetails) ProtoMessage()    {}
 func (*TransactionDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{29}
+	return fileDescriptor_2cc4e03d2c28c490, []int{32}
 }
 
 func (m *TransactionDetails) XXX_Unmarshal(b []byte) error {
@@ -1799,7 +2039,7 @@ func (m *ReqAddrs) Reset()         { *m = ReqAddrs{} }
 func (m *ReqAddrs) String() string { return proto.CompactTextString(m) }
 func (*ReqAddrs) ProtoMessage()    {}
 func (*ReqAddrs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{30}
+	return fileDescriptor_2cc4e03d2c28c490, []int{33}
 }
 
 func (m *ReqAddrs) XXX_Unmarshal(b []byte) error {
@@ -1838,7 +2078,7 @@ func (m *ReqDecodeRawTransaction) Reset()         { *m = ReqDecodeRawTransaction
 func (m *ReqDecodeRawTransaction) String() string { return proto.CompactTextString(m) }
 func (*ReqDecodeRawTransaction) ProtoMessage()    {}
 func (*ReqDecodeRawTransaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{31}
+	return fileDescriptor_2cc4e03d2c28c490, []int{34}
 }
 
 func (m *ReqDecodeRawTransaction) XXX_Unmarshal(b []byte) error {
@@ -1878,7 +2118,7 @@ func (m *UserWrite) Reset()         { *m = UserWrite{} }
 func (m *UserWrite) String() string { return proto.CompactTextString(m) }
 func (*UserWrite) ProtoMessage()    {}
 func (*UserWrite) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{32}
+	return fileDescriptor_2cc4e03d2c28c490, []int{35}
 }
 
 func (m *UserWrite) XXX_Unmarshal(b []byte) error {
@@ -1926,7 +2166,7 @@ func (m *UpgradeMeta) Reset()         { *m = UpgradeMeta{} }
 func (m *UpgradeMeta) String() string { return proto.CompactTextString(m) }
 func (*UpgradeMeta) ProtoMessage()    {}
 func (*UpgradeMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{33}
+	return fileDescriptor_2cc4e03d2c28c490, []int{36}
 }
 
 func (m *UpgradeMeta) XXX_Unmarshal(b []byte) error {
@@ -1968,6 +2208,109 @@ func (m *UpgradeMeta) GetHeight() int64 {
 	return 0
 }
 
+//执行器localdb的版本, indexing 表示正在重建这个执行器的localdb, height 是下一个需要重建的高度
+type ExecLocalDBMeta struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version              int32    `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Indexing             bool     `protobuf:"varint,3,opt,name=indexing,proto3" json:"indexing,omitempty"`
+	Height               int64    `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExecLocalDBMeta) Reset()         { *m = ExecLocalDBMeta{} }
+func (m *ExecLocalDBMeta) String() string { return proto.CompactTextString(m) }
+func (*ExecLocalDBMeta) ProtoMessage()    {}
+func (*ExecLocalDBMeta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cc4e03d2c28c490, []int{37}
+}
+
+func (m *ExecLocalDBMeta) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExecLocalDBMeta.Unmarshal(m, b)
+}
+func (m *ExecLocalDBMeta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExecLocalDBMeta.Marshal(b, m, deterministic)
+}
+func (m *ExecLocalDBMeta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecLocalDBMeta.Merge(m, src)
+}
+func (m *ExecLocalDBMeta) XXX_Size() int {
+	return xxx_messageInfo_ExecLocalDBMeta.Size(m)
+}
+func (m *ExecLocalDBMeta) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecLocalDBMeta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecLocalDBMeta proto.InternalMessageInfo
+
+func (m *ExecLocalDBMeta) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ExecLocalDBMeta) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ExecLocalDBMeta) GetIndexing() bool {
+	if m != nil {
+		return m.Indexing
+	}
+	return false
+}
+
+func (m *ExecLocalDBMeta) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type ExecLocalDBMetas struct {
+	Metas                []*ExecLocalDBMeta `protobuf:"bytes,1,rep,name=metas,proto3" json:"metas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ExecLocalDBMetas) Reset()         { *m = ExecLocalDBMetas{} }
+func (m *ExecLocalDBMetas) String() string { return proto.CompactTextString(m) }
+func (*ExecLocalDBMetas) ProtoMessage()    {}
+func (*ExecLocalDBMetas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cc4e03d2c28c490, []int{38}
+}
+
+func (m *ExecLocalDBMetas) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExecLocalDBMetas.Unmarshal(m, b)
+}
+func (m *ExecLocalDBMetas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExecLocalDBMetas.Marshal(b, m, deterministic)
+}
+func (m *ExecLocalDBMetas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecLocalDBMetas.Merge(m, src)
+}
+func (m *ExecLocalDBMetas) XXX_Size() int {
+	return xxx_messageInfo_ExecLocalDBMetas.Size(m)
+}
+func (m *ExecLocalDBMetas) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecLocalDBMetas.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecLocalDBMetas proto.InternalMessageInfo
+
+func (m *ExecLocalDBMetas) GetMetas() []*ExecLocalDBMeta {
+	if m != nil {
+		return m.Metas
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*AssetsGenesis)(nil), "types.AssetsGenesis")
 	proto.RegisterType((*AssetsTransferToExec)(nil), "types.AssetsTransferToExec")
@@ -1994,6 +2337,9 @@ func init() {
 	proto.RegisterType((*TxHashList)(nil), "types.TxHashList")
 	proto.RegisterType((*ReplyTxInfos)(nil), "types.ReplyTxInfos")
 	proto.RegisterType((*ReceiptLog)(nil), "types.ReceiptLog")
+	proto.RegisterType((*ReqLogs)(nil), "types.ReqLogs")
+	proto.RegisterType((*LogIndexInfo)(nil), "types.LogIndexInfo")
+	proto.RegisterType((*ReplyLogs)(nil), "types.ReplyLogs")
 	proto.RegisterType((*Receipt)(nil), "types.Receipt")
 	proto.RegisterType((*ReceiptData)(nil), "types.ReceiptData")
 	proto.RegisterType((*TxResult)(nil), "types.TxResult")
@@ -2003,92 +2349,107 @@ func init() {
 	proto.RegisterType((*ReqDecodeRawTransaction)(nil), "types.ReqDecodeRawTransaction")
 	proto.RegisterType((*UserWrite)(nil), "types.UserWrite")
 	proto.RegisterType((*UpgradeMeta)(nil), "types.UpgradeMeta")
+	proto.RegisterType((*ExecLocalDBMeta)(nil), "types.ExecLocalDBMeta")
+	proto.RegisterType((*ExecLocalDBMetas)(nil), "types.ExecLocalDBMetas")
 }
 
 func init() { proto.RegisterFile("transaction.proto", fileDescriptor_2cc4e03d2c28c490) }
 
 var fileDescriptor_2cc4e03d2c28c490 = []byte{
-	// 1306 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xdd, 0x6e, 0x13, 0x47,
-	0x14, 0xd6, 0xae, 0x7f, 0x62, 0x1f, 0x1b, 0x4a, 0x56, 0x28, 0x58, 0x88, 0x42, 0x3a, 0xa2, 0x12,
-	0x42, 0xc8, 0x91, 0x12, 0xee, 0x5a, 0xa9, 0x05, 0x52, 0x01, 0x0a, 0xd0, 0x76, 0x30, 0x50, 0xb5,
-	0x55, 0xa5, 0xc9, 0xfa, 0xc4, 0x9e, 0xc6, 0xde, 0x71, 0x76, 0xc7, 0x61, 0xfd, 0x02, 0xbd, 0x69,
-	0xef, 0xfa, 0x48, 0x7d, 0x81, 0x3e, 0x46, 0x1f, 0xa3, 0x9a, 0x33, 0x33, 0xbb, 0xe3, 0xfc, 0x20,
-	0x2e, 0x2a, 0xf5, 0x6e, 0xbe, 0x33, 0xc7, 0xe7, 0xf7, 0x3b, 0x67, 0xc7, 0xb0, 0xa9, 0x73, 0x91,
-	0x15, 0x22, 0xd5, 0x52, 0x65, 0xc3, 0x45, 0xae, 0xb4, 0x4a, 0x5a, 0x7a, 0xb5, 0xc0, 0xe2, 0x66,
-	0x3f, 0x55, 0xf3, 0xb9, 0x17, 0xb2, 0x97, 0x70, 0xe5, 0x51, 0x51, 0xa0, 0x2e, 0x9e, 0x62, 0x86,
-	0x85, 0x2c, 0x92, 0x2d, 0x68, 0x8b, 0xb9, 0x5a, 0x66, 0x7a, 0x10, 0x6f, 0x47, 0xf7, 0x1a, 0xdc,
-	0xa1, 0xe4, 0x2e, 0x5c, 0xc9, 0x51, 0x2f, 0xf3, 0xec, 0xd1, 0x78, 0x9c, 0x63, 0x51, 0x0c, 0x1a,
-	0xdb, 0xd1, 0xbd, 0x2e, 0x5f, 0x17, 0xb2, 0x3f, 0x22, 0xb8, 0x6e, 0xed, 0x8d, 0x8c, 0xff, 0x23,
-	0xcc, 0x47, 0xea, 0x9b, 0x12, 0xd3, 0xe4, 0x16, 0x74, 0x53, 0x25, 0x33, 0xad, 0x8e, 0x31, 0x1b,
-	0x44, 0xf4, 0xd3, 0x5a, 0x70, 0xa9, 0xd3, 0x04, 0x9a, 0x99, 0xd2, 0x48, 0xbe, 0xfa, 0x9c, 0xce,
-	0xc9, 0x4d, 0xe8, 0x60, 0x89, 0xe9, 0x2b, 0x31, 0xc7, 0x41, 0x93, 0x0c, 0x55, 0x38, 0xb9, 0x0a,
-	0xb1, 0x56, 0x83, 0x16, 0x49, 0x63, 0xad, 0xd8, 0x6f, 0x11, 0x5c, 0xb5, 0xe1, 0xbc, 0x93, 0x7a,
-	0x3a, 0xce, 0xc5, 0xfb, 0xff, 0x29, 0x90, 0x5f, 0x7d, 0x1c, 0xbe, 0x2c, 0xff, 0x61, 0x1c, 0xd6,
-	0x57, 0xb3, 0xf2, 0x75, 0x00, 0x2d, 0xf2, 0x65, 0x94, 0x4d, 0x40, 0xce, 0x3a, 0x9d, 0x8d, 0xe1,
-	0x62, 0x35, 0x3f, 0x54, 0x33, 0x32, 0xdc, 0xe5, 0x0e, 0x05, 0x0e, 0x1b, 0xa1, 0x43, 0xf6, 0x4f,
-	0x04, 0x9d, 0x27, 0x39, 0x0a, 0x8d, 0xa3, 0xd2, 0x79, 0x8a, 0xbc, 0xa7, 0x4b, 0xa3, 0xbc, 0x06,
-	0x8d, 0x23, 0x44, 0x67, 0xc9, 0x1c, 0xab, 0xb8, 0x9b, 0x41, 0xdc, 0xb7, 0x01, 0x64, 0xd5, 0x17,
-	0xaa, 0x55, 0x87, 0x07, 0x92, 0x64, 0x00, 0x1b, 0xb2, 0x18, 0x51, 0x7d, 0xda, 0x74, 0xe9, 0x61,
-	0xb2, 0x0d, 0x3d, 0x2a, 0xd3, 0x6b, 0x9b, 0xc9, 0x06, 0x05, 0x14, 0x8a, 0xd6, 0x7a, 0xd3, 0x39,
-	0xd3, 0x9b, 0x2d, 0x68, 0x9b, 0x33, 0xe6, 0x83, 0xae, 0x2d, 0x81, 0x45, 0xec, 0x3e, 0x6c, 0xb9,
-	0x4c, 0xeb, 0xd1, 0x79, 0x9a, 0xab, 0xe5, 0xc2, 0xe4, 0xa3, 0xcb, 0x62, 0x10, 0x6d, 0x37, 0xee,
-	0x75, 0xb9, 0x39, 0xb2, 0xdb, 0xd0, 0x79, 0x93, 0x15, 0x72, 0x92, 0x8d, 0x4a, 0x93, 0xdb, 0x58,
-	0x68, 0x41, 0x75, 0xe9, 0x73, 0x3a, 0x33, 0x05, 0xbd, 0x57, 0xea, 0xb1, 0x98, 0x89, 0x2c, 0x35,
-	0x85, 0xbb, 0x0e, 0x2d, 0x5d, 0x3e, 0xc3, 0xd2, 0xd5, 0xce, 0x02, 0x93, 0xe0, 0x42, 0xac, 0xcc,
-	0xe8, 0xb8, 0x66, 0x78, 0x48, 0x37, 0xb9, 0x3c, 0x3d, 0xc6, 0x95, 0x1b, 0x33, 0x0f, 0x6d, 0xf0,
-	0x0b, 0x99, 0x7b, 0xca, 0x39, 0xc4, 0x7e, 0x81, 0xce, 0x6b, 0x39, 0xc9, 0x70, 0x3c, 0x2a, 0x8d,
-	0xce, 0x92, 0x82, 0x73, 0x21, 0x39, 0x64, 0x02, 0x25, 0x69, 0x6c, 0x03, 0x25, 0xd9, 0x16, 0xb4,
-	0x17, 0xcb, 0x43, 0xef, 0xa8, 0xcf, 0x1d, 0xa2, 0x56, 0xaf, 0xc8, 0x47, 0x8b, 0xc7, 0x7a, 0xc5,
-	0x7e, 0x8f, 0xa1, 0x17, 0xd4, 0x25, 0x28, 0xa2, 0xf3, 0x61, 0x91, 0xcb, 0x69, 0xa6, 0xc4, 0xd8,
-	0xb9, 0xf1, 0x30, 0x19, 0x42, 0xd7, 0x78, 0x14, 0x7a, 0x99, 0x5b, 0x6a, 0xf4, 0x76, 0xaf, 0x0d,
-	0x69, 0x25, 0x0d, 0x5f, 0x7b, 0x39, 0xaf, 0x55, 0x3c, 0x89, 0x9a, 0x35, 0x89, 0xea, 0xdc, 0x5b,
-	0x96, 0x6e, 0x16, 0x99, 0xea, 0x66, 0x2a, 0x4b, 0x91, 0x68, 0xd2, 0xe0, 0x16, 0x38, 0xb2, 0x6e,
-	0x54, 0x64, 0xbd, 0x0d, 0x30, 0x31, 0xdd, 0x7c, 0x42, 0x84, 0xed, 0x50, 0x66, 0x81, 0xc4, 0x58,
-	0x9f, 0xa2, 0x18, 0x3b, 0x5a, 0xf4, 0xb9, 0x43, 0x44, 0x5d, 0x2c, 0xf5, 0x00, 0x1c, 0x75, 0xb1,
-	0xd4, 0xec, 0x21, 0xf4, 0x83, 0x62, 0x14, 0xc9, 0xdd, 0x9a, 0x20, 0xbd, 0xdd, 0xc4, 0x65, 0x15,
-	0x68, 0x58, 0xd2, 0x7c, 0x05, 0x57, 0xb8, 0xcc, 0x26, 0x55, 0xb6, 0xc9, 0x10, 0x5a, 0x52, 0xe3,
-	0xdc, 0xff, 0x70, 0xe0, 0x7e, 0xb8, 0xa6, 0xf4, 0x5c, 0xe3, 0x9c, 0x5b, 0x35, 0xf6, 0x1c, 0x36,
-	0xcf, 0xdd, 0x05, 0x1d, 0x34, 0x56, 0xea, 0x0e, 0xde, 0x0a, 0xeb, 0x1d, 0xd3, 0x55, 0x2d, 0x60,
-	0xdf, 0x43, 0xb7, 0x8e, 0xc3, 0x36, 0x3b, 0xf2, 0xcd, 0x0e, 0x4c, 0xc6, 0x6b, 0xa4, 0xb8, 0x75,
-	0xb6, 0x85, 0x6b, 0x26, 0x7f, 0x86, 0xbe, 0x21, 0xef, 0xb7, 0xa7, 0x98, 0x9f, 0x4a, 0xa4, 0xf9,
-	0xcd, 0x31, 0x95, 0xa7, 0x8e, 0x23, 0x0d, 0xee, 0xa1, 0xb9, 0x39, 0xb4, 0xb3, 0xe1, 0x16, 0x87,
-	0x87, 0xe6, 0x46, 0x97, 0x4f, 0x82, 0x3d, 0xe4, 0x21, 0xfb, 0x33, 0x82, 0x0d, 0x8e, 0x27, 0x34,
-	0x1e, 0x09, 0x34, 0x85, 0x99, 0x1a, 0xb7, 0xd8, 0x84, 0x93, 0x1d, 0xcd, 0xc4, 0x84, 0x0c, 0xb6,
-	0x38, 0x9d, 0x0d, 0x31, 0xd2, 0xca, 0x56, 0x8b, 0x5b, 0x60, 0xb2, 0x18, 0xcb, 0x1c, 0xa9, 0x31,
-	0x8e, 0xe1, 0xb5, 0xc0, 0xd2, 0x40, 0x4e, 0xa6, 0xda, 0x93, 0xcc, 0x22, 0x63, 0x4b, 0x66, 0x63,
-	0x2c, 0x3d, 0xc9, 0x08, 0xb0, 0x1f, 0x00, 0x38, 0x9e, 0x7c, 0x97, 0xcb, 0x53, 0x91, 0xae, 0x6a,
-	0x7f, 0xd1, 0xa5, 0xfe, 0xe2, 0xcb, 0xfd, 0x35, 0x42, 0x7f, 0xec, 0x06, 0xb4, 0x9e, 0x61, 0xe9,
-	0x96, 0x6e, 0x59, 0x2d, 0xdd, 0x92, 0x2d, 0xa1, 0xc7, 0x71, 0x31, 0x5b, 0x8d, 0xca, 0xe7, 0xd9,
-	0x91, 0x32, 0x79, 0x4f, 0x45, 0x31, 0xf5, 0xdb, 0xc7, 0x9c, 0x03, 0x9b, 0xf1, 0xc5, 0x39, 0x34,
-	0x82, 0x1c, 0x92, 0xbb, 0xd0, 0x16, 0xf4, 0x6d, 0x1a, 0x34, 0x89, 0x86, 0x7d, 0x47, 0x43, 0xfa,
-	0x88, 0x70, 0x77, 0xc7, 0x3e, 0x83, 0x2e, 0xc7, 0x93, 0x51, 0xf9, 0x42, 0x16, 0x7a, 0x3d, 0xd1,
-	0x86, 0x4b, 0x94, 0xed, 0x55, 0x91, 0x91, 0xd2, 0xc7, 0x0d, 0x05, 0x07, 0x18, 0x95, 0xcf, 0x44,
-	0x31, 0xa5, 0xdf, 0x98, 0xc8, 0x45, 0x31, 0xc5, 0xc2, 0x93, 0xd9, 0xa2, 0xda, 0x61, 0x1c, 0x38,
-	0x0c, 0x16, 0x42, 0x63, 0xbb, 0x51, 0x2f, 0x04, 0xf6, 0x25, 0xf4, 0x83, 0x12, 0x15, 0xc9, 0x03,
-	0xc3, 0x2a, 0x3a, 0x9e, 0x89, 0x26, 0xd0, 0xe2, 0x5e, 0x85, 0x0d, 0x4d, 0x4f, 0x53, 0x94, 0x0b,
-	0xfd, 0x42, 0x4d, 0xce, 0xcd, 0xc6, 0x35, 0x68, 0xcc, 0xd4, 0xc4, 0x0d, 0x86, 0x39, 0x32, 0x61,
-	0x88, 0x49, 0xfa, 0xe7, 0x94, 0xef, 0x40, 0x7c, 0xf0, 0x96, 0x86, 0xaf, 0xb7, 0xfb, 0x89, 0xf3,
-	0x79, 0x80, 0xab, 0xb7, 0x62, 0xb6, 0x44, 0x1e, 0x1f, 0xbc, 0x4d, 0x3e, 0x87, 0xe6, 0x4c, 0x4d,
-	0x0a, 0x8a, 0xbf, 0xb7, 0xbb, 0x59, 0x85, 0xe5, 0xdd, 0x73, 0xba, 0x66, 0xfb, 0xa6, 0xb2, 0x24,
-	0xdb, 0x17, 0x5a, 0x9c, 0x73, 0xf3, 0x91, 0x56, 0xfe, 0x8e, 0xa0, 0x33, 0x2a, 0x39, 0x16, 0xcb,
-	0x99, 0x0e, 0x38, 0x12, 0x5d, 0xcc, 0x11, 0xcb, 0x54, 0xc7, 0x11, 0x46, 0x24, 0xb4, 0x5b, 0xfb,
-	0xa2, 0x56, 0xc6, 0xba, 0x4c, 0x1e, 0x42, 0x2f, 0xb7, 0x2e, 0xc7, 0xc2, 0x7d, 0xea, 0xc3, 0x4a,
-	0x57, 0xe1, 0xf3, 0x50, 0xcd, 0x4c, 0xc7, 0xe1, 0x4c, 0xa5, 0xc7, 0x5a, 0xce, 0xfd, 0x5e, 0xaf,
-	0x05, 0x66, 0x69, 0x5b, 0x0f, 0xf4, 0x25, 0x6f, 0xd3, 0x10, 0x04, 0x12, 0xf6, 0x57, 0x0c, 0x9b,
-	0x41, 0x1c, 0xfb, 0xa8, 0x85, 0x9c, 0xb9, 0x68, 0xa3, 0x0f, 0x46, 0xfb, 0x80, 0xb6, 0x93, 0x09,
-	0x83, 0x32, 0xbd, 0x38, 0x52, 0xaf, 0x42, 0x1b, 0x31, 0x57, 0xea, 0xc8, 0xd6, 0xd8, 0x6c, 0x44,
-	0x42, 0x41, 0x15, 0x9b, 0x17, 0x57, 0xb1, 0x15, 0x4e, 0xda, 0x5a, 0xae, 0xed, 0xb3, 0xb9, 0xd6,
-	0xaf, 0xa9, 0x8d, 0xb5, 0xd7, 0xd4, 0x4d, 0xe8, 0x1c, 0xe5, 0x6a, 0x4e, 0x1b, 0xcf, 0xbd, 0x65,
-	0x3c, 0x3e, 0x53, 0x9f, 0xee, 0xd9, 0xfa, 0x04, 0xb3, 0x0d, 0x1f, 0x98, 0xed, 0xaf, 0x21, 0x39,
-	0x57, 0xc4, 0x22, 0xb9, 0x1f, 0xce, 0xef, 0xe0, 0x7c, 0x19, 0xad, 0x9e, 0x9d, 0xe2, 0x6d, 0xe8,
-	0xb8, 0xe5, 0x4c, 0xb3, 0x6a, 0x62, 0xf3, 0xef, 0x25, 0x0b, 0xd8, 0x0e, 0xdc, 0xe0, 0x78, 0xb2,
-	0x8f, 0xa9, 0x1a, 0x23, 0x17, 0xef, 0xc3, 0xb7, 0xc4, 0x85, 0xaf, 0x23, 0xf6, 0x05, 0x74, 0xdf,
-	0x14, 0x98, 0xbf, 0xcb, 0xa5, 0xa6, 0x4f, 0xbc, 0x56, 0x0b, 0x99, 0x56, 0x2a, 0x06, 0x98, 0xaf,
-	0x45, 0xaa, 0x32, 0x8d, 0x6e, 0x2f, 0x74, 0xb9, 0x87, 0xec, 0x27, 0xe8, 0xbd, 0x59, 0x4c, 0x72,
-	0x31, 0xc6, 0x97, 0xa8, 0x85, 0x29, 0x21, 0x75, 0x40, 0x66, 0x13, 0xb2, 0xd0, 0xe1, 0x15, 0x36,
-	0x46, 0x4e, 0x31, 0x2f, 0xfc, 0x72, 0xee, 0x72, 0x0f, 0x2f, 0x5b, 0xcd, 0x8f, 0xef, 0xfc, 0xf8,
-	0xe9, 0x44, 0xea, 0xe9, 0xf2, 0x70, 0x98, 0xaa, 0xf9, 0xce, 0xde, 0x5e, 0x9a, 0xed, 0xa4, 0x53,
-	0x21, 0xb3, 0xbd, 0xbd, 0x1d, 0x2a, 0xd2, 0x61, 0x9b, 0xfe, 0x5b, 0xed, 0xfd, 0x1b, 0x00, 0x00,
-	0xff, 0xff, 0xc0, 0xaa, 0xbd, 0xba, 0x85, 0x0d, 0x00, 0x00,
+	// 1514 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xed, 0x6e, 0xdb, 0x36,
+	0x17, 0x86, 0x24, 0x7f, 0x1e, 0x3b, 0x69, 0xa2, 0xb7, 0x48, 0x8d, 0xa0, 0x6f, 0xea, 0x97, 0x6f,
+	0x87, 0x15, 0x45, 0x91, 0x00, 0x49, 0xff, 0x6d, 0xc0, 0xfa, 0x91, 0xa1, 0x09, 0x92, 0x76, 0x1b,
+	0xeb, 0xb6, 0xc3, 0x36, 0x0c, 0x60, 0x24, 0xc6, 0xd6, 0x6a, 0x8b, 0x8e, 0x44, 0xa7, 0xf2, 0x0d,
+	0xec, 0xcf, 0x76, 0x37, 0xbb, 0x82, 0x61, 0x37, 0xb0, 0xdd, 0xc5, 0x2e, 0x63, 0xe0, 0x21, 0x29,
+	0xd1, 0x4e, 0x5c, 0xf4, 0x47, 0x81, 0xfd, 0xe3, 0x73, 0x78, 0x74, 0x3e, 0x1f, 0x1e, 0xd2, 0x86,
+	0x4d, 0x99, 0xb1, 0x34, 0x67, 0x91, 0x4c, 0x44, 0xba, 0x3b, 0xcd, 0x84, 0x14, 0x61, 0x5d, 0xce,
+	0xa7, 0x3c, 0xdf, 0xee, 0x46, 0x62, 0x32, 0xb1, 0xc2, 0xed, 0x35, 0x16, 0x45, 0x62, 0x96, 0x4a,
+	0x0d, 0xc9, 0x73, 0x58, 0x7b, 0x9c, 0xe7, 0x5c, 0xe6, 0xcf, 0x78, 0xca, 0xf3, 0x24, 0x0f, 0xb7,
+	0xa0, 0xc1, 0x26, 0x4a, 0xa1, 0xe7, 0xf7, 0xbd, 0x7b, 0x01, 0x35, 0x28, 0xbc, 0x0b, 0x6b, 0x19,
+	0x97, 0xb3, 0x2c, 0x7d, 0x1c, 0xc7, 0x19, 0xcf, 0xf3, 0x5e, 0xd0, 0xf7, 0xee, 0xb5, 0xe9, 0xa2,
+	0x90, 0xfc, 0xea, 0xc1, 0x4d, 0x6d, 0x6f, 0xa0, 0xc2, 0x39, 0xe7, 0xd9, 0x40, 0x7c, 0x59, 0xf0,
+	0x28, 0xbc, 0x0d, 0xed, 0x48, 0x24, 0xa9, 0x14, 0x6f, 0x79, 0xda, 0xf3, 0xf0, 0xd3, 0x4a, 0xb0,
+	0xd2, 0x69, 0x08, 0xb5, 0x54, 0x48, 0x8e, 0xbe, 0xba, 0x14, 0xd7, 0xe1, 0x36, 0xb4, 0x78, 0xc1,
+	0xa3, 0x17, 0x6c, 0xc2, 0x7b, 0x35, 0x34, 0x54, 0xe2, 0x70, 0x1d, 0x7c, 0x29, 0x7a, 0x75, 0x94,
+	0xfa, 0x52, 0x90, 0x9f, 0x3d, 0x58, 0xd7, 0xe1, 0xbc, 0x49, 0xe4, 0x28, 0xce, 0xd8, 0xbb, 0x7f,
+	0x29, 0x90, 0x9f, 0x60, 0x7d, 0xb1, 0x2c, 0x1f, 0x31, 0x0e, 0xed, 0xab, 0x56, 0xfa, 0x3a, 0x81,
+	0x3a, 0xfa, 0x52, 0xca, 0x2a, 0x20, 0x63, 0x1d, 0xd7, 0xca, 0x70, 0x3e, 0x9f, 0x9c, 0x89, 0x31,
+	0x1a, 0x6e, 0x53, 0x83, 0x1c, 0x87, 0x81, 0xeb, 0x90, 0xfc, 0xed, 0x41, 0xeb, 0x69, 0xc6, 0x99,
+	0xe4, 0x83, 0xc2, 0x78, 0xf2, 0xac, 0xa7, 0x95, 0x51, 0x6e, 0x40, 0x70, 0xce, 0xb9, 0xb1, 0xa4,
+	0x96, 0x65, 0xdc, 0x35, 0x27, 0xee, 0x1d, 0x80, 0xa4, 0xec, 0x0b, 0xd6, 0xaa, 0x45, 0x1d, 0x49,
+	0xd8, 0x83, 0x66, 0x92, 0x0f, 0xb0, 0x3e, 0x0d, 0xdc, 0xb4, 0x30, 0xec, 0x43, 0x07, 0xcb, 0xf4,
+	0x52, 0x67, 0xd2, 0xc4, 0x80, 0x5c, 0xd1, 0x42, 0x6f, 0x5a, 0x4b, 0xbd, 0xd9, 0x82, 0x86, 0x5a,
+	0xf3, 0xac, 0xd7, 0xd6, 0x25, 0xd0, 0x88, 0xdc, 0x87, 0x2d, 0x93, 0x69, 0x75, 0x92, 0x9e, 0x65,
+	0x62, 0x36, 0x55, 0xf9, 0xc8, 0x22, 0xef, 0x79, 0xfd, 0xe0, 0x5e, 0x9b, 0xaa, 0x25, 0xd9, 0x81,
+	0xd6, 0xab, 0x34, 0x4f, 0x86, 0xe9, 0xa0, 0x50, 0xb9, 0xc5, 0x4c, 0x32, 0xac, 0x4b, 0x97, 0xe2,
+	0x9a, 0x08, 0xe8, 0xbc, 0x10, 0x4f, 0xd8, 0x98, 0xa5, 0x91, 0x2a, 0xdc, 0x4d, 0xa8, 0xcb, 0xe2,
+	0x88, 0x17, 0xa6, 0x76, 0x1a, 0xa8, 0x04, 0xa7, 0x6c, 0xae, 0x8e, 0x8e, 0x69, 0x86, 0x85, 0xb8,
+	0x93, 0x25, 0x97, 0x6f, 0xf9, 0xdc, 0x1c, 0x33, 0x0b, 0x75, 0xf0, 0xd3, 0x24, 0xb3, 0x94, 0x33,
+	0x88, 0xfc, 0x08, 0xad, 0x97, 0xc9, 0x30, 0xe5, 0xf1, 0xa0, 0x50, 0x3a, 0x33, 0x0c, 0xce, 0x84,
+	0x64, 0x90, 0x0a, 0x14, 0xa5, 0xbe, 0x0e, 0x14, 0x65, 0x5b, 0xd0, 0x98, 0xce, 0xce, 0xac, 0xa3,
+	0x2e, 0x35, 0x08, 0x5b, 0x3d, 0x47, 0x1f, 0x75, 0xea, 0xcb, 0x39, 0xf9, 0xc5, 0x87, 0x8e, 0x53,
+	0x17, 0xa7, 0x88, 0xc6, 0x87, 0x46, 0x26, 0xa7, 0xb1, 0x60, 0xb1, 0x71, 0x63, 0x61, 0xb8, 0x0b,
+	0x6d, 0xe5, 0x91, 0xc9, 0x59, 0xa6, 0xa9, 0xd1, 0xd9, 0xdf, 0xd8, 0xc5, 0x09, 0xb5, 0xfb, 0xd2,
+	0xca, 0x69, 0xa5, 0x62, 0x49, 0x54, 0xab, 0x48, 0x54, 0xe5, 0x5e, 0xd7, 0x74, 0xd3, 0x48, 0x55,
+	0x37, 0x15, 0x69, 0xc4, 0x91, 0x26, 0x01, 0xd5, 0xc0, 0x90, 0xb5, 0x59, 0x92, 0x75, 0x07, 0x60,
+	0xa8, 0xba, 0xf9, 0x14, 0x09, 0xdb, 0xc2, 0xcc, 0x1c, 0x89, 0xb2, 0x3e, 0xe2, 0x2c, 0x36, 0xb4,
+	0xe8, 0x52, 0x83, 0x90, 0xba, 0xbc, 0x90, 0x3d, 0x30, 0xd4, 0xe5, 0x85, 0x24, 0x0f, 0xa1, 0xeb,
+	0x14, 0x23, 0x0f, 0xef, 0x56, 0x04, 0xe9, 0xec, 0x87, 0x26, 0x2b, 0x47, 0x43, 0x93, 0xe6, 0x0b,
+	0x58, 0xa3, 0x49, 0x3a, 0x2c, 0xb3, 0x0d, 0x77, 0xa1, 0x9e, 0x48, 0x3e, 0xb1, 0x1f, 0xf6, 0xcc,
+	0x87, 0x0b, 0x4a, 0xc7, 0x92, 0x4f, 0xa8, 0x56, 0x23, 0xc7, 0xb0, 0x79, 0x65, 0xcf, 0xe9, 0xa0,
+	0xb2, 0x52, 0x75, 0xf0, 0xb6, 0x5b, 0x6f, 0x1f, 0xb7, 0x2a, 0x01, 0xf9, 0x06, 0xda, 0x55, 0x1c,
+	0xba, 0xd9, 0x9e, 0x6d, 0xb6, 0x63, 0xd2, 0xef, 0x7b, 0xab, 0x4c, 0x6a, 0xbe, 0x38, 0x26, 0x7f,
+	0x80, 0xae, 0x22, 0xef, 0x57, 0x97, 0x3c, 0xbb, 0x4c, 0x38, 0x9e, 0xdf, 0x8c, 0x47, 0xc9, 0xa5,
+	0xe1, 0x48, 0x40, 0x2d, 0x54, 0x3b, 0x67, 0xfa, 0x6c, 0x98, 0xc1, 0x61, 0xa1, 0xda, 0x91, 0xc5,
+	0x53, 0x67, 0x0e, 0x59, 0x48, 0x7e, 0xf3, 0xa0, 0x49, 0xf9, 0x05, 0x1e, 0x8f, 0x10, 0x6a, 0x2c,
+	0x8e, 0xb5, 0xd9, 0x36, 0xad, 0x31, 0x23, 0x3b, 0x1f, 0xb3, 0x21, 0x1a, 0xac, 0x53, 0x5c, 0x2b,
+	0x62, 0x44, 0xa5, 0xad, 0x3a, 0xd5, 0x40, 0x65, 0x11, 0x27, 0x19, 0xc7, 0xc6, 0x18, 0x86, 0x57,
+	0x02, 0x4d, 0x83, 0x64, 0x38, 0x92, 0x96, 0x64, 0x1a, 0x29, 0x5b, 0x49, 0x1a, 0xf3, 0xc2, 0x92,
+	0x0c, 0x41, 0xb8, 0x03, 0x3e, 0x93, 0x48, 0xb2, 0xce, 0xfe, 0xba, 0x65, 0xb3, 0x64, 0x92, 0x3f,
+	0x96, 0xd4, 0x67, 0x92, 0x7c, 0x0b, 0x40, 0xf9, 0xc5, 0xd7, 0x59, 0x72, 0xc9, 0xa2, 0x79, 0x15,
+	0x8f, 0xb7, 0x32, 0x1e, 0x7f, 0x75, 0x3c, 0x81, 0x1b, 0x0f, 0xb9, 0x05, 0xf5, 0x23, 0x5e, 0x98,
+	0xa1, 0x5c, 0x94, 0x43, 0xb9, 0x20, 0x33, 0xe8, 0x50, 0x3e, 0x1d, 0xcf, 0x07, 0xc5, 0x71, 0x7a,
+	0x2e, 0x54, 0x5d, 0x46, 0x2c, 0x1f, 0xd9, 0xe9, 0xa4, 0xd6, 0x8e, 0x4d, 0xff, 0xfa, 0x1c, 0x03,
+	0x37, 0xc7, 0xbb, 0xd0, 0x60, 0x78, 0x77, 0xf5, 0x6a, 0x48, 0xd3, 0xae, 0xc9, 0x13, 0x2f, 0x19,
+	0x6a, 0xf6, 0xc8, 0xff, 0xa0, 0x4d, 0xf9, 0xc5, 0xa0, 0x38, 0x4d, 0x72, 0xb9, 0x98, 0x68, 0x60,
+	0x12, 0x25, 0x07, 0x65, 0x64, 0xa8, 0xf4, 0x61, 0x87, 0x86, 0x02, 0x0c, 0x8a, 0x23, 0x96, 0x8f,
+	0xf0, 0x1b, 0x15, 0x39, 0xcb, 0x47, 0x3c, 0xb7, 0x64, 0xd7, 0xa8, 0x72, 0xe8, 0x3b, 0x0e, 0x9d,
+	0x81, 0x11, 0xf4, 0x83, 0x6a, 0x60, 0x90, 0xcf, 0xa1, 0xeb, 0x94, 0x28, 0x0f, 0x1f, 0x28, 0xd6,
+	0xe1, 0x72, 0x29, 0x1a, 0x47, 0x8b, 0x5a, 0x15, 0xb2, 0xab, 0x7a, 0x1a, 0xf1, 0x64, 0x2a, 0x4f,
+	0xc5, 0xf0, 0xca, 0xd9, 0xd9, 0x80, 0x60, 0x2c, 0x86, 0xe6, 0xe0, 0xa8, 0x25, 0xf9, 0x4b, 0x33,
+	0xf7, 0x54, 0x0c, 0xf3, 0xa5, 0xb1, 0x59, 0xde, 0x3d, 0xc6, 0x8a, 0x5f, 0x5a, 0xb1, 0x0c, 0x0f,
+	0x1c, 0x86, 0xf7, 0xa1, 0x93, 0x4b, 0x96, 0xc9, 0x23, 0xdd, 0x3a, 0x3d, 0x18, 0x5d, 0x91, 0x62,
+	0x12, 0x4f, 0xe3, 0x23, 0x97, 0xbe, 0x95, 0xa0, 0xaa, 0x51, 0x63, 0x25, 0xfb, 0x9a, 0xd7, 0xb0,
+	0x2f, 0x9a, 0x65, 0xb9, 0xc8, 0xcc, 0x2d, 0x6a, 0x10, 0xf9, 0xdd, 0x83, 0xee, 0xa9, 0x18, 0x1e,
+	0x2b, 0x82, 0x7c, 0x24, 0x9a, 0x6d, 0x43, 0x6b, 0x6c, 0x2c, 0x9a, 0x53, 0x59, 0x62, 0xa7, 0x6c,
+	0xf5, 0x85, 0xb2, 0xfd, 0x5f, 0x17, 0xbb, 0x81, 0xe7, 0x6f, 0xb3, 0x6c, 0x9a, 0x6d, 0x0e, 0xd6,
+	0xdf, 0xc9, 0xa1, 0xb9, 0x90, 0xc3, 0xa9, 0x62, 0xec, 0x74, 0x3c, 0xc7, 0xc6, 0x7c, 0x0a, 0xb5,
+	0xb1, 0x18, 0xda, 0xfe, 0xff, 0xc7, 0x98, 0x72, 0x53, 0xa4, 0xa8, 0xe0, 0x58, 0xf3, 0x17, 0xac,
+	0x31, 0x68, 0x1a, 0xc7, 0x57, 0x28, 0x71, 0x07, 0xfc, 0x93, 0xd7, 0x38, 0x82, 0x3b, 0xfb, 0x37,
+	0x8c, 0xe5, 0x13, 0x3e, 0x7f, 0xcd, 0xc6, 0x33, 0x4e, 0xfd, 0x93, 0xd7, 0xe1, 0x27, 0xc6, 0x79,
+	0xd0, 0x0f, 0xae, 0xcf, 0x03, 0xb7, 0xc9, 0x21, 0x74, 0x8c, 0xec, 0x90, 0x49, 0x76, 0xc5, 0xcd,
+	0x07, 0x5a, 0xf9, 0xd3, 0x83, 0xd6, 0xa0, 0xa0, 0x3c, 0x9f, 0x8d, 0xa5, 0xd3, 0x22, 0xef, 0xfa,
+	0x16, 0x69, 0x4a, 0x6a, 0x10, 0x12, 0x1c, 0x35, 0xfa, 0xee, 0xbe, 0xee, 0xc0, 0xfa, 0xb2, 0x08,
+	0x1f, 0x42, 0x27, 0xd3, 0x2e, 0x63, 0x66, 0x1e, 0x7c, 0xee, 0x79, 0x2a, 0xc3, 0xa7, 0xae, 0x9a,
+	0x62, 0xe1, 0xd9, 0x58, 0x44, 0x6f, 0x65, 0x32, 0xb1, 0xb7, 0x7b, 0x25, 0x50, 0x57, 0xb7, 0xf6,
+	0x80, 0xef, 0xb9, 0x06, 0xd6, 0xdd, 0x91, 0x90, 0x3f, 0x7c, 0xd8, 0x74, 0xe2, 0x38, 0xe4, 0x92,
+	0x25, 0x63, 0x13, 0xad, 0xf7, 0xde, 0x68, 0x1f, 0x40, 0xd3, 0x84, 0xd1, 0xf3, 0x17, 0x14, 0xdd,
+	0x48, 0xad, 0x0a, 0xde, 0x8b, 0x99, 0x10, 0xe7, 0xba, 0xc6, 0x5d, 0x6a, 0x90, 0x53, 0xc5, 0xda,
+	0xf5, 0x55, 0xac, 0xbb, 0x44, 0x5f, 0xc8, 0xb5, 0xb1, 0x9c, 0x6b, 0xf5, 0xa6, 0x6e, 0x2e, 0xbc,
+	0xa9, 0xb7, 0xa1, 0x75, 0x9e, 0x89, 0x09, 0x4e, 0x05, 0xf3, 0xa2, 0xb5, 0x78, 0xa9, 0x3e, 0xed,
+	0xe5, 0xfa, 0x38, 0x13, 0x1c, 0xde, 0x33, 0xc1, 0x1f, 0x41, 0x78, 0xa5, 0x88, 0x79, 0x78, 0xdf,
+	0x9d, 0xd2, 0xbd, 0xab, 0x65, 0xd4, 0x7a, 0x7a, 0x56, 0xf7, 0xa1, 0x65, 0xae, 0x68, 0x9c, 0xc8,
+	0x2a, 0x36, 0xfb, 0x6a, 0xd6, 0x80, 0xec, 0xc1, 0x2d, 0xca, 0x2f, 0x0e, 0x79, 0x24, 0x62, 0x4e,
+	0xd9, 0x3b, 0xc7, 0xce, 0xf5, 0x6f, 0x64, 0xf2, 0x19, 0xb4, 0x5f, 0xe5, 0x3c, 0x7b, 0x93, 0x25,
+	0x12, 0x1f, 0x7a, 0x52, 0x4c, 0x93, 0xa8, 0x54, 0x51, 0x40, 0xbd, 0x19, 0x22, 0x91, 0x4a, 0x6e,
+	0xa6, 0x7f, 0x9b, 0x5a, 0x48, 0xbe, 0x87, 0xce, 0xab, 0xe9, 0x30, 0x63, 0x31, 0x7f, 0xce, 0x25,
+	0x53, 0x25, 0xc4, 0x0e, 0x24, 0xe9, 0x10, 0x2d, 0xb4, 0x68, 0x89, 0x95, 0x91, 0x4b, 0x9e, 0xe5,
+	0xf6, 0x0a, 0x6e, 0x53, 0x0b, 0x57, 0x5e, 0xc0, 0x39, 0xdc, 0x50, 0xbf, 0x6c, 0x4f, 0x45, 0xc4,
+	0xc6, 0x87, 0x4f, 0xd0, 0x81, 0x7a, 0x2a, 0xaa, 0x0e, 0x98, 0x77, 0x89, 0x5a, 0x2f, 0x1b, 0xae,
+	0x57, 0x86, 0xdd, 0x70, 0x82, 0xa5, 0x70, 0x56, 0x30, 0x8a, 0x3c, 0x82, 0x8d, 0x25, 0xa7, 0xea,
+	0xf6, 0xaa, 0x4f, 0xd4, 0xc2, 0xf4, 0x68, 0xcb, 0xf4, 0x68, 0x49, 0x8f, 0x6a, 0xa5, 0x27, 0x77,
+	0xbe, 0xfb, 0xef, 0x30, 0x91, 0xa3, 0xd9, 0xd9, 0x6e, 0x24, 0x26, 0x7b, 0x07, 0x07, 0x51, 0xba,
+	0x17, 0x8d, 0x58, 0x92, 0x1e, 0x1c, 0xec, 0xe1, 0x77, 0x67, 0x0d, 0xfc, 0x63, 0xe0, 0xe0, 0x9f,
+	0x01, 0x00, 0x24, 0x8f, 0x8c, 0xd6, 0x51, 0x10, 0x00, 0x00,
 }
@@ -6,6 +6,7 @@ package types
 
 import (
 	"bytes"
+	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
 	"time"
@@ -332,6 +333,17 @@ func (tx *Transaction) Hash() []byte {
 	return common.Sha256(data)
 }
 
+//ShortTxIDMask 紧凑区块中交易的短哈希取 48 位
+const ShortTxIDMask = 1<<48 - 1
+
+//ShortTxID 紧凑区块中交易的短哈希, 每个区块使用不同的随机数, 避免构造短哈希冲突的交易
+func ShortTxID(nonce int64, txhash []byte) uint64 {
+	buf := make([]byte, 8, 8+len(txhash))
+	binary.LittleEndian.PutUint64(buf, uint64(nonce))
+	hash := common.Sha256(append(buf, txhash...))
+	return binary.LittleEndian.Uint64(hash[:8]) & ShortTxIDMask
+}
+
 //clone copytx := proto.Clone(tx).(*Transaction) too slow
 func clone(tx *Transaction) *Transaction {
 	copytx := &Transaction{}
@@ -14,6 +14,16 @@ import (
 	_ "github.com/33cn/chain33/system/crypto/init"
 )
 
+func TestShortTxID(t *testing.T) {
+	hash := []byte("txhash")
+	if ShortTxID(1, hash) != ShortTxID(1, hash) || ShortTxID(1, hash) == ShortTxID(2, hash) {
+		t.Error("TestShortTxID failed")
+	}
+	if ShortTxID(1, hash) > ShortTxIDMask {
+		t.Error("TestShortTxID mask failed")
+	}
+}
+
 func TestCreateGroupTx(t *testing.T) {
 	tx1 := "0a05636f696e73120e18010a0a1080c2d72f1a036f746520a08d0630f1cdebc8f7efa5e9283a22313271796f6361794e46374c7636433971573461767873324537553431664b536676"
 	tx2 := "0a05636f696e73120e18010a0a1080c2d72f1a036f746520a08d0630de92c3828ad194b26d3a22313271796f6361794e46374c7636433971573461767873324537553431664b536676"
@@ -242,6 +242,20 @@ func (innernode *InnerNode) Hash() []byte {
 	return common.Sha256(data)
 }
 
+//IsPruned 判断指定高度的状态是否可能已经被裁剪, 关闭裁剪之后按照持久化的裁剪高度判断
+func (status *StorePruneStatus) IsPruned(height int64) bool {
+	if status.CurPruneHeight == 0 || height >= status.CurPruneHeight {
+		return false
+	}
+	for _, h := range status.PinnedHeights {
+		if h == height {
+			return false
+		}
+	}
+	//叶子节点在被新版本覆盖KeepHeight个区块之后才会被裁剪
+	return status.KeepHeight == 0 || height+1+status.KeepHeight <= status.CurPruneHeight
+}
+
 //NewErrReceipt  new一个新的Receipt
 func NewErrReceipt(err error) *Receipt {
 	berr := err.Error()
@@ -323,6 +337,24 @@ func ManaeKeyWithHeigh(key string, height int64) string {
 	return ConfigKey(key)
 }
 
+//ManageGovernPrefix 链上管理执行器状态的key前缀, 不能通过修改配置的方式修改
+const ManageGovernPrefix = "govern-"
+
+//ManageExecStatusKey 执行器运行状态的key
+func ManageExecStatusKey(name string) string {
+	return ManageKey(ManageGovernPrefix + "exec-status-" + name)
+}
+
+//ManageExecWhitelistKey 允许调用的执行器列表的key
+func ManageExecWhitelistKey() string {
+	return ManageKey(ManageGovernPrefix + "exec-whitelist")
+}
+
+//ManageDappForkKey 链上设置的dapp fork高度的key
+func ManageDappForkKey(dapp, fork string) string {
+	return ManageKey(ManageGovernPrefix + "dapp-fork-" + dapp + "-" + fork)
+}
+
 //ReceiptDataResult 回执数据
 type ReceiptDataResult struct {
 	Ty     int32               `json:"ty"`
@@ -377,3 +377,24 @@ func TestIterateCallBack_PrefixWithExecAddr(t *testing.T) {
 	assert.Equal(t, int64(3), reply.Num)
 	assert.Equal(t, len(key), len(reply.NextKey))
 }
+
+func TestStorePruneStatusIsPruned(t *testing.T) {
+	status := &StorePruneStatus{}
+	assert.False(t, status.IsPruned(1))
+	//关闭裁剪之前已经裁剪过的状态
+	status.CurPruneHeight = 1000
+	assert.True(t, status.IsPruned(1))
+	status.CurPruneHeight = 0
+	status.Enable = true
+	assert.False(t, status.IsPruned(1))
+
+	status.CurPruneHeight = 1000
+	assert.True(t, status.IsPruned(1))
+	assert.False(t, status.IsPruned(1000))
+
+	status.KeepHeight = 100
+	status.PinnedHeights = []int64{500}
+	assert.True(t, status.IsPruned(899))
+	assert.False(t, status.IsPruned(900))
+	assert.False(t, status.IsPruned(500))
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/33cn/chain33/common/version"
 	"github.com/33cn/chain33/consensus"
 	"github.com/33cn/chain33/executor"
+	"github.com/33cn/chain33/light"
 	"github.com/33cn/chain33/mempool"
 	"github.com/33cn/chain33/p2p"
 	"github.com/33cn/chain33/queue"
@@ -129,26 +130,48 @@ func RunChain33(name string) {
 	log.Info("loading queue")
 	q := queue.New("channel")
 
-	log.Info("loading mempool module")
-	mem := mempool.New(cfg.Mempool, sub.Mempool)
-	mem.SetQueueClient(q.Client())
+	var mem, s, cs queue.Module
+	var chain *blockchain.BlockChain
+	var lc *light.Light
+	//轻节点只同步区块头, 由 light 模块代替 blockchain, store 和 mempool 模块, 不加载共识模块
+	if cfg.BlockChain.LightClient {
+		log.Info("loading light client module")
+		lc, err = light.New(cfg.BlockChain, consensus.GenesisBlock(cfg.Consensus, sub.Consensus))
+		if err != nil {
+			log.Error("loading light client module", "err", err)
+			fmt.Fprintln(os.Stderr, "loading light client module failed:", err)
+			q.Close()
+			return
+		}
+		lc.SetQueueClient(q.Client())
+		lc.SetStoreClient(q.Client())
+		lc.SetMempoolClient(q.Client())
+		//轻节点没有 store, 不能向其他节点提供状态证明
+		cfg.P2P.DisableCaps = append(cfg.P2P.DisableCaps, "lightService")
+	} else {
+		log.Info("loading mempool module")
+		mem = mempool.New(cfg.Mempool, sub.Mempool)
+		mem.SetQueueClient(q.Client())
+	}
 
 	log.Info("loading execs module")
 	exec := executor.New(cfg.Exec, sub.Exec)
 	exec.SetQueueClient(q.Client())
 
-	log.Info("loading store module")
-	s := store.New(cfg.Store, sub.Store)
-	s.SetQueueClient(q.Client())
+	if !cfg.BlockChain.LightClient {
+		log.Info("loading store module")
+		s = store.New(cfg.Store, sub.Store)
+		s.SetQueueClient(q.Client())
 
-	log.Info("loading blockchain module")
-	chain := blockchain.New(cfg.BlockChain)
-	chain.SetQueueClient(q.Client())
-	chain.UpgradeChain()
+		log.Info("loading blockchain module")
+		chain = blockchain.New(cfg.BlockChain)
+		chain.SetQueueClient(q.Client())
+		chain.UpgradeChain()
 
-	log.Info("loading consensus module")
-	cs := consensus.New(cfg.Consensus, sub.Consensus)
-	cs.SetQueueClient(q.Client())
+		log.Info("loading consensus module")
+		cs = consensus.New(cfg.Consensus, sub.Consensus)
+		cs.SetQueueClient(q.Client())
+	}
 
 	var network *p2p.P2p
 	if cfg.P2P.Enable {
@@ -165,20 +188,27 @@ func RunChain33(name string) {
 	walletm.SetQueueClient(q.Client())
 	defer func() {
 		//close all module,clean some resource
-		log.Info("begin close blockchain module")
-		chain.Close()
-		log.Info("begin close mempool module")
-		mem.Close()
+		if lc != nil {
+			log.Info("begin close light client module")
+			lc.Close()
+		} else {
+			log.Info("begin close blockchain module")
+			chain.Close()
+			log.Info("begin close mempool module")
+			mem.Close()
+		}
 		if cfg.P2P.Enable {
 			log.Info("begin close P2P module")
 			network.Close()
 		}
 		log.Info("begin close execs module")
 		exec.Close()
-		log.Info("begin close store module")
-		s.Close()
-		log.Info("begin close consensus module")
-		cs.Close()
+		if lc == nil {
+			log.Info("begin close store module")
+			s.Close()
+			log.Info("begin close consensus module")
+			cs.Close()
+		}
 		log.Info("begin close rpc module")
 		rpcapi.Close()
 		log.Info("begin close wallet module")
@@ -52,7 +52,7 @@ enable=false
 isSeed=false
 serverStart=true
 innerSeedEnable=true
-useGithub=true
+enableDHT=true
 innerBounds=300
 msgCacheSize=10240
 driver="memdb"
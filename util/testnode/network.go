@@ -0,0 +1,534 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testnode
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/client"
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+)
+
+//Network 在一个进程中运行多个完整的节点. NewNetwork 创建的节点运行真实的 p2p 模块, 监听本地回环地址上的随机端口,
+//以其他节点为种子节点互相连接; NewSimNetwork 创建的节点通过内存中的模拟网络代替 p2p 模块连接,
+//可以设置链路的延迟, 丢包率以及网络分区, 丢包使用固定的随机数种子, 用于测试同步, 分叉, 孤儿区块和广播
+type Network struct {
+	mtx            sync.Mutex
+	nodes          []*Chain33Mock
+	peers          []*simP2P
+	sim            bool
+	random         *rand.Rand
+	defaultLatency time.Duration
+	defaultLoss    float64
+	latency        map[[2]int]time.Duration
+	loss           map[[2]int]float64
+	//每个节点所在的分区, 不同分区的节点之间不能通信
+	group []int
+}
+
+//ErrNotConverged 节点的最新区块不一致
+var ErrNotConverged = errors.New("ErrNotConverged")
+
+//NewNetwork 创建 n 个使用 p2p 模块连接的节点, 只有第一个节点挖矿, modify 不为 nil 时可以修改每个节点的配置.
+//返回之前第一个节点会挖出高度为 1 的区块并同步到所有节点, 否则其他节点在创世高度需要等待 60 秒才认为已经同步
+func NewNetwork(n int, modify func(index int, cfg *types.Config)) *Network {
+	ports := make([]int32, n)
+	for i := range ports {
+		ports[i] = freePort()
+	}
+	return newNetwork(n, false, 0, func(index int, cfg *types.Config) {
+		cfg.P2P.Enable = true
+		cfg.P2P.ServerStart = true
+		//种子节点认为自己在外网, 不做端口映射
+		cfg.P2P.IsSeed = true
+		cfg.P2P.InnerSeedEnable = false
+		cfg.P2P.DNSSeeds = nil
+		cfg.P2P.Port = ports[index]
+		cfg.P2P.Seeds = nil
+		for i, port := range ports {
+			if i != index {
+				cfg.P2P.Seeds = append(cfg.P2P.Seeds, fmt.Sprintf("127.0.0.1:%d", port))
+			}
+		}
+		if modify != nil {
+			modify(index, cfg)
+		}
+	})
+}
+
+//NewSimNetwork 创建 n 个通过模拟网络连接的节点, seed 为丢包使用的随机数种子, 其他同 NewNetwork
+func NewSimNetwork(n int, seed int64, modify func(index int, cfg *types.Config)) *Network {
+	return newNetwork(n, true, seed, modify)
+}
+
+func newNetwork(n int, sim bool, seed int64, modify func(index int, cfg *types.Config)) *Network {
+	chain33globalLock.Lock()
+	network := &Network{
+		sim:     sim,
+		random:  rand.New(rand.NewSource(seed)),
+		latency: make(map[[2]int]time.Duration),
+		loss:    make(map[[2]int]float64),
+		group:   make([]int, n),
+	}
+	for i := 0; i < n; i++ {
+		cfg, sub := types.InitCfgString(cfgstring)
+		cfg.Consensus.Minerstart = i == 0
+		if modify != nil {
+			modify(i, cfg)
+		}
+		var node *Chain33Mock
+		if sim {
+			peer := &simP2P{network: network, index: i, name: fmt.Sprintf("node%d", i)}
+			node = newWithConfigNoLock(cfg, sub, nil, peer)
+			network.mtx.Lock()
+			network.peers = append(network.peers, peer)
+			network.mtx.Unlock()
+		} else {
+			node = newWithConfigNoLock(cfg, sub, nil, nil)
+		}
+		network.mtx.Lock()
+		network.nodes = append(network.nodes, node)
+		network.mtx.Unlock()
+	}
+	//p2p 节点之间连接上之后再挖矿, 否则广播的区块会丢失, 落后一个高度的节点不会主动同步
+	if !sim {
+		if err := network.waitPeers(time.Minute); err != nil {
+			panic(err)
+		}
+	}
+	node := network.nodes[0]
+	node.SendTx(util.CreateCoinsTx(node.GetGenesisKey(), node.GetHotAddress(), 10000*types.Coin))
+	if err := network.WaitHeight(1, time.Minute); err != nil {
+		panic(err)
+	}
+	return network
+}
+
+//waitPeers 等待每个节点都连接了其他所有节点
+func (network *Network) waitPeers(timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		var counts []int
+		connected := true
+		for _, node := range network.nodes {
+			var count int
+			peers, err := node.GetAPI().PeerInfo()
+			if err == nil {
+				for _, peer := range peers.GetPeers() {
+					if !peer.GetSelf() {
+						count++
+					}
+				}
+			}
+			if count < len(network.nodes)-1 {
+				connected = false
+			}
+			counts = append(counts, count)
+		}
+		if connected {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("wait peers timeout, peers %v", counts)
+		}
+		time.Sleep(time.Second / 10)
+	}
+}
+
+//freePort 本地回环地址上没有使用的端口
+func freePort() int32 {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(err)
+	}
+	defer l.Close()
+	return int32(l.Addr().(*net.TCPAddr).Port)
+}
+
+//Node 第 i 个节点
+func (network *Network) Node(i int) *Chain33Mock {
+	return network.nodes[i]
+}
+
+//Size 节点个数
+func (network *Network) Size() int {
+	return len(network.nodes)
+}
+
+//Close 关闭所有节点
+func (network *Network) Close() {
+	for _, node := range network.nodes {
+		node.closeNoLock()
+	}
+	chain33globalLock.Unlock()
+}
+
+//mustSim 链路的延迟, 丢包和分区只能在模拟网络中设置
+func (network *Network) mustSim() {
+	if !network.sim {
+		panic("testnode: link settings need NewSimNetwork")
+	}
+}
+
+func linkKey(a, b int) [2]int {
+	if a > b {
+		a, b = b, a
+	}
+	return [2]int{a, b}
+}
+
+//SetLatency 设置所有链路的默认延迟
+func (network *Network) SetLatency(delay time.Duration) {
+	network.mustSim()
+	network.mtx.Lock()
+	defer network.mtx.Unlock()
+	network.defaultLatency = delay
+}
+
+//SetLinkLatency 设置节点 a 和 b 之间的延迟
+func (network *Network) SetLinkLatency(a, b int, delay time.Duration) {
+	network.mustSim()
+	network.mtx.Lock()
+	defer network.mtx.Unlock()
+	network.latency[linkKey(a, b)] = delay
+}
+
+//SetLoss 设置所有链路的默认丢包率, 取值 0 到 1
+func (network *Network) SetLoss(rate float64) {
+	network.mustSim()
+	network.mtx.Lock()
+	defer network.mtx.Unlock()
+	network.defaultLoss = rate
+}
+
+//SetLinkLoss 设置节点 a 和 b 之间的丢包率
+func (network *Network) SetLinkLoss(a, b int, rate float64) {
+	network.mustSim()
+	network.mtx.Lock()
+	defer network.mtx.Unlock()
+	network.loss[linkKey(a, b)] = rate
+}
+
+//Partition 把节点分成几个分区, 没有列出的节点单独在一个分区
+func (network *Network) Partition(groups ...[]int) {
+	network.mustSim()
+	network.mtx.Lock()
+	defer network.mtx.Unlock()
+	for i := range network.group {
+		network.group[i] = -1 - i
+	}
+	for id, group := range groups {
+		for _, i := range group {
+			network.group[i] = id
+		}
+	}
+}
+
+//Heal 取消网络分区
+func (network *Network) Heal() {
+	network.mustSim()
+	network.mtx.Lock()
+	defer network.mtx.Unlock()
+	for i := range network.group {
+		network.group[i] = 0
+	}
+}
+
+//connected 两个节点是否在同一个分区
+func (network *Network) connected(a, b int) bool {
+	network.mtx.Lock()
+	defer network.mtx.Unlock()
+	return a != b && a < len(network.peers) && b < len(network.peers) && network.group[a] == network.group[b]
+}
+
+//link 一次发送的延迟, 分区或者丢包时返回 false
+func (network *Network) link(from, to int) (time.Duration, bool) {
+	if !network.connected(from, to) {
+		return 0, false
+	}
+	network.mtx.Lock()
+	defer network.mtx.Unlock()
+	key := linkKey(from, to)
+	loss, ok := network.loss[key]
+	if !ok {
+		loss = network.defaultLoss
+	}
+	if loss > 0 && network.random.Float64() < loss {
+		return 0, false
+	}
+	delay, ok := network.latency[key]
+	if !ok {
+		delay = network.defaultLatency
+	}
+	return delay, true
+}
+
+//send 经过链路的延迟之后在 to 节点上执行 fn
+func (network *Network) send(from, to int, fn func()) {
+	delay, ok := network.link(from, to)
+	if !ok {
+		return
+	}
+	if delay <= 0 {
+		go fn()
+		return
+	}
+	time.AfterFunc(delay, fn)
+}
+
+//StartMining 开始挖矿, solo 共识在 mempool 中有交易时才会打包区块
+func (network *Network) StartMining(i int) error {
+	return network.setMining(i, types.EventMinerStart)
+}
+
+//StopMining 停止挖矿
+func (network *Network) StopMining(i int) error {
+	return network.setMining(i, types.EventMinerStop)
+}
+
+func (network *Network) setMining(i int, ty int64) error {
+	cli := network.nodes[i].GetClient()
+	msg := cli.NewMessage("consensus", ty, nil)
+	if err := cli.Send(msg, true); err != nil {
+		return err
+	}
+	resp, err := cli.Wait(msg)
+	if err != nil {
+		return err
+	}
+	if err, ok := resp.GetData().(error); ok && err != nil {
+		return err
+	}
+	return nil
+}
+
+//Headers 所有节点的最新区块头
+func (network *Network) Headers() ([]*types.Header, error) {
+	var headers []*types.Header
+	for _, node := range network.nodes {
+		header, err := node.GetAPI().GetLastHeader()
+		if err != nil {
+			return nil, err
+		}
+		headers = append(headers, header)
+	}
+	return headers, nil
+}
+
+//Heights 所有节点的高度
+func (network *Network) Heights() []int64 {
+	heights := make([]int64, len(network.nodes))
+	headers, err := network.Headers()
+	if err != nil {
+		return heights
+	}
+	for i, header := range headers {
+		heights[i] = header.GetHeight()
+	}
+	return heights
+}
+
+//CheckConverged 检查所有节点的最新区块的高度, 哈希以及状态哈希是否一致
+func (network *Network) CheckConverged() error {
+	headers, err := network.Headers()
+	if err != nil {
+		return err
+	}
+	for i, header := range headers[1:] {
+		if header.GetHeight() != headers[0].GetHeight() || !bytes.Equal(header.GetHash(), headers[0].GetHash()) ||
+			!bytes.Equal(header.GetStateHash(), headers[0].GetStateHash()) {
+			return fmt.Errorf("%s: node0 height %d hash %s, node%d height %d hash %s", ErrNotConverged, headers[0].GetHeight(),
+				common.ToHex(headers[0].GetHash()), i+1, header.GetHeight(), common.ToHex(header.GetHash()))
+		}
+	}
+	return nil
+}
+
+//WaitConverged 等待所有节点的最新区块一致, 超时返回最后一次检查的错误
+func (network *Network) WaitConverged(timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		err := network.CheckConverged()
+		if err == nil || time.Now().After(deadline) {
+			return err
+		}
+		time.Sleep(time.Second / 10)
+	}
+}
+
+//WaitHeight 等待所有节点的高度都不低于 height
+func (network *Network) WaitHeight(height int64, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		heights := network.Heights()
+		reached := true
+		for _, h := range heights {
+			if h < height {
+				reached = false
+			}
+		}
+		if reached {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("wait height %d timeout, heights %v", height, heights)
+		}
+		time.Sleep(time.Second / 10)
+	}
+}
+
+//simP2P 代替 p2p 模块, 通过 Network 在节点之间转发交易和区块
+type simP2P struct {
+	network *Network
+	index   int
+	name    string
+	client  queue.Client
+	api     client.QueueProtocolAPI
+}
+
+//SetQueueClient :
+func (m *simP2P) SetQueueClient(cli queue.Client) {
+	m.client = cli
+	m.api, _ = client.New(cli, nil)
+	cli.Sub("p2p")
+	go func() {
+		for msg := range cli.Recv() {
+			//处理时会访问其他节点, 不能阻塞消息循环
+			go m.handle(msg)
+		}
+	}()
+}
+
+func (m *simP2P) handle(msg queue.Message) {
+	switch msg.Ty {
+	case types.EventTxBroadcast:
+		tx := msg.GetData().(*types.Transaction)
+		for _, peer := range m.connectedPeers() {
+			m.network.send(m.index, peer.index, func() {
+				peer.client.Send(peer.client.NewMessage("mempool", types.EventTx, tx), false)
+			})
+		}
+	case types.EventBlockBroadcast:
+		block := msg.GetData().(*types.Block)
+		for _, peer := range m.connectedPeers() {
+			m.network.send(m.index, peer.index, func() {
+				peer.client.Send(peer.client.NewMessage("blockchain", types.EventBroadcastAddBlock, &types.BlockPid{Pid: m.name, Block: block}), false)
+			})
+		}
+	case types.EventPeerInfo:
+		msg.Reply(m.client.NewMessage("blockchain", types.EventPeerList, &types.PeerList{Peers: m.peerInfos()}))
+	case types.EventGetNetInfo:
+		netinfo := &types.NodeNetInfo{Externaladdr: m.name, Localaddr: m.name, Outbounds: int32(len(m.connectedPeers()))}
+		msg.Reply(m.client.NewMessage("rpc", types.EventReplyNetInfo, netinfo))
+	case types.EventFetchBlocks:
+		req := msg.GetData().(*types.ReqBlocks)
+		peer := m.selectPeer(req)
+		if peer == nil {
+			msg.Reply(m.client.NewMessage("blockchain", types.EventReply, types.Reply{Msg: []byte("no peers")}))
+			return
+		}
+		msg.Reply(m.client.NewMessage("blockchain", types.EventReply, types.Reply{IsOk: true, Msg: []byte("downloading...")}))
+		m.network.send(m.index, peer.index, func() {
+			blocks, err := peer.api.GetBlocks(&types.ReqBlocks{Start: req.GetStart(), End: req.GetEnd()})
+			if err != nil {
+				return
+			}
+			m.network.send(peer.index, m.index, func() {
+				for _, item := range blocks.GetItems() {
+					newmsg := m.client.NewMessage("blockchain", types.EventSyncBlock, &types.BlockPid{Pid: peer.name, Block: item.GetBlock()})
+					m.client.SendTimeout(newmsg, false, time.Minute)
+				}
+			})
+		})
+	case types.EventFetchBlockHeaders:
+		req := msg.GetData().(*types.ReqBlocks)
+		peer := m.selectPeer(req)
+		if peer == nil || len(req.GetPid()) == 0 {
+			msg.Reply(m.client.NewMessage("blockchain", types.EventReply, types.Reply{Msg: []byte("no pid")}))
+			return
+		}
+		msg.Reply(m.client.NewMessage("blockchain", types.EventReply, types.Reply{IsOk: true, Msg: []byte("ok")}))
+		m.network.send(m.index, peer.index, func() {
+			headers, err := peer.api.GetHeaders(&types.ReqBlocks{Start: req.GetStart(), End: req.GetEnd()})
+			if err != nil {
+				return
+			}
+			m.network.send(peer.index, m.index, func() {
+				newmsg := m.client.NewMessage("blockchain", types.EventAddBlockHeaders, &types.HeadersPid{Pid: peer.name, Headers: headers})
+				m.client.Send(newmsg, false)
+			})
+		})
+	default:
+		msg.ReplyErr("p2p->Do not support "+types.GetEventName(int(msg.Ty)), types.ErrNotSupport)
+	}
+}
+
+//connectedPeers 和本节点在同一个分区的节点
+func (m *simP2P) connectedPeers() []*simP2P {
+	m.network.mtx.Lock()
+	peers := m.network.peers
+	m.network.mtx.Unlock()
+	var connected []*simP2P
+	for _, peer := range peers {
+		if m.network.connected(m.index, peer.index) {
+			connected = append(connected, peer)
+		}
+	}
+	return connected
+}
+
+//peerInfos 连接的节点以及本节点的最新区块头
+func (m *simP2P) peerInfos() []*types.Peer {
+	var peers []*types.Peer
+	for _, peer := range m.connectedPeers() {
+		header, err := peer.api.GetLastHeader()
+		if err != nil {
+			continue
+		}
+		peers = append(peers, &types.Peer{Addr: peer.name, Name: peer.name, Header: header})
+	}
+	header, err := m.api.GetLastHeader()
+	if err == nil {
+		peers = append(peers, &types.Peer{Addr: m.name, Name: m.name, Self: true, Header: header})
+	}
+	return peers
+}
+
+//selectPeer 优先使用请求中指定的节点, 否则选择高度足够的节点
+func (m *simP2P) selectPeer(req *types.ReqBlocks) *simP2P {
+	peers := m.connectedPeers()
+	for _, pid := range req.GetPid() {
+		for _, peer := range peers {
+			if peer.name == pid {
+				return peer
+			}
+		}
+	}
+	if len(req.GetPid()) > 0 && req.GetPid()[0] != "" {
+		return nil
+	}
+	for _, peer := range peers {
+		header, err := peer.api.GetLastHeader()
+		if err == nil && header.GetHeight() >= req.GetEnd() {
+			return peer
+		}
+	}
+	return nil
+}
+
+//Wait for ready
+func (m *simP2P) Wait() {}
+
+//Close :
+func (m *simP2P) Close() {}
@@ -0,0 +1,56 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testnode
+
+import (
+	"testing"
+	"time"
+
+	_ "github.com/33cn/chain33/system"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetwork(t *testing.T) {
+	network := NewNetwork(3, nil)
+	defer network.Close()
+	node := network.Node(0)
+	assert.Nil(t, network.WaitConverged(time.Minute))
+
+	//通过 p2p 模块广播交易和区块
+	node.SendTx(util.CreateCoinsTx(node.GetHotKey(), node.GetGenesisAddress(), types.Coin))
+	assert.Nil(t, network.WaitHeight(2, time.Minute))
+	assert.Nil(t, network.WaitConverged(time.Minute))
+	assert.Panics(t, func() { network.Heal() })
+}
+
+func TestSimNetwork(t *testing.T) {
+	network := NewSimNetwork(3, 1, nil)
+	defer network.Close()
+	network.SetLatency(10 * time.Millisecond)
+	node := network.Node(0)
+	assert.Nil(t, network.WaitConverged(10*time.Second))
+
+	//广播交易和区块
+	node.SendTx(util.CreateCoinsTx(node.GetHotKey(), node.GetGenesisAddress(), types.Coin))
+	assert.Nil(t, network.WaitHeight(2, 10*time.Second))
+	assert.Nil(t, network.WaitConverged(10*time.Second))
+
+	//分区之后 node2 收不到新的区块, 恢复之后从其他节点同步落后的区块
+	network.Partition([]int{0, 1}, []int{2})
+	network.SetLinkLoss(0, 1, 0.5)
+	for i := int64(1); i <= 2; i++ {
+		node.SendTx(util.CreateCoinsTx(node.GetHotKey(), node.GetGenesisAddress(), i*types.Coin))
+		assert.Nil(t, node.WaitHeight(2+i))
+	}
+	assert.Equal(t, int64(2), network.Heights()[2])
+	assert.NotNil(t, network.CheckConverged())
+
+	network.Heal()
+	network.SetLinkLoss(0, 1, 0)
+	assert.Nil(t, network.WaitConverged(time.Minute))
+	assert.Equal(t, []int64{4, 4, 4}, network.Heights())
+}
@@ -80,6 +80,11 @@ func NewWithConfig(cfg *types.Config, sub *types.ConfigSubModule, mockapi client
 
 func newWithConfig(cfg *types.Config, sub *types.ConfigSubModule, mockapi client.QueueProtocolAPI) *Chain33Mock {
 	chain33globalLock.Lock()
+	return newWithConfigNoLock(cfg, sub, mockapi, nil)
+}
+
+//network 不为 nil 时使用 network 代替 p2p 模块
+func newWithConfigNoLock(cfg *types.Config, sub *types.ConfigSubModule, mockapi client.QueueProtocolAPI, network queue.Module) *Chain33Mock {
 	types.Init(cfg.Title, cfg)
 	q := queue.New("channel")
 	types.Debug = false
@@ -107,7 +112,10 @@ func newWithConfig(cfg *types.Config, sub *types.ConfigSubModule, mockapi client
 	mock.mem.SetQueueClient(q.Client())
 	mock.mem.Wait()
 	lognode.Info("init mempool")
-	if cfg.P2P.Enable {
+	if network != nil {
+		mock.network = network
+		mock.network.SetQueueClient(q.Client())
+	} else if cfg.P2P.Enable {
 		mock.network = p2p.New(cfg.P2P)
 		mock.network.SetQueueClient(q.Client())
 	} else {
@@ -247,6 +255,11 @@ func (mock *Chain33Mock) GetCfg() *types.Config {
 
 //Close :
 func (mock *Chain33Mock) Close() {
+	mock.closeNoLock()
+	chain33globalLock.Unlock()
+}
+
+func (mock *Chain33Mock) closeNoLock() {
 	mock.chain.Close()
 	mock.store.Close()
 	mock.mem.Close()
@@ -256,7 +269,6 @@ func (mock *Chain33Mock) Close() {
 	mock.network.Close()
 	mock.client.Close()
 	mock.rpc.Close()
-	chain33globalLock.Unlock()
 }
 
 //WaitHeight :